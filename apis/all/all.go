@@ -23,6 +23,11 @@ import (
 	_ "github.com/kuidio/kuid/apis/backend/vlan/register"
 	_ "github.com/kuidio/kuid/apis/backend/genid/register"
 	_ "github.com/kuidio/kuid/apis/backend/extcomm/register"
+	_ "github.com/kuidio/kuid/apis/backend/esi/register"
+	_ "github.com/kuidio/kuid/apis/backend/quota/register"
+	_ "github.com/kuidio/kuid/apis/backend/rd/register"
+	_ "github.com/kuidio/kuid/apis/backend/label/register"
+	_ "github.com/kuidio/kuid/apis/backend/community/register"
 	
 )
 
@@ -29,6 +29,10 @@ const (
 	KuidClaimUIDKey  = "be.kuid.dev/claim-uid"
 	KuidClaimTypeKey = "be.kuid.dev/claim-type"
 	KuidIndexEntryKey = "be.kuid.dev/index-entry"
+	// index templates
+	KuidIndexTemplateKey  = "be.kuid.dev/index-template"   // template from which the index was generated
+	KuidIndexOwnerKindKey = "be.kuid.dev/index-owner-kind" // kind of the infra resource the index was generated for
+	KuidIndexOwnerNameKey = "be.kuid.dev/index-owner-name" // name of the infra resource the index was generated for
 	// system defined ipam
 	KuidIPAMIPPrefixTypeKey     = "ipam.be.kuid.dev/ipprefix-type"
 	KuidIPAMClaimSummaryTypeKey = "ipam.be.kuid.dev/claim-summary-type" // used for easy lookup
@@ -56,6 +60,7 @@ const (
 	KuidINVLinkTypeKey         = "infra.be.kuid.dev/link-type"
 	KuidINVPurpose             = "infra.be.kuid.dev/purpose"
 	KuidINVExclude             = "infra.be.kuid.dev/exclude"
+	KuidINVPlatformProfileKey  = "infra.be.kuid.dev/platform-profile" // profile from which the resource was generated
 	// Network Inventory
 	KuidINVNetworkDeviceType   = "network.infra.be.kuid.dev/device-type"     // edge, core or maybe p and pe
 	KuidINVNetworkLinkBFD      = "link.network.infra.be.kuid.dev/bfd"        // enable or disable true or false
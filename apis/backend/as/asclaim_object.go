@@ -23,7 +23,6 @@ import (
 	"strings"
 
 	"github.com/henderiw/idxtable/pkg/table"
-	"github.com/henderiw/idxtable/pkg/table/table32"
 	"github.com/henderiw/idxtable/pkg/tree"
 	"github.com/henderiw/idxtable/pkg/tree/id32"
 	"github.com/henderiw/store"
@@ -94,45 +93,44 @@ func (r *ASClaim) ValidateASRange() error {
 	if r.Spec.Range == nil {
 		return fmt.Errorf("no AS range provided")
 	}
-	parts := strings.SplitN(*r.Spec.Range, "-", 2)
-	if len(parts) != 2 {
-		return fmt.Errorf("invalid AS range, expected <start>-<end>, got: %s", *r.Spec.Range)
-	}
 	var errm error
 	if r.Name == r.Spec.Index {
 		// to be able to check if the entry is reserved we get a parentname (rang name) equal to index
 		// this is because the ownerreference uses the name of the index in its labels in the cache
 		errm = errors.Join(errm, fmt.Errorf("a name of range cannot be the same as the index"))
 	}
-	start, err := strconv.Atoi(parts[0])
-	if err != nil {
-		errm = errors.Join(errm, fmt.Errorf("invalid AS range start, got: %s, err: %s", *r.Spec.Range, err.Error()))
-	}
-	end, err := strconv.Atoi(parts[1])
-	if err != nil {
-		errm = errors.Join(errm, fmt.Errorf("invalid AS range end, got: %s, err: %s", *r.Spec.Range, err.Error()))
+	segments := []backend.IDRange{}
+	for _, segment := range backend.GetRangeSegments(*r.Spec.Range) {
+		start, end, err := ParseASRange(segment)
+		if err != nil {
+			errm = errors.Join(errm, err)
+			continue
+		}
+		if start > end {
+			errm = errors.Join(errm, fmt.Errorf("invalid AS range start > end %s", segment))
+			continue
+		}
+		segments = append(segments, backend.IDRange{From: uint64(start), To: uint64(end)})
 	}
 	if errm != nil {
 		return errm
 	}
-	if start > end {
-		errm = errors.Join(errm, fmt.Errorf("invalid AS range start > end %s", *r.Spec.Range))
-	}
-	if err := validateASID(start); err != nil {
-		errm = errors.Join(errm, fmt.Errorf("invalid AS start err %s", err.Error()))
-	}
-	if err := validateASID(end); err != nil {
-		errm = errors.Join(errm, fmt.Errorf("invalid AS end err %s", err.Error()))
-	}
-	return errm
+	return backend.ValidateIDRanges(segments)
 }
 
 func (r *ASClaim) ValidateASID() error {
-	if r.Spec.ID == nil {
+	if r.Spec.ID == nil && r.Spec.ASN == nil {
 		return fmt.Errorf("no id provided")
 	}
-	if err := validateASID(int(*r.Spec.ID)); err != nil {
-		return fmt.Errorf("invalid id err %s", err.Error())
+	if r.Spec.ID != nil {
+		if err := validateASID(int(*r.Spec.ID)); err != nil {
+			return fmt.Errorf("invalid id err %s", err.Error())
+		}
+	}
+	if r.Spec.ASN != nil {
+		if _, err := ParseASN(*r.Spec.ASN); err != nil {
+			return fmt.Errorf("invalid asn err %s", err.Error())
+		}
 	}
 	return nil
 }
@@ -144,6 +142,14 @@ func (r *ASClaim) ValidateASClaimType() error {
 		sb.WriteString(fmt.Sprintf("id: %d", *r.Spec.ID))
 		count++
 
+	}
+	if r.Spec.ASN != nil {
+		if count > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("asn: %s", *r.Spec.ASN))
+		count++
+
 	}
 	if r.Spec.Range != nil {
 		if count > 0 {
@@ -225,7 +231,7 @@ func (r *ASClaim) ValidateOwner(labels labels.Set) error {
 func (r *ASClaim) GetClaimType() backend.ClaimType {
 	claimType := backend.ClaimType_Invalid
 	count := 0
-	if r.Spec.ID != nil {
+	if r.Spec.ID != nil || r.Spec.ASN != nil {
 		claimType = backend.ClaimType_StaticID
 		count++
 
@@ -243,17 +249,35 @@ func (r *ASClaim) GetClaimType() backend.ClaimType {
 	}
 	return claimType
 }
+
+// getStaticID returns the AS of the id or the asn of the claim
+func (r *ASClaim) getStaticID() *uint32 {
+	if r.Spec.ID != nil {
+		return r.Spec.ID
+	}
+	if r.Spec.ASN != nil {
+		asn, err := ParseASN(*r.Spec.ASN)
+		if err != nil {
+			return nil
+		}
+		return ptr.To[uint32](asn)
+	}
+	return nil
+}
+
 func (r *ASClaim) GetStaticID() *uint64 {
-	if r.Spec.ID == nil {
+	id := r.getStaticID()
+	if id == nil {
 		return nil
 	}
-	return ptr.To[uint64](uint64(*r.Spec.ID))
+	return ptr.To[uint64](uint64(*id))
 }
 func (r *ASClaim) GetStaticTreeID(t string) tree.ID {
-	if r.Spec.ID == nil {
+	id := r.getStaticID()
+	if id == nil {
 		return nil
 	}
-	return id32.NewID(*r.Spec.ID, id32.IDBitSize)
+	return id32.NewID(*id, id32.IDBitSize)
 }
 
 func (r *ASClaim) GetClaimID(t string, id uint64) tree.ID {
@@ -264,34 +288,46 @@ func (r *ASClaim) GetStatusClaimID(_ string) tree.ID {
 	if r.Status.ID == nil {
 		return nil
 	}
-	return id32.NewID(uint32(*r.Status.ID), id32.IDBitSize) 
+	return id32.NewID(uint32(*r.Status.ID), id32.IDBitSize)
 }
 
+// GetRange returns the range of the claim in asplain notation
 func (r *ASClaim) GetRange() *string {
-	return r.Spec.Range
+	if r.Spec.Range == nil {
+		return nil
+	}
+	return ptr.To[string](getASPlainRange(*r.Spec.Range))
 }
 
-func (r *ASClaim) GetRangeID(_ string) (tree.Range, error) {
+func (r *ASClaim) GetRangeIDs(_ string) ([]tree.Range, error) {
 	if r.Spec.Range == nil {
 		return nil, fmt.Errorf("cannot provide a range without an id")
 	}
-	return id32.ParseRange(*r.Spec.Range)
+	return backend.ParseRangeSegments(getASPlainRange(*r.Spec.Range), id32.ParseRange)
 }
 
-func (r *ASClaim) GetTable(_ string, to, from uint64) table.Table {
-	return table32.New(uint32(to), uint32(from))
+func (r *ASClaim) GetTable(_ string, ranges []backend.IDRange) table.Table {
+	return backend.NewRangeTable(ranges, func(id uint64) tree.ID {
+		return id32.NewID(uint32(id), id32.IDBitSize)
+	})
 }
 
 func (r *ASClaim) SetStatusRange(s *string) {
 	r.Status.Range = s
+	r.Status.ASDot = nil
+	if s != nil {
+		r.Status.ASDot = ptr.To[string](getASDotRange(*s))
+	}
 }
 
 func (r *ASClaim) SetStatusID(s *uint64) {
 	if s == nil {
 		r.Status.ID = nil
+		r.Status.ASDot = nil
 		return
 	}
 	r.Status.ID = ptr.To[uint32](uint32(*s))
+	r.Status.ASDot = ptr.To[string](getASDot(uint32(*s)))
 }
 
 func (r *ASClaim) GetStatusID() *uint64 {
@@ -302,15 +338,11 @@ func (r *ASClaim) GetStatusID() *uint64 {
 }
 
 func (r *ASClaim) GetClaimRequest() string {
-	if r.Spec.ID != nil {
-		return getASDot(*r.Spec.ID)
+	if id := r.getStaticID(); id != nil {
+		return getASDot(*id)
 	}
 	if r.Spec.Range != nil {
-		range32, err := id32.ParseRange(*r.Spec.Range)
-		if err != nil {
-			return *r.Spec.Range
-		}
-		return fmt.Sprintf("%s-%s", getASDot(uint32(range32.From().ID())), getASDot(uint32(range32.To().ID())))
+		return getASDotRange(*r.Spec.Range)
 	}
 	return ""
 }
@@ -320,26 +352,35 @@ func (r *ASClaim) GetClaimResponse() string {
 		return getASDot(*r.Status.ID)
 	}
 	if r.Status.Range != nil {
-		range32, err := id32.ParseRange(*r.Status.Range)
-		if err != nil {
-			return *r.Status.Range
-		}
-		return fmt.Sprintf("%s-%s", getASDot(uint32(range32.From().ID())), getASDot(uint32(range32.To().ID())))
+		return getASDotRange(*r.Status.Range)
+	}
+	return ""
+}
+
+// GetClaimResponseASPlain returns the claimed AS or AS range in asplain notation
+func (r *ASClaim) GetClaimResponseASPlain() string {
+	if r.Status.ID != nil {
+		return strconv.FormatUint(uint64(*r.Status.ID), 10)
+	}
+	if r.Status.Range != nil {
+		return getASPlainRange(*r.Status.Range)
 	}
 	return ""
 }
 
 func (r *ASClaim) GetClaimSet(typ string) (map[string]tree.ID, sets.Set[string], error) {
-	arange, err := r.GetRangeID(typ)
+	aranges, err := r.GetRangeIDs(typ)
 	if err != nil {
 		return nil, nil, fmt.Errorf("cannot get range from claim: %v", err)
 	}
 	// claim set represents the new entries
 	newClaimSet := sets.New[string]()
 	newClaimMap := map[string]tree.ID{}
-	for _, rangeID := range arange.IDs() {
-		newClaimSet.Insert(rangeID.String())
-		newClaimMap[rangeID.String()] = rangeID
+	for _, arange := range aranges {
+		for _, rangeID := range arange.IDs() {
+			newClaimSet.Insert(rangeID.String())
+			newClaimMap[rangeID.String()] = rangeID
+		}
 	}
 	return newClaimMap, newClaimSet, nil
 }
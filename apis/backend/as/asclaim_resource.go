@@ -185,6 +185,7 @@ func (r *ASClaim) TableConvertor() func(gr schema.GroupResource) rest.TableConve
 					string(claim.GetClaimType()),
 					claim.GetClaimRequest(),
 					claim.GetClaimResponse(),
+					claim.GetClaimResponseASPlain(),
 				}
 			},
 			[]metav1.TableColumnDefinition{
@@ -194,6 +195,7 @@ func (r *ASClaim) TableConvertor() func(gr schema.GroupResource) rest.TableConve
 			{Name: "ClaimType", Type: "string"},
 			{Name: "ClaimReq", Type: "string"},
 			{Name: "ClaimRsp", Type: "string"},
+			{Name: "ASPlain", Type: "string"},
 			},
 		)
 	}
@@ -38,6 +38,10 @@ type ASEntrySpec struct {
 	// ClaimLabels define the user defined labels and selector labels used
 	// in resource claim
 	common.ClaimLabels `json:",inline" protobuf:"bytes,5,opt,name=claimLabels"`
+	// Count defines the number of ids the entry holds when the entry is a contiguous run
+	// of ids claimed by a range
+	// +optional
+	Count uint64 `json:"count,omitempty" protobuf:"varint,6,opt,name=count"`
 }
 
 // ASEntryStatus defines the observed state of ASEntry
@@ -65,6 +65,15 @@ func (r *ASIndex) ValidateSyntax(s string) field.ErrorList {
 			))
 		}
 	}
+	for i, claim := range r.Spec.Claims {
+		if errs := r.GetClaim(claim).ValidateSyntax(s); len(errs) != 0 {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.claims").Index(i),
+				r,
+				fmt.Errorf("invalid claim %s: %s", claim.Name, errs.ToAggregate().Error()).Error(),
+			))
+		}
+	}
 	return allErrs
 }
 
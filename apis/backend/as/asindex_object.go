@@ -33,6 +33,7 @@ import (
 )
 
 var _ backend.IndexObject = &ASIndex{}
+var _ backend.DynamicRangeIndexObject = &ASIndex{}
 
 func (r *ASIndex) GetKey() store.Key {
 	return store.KeyFromNSN(r.GetNamespacedName())
@@ -105,6 +106,9 @@ func (r *ASIndex) GetClaims() []backend.ClaimObject {
 	if r.GetMaxID() != nil && *r.GetMaxID() != r.GetMax() {
 		claims = append(claims, r.GetMaxClaim())
 	}
+	if !r.Spec.AllowReserved {
+		claims = append(claims, r.GetReservedClaims()...)
+	}
 	for _, claim := range r.Spec.Claims {
 		claims = append(claims, r.GetClaim(claim))
 	}
@@ -155,6 +159,53 @@ func (r *ASIndex) GetMaxClaim() backend.ClaimObject {
 	)
 }
 
+// GetReservedClaims returns the claims of the reserved ASs within the min and max ID of the
+// index, they are clipped to the min and max ID
+func (r *ASIndex) GetReservedClaims() []backend.ClaimObject {
+	minID, maxID := uint32(ASID_Min), uint32(ASID_Max)
+	if r.Spec.MinID != nil {
+		minID = *r.Spec.MinID
+	}
+	if r.Spec.MaxID != nil {
+		maxID = *r.Spec.MaxID
+	}
+	claims := []backend.ClaimObject{}
+	for _, reserved := range ASReservedRanges {
+		if reserved.To < minID || reserved.From > maxID {
+			continue
+		}
+		claims = append(claims, BuildASClaim(
+			metav1.ObjectMeta{
+				Namespace: r.GetNamespace(),
+				Name:      fmt.Sprintf("%s.%s-%s", r.Name, backend.IndexReservedName, reserved.Name),
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+						Kind:       ASIndexKind,
+						Name:       r.Name,
+						UID:        r.UID,
+					},
+				},
+			},
+			&ASClaimSpec{
+				Index: r.Name,
+				Range: ptr.To[string](fmt.Sprintf("%d-%d", max(reserved.From, minID), min(reserved.To, maxID))),
+			},
+			nil,
+		))
+	}
+	return claims
+}
+
+// GetDynamicRanges returns the private ASs when the index restricts the dynamic claims to
+// the private ASs
+func (r *ASIndex) GetDynamicRanges() []backend.IDRange {
+	if !r.Spec.PrivateOnly {
+		return nil
+	}
+	return ASPrivateRanges
+}
+
 func (r *ASIndex) GetClaim(claim ASIndexClaim) backend.ClaimObject {
 	spec := &ASClaimSpec{
 		Index:       r.Name,
@@ -162,9 +213,12 @@ func (r *ASIndex) GetClaim(claim ASIndexClaim) backend.ClaimObject {
 			UserDefinedLabels: claim.UserDefinedLabels,
 		},
 	}
-	if claim.ID != nil {
+	switch {
+	case claim.ID != nil:
 		spec.ID = claim.ID
-	} else {
+	case claim.ASN != nil:
+		spec.ASN = claim.ASN
+	default:
 		spec.Range = claim.Range
 	}
 	return BuildASClaim(
@@ -19,6 +19,9 @@ package as
 import (
 	"fmt"
 	"strconv"
+	"strings"
+
+	"github.com/kuidio/kuid/apis/backend"
 )
 
 const ASID_Min = 0
@@ -35,10 +38,97 @@ func validateASID(id int) error {
 }
 
 func getASDot(asn uint32) string {
-	if asn > 65536 {
+	if asn >= 65536 {
 		a := asn / 65536
 		b := asn - (a * 65536)
 		return fmt.Sprintf("%d.%d", a, b)
 	}
 	return strconv.Itoa(int(asn))
 }
+
+// ASReservedRange defines a range of ASs that are reserved or used for documentation
+type ASReservedRange struct {
+	Name string
+	From uint32
+	To   uint32
+}
+
+// ASReservedRanges define the ASs an index reserves unless it allows the reserved ASs
+var ASReservedRanges = []ASReservedRange{
+	{Name: "zero", From: 0, To: 0},                     // RFC7607
+	{Name: "astrans", From: 23456, To: 23456},          // RFC6793
+	{Name: "doc16", From: 64496, To: 64511},            // RFC5398
+	{Name: "last16", From: 65535, To: 65535},           // RFC7300
+	{Name: "doc32", From: 65536, To: 65551},            // RFC5398
+	{Name: "last32", From: 4294967295, To: 4294967295}, // RFC7300
+}
+
+// ASPrivateRanges define the private ASs (RFC6996)
+var ASPrivateRanges = []backend.IDRange{
+	{From: 64512, To: 65534},
+	{From: 4200000000, To: 4294967294},
+}
+
+// ParseASN parses an AS in asplain (4259840100) or asdot (65000.100) notation
+func ParseASN(s string) (uint32, error) {
+	high, low, ok := strings.Cut(s, ".")
+	if !ok {
+		asn, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("invalid AS %s, expected asplain or asdot notation", s)
+		}
+		return uint32(asn), nil
+	}
+	h, err := strconv.ParseUint(high, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid AS %s, the high order value of asdot must be between 0 and 65535", s)
+	}
+	l, err := strconv.ParseUint(low, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid AS %s, the low order value of asdot must be between 0 and 65535", s)
+	}
+	return uint32(h)<<16 | uint32(l), nil
+}
+
+// ParseASRange parses an AS range <start>-<end>, the ASs are in asplain or asdot notation
+func ParseASRange(s string) (uint32, uint32, error) {
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid AS range, expected <start>-<end>, got: %s", s)
+	}
+	start, err := ParseASN(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid AS range start, got: %s, err: %s", s, err.Error())
+	}
+	end, err := ParseASN(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid AS range end, got: %s, err: %s", s, err.Error())
+	}
+	return start, end, nil
+}
+
+// getASPlainRange returns the range in asplain notation, the segments of the range are
+// returned as is when they cannot be parsed
+func getASPlainRange(s string) string {
+	segments := backend.GetRangeSegments(s)
+	for i, segment := range segments {
+		start, end, err := ParseASRange(segment)
+		if err != nil {
+			continue
+		}
+		segments[i] = fmt.Sprintf("%d-%d", start, end)
+	}
+	return strings.Join(segments, backend.RangeSegmentSeparator)
+}
+
+func getASDotRange(s string) string {
+	segments := backend.GetRangeSegments(s)
+	for i, segment := range segments {
+		start, end, err := ParseASRange(segment)
+		if err != nil {
+			continue
+		}
+		segments[i] = fmt.Sprintf("%s-%s", getASDot(start), getASDot(end))
+	}
+	return strings.Join(segments, backend.RangeSegmentSeparator)
+}
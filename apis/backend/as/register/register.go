@@ -29,11 +29,13 @@ import (
 	bebackend "github.com/kuidio/kuid/pkg/backend"
 	genericbackend "github.com/kuidio/kuid/pkg/backend/generic"
 	"github.com/kuidio/kuid/pkg/config"
+	"github.com/kuidio/kuid/pkg/quota"
 	genericregistry "github.com/kuidio/kuid/pkg/registry/generic"
 	"github.com/kuidio/kuid/pkg/registry/options"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/registry/generic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func init() {
@@ -42,10 +44,11 @@ func init() {
 		asbev1alpha1.AddToScheme,
 		NewBackend,
 		ApplyStorageToBackend,
+		ApplyClientToBackend,
 		[]*config.ResourceConfig{
-			{StorageProviderFn: NewIndexStorageProvider, Internal: &as.ASIndex{}, ResourceVersions: []resource.Object{&as.ASIndex{}, &asbev1alpha1.ASIndex{}}},
-			{StorageProviderFn: NewClaimStorageProvider, Internal: &as.ASClaim{}, ResourceVersions: []resource.Object{&as.ASClaim{}, &asbev1alpha1.ASClaim{}}},
-			{StorageProviderFn: NewStorageProvider, Internal: &as.ASEntry{}, ResourceVersions: []resource.Object{&as.ASEntry{}, &asbev1alpha1.ASEntry{}}},
+			{StorageProviderFn: NewIndexStorageProvider, Internal: &as.ASIndex{}, ResourceVersions: []resource.Object{&as.ASIndex{}, &asbev1alpha1.ASIndex{}}, Index: true},
+			{StorageProviderFn: NewClaimStorageProvider, Internal: &as.ASClaim{}, ResourceVersions: []resource.Object{&as.ASClaim{}, &asbev1alpha1.ASClaim{}}, Claim: true},
+			{StorageProviderFn: NewStorageProvider, Internal: &as.ASEntry{}, ResourceVersions: []resource.Object{&as.ASEntry{}, &asbev1alpha1.ASEntry{}}, Entry: true},
 		},
 	)
 }
@@ -70,12 +73,14 @@ func NewIndexStorageProvider(ctx context.Context, obj resource.InternalObject, b
 	return genericregistry.NewStorageProvider(ctx, obj, &opts)
 }
 
+// NewClaimStorageProvider enforces the claim quotas before the backend allocates the claim
 func NewClaimStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *rest.StorageProvider {
 	opts := *options
 	if sync {
-		opts.BackendInvoker = bebackend.NewClaimInvoker(be)
+		opts.BackendInvoker = quota.NewClaimInvoker(obj.GetGroupVersionResource().Group, bebackend.NewClaimInvoker(be))
 		return genericregistry.NewStorageProvider(ctx, obj, &opts)
 	}
+	opts.BackendInvoker = quota.NewClaimInvoker(obj.GetGroupVersionResource().Group, nil)
 	return genericregistry.NewStorageProvider(ctx, obj, &opts)
 }
 
@@ -115,6 +120,22 @@ func ApplyStorageToBackend(ctx context.Context, be bebackend.Backend, apiServer
 	return be.AddStorageInterfaces(genericbackend.NewKuidBackendstorage(entryStore, claimStore))
 }
 
+// ApplyClientToBackend attaches the CRD storage to the backend, the entries and claims
+// are persisted as CRDs using the client
+func ApplyClientToBackend(ctx context.Context, be bebackend.Backend, c client.Client) error {
+	scheme := runtime.NewScheme()
+	if err := as.AddToScheme(scheme); err != nil {
+		return err
+	}
+	if err := asbev1alpha1.AddToScheme(scheme); err != nil {
+		return err
+	}
+	entryStore := bebackend.NewClientStore(c, scheme, asbev1alpha1.SchemeGroupVersion.WithKind(as.ASEntryKind), true)
+	claimStore := bebackend.NewClientStore(c, scheme, asbev1alpha1.SchemeGroupVersion.WithKind(as.ASClaimKind), false)
+
+	return be.AddStorageInterfaces(genericbackend.NewClientBackendstorage(entryStore, claimStore))
+}
+
 var _ generic.RESTOptionsGetter = &Getter{}
 
 type Getter struct{}
@@ -33,10 +33,16 @@ type ASClaimSpec struct {
 	// Range defines the AS range for the AS claim
 	// The following notation is used: start-end <start-ASID>-<end-ASID>
 	// the ASs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
+	// The ASs can be provided in asplain (4259840100) or asdot (65000.100) notation
 	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
 	// ClaimLabels define the user defined labels and selector labels used
 	// in resource claim
 	commonv1alpha1.ClaimLabels `json:",inline" protobuf:"bytes,4,opt,name=claimLabels"`
+	// ASN defines the AS for the AS claim in asplain (4259840100) or asdot (65000.100)
+	// notation, as an alternative for the id
+	// +optional
+	ASN *string `json:"asn,omitempty" protobuf:"bytes,5,opt,name=asn"`
 }
 
 // ASClaimStatus defines the observed state of ASClaim
@@ -54,6 +60,9 @@ type ASClaimStatus struct {
 	// +kubebuilder:validation:Optional
 	// +optional
 	ExpiryTime *string `json:"expiryTime,omitempty" protobuf:"bytes,4,opt,name=expiryTime"`
+	// ASDot defines the claimed AS or AS range in asdot notation
+	// +optional
+	ASDot *string `json:"asdot,omitempty" protobuf:"bytes,5,opt,name=asdot"`
 }
 
 // +genclient
@@ -37,6 +37,14 @@ type ASIndexSpec struct {
 	commonv1alpha1.UserDefinedLabels `json:",inline" protobuf:"bytes,3,opt,name=userDefinedLabels"`
 	// Claims define the embedded claims in the Index
 	Claims []ASIndexClaim `json:"claims,omitempty" protobuf:"bytes,4,rep,name=claims"`
+	// AllowReserved allows claims on the reserved and documentation ASs (0, 23456,
+	// 64496-64511, 65535, 65536-65551 and 4294967295), by default the index reserves them
+	// +optional
+	AllowReserved bool `json:"allowReserved,omitempty" protobuf:"varint,5,opt,name=allowReserved"`
+	// PrivateOnly restricts the dynamic claims without a selector to the private ASs
+	// (64512-65534 and 4200000000-4294967294)
+	// +optional
+	PrivateOnly bool `json:"privateOnly,omitempty" protobuf:"varint,6,opt,name=privateOnly"`
 }
 
 type ASIndexClaim struct {
@@ -47,10 +55,15 @@ type ASIndexClaim struct {
 	// Range defines the range of the resource
 	// The following notation is used: start-end <start-ID>-<end-ID>
 	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
+	// The ASs can be provided in asplain or asdot notation
 	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
 	// UserDefinedLabels define metadata to the resource.
 	// defined in the spec to distingiush metadata labels from user defined labels
 	commonv1alpha1.UserDefinedLabels `json:",inline" protobuf:"bytes,4,opt,name=userDefinedLabels"`
+	// ASN defines the AS for the AS claim in asplain or asdot notation, as an alternative
+	// for the id
+	ASN *string `json:"asn,omitempty" protobuf:"bytes,5,opt,name=asn"`
 }
 
 // ASIndexStatus defines the observed state of ASIndex
@@ -70,7 +83,7 @@ type ASIndexStatus struct {
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:categories={kuid}
+// +kubebuilder:resource:path=asindices,categories={kuid}
 // ASIndex is the Schema for the ASIndex API
 type ASIndex struct {
 	metav1.TypeMeta   `json:",inline"`
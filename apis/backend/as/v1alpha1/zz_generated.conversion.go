@@ -294,6 +294,7 @@ func autoConvert_v1alpha1_ASClaimSpec_To_as_ASClaimSpec(in *ASClaimSpec, out *as
 	if err := Convert_v1alpha1_ClaimLabels_To_common_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
+	out.ASN = (*string)(unsafe.Pointer(in.ASN))
 	return nil
 }
 
@@ -309,6 +310,7 @@ func autoConvert_as_ASClaimSpec_To_v1alpha1_ASClaimSpec(in *as.ASClaimSpec, out
 	if err := Convert_common_ClaimLabels_To_v1alpha1_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
+	out.ASN = (*string)(unsafe.Pointer(in.ASN))
 	return nil
 }
 
@@ -324,6 +326,7 @@ func autoConvert_v1alpha1_ASClaimStatus_To_as_ASClaimStatus(in *ASClaimStatus, o
 	out.ID = (*uint32)(unsafe.Pointer(in.ID))
 	out.Range = (*string)(unsafe.Pointer(in.Range))
 	out.ExpiryTime = (*string)(unsafe.Pointer(in.ExpiryTime))
+	out.ASDot = (*string)(unsafe.Pointer(in.ASDot))
 	return nil
 }
 
@@ -339,6 +342,7 @@ func autoConvert_as_ASClaimStatus_To_v1alpha1_ASClaimStatus(in *as.ASClaimStatus
 	out.ID = (*uint32)(unsafe.Pointer(in.ID))
 	out.Range = (*string)(unsafe.Pointer(in.Range))
 	out.ExpiryTime = (*string)(unsafe.Pointer(in.ExpiryTime))
+	out.ASDot = (*string)(unsafe.Pointer(in.ASDot))
 	return nil
 }
 
@@ -426,6 +430,7 @@ func autoConvert_v1alpha1_ASEntrySpec_To_as_ASEntrySpec(in *ASEntrySpec, out *as
 	out.IndexEntry = in.IndexEntry
 	out.ClaimType = backend.ClaimType(in.ClaimType)
 	out.ID = in.ID
+	out.Count = in.Count
 	if err := Convert_v1alpha1_ClaimLabels_To_common_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
@@ -442,6 +447,7 @@ func autoConvert_as_ASEntrySpec_To_v1alpha1_ASEntrySpec(in *as.ASEntrySpec, out
 	out.IndexEntry = in.IndexEntry
 	out.ClaimType = backend.ClaimType(in.ClaimType)
 	out.ID = in.ID
+	out.Count = in.Count
 	if err := Convert_common_ClaimLabels_To_v1alpha1_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
@@ -516,6 +522,7 @@ func autoConvert_v1alpha1_ASIndexClaim_To_as_ASIndexClaim(in *ASIndexClaim, out
 	if err := Convert_v1alpha1_UserDefinedLabels_To_common_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
 		return err
 	}
+	out.ASN = (*string)(unsafe.Pointer(in.ASN))
 	return nil
 }
 
@@ -531,6 +538,7 @@ func autoConvert_as_ASIndexClaim_To_v1alpha1_ASIndexClaim(in *as.ASIndexClaim, o
 	if err := Convert_common_UserDefinedLabels_To_v1alpha1_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
 		return err
 	}
+	out.ASN = (*string)(unsafe.Pointer(in.ASN))
 	return nil
 }
 
@@ -598,6 +606,8 @@ func autoConvert_v1alpha1_ASIndexSpec_To_as_ASIndexSpec(in *ASIndexSpec, out *as
 	} else {
 		out.Claims = nil
 	}
+	out.AllowReserved = in.AllowReserved
+	out.PrivateOnly = in.PrivateOnly
 	return nil
 }
 
@@ -623,6 +633,8 @@ func autoConvert_as_ASIndexSpec_To_v1alpha1_ASIndexSpec(in *as.ASIndexSpec, out
 	} else {
 		out.Claims = nil
 	}
+	out.AllowReserved = in.AllowReserved
+	out.PrivateOnly = in.PrivateOnly
 	return nil
 }
 
@@ -97,6 +97,11 @@ func (in *ASClaimSpec) DeepCopyInto(out *ASClaimSpec) {
 		**out = **in
 	}
 	in.ClaimLabels.DeepCopyInto(&out.ClaimLabels)
+	if in.ASN != nil {
+		in, out := &in.ASN, &out.ASN
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ASClaimSpec.
@@ -128,6 +133,11 @@ func (in *ASClaimStatus) DeepCopyInto(out *ASClaimStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ASDot != nil {
+		in, out := &in.ASDot, &out.ASDot
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ASClaimStatus.
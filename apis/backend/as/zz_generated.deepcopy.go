@@ -112,6 +112,11 @@ func (in *ASClaimSpec) DeepCopyInto(out *ASClaimSpec) {
 		**out = **in
 	}
 	in.ClaimLabels.DeepCopyInto(&out.ClaimLabels)
+	if in.ASN != nil {
+		in, out := &in.ASN, &out.ASN
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ASClaimSpec.
@@ -143,6 +148,11 @@ func (in *ASClaimStatus) DeepCopyInto(out *ASClaimStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ASDot != nil {
+		in, out := &in.ASDot, &out.ASDot
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ASClaimStatus.
@@ -43,4 +43,7 @@ func GetClaimTypeFromString(s string) ClaimType {
 const (
 	IndexReservedMinName = "rangereservedmin"
 	IndexReservedMaxName = "rangereservedmax"
+	// IndexReservedName prefixes the names of the claims an index reserves, the min and
+	// max claims included
+	IndexReservedName = "rangereserved"
 )
@@ -0,0 +1,40 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package community
+
+type CommunityType string
+
+const (
+	CommunityType_Invalid CommunityType = "invalid"
+	// CommunityType_Standard are RFC1997 communities, a 2 byte AS global administrator
+	// followed by a 16bit value
+	CommunityType_Standard CommunityType = "standard"
+	// CommunityType_Large are RFC8092 large communities, a 4 byte AS global administrator
+	// followed by 2 32bit local data parts
+	CommunityType_Large CommunityType = "large"
+)
+
+func GetCommunityType(s string) CommunityType {
+	switch s {
+	case string(CommunityType_Standard):
+		return CommunityType_Standard
+	case string(CommunityType_Large):
+		return CommunityType_Large
+	default:
+		return CommunityType_Invalid
+	}
+}
@@ -0,0 +1,505 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package community
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/henderiw/idxtable/pkg/table"
+	"github.com/henderiw/idxtable/pkg/tree"
+	"github.com/henderiw/idxtable/pkg/tree/id16"
+	"github.com/henderiw/idxtable/pkg/tree/id32"
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/apis/condition"
+	"github.com/kuidio/kuid/apis/backend"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+var _ backend.ClaimObject = &CommunityClaim{}
+var _ backend.NotationClaimObject = &CommunityClaim{}
+var _ backend.TypedClaimObject = &CommunityClaim{}
+
+func (r *CommunityClaim) GetNamespacedName() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.GetNamespace(),
+		Name:      r.GetName(),
+	}
+}
+
+func (r *CommunityClaim) GetKey() store.Key {
+	return store.KeyFromNSN(types.NamespacedName{Namespace: r.Namespace, Name: r.Spec.Index})
+}
+
+// GetCondition returns the condition based on the condition kind
+func (r *CommunityClaim) GetCondition(t condition.ConditionType) condition.Condition {
+	return r.Status.GetCondition(t)
+}
+
+// SetConditions sets the conditions on the resource. it allows for 0, 1 or more conditions
+// to be set at once
+func (r *CommunityClaim) SetConditions(c ...condition.Condition) {
+	r.Status.SetConditions(c...)
+}
+
+// ValidateSyntax validates the claim, the type and global administrator of the index are
+// used to validate the id and the community of the claim when provided
+func (r *CommunityClaim) ValidateSyntax(s string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if err := r.ValidateCommunityClaimType(); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath(""),
+			r,
+			err.Error(),
+		))
+		return allErrs
+	}
+	var v SyntaxValidator
+	claimType := r.GetClaimType()
+	switch claimType {
+	case backend.ClaimType_DynamicID:
+		v = &CommunityDynamicIDSyntaxValidator{Name: string(claimType)}
+	case backend.ClaimType_StaticID:
+		v = &CommunityStaticIDSyntaxValidator{Name: string(claimType), Prefix: s}
+	case backend.ClaimType_Range:
+		v = &CommunityRangeSyntaxValidator{Name: string(claimType), Prefix: s}
+	default:
+		return allErrs
+	}
+	return v.Validate(r)
+}
+
+func (r *CommunityClaim) ValidateCommunityRange(prefix string) error {
+	if r.Spec.Range == nil {
+		return fmt.Errorf("no community range provided")
+	}
+	var errm error
+	if r.Name == r.Spec.Index {
+		// to be able to check if the entry is reserved we get a parentname (rang name) equal to index
+		// this is because the ownerreference uses the name of the index in its labels in the cache
+		errm = errors.Join(errm, fmt.Errorf("a name of range cannot be the same as the index"))
+	}
+	segments := []backend.IDRange{}
+	for _, segment := range backend.GetRangeSegments(*r.Spec.Range) {
+		start, end, err := ParseCommunityRange(segment)
+		if err != nil {
+			errm = errors.Join(errm, err)
+			continue
+		}
+		if start > end {
+			errm = errors.Join(errm, fmt.Errorf("invalid community range start > end %s", segment))
+			continue
+		}
+		if err := validateCommunityPrefixID(prefix, end); err != nil {
+			errm = errors.Join(errm, err)
+			continue
+		}
+		segments = append(segments, backend.IDRange{From: uint64(start), To: uint64(end)})
+	}
+	if errm != nil {
+		return errm
+	}
+	return backend.ValidateIDRanges(segments)
+}
+
+func (r *CommunityClaim) ValidateCommunityID(prefix string) error {
+	if r.Spec.ID == nil && r.Spec.Community == nil {
+		return fmt.Errorf("no id provided")
+	}
+	if r.Spec.ID != nil {
+		if err := validateCommunityID(int(*r.Spec.ID)); err != nil {
+			return fmt.Errorf("invalid id err %s", err.Error())
+		}
+		if err := validateCommunityPrefixID(prefix, *r.Spec.ID); err != nil {
+			return err
+		}
+	}
+	if r.Spec.Community != nil {
+		if _, err := ParseCommunityID(*r.Spec.Community); err != nil {
+			return fmt.Errorf("invalid community err %s", err.Error())
+		}
+		if prefix != "" {
+			if err := validateCommunityPrefix(prefix, *r.Spec.Community); err != nil {
+				return fmt.Errorf("invalid community err %s", err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// validateCommunityPrefixID validates the id fits in the value of the community type of the
+// index, the id is not validated when the type and global administrator of the index are unknown
+func validateCommunityPrefixID(prefix string, id uint32) error {
+	if prefix == "" {
+		return nil
+	}
+	typ, _ := getPrefixCommunityType(prefix)
+	if typ == CommunityType_Invalid {
+		return fmt.Errorf("invalid community prefix %s", prefix)
+	}
+	if id > GetCommunityMaxID(typ) {
+		return fmt.Errorf("invalid id %d, the max id of the %s type is %d", id, typ, GetCommunityMaxID(typ))
+	}
+	return nil
+}
+
+// ValidateIndexType validates the id, range or community of the claim against the type and
+// the global administrator of the index, the id must fit in the community type and the
+// community must match the global administrator
+func (r *CommunityClaim) ValidateIndexType(typ string) error {
+	switch r.GetClaimType() {
+	case backend.ClaimType_StaticID:
+		return r.ValidateCommunityID(typ)
+	case backend.ClaimType_Range:
+		return r.ValidateCommunityRange(typ)
+	}
+	return nil
+}
+
+func (r *CommunityClaim) ValidateCommunityClaimType() error {
+	var sb strings.Builder
+	count := 0
+	if r.Spec.ID != nil {
+		sb.WriteString(fmt.Sprintf("id: %d", *r.Spec.ID))
+		count++
+
+	}
+	if r.Spec.Community != nil {
+		if count > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("community: %s", *r.Spec.Community))
+		count++
+
+	}
+	if r.Spec.Range != nil {
+		if count > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("range: %s", *r.Spec.Range))
+		count++
+
+	}
+	if count > 1 {
+		return fmt.Errorf("a claim can only have 1 type, got %s", sb.String())
+	}
+	return nil
+}
+
+func (r *CommunityClaim) GetIndex() string { return r.Spec.Index }
+
+func (r *CommunityClaim) GetSelector() *metav1.LabelSelector { return r.Spec.Selector }
+
+func (r *CommunityClaim) IsOwner(labels labels.Set) bool {
+	for k, v := range r.getOwnerLabels() {
+		if val, ok := labels[k]; !ok || val != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *CommunityClaim) getOwnerLabels() map[string]string {
+	return map[string]string{
+		backend.KuidClaimNameKey: r.Name,
+		backend.KuidClaimUIDKey:  string(r.UID),
+	}
+}
+
+// GetOwnerSelector selects the route based on the name of the claim
+func (r *CommunityClaim) GetOwnerSelector() (labels.Selector, error) {
+	l := r.getOwnerLabels()
+
+	fullselector := labels.NewSelector()
+	for k, v := range l {
+		req, err := labels.NewRequirement(k, selection.Equals, []string{v})
+		if err != nil {
+			return nil, err
+		}
+		fullselector = fullselector.Add(*req)
+	}
+	return fullselector, nil
+}
+
+func (r *CommunityClaim) GetLabelSelector() (labels.Selector, error) {
+	return r.Spec.GetLabelSelector()
+}
+
+func (r *CommunityClaim) GetClaimLabels() labels.Set {
+	labels := r.Spec.GetUserDefinedLabels()
+
+	// system defined labels
+	labels[backend.KuidClaimTypeKey] = string(r.GetClaimType())
+	labels[backend.KuidClaimNameKey] = r.Name
+	labels[backend.KuidClaimUIDKey] = string(r.UID)
+	labels[backend.KuidOwnerKindKey] = r.Kind
+	return labels
+}
+
+func (r *CommunityClaim) ValidateOwner(labels labels.Set) error {
+	routeClaimName := labels[backend.KuidClaimNameKey]
+	routeClaimUID := labels[backend.KuidClaimUIDKey]
+
+	if string(r.UID) != routeClaimUID && r.Name != routeClaimName {
+		return fmt.Errorf("route owned by different claim got name %s/%s uid %s/%s",
+			r.Name,
+			routeClaimName,
+			string(r.UID),
+			routeClaimUID,
+		)
+	}
+	return nil
+}
+
+func (r *CommunityClaim) GetClaimType() backend.ClaimType {
+	claimType := backend.ClaimType_Invalid
+	count := 0
+	if r.Spec.ID != nil || r.Spec.Community != nil {
+		claimType = backend.ClaimType_StaticID
+		count++
+
+	}
+	if r.Spec.Range != nil {
+		claimType = backend.ClaimType_Range
+		count++
+
+	}
+	if count > 1 {
+		return backend.ClaimType_Invalid
+	}
+	if count == 0 {
+		return backend.ClaimType_DynamicID
+	}
+	return claimType
+}
+
+// getStaticID returns the id or the id of the community of the claim
+func (r *CommunityClaim) getStaticID() *uint32 {
+	if r.Spec.ID != nil {
+		return r.Spec.ID
+	}
+	if r.Spec.Community != nil {
+		id, err := ParseCommunityID(*r.Spec.Community)
+		if err != nil {
+			return nil
+		}
+		return ptr.To[uint32](id)
+	}
+	return nil
+}
+
+func (r *CommunityClaim) GetStaticID() *uint64 {
+	id := r.getStaticID()
+	if id == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*id))
+}
+
+func (r *CommunityClaim) GetStaticTreeID(typ string) tree.ID {
+	id := r.getStaticID()
+	if id == nil {
+		return nil
+	}
+	return getTreeID(typ, *id)
+}
+
+func (r *CommunityClaim) GetClaimID(typ string, id uint64) tree.ID {
+	return getTreeID(typ, uint32(id))
+}
+
+func (r *CommunityClaim) GetStatusClaimID(typ string) tree.ID {
+	if r.Status.ID == nil {
+		return nil
+	}
+	return getTreeID(typ, *r.Status.ID)
+}
+
+// getTreeID returns the id in the tree of the index, standard communities use a 16bit tree
+func getTreeID(typ string, id uint32) tree.ID {
+	switch t, _ := getPrefixCommunityType(typ); t {
+	case CommunityType_Standard:
+		return id16.NewID(uint16(id), id16.IDBitSize)
+	case CommunityType_Large:
+		return id32.NewID(id, id32.IDBitSize)
+	default:
+		return nil
+	}
+}
+
+func (r *CommunityClaim) GetRange() *string {
+	return r.Spec.Range
+}
+
+func (r *CommunityClaim) GetRangeIDs(typ string) ([]tree.Range, error) {
+	if r.Spec.Range == nil {
+		return nil, fmt.Errorf("cannot provide a range without an id")
+	}
+	switch t, _ := getPrefixCommunityType(typ); t {
+	case CommunityType_Standard:
+		return backend.ParseRangeSegments(*r.Spec.Range, id16.ParseRange)
+	case CommunityType_Large:
+		return backend.ParseRangeSegments(*r.Spec.Range, id32.ParseRange)
+	default:
+		return nil, fmt.Errorf("cannot provide a range for an invalid community type %s", typ)
+	}
+}
+
+func (r *CommunityClaim) GetTable(typ string, ranges []backend.IDRange) table.Table {
+	if getTreeID(typ, 0) == nil {
+		return nil
+	}
+	return backend.NewRangeTable(ranges, func(id uint64) tree.ID {
+		return getTreeID(typ, uint32(id))
+	})
+}
+
+func (r *CommunityClaim) SetStatusRange(s *string) {
+	r.Status.Range = s
+	r.resetStatusNotation()
+}
+
+func (r *CommunityClaim) SetStatusID(s *uint64) {
+	r.resetStatusNotation()
+	if s == nil {
+		r.Status.ID = nil
+		return
+	}
+	r.Status.ID = ptr.To[uint32](uint32(*s))
+}
+
+func (r *CommunityClaim) resetStatusNotation() {
+	r.Status.Community = nil
+}
+
+// SetStatusNotation renders the claimed id or range as communities in the canonical textual
+// form using the type and the global administrator of the index
+func (r *CommunityClaim) SetStatusNotation(typ string) {
+	r.resetStatusNotation()
+	if t, _ := getPrefixCommunityType(typ); t == CommunityType_Invalid {
+		return
+	}
+	if r.Status.ID != nil {
+		r.Status.Community = ptr.To[string](GetCommunity(typ, *r.Status.ID))
+	}
+	if r.Status.Range != nil {
+		r.Status.Community = ptr.To[string](getCommunityRange(typ, *r.Status.Range))
+	}
+}
+
+func (r *CommunityClaim) GetStatusID() *uint64 {
+	if r.Status.ID == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*r.Status.ID))
+}
+
+func (r *CommunityClaim) GetClaimRequest() string {
+	if r.Spec.Community != nil {
+		return *r.Spec.Community
+	}
+	if r.Spec.ID != nil {
+		return strconv.FormatUint(uint64(*r.Spec.ID), 10)
+	}
+	if r.Spec.Range != nil {
+		return *r.Spec.Range
+	}
+	return ""
+}
+
+func (r *CommunityClaim) GetClaimResponse() string {
+	if r.Status.Community != nil {
+		return *r.Status.Community
+	}
+	if r.Status.ID != nil {
+		return strconv.FormatUint(uint64(*r.Status.ID), 10)
+	}
+	if r.Status.Range != nil {
+		return *r.Status.Range
+	}
+	return ""
+}
+
+func (r *CommunityClaim) GetClaimSet(typ string) (map[string]tree.ID, sets.Set[string], error) {
+	aranges, err := r.GetRangeIDs(typ)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot get range from claim: %v", err)
+	}
+	// claim set represents the new entries
+	newClaimSet := sets.New[string]()
+	newClaimMap := map[string]tree.ID{}
+	for _, arange := range aranges {
+		for _, rangeID := range arange.IDs() {
+			newClaimSet.Insert(rangeID.String())
+			newClaimMap[rangeID.String()] = rangeID
+		}
+	}
+	return newClaimMap, newClaimSet, nil
+}
+
+func (r *CommunityClaim) GetChoreoAPIVersion() string {
+	return schema.GroupVersion{Group: GroupName, Version: "community"}.String()
+}
+
+func CommunityClaimFromUnstructured(ru runtime.Unstructured) (backend.ClaimObject, error) {
+	obj := &CommunityClaim{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(ru.UnstructuredContent(), obj)
+	if err != nil {
+		return nil, fmt.Errorf("error converting unstructured to communityClaim: %v", err)
+	}
+	return obj, nil
+}
+
+func CommunityClaimFromRuntime(ru runtime.Object) (backend.ClaimObject, error) {
+	claim, ok := ru.(*CommunityClaim)
+	if !ok {
+		return nil, errors.New("runtime object not CommunityClaim")
+	}
+	return claim, nil
+}
+
+// BuildCommunityClaim returns a reource from a client Object a Spec/Status
+func BuildCommunityClaim(meta metav1.ObjectMeta, spec *CommunityClaimSpec, status *CommunityClaimStatus) backend.ClaimObject {
+	aspec := CommunityClaimSpec{}
+	if spec != nil {
+		aspec = *spec
+	}
+	astatus := CommunityClaimStatus{}
+	if status != nil {
+		astatus = *status
+	}
+	return &CommunityClaim{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.Identifier(),
+			Kind:       CommunityClaimKind,
+		},
+		ObjectMeta: meta,
+		Spec:       aspec,
+		Status:     astatus,
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package community
+
+import (
+	fmt "fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// +kubebuilder:object:generate=false
+// +k8s:deepcopy-gen:false
+type SyntaxValidator interface {
+	Validate(claim *CommunityClaim) field.ErrorList
+}
+
+// +k8s:deepcopy-gen:false
+type CommunityRangeSyntaxValidator struct {
+	Name string
+	// Prefix is the type and the global administrator of the index
+	Prefix string
+}
+
+func (r *CommunityRangeSyntaxValidator) Validate(claim *CommunityClaim) field.ErrorList {
+	var allErrs field.ErrorList
+	if err := claim.ValidateCommunityRange(r.Prefix); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.range"),
+			claim,
+			fmt.Errorf("invalid community range %s: %s", r.Name, err.Error()).Error(),
+		))
+	}
+	return allErrs
+}
+
+type CommunityDynamicIDSyntaxValidator struct {
+	Name string
+}
+
+func (r *CommunityDynamicIDSyntaxValidator) Validate(claim *CommunityClaim) field.ErrorList {
+	var allErrs field.ErrorList
+	return allErrs
+}
+
+type CommunityStaticIDSyntaxValidator struct {
+	Name string
+	// Prefix is the type and the global administrator of the index
+	Prefix string
+}
+
+func (r *CommunityStaticIDSyntaxValidator) Validate(claim *CommunityClaim) field.ErrorList {
+	var allErrs field.ErrorList
+	if err := claim.ValidateCommunityID(r.Prefix); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.id"),
+			claim,
+			fmt.Errorf("invalid community id %s: %s", r.Name, err.Error()).Error(),
+		))
+	}
+	return allErrs
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package community
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/kform-dev/choreo/apis/condition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// GetCondition returns the condition based on the condition kind
+func (r *CommunityIndex) GetCondition(t condition.ConditionType) condition.Condition {
+	return r.Status.GetCondition(t)
+}
+
+// SetConditions sets the conditions on the resource. it allows for 0, 1 or more conditions
+// to be set at once
+func (r *CommunityIndex) SetConditions(c ...condition.Condition) {
+	r.Status.SetConditions(c...)
+}
+
+func (r *CommunityIndex) ValidateSyntax(s string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	typ := GetCommunityType(r.Spec.Type)
+	if typ == CommunityType_Invalid {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.type"),
+			r,
+			fmt.Errorf("invalid community type %s, supported: %s, %s", r.Spec.Type, CommunityType_Standard, CommunityType_Large).Error(),
+		))
+		return allErrs
+	}
+	allErrs = append(allErrs, r.validateGlobalAdministrator(typ)...)
+
+	if r.Spec.MinID != nil {
+		if *r.Spec.MinID > GetCommunityMaxID(typ) {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.minID"),
+				r,
+				fmt.Errorf("invalid community ID %d, the max ID of the %s type is %d", *r.Spec.MinID, typ, GetCommunityMaxID(typ)).Error(),
+			))
+		}
+	}
+	if r.Spec.MaxID != nil {
+		if *r.Spec.MaxID > GetCommunityMaxID(typ) {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.maxID"),
+				r,
+				fmt.Errorf("invalid community ID %d, the max ID of the %s type is %d", *r.Spec.MaxID, typ, GetCommunityMaxID(typ)).Error(),
+			))
+		}
+	}
+	if r.Spec.MinID != nil && r.Spec.MaxID != nil {
+		if *r.Spec.MinID > *r.Spec.MaxID {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.maxID"),
+				r,
+				fmt.Errorf("min community ID %d cannot be bigger than max community ID %d", *r.Spec.MinID, *r.Spec.MaxID).Error(),
+			))
+		}
+	}
+	if len(allErrs) != 0 {
+		return allErrs
+	}
+	// the claims are validated against the type and the global administrator of the index
+	prefix := r.GetType()
+	for i, claim := range r.Spec.Claims {
+		if errs := r.GetClaim(claim).ValidateSyntax(prefix); len(errs) != 0 {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.claims").Index(i),
+				r,
+				fmt.Errorf("invalid claim %s: %s", claim.Name, errs.ToAggregate().Error()).Error(),
+			))
+		}
+	}
+	return allErrs
+}
+
+// validateGlobalAdministrator validates the global administrator of the index matches the
+// type and is not reserved, the reserved global administrators hold the well-known
+// communities. The local data part 1 is only provided for large communities
+func (r *CommunityIndex) validateGlobalAdministrator(typ CommunityType) field.ErrorList {
+	var allErrs field.ErrorList
+	if _, err := ParseGlobalAdministrator(typ, r.Spec.GlobalAdministrator); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.globalAdministrator"),
+			r,
+			err.Error(),
+		))
+	}
+	switch {
+	case typ == CommunityType_Standard && r.Spec.LocalDataPart1 != nil:
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.localDataPart1"),
+			r,
+			fmt.Errorf("a localDataPart1 is only supported for the %s type", CommunityType_Large).Error(),
+		))
+	case typ == CommunityType_Large && r.Spec.LocalDataPart1 == nil:
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.localDataPart1"),
+			r,
+			fmt.Errorf("a localDataPart1 is required for the %s type", CommunityType_Large).Error(),
+		))
+	}
+	return allErrs
+}
+
+// validateImmutable validates the type, the global administrator and the local data part 1
+// of the index do not change, the allocated communities are derived from them
+func (r *CommunityIndex) validateImmutable(old *CommunityIndex) field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.Type != old.Spec.Type {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec.type"),
+			"the community type of an index is immutable",
+		))
+	}
+	if r.Spec.GlobalAdministrator != old.Spec.GlobalAdministrator ||
+		!reflect.DeepEqual(r.Spec.LocalDataPart1, old.Spec.LocalDataPart1) {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec"),
+			"the globalAdministrator and localDataPart1 of an index are immutable",
+		))
+	}
+	return allErrs
+}
+
+// BuildCommunityIndex returns a reource from a client Object a Spec/Status
+func BuildCommunityIndex(meta metav1.ObjectMeta, spec *CommunityIndexSpec, status *CommunityIndexStatus) *CommunityIndex {
+	aspec := CommunityIndexSpec{}
+	if spec != nil {
+		aspec = *spec
+	}
+	astatus := CommunityIndexStatus{}
+	if status != nil {
+		astatus = *status
+	}
+	return &CommunityIndex{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.Identifier(),
+			Kind:       CommunityIndexKind,
+		},
+		ObjectMeta: meta,
+		Spec:       aspec,
+		Status:     astatus,
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package community
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/henderiw/idxtable/pkg/tree/gtree"
+	"github.com/henderiw/idxtable/pkg/tree/tree16"
+	"github.com/henderiw/idxtable/pkg/tree/tree32"
+	"github.com/henderiw/store"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+var _ backend.IndexObject = &CommunityIndex{}
+
+func (r *CommunityIndex) GetKey() store.Key {
+	return store.KeyFromNSN(r.GetNamespacedName())
+}
+
+func (r *CommunityIndex) GetNamespacedName() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.GetNamespace(),
+		Name:      r.GetName(),
+	}
+}
+
+// GetTree returns the tree of the index, the standard type uses a 16bit tree for the
+// values, the large type a 32bit tree for the second local data part
+func (r *CommunityIndex) GetTree() gtree.GTree {
+	switch GetCommunityType(r.Spec.Type) {
+	case CommunityType_Standard:
+		tree, err := tree16.New(fmt.Sprintf("communityindex.%s", r.Name), 16)
+		if err != nil {
+			return nil
+		}
+		return tree
+	case CommunityType_Large:
+		tree, err := tree32.New(fmt.Sprintf("communityindex.%s", r.Name), 32)
+		if err != nil {
+			return nil
+		}
+		return tree
+	default:
+		return nil
+	}
+}
+
+// GetType returns the type and the fixed part of the communities of the index,
+// standard:<global administrator> or large:<global administrator>:<local data part 1>.
+// The claims render their communities from it, the type is empty when the global
+// administrator is invalid
+func (r *CommunityIndex) GetType() string {
+	prefix, err := GetCommunityPrefix(GetCommunityType(r.Spec.Type), r.Spec.GlobalAdministrator, r.Spec.LocalDataPart1)
+	if err != nil {
+		return ""
+	}
+	return prefix
+}
+
+func (r *CommunityIndex) GetMinID() *uint64 {
+	if r.Spec.MinID == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*r.Spec.MinID))
+}
+
+func (r *CommunityIndex) GetMaxID() *uint64 {
+	if r.Spec.MaxID == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*r.Spec.MaxID))
+}
+
+// GetMax returns the max value of the type of the index
+func (r *CommunityIndex) GetMax() uint64 {
+	return uint64(GetCommunityMaxID(GetCommunityType(r.Spec.Type)))
+}
+
+func GetMinClaimRange(id uint64) string {
+	return fmt.Sprintf("%d-%d", CommunityID_Min, id-1)
+}
+
+func GetMaxClaimRange(id, max uint64) string {
+	return fmt.Sprintf("%d-%d", id+1, max)
+}
+
+func (r *CommunityIndex) GetMinClaimNSN() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.Namespace,
+		Name:      fmt.Sprintf("%s.%s", r.Name, backend.IndexReservedMinName),
+	}
+}
+
+func (r *CommunityIndex) GetMaxClaimNSN() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.Namespace,
+		Name:      fmt.Sprintf("%s.%s", r.Name, backend.IndexReservedMaxName),
+	}
+}
+
+func (r *CommunityIndex) GetClaims() []backend.ClaimObject {
+	claims := []backend.ClaimObject{}
+	if r.GetMinID() != nil && *r.GetMinID() != 0 {
+		claims = append(claims, r.GetMinClaim())
+	}
+	if r.GetMaxID() != nil && *r.GetMaxID() != r.GetMax() {
+		claims = append(claims, r.GetMaxClaim())
+	}
+	for _, claim := range r.Spec.Claims {
+		claims = append(claims, r.GetClaim(claim))
+	}
+	return claims
+}
+
+func (r *CommunityIndex) GetMinClaim() backend.ClaimObject {
+	return BuildCommunityClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      r.GetMinClaimNSN().Name,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       CommunityIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		&CommunityClaimSpec{
+			Index: r.Name,
+			Range: ptr.To[string](GetMinClaimRange(*r.GetMinID())),
+		},
+		nil,
+	)
+}
+
+func (r *CommunityIndex) GetMaxClaim() backend.ClaimObject {
+	return BuildCommunityClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      r.GetMaxClaimNSN().Name,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       CommunityIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		&CommunityClaimSpec{
+			Index: r.Name,
+			Range: ptr.To[string](GetMaxClaimRange(*r.GetMaxID(), r.GetMax())),
+		},
+		nil,
+	)
+}
+
+func (r *CommunityIndex) GetClaim(claim CommunityIndexClaim) backend.ClaimObject {
+	spec := &CommunityClaimSpec{
+		Index: r.Name,
+		ClaimLabels: common.ClaimLabels{
+			UserDefinedLabels: claim.UserDefinedLabels,
+		},
+	}
+	switch {
+	case claim.ID != nil:
+		spec.ID = claim.ID
+	case claim.Community != nil:
+		spec.Community = claim.Community
+	default:
+		spec.Range = claim.Range
+	}
+	return BuildCommunityClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      fmt.Sprintf("%s.%s", r.Name, claim.Name),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       CommunityIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		spec,
+		nil,
+	)
+}
+
+func CommunityIndexFromRuntime(ru runtime.Object) (backend.IndexObject, error) {
+	index, ok := ru.(*CommunityIndex)
+	if !ok {
+		return nil, errors.New("runtime object not CommunityIndex")
+	}
+	return index, nil
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package community
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/as"
+)
+
+const CommunityID_Min = 0
+const CommunityID_Max = 4294967295
+
+// CommunityIDBits defines the size of the values the claims allocate, the value of a standard
+// community (RFC1997) and the second local data part of a large community (RFC8092)
+var CommunityIDBits = map[CommunityType]int{
+	CommunityType_Standard: 16,
+	CommunityType_Large:    32,
+}
+
+// CommunityReservedGlobalAdministrators define the global administrators an index cannot
+// allocate in. RFC1997 reserves the communities 0:0-0:65535 and 65535:0-65535:65535, the
+// latter hold the well-known communities, the ASs 0 (RFC7607) and 4294967295 (RFC7300)
+// are reserved
+var CommunityReservedGlobalAdministrators = map[CommunityType][]uint32{
+	CommunityType_Standard: {0, 65535},
+	CommunityType_Large:    {0, 65535, 4294967295},
+}
+
+// WellKnownCommunities define the well-known standard communities (IANA), they are within
+// the reserved global administrator 65535 and cannot be claimed
+var WellKnownCommunities = map[string]uint32{
+	"GRACEFUL_SHUTDOWN":   0xFFFF0000, // RFC8326
+	"ACCEPT_OWN":          0xFFFF0001, // RFC7611
+	"LLGR_STALE":          0xFFFF0006, // RFC9494
+	"NO_LLGR":             0xFFFF0007, // RFC9494
+	"BLACKHOLE":           0xFFFF029A, // RFC7999
+	"NO_EXPORT":           0xFFFFFF01, // RFC1997
+	"NO_ADVERTISE":        0xFFFFFF02, // RFC1997
+	"NO_EXPORT_SUBCONFED": 0xFFFFFF03, // RFC1997
+	"NOPEER":              0xFFFFFF04, // RFC3765
+}
+
+func validateCommunityID(id int) error {
+	if id < CommunityID_Min {
+		return fmt.Errorf("invalid id, got %d", id)
+	}
+	if id > CommunityID_Max {
+		return fmt.Errorf("invalid id, got %d", id)
+	}
+	return nil
+}
+
+// GetCommunityMaxID returns the max value the claims of the community type allocate
+func GetCommunityMaxID(typ CommunityType) uint32 {
+	bits, ok := CommunityIDBits[typ]
+	if !ok {
+		return 0
+	}
+	return uint32(uint64(1)<<bits - 1)
+}
+
+// ParseGlobalAdministrator parses the global administrator of the community type, a 2 byte
+// AS for standard communities or a 4 byte AS in asplain or asdot notation for large
+// communities. The global administrator is returned in asplain, the reserved ASs are rejected
+func ParseGlobalAdministrator(typ CommunityType, s string) (uint32, error) {
+	var asn uint32
+	switch typ {
+	case CommunityType_Standard:
+		id, err := strconv.ParseUint(s, 10, 16)
+		if err != nil {
+			return 0, fmt.Errorf("invalid global administrator %s, expected a 2 byte AS", s)
+		}
+		asn = uint32(id)
+	case CommunityType_Large:
+		id, err := as.ParseASN(s)
+		if err != nil {
+			return 0, fmt.Errorf("invalid global administrator %s, expected a 4 byte AS in asplain or asdot notation", s)
+		}
+		asn = id
+	default:
+		return 0, fmt.Errorf("invalid community type %s", typ)
+	}
+	if slices.Contains(CommunityReservedGlobalAdministrators[typ], asn) {
+		return 0, fmt.Errorf("invalid global administrator %s, the AS is reserved for the well-known communities", s)
+	}
+	return asn, nil
+}
+
+// GetCommunityPrefix returns the type and the fixed part of the communities of an index,
+// standard:<global administrator> or large:<global administrator>:<local data part 1>
+func GetCommunityPrefix(typ CommunityType, globalAdministrator string, localDataPart1 *uint32) (string, error) {
+	asn, err := ParseGlobalAdministrator(typ, globalAdministrator)
+	if err != nil {
+		return "", err
+	}
+	switch typ {
+	case CommunityType_Standard:
+		if localDataPart1 != nil {
+			return "", fmt.Errorf("a local data part 1 is only supported for the %s type", CommunityType_Large)
+		}
+		return fmt.Sprintf("%s:%d", typ, asn), nil
+	default:
+		if localDataPart1 == nil {
+			return "", fmt.Errorf("the %s type requires a local data part 1", CommunityType_Large)
+		}
+		return fmt.Sprintf("%s:%d:%d", typ, asn, *localDataPart1), nil
+	}
+}
+
+// getPrefixCommunityType returns the community type and the fixed part of the communities
+// of the prefix of an index
+func getPrefixCommunityType(prefix string) (CommunityType, string) {
+	t, fixed, ok := strings.Cut(prefix, ":")
+	typ := GetCommunityType(t)
+	if !ok || typ == CommunityType_Invalid || fixed == "" {
+		return CommunityType_Invalid, ""
+	}
+	return typ, fixed
+}
+
+// GetCommunity returns the community of the value in the canonical textual form,
+// <global administrator>:<value> or <global administrator>:<local data part 1>:<local data part 2>
+func GetCommunity(prefix string, id uint32) string {
+	typ, fixed := getPrefixCommunityType(prefix)
+	if typ == CommunityType_Invalid {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", fixed, id)
+}
+
+// ParseCommunity parses a standard or large community in the textual form, the community
+// type is derived from the amount of fields. The fixed part of the community is returned in
+// the canonical form, the well-known communities are rejected
+func ParseCommunity(s string) (CommunityType, string, uint32, error) {
+	if _, ok := WellKnownCommunities[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return CommunityType_Invalid, "", 0, fmt.Errorf("invalid community %s, well-known communities cannot be claimed", s)
+	}
+	parts := strings.Split(s, ":")
+	switch len(parts) {
+	case 2:
+		asn, err := strconv.ParseUint(parts[0], 10, 16)
+		if err != nil {
+			return CommunityType_Invalid, "", 0, fmt.Errorf("invalid community %s, the global administrator must be a 2 byte AS", s)
+		}
+		id, err := strconv.ParseUint(parts[1], 10, 16)
+		if err != nil {
+			return CommunityType_Invalid, "", 0, fmt.Errorf("invalid community %s, the value must be between 0 and %d", s, GetCommunityMaxID(CommunityType_Standard))
+		}
+		for name, wellKnown := range WellKnownCommunities {
+			if uint32(asn)<<16|uint32(id) == wellKnown {
+				return CommunityType_Invalid, "", 0, fmt.Errorf("invalid community %s, is the well-known community %s", s, name)
+			}
+		}
+		return CommunityType_Standard, strconv.FormatUint(asn, 10), uint32(id), nil
+	case 3:
+		asn, err := as.ParseASN(parts[0])
+		if err != nil {
+			return CommunityType_Invalid, "", 0, fmt.Errorf("invalid community %s, the global administrator must be a 4 byte AS", s)
+		}
+		localDataPart1, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return CommunityType_Invalid, "", 0, fmt.Errorf("invalid community %s, the local data part 1 must be between 0 and %d", s, CommunityID_Max)
+		}
+		id, err := strconv.ParseUint(parts[2], 10, 32)
+		if err != nil {
+			return CommunityType_Invalid, "", 0, fmt.Errorf("invalid community %s, the local data part 2 must be between 0 and %d", s, CommunityID_Max)
+		}
+		return CommunityType_Large, fmt.Sprintf("%d:%d", asn, localDataPart1), uint32(id), nil
+	default:
+		return CommunityType_Invalid, "", 0, fmt.Errorf("invalid community %s, expected <global administrator>:<value> or <global administrator>:<local data part 1>:<local data part 2>", s)
+	}
+}
+
+// ParseCommunityID returns the value of a community, the second local data part of a large
+// community
+func ParseCommunityID(s string) (uint32, error) {
+	_, _, id, err := ParseCommunity(s)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// validateCommunityPrefix validates the community is of the type of the index and within
+// the global administrator (and local data part 1) of the index
+func validateCommunityPrefix(prefix, s string) error {
+	typ, fixed, _, err := ParseCommunity(s)
+	if err != nil {
+		return err
+	}
+	indexTyp, indexFixed := getPrefixCommunityType(prefix)
+	if indexTyp == CommunityType_Invalid {
+		return fmt.Errorf("invalid community prefix %s", prefix)
+	}
+	if typ != indexTyp {
+		return fmt.Errorf("invalid community %s, expected a %s community", s, indexTyp)
+	}
+	if fixed != indexFixed {
+		return fmt.Errorf("invalid community %s, is not within %s of the index", s, indexFixed)
+	}
+	return nil
+}
+
+// ParseCommunityRange parses a range of values <start>-<end>
+func ParseCommunityRange(s string) (uint32, uint32, error) {
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid community range, expected <start>-<end>, got: %s", s)
+	}
+	start, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid community range start, got: %s, err: %s", s, err.Error())
+	}
+	end, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid community range end, got: %s, err: %s", s, err.Error())
+	}
+	return uint32(start), uint32(end), nil
+}
+
+// getCommunityRange returns the range in community notation, the segments of the range are
+// returned as is when they cannot be parsed
+func getCommunityRange(prefix, s string) string {
+	segments := backend.GetRangeSegments(s)
+	for i, segment := range segments {
+		start, end, err := ParseCommunityRange(segment)
+		if err != nil {
+			continue
+		}
+		segments[i] = fmt.Sprintf("%s-%s", GetCommunity(prefix, start), GetCommunity(prefix, end))
+	}
+	return strings.Join(segments, backend.RangeSegmentSeparator)
+}
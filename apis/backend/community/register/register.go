@@ -0,0 +1,145 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package register
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/henderiw/apiserver-builder/pkg/builder"
+	"github.com/henderiw/apiserver-builder/pkg/builder/resource"
+	"github.com/henderiw/apiserver-builder/pkg/builder/rest"
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"github.com/kuidio/kuid/apis/backend/community"
+	communitybev1alpha1 "github.com/kuidio/kuid/apis/backend/community/v1alpha1"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	genericbackend "github.com/kuidio/kuid/pkg/backend/generic"
+	"github.com/kuidio/kuid/pkg/config"
+	"github.com/kuidio/kuid/pkg/quota"
+	genericregistry "github.com/kuidio/kuid/pkg/registry/generic"
+	"github.com/kuidio/kuid/pkg/registry/options"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	config.Register(
+		community.SchemeGroupVersion.Group,
+		communitybev1alpha1.AddToScheme,
+		NewBackend,
+		ApplyStorageToBackend,
+		ApplyClientToBackend,
+		[]*config.ResourceConfig{
+			{StorageProviderFn: NewIndexStorageProvider, Internal: &community.CommunityIndex{}, ResourceVersions: []resource.Object{&community.CommunityIndex{}, &communitybev1alpha1.CommunityIndex{}}, Index: true},
+			{StorageProviderFn: NewClaimStorageProvider, Internal: &community.CommunityClaim{}, ResourceVersions: []resource.Object{&community.CommunityClaim{}, &communitybev1alpha1.CommunityClaim{}}, Claim: true},
+			{StorageProviderFn: NewStorageProvider, Internal: &community.CommunityEntry{}, ResourceVersions: []resource.Object{&community.CommunityEntry{}, &communitybev1alpha1.CommunityEntry{}}, Entry: true},
+		},
+	)
+}
+
+func NewBackend() bebackend.Backend {
+	return genericbackend.New(
+		community.CommunityIndexKind,
+		community.CommunityClaimKind,
+		community.CommunityIndexFromRuntime,
+		community.CommunityClaimFromRuntime,
+		community.CommunityEntryFromRuntime,
+		community.GetCommunityEntry,
+	)
+}
+
+func NewIndexStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *rest.StorageProvider {
+	opts := *options
+	if sync {
+		opts.BackendInvoker = bebackend.NewIndexInvoker(be)
+		return genericregistry.NewStorageProvider(ctx, obj, &opts)
+	}
+	return genericregistry.NewStorageProvider(ctx, obj, &opts)
+}
+
+// NewClaimStorageProvider enforces the claim quotas before the backend allocates the claim
+func NewClaimStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *rest.StorageProvider {
+	opts := *options
+	if sync {
+		opts.BackendInvoker = quota.NewClaimInvoker(obj.GetGroupVersionResource().Group, bebackend.NewClaimInvoker(be))
+		return genericregistry.NewStorageProvider(ctx, obj, &opts)
+	}
+	opts.BackendInvoker = quota.NewClaimInvoker(obj.GetGroupVersionResource().Group, nil)
+	return genericregistry.NewStorageProvider(ctx, obj, &opts)
+}
+
+func NewStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *rest.StorageProvider {
+	return genericregistry.NewStorageProvider(ctx, obj, options)
+}
+
+func ApplyStorageToBackend(ctx context.Context, be bebackend.Backend, apiServer *builder.Server) error {
+	claimStorageProvider := apiServer.StorageProvider[schema.GroupResource{
+		Group:    community.SchemeGroupVersion.Group,
+		Resource: community.CommunityClaimPlural,
+	}]
+
+	claimStorage, err := claimStorageProvider.Get(ctx, apiServer.Schemes[0], &Getter{})
+	if err != nil {
+		return err
+	}
+	claimStore, ok := claimStorage.(*registry.Store)
+	if !ok {
+		return fmt.Errorf("claimstore is not a registry store")
+	}
+
+	entryStorageProvider := apiServer.StorageProvider[schema.GroupResource{
+		Group:    community.SchemeGroupVersion.Group,
+		Resource: community.CommunityEntryPlural,
+	}]
+
+	entryStorage, err := entryStorageProvider.Get(ctx, apiServer.Schemes[0], &Getter{})
+	if err != nil {
+		return err
+	}
+	entryStore, ok := entryStorage.(*registry.Store)
+	if !ok {
+		return fmt.Errorf("entrystore is not a registry store")
+	}
+
+	return be.AddStorageInterfaces(genericbackend.NewKuidBackendstorage(entryStore, claimStore))
+}
+
+// ApplyClientToBackend attaches the CRD storage to the backend, the entries and claims
+// are persisted as CRDs using the client
+func ApplyClientToBackend(ctx context.Context, be bebackend.Backend, c client.Client) error {
+	scheme := runtime.NewScheme()
+	if err := community.AddToScheme(scheme); err != nil {
+		return err
+	}
+	if err := communitybev1alpha1.AddToScheme(scheme); err != nil {
+		return err
+	}
+	entryStore := bebackend.NewClientStore(c, scheme, communitybev1alpha1.SchemeGroupVersion.WithKind(community.CommunityEntryKind), true)
+	claimStore := bebackend.NewClientStore(c, scheme, communitybev1alpha1.SchemeGroupVersion.WithKind(community.CommunityClaimKind), false)
+
+	return be.AddStorageInterfaces(genericbackend.NewClientBackendstorage(entryStore, claimStore))
+}
+
+var _ generic.RESTOptionsGetter = &Getter{}
+
+type Getter struct{}
+
+func (r *Getter) GetRESTOptions(resource schema.GroupResource, example runtime.Object) (generic.RESTOptions, error) {
+	return generic.RESTOptions{}, nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	commonv1alpha1 "github.com/kuidio/kuid/apis/common/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CommunityClaimSpec defines the desired state of CommunityClaim
+type CommunityClaimSpec struct {
+	// Index defines the index for the Community Claim
+	Index string `json:"index" protobuf:"bytes,1,opt,name=index"`
+	// ID defines the value of the community, the second local data part for large communities
+	ID *uint32 `json:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
+	// Range defines the range of the resource
+	// The following notation is used: start-end <start-ID>-<end-ID>
+	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
+	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
+	// ClaimLabels define the user defined labels and selector labels used
+	// in resource claim
+	commonv1alpha1.ClaimLabels `json:",inline" protobuf:"bytes,4,opt,name=claimLabels"`
+	// Community defines the community in the textual form as an alternative for the id,
+	// <global administrator>:<value> for standard communities, e.g. 65000:100, and
+	// <global administrator>:<local data part 1>:<local data part 2> for large communities.
+	// The community must be within the global administrator of the index
+	// +optional
+	Community *string `json:"community,omitempty" protobuf:"bytes,5,opt,name=community"`
+}
+
+// CommunityClaimStatus defines the observed state of CommunityClaim
+type CommunityClaimStatus struct {
+	// ConditionedStatus provides the status of the CommunityClaim using conditions
+	// - a ready condition indicates the overall status of the resource
+	condv1alpha1.ConditionedStatus `json:",inline" protobuf:"bytes,1,opt,name=conditionedStatus"`
+	// ID defines the ID of the Community claim
+	// +optional
+	ID *uint32 `json:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
+	// Range defines the ID range of the Community claim
+	// +optional
+	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
+	// ExpiryTime defines when the claim expires
+	// +kubebuilder:validation:Optional
+	// +optional
+	ExpiryTime *string `json:"expiryTime,omitempty" protobuf:"bytes,4,opt,name=expiryTime"`
+	// Community defines the claimed community or community range in the canonical textual form
+	// +optional
+	Community *string `json:"community,omitempty" protobuf:"bytes,5,opt,name=community"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kuid}
+// CommunityClaim is the Schema for the CommunityClaim API
+type CommunityClaim struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   CommunityClaimSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status CommunityClaimStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// CommunityClaimList contains a list of CommunityClaims
+type CommunityClaimList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []CommunityClaim `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+var (
+	CommunityClaimKind     = reflect.TypeOf(CommunityClaim{}).Name()
+	CommunityClaimListKind = reflect.TypeOf(CommunityClaimList{}).Name()
+)
@@ -0,0 +1,111 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	commonv1alpha1 "github.com/kuidio/kuid/apis/common/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CommunityIndexSpec defines the desired state of CommunityIndex
+type CommunityIndexSpec struct {
+	// MinID defines the min ID the index supports
+	// +optional
+	MinID *uint32 `json:"minID,omitempty" protobuf:"bytes,1,opt,name=minID"`
+	// MaxID defines the max ID the index supports
+	// +optional
+	MaxID *uint32 `json:"maxID,omitempty" protobuf:"bytes,2,opt,name=maxID"`
+	// UserDefinedLabels define metadata to the resource.
+	// defined in the spec to distingiush metadata labels from user defined labels
+	commonv1alpha1.UserDefinedLabels `json:",inline" protobuf:"bytes,3,opt,name=userDefinedLabels"`
+	// Claims define the embedded claims in the Index
+	Claims []CommunityIndexClaim `json:"claims,omitempty" protobuf:"bytes,4,rep,name=claims"`
+	// Type defines the type of the communities of the index
+	// standard: RFC1997 communities, a 2 byte AS global administrator followed by a 16bit value
+	// large: RFC8092 large communities, a 4 byte AS global administrator followed by 2 32bit local data parts
+	// +kubebuilder:validation:Enum=standard;large
+	Type string `json:"type" protobuf:"bytes,5,opt,name=type"`
+	// GlobalAdministrator defines the AS the communities of the index are allocated in, asplain
+	// or asdot for the large type. The reserved ASs of the well-known communities cannot be used
+	GlobalAdministrator string `json:"globalAdministrator" protobuf:"bytes,6,opt,name=globalAdministrator"`
+	// LocalDataPart1 defines the first local data part of the large communities of the index,
+	// the claims allocate the second local data part. Required for the large type
+	// +optional
+	LocalDataPart1 *uint32 `json:"localDataPart1,omitempty" protobuf:"bytes,7,opt,name=localDataPart1"`
+}
+
+type CommunityIndexClaim struct {
+	// Name of the Claim
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ID defines the value of the community, the second local data part for large communities
+	ID *uint32 `json:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
+	// Range defines the range of the resource
+	// The following notation is used: start-end <start-ID>-<end-ID>
+	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
+	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
+	// UserDefinedLabels define metadata to the resource.
+	// defined in the spec to distingiush metadata labels from user defined labels
+	commonv1alpha1.UserDefinedLabels `json:",inline" protobuf:"bytes,4,opt,name=userDefinedLabels"`
+	// Community defines the community in the textual form as an alternative for the id
+	// +optional
+	Community *string `json:"community,omitempty" protobuf:"bytes,5,opt,name=community"`
+}
+
+// CommunityIndexStatus defines the observed state of CommunityIndex
+type CommunityIndexStatus struct {
+	// MinID defines the min ID the index supports
+	// +optional
+	MinID *uint32 `json:"minID,omitempty" protobuf:"bytes,1,opt,name=minID"`
+	// MaxID defines the max ID the index supports
+	// +optional
+	MaxID *uint32 `json:"maxID,omitempty" protobuf:"bytes,2,opt,name=maxID"`
+	// ConditionedStatus provides the status of the CommunityIndex using conditions
+	// - a ready condition indicates the overall status of the resource
+	condv1alpha1.ConditionedStatus `json:",inline" protobuf:"bytes,3,opt,name=conditionedStatus"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=communityindices,categories={kuid}
+// CommunityIndex is the Schema for the CommunityIndex API
+type CommunityIndex struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   CommunityIndexSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status CommunityIndexStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// CommunityIndexList contains a list of CommunityIndex
+type CommunityIndexList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []CommunityIndex `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+var (
+	CommunityIndexKind     = reflect.TypeOf(CommunityIndex{}).Name()
+	CommunityIndexListKind = reflect.TypeOf(CommunityIndexList{}).Name()
+)
@@ -0,0 +1,28 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+//go:generate deepcopy-gen -O zz_generated.deepcopy -i . -h ../../../../boilerplate.go.txt
+//go:generate defaulter-gen -O zz_generated.defaults -i . -h ../../../../boilerplate.go.txt
+//go:generate conversion-gen -O zz_generated.conversion -i . -h ../../../../boilerplate.go.txt
+
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen=package,register
+// +k8s:conversion-gen=github.com/kuidio/kuid/apis/backend/community
+// +k8s:defaulter-gen=TypeMeta
+// +groupName=community.be.kuid.dev
+
+// v1alpha1 is the v1alpha1 version of the API.
+package v1alpha1
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RangeEntryID returns the id of an entry covering the contiguous run of ids from-to
+func RangeEntryID(from, to uint64) string {
+	return fmt.Sprintf("%d-%d", from, to)
+}
+
+// ParseRangeEntryID returns the first and last id of an entry covering a run of ids,
+// false is returned when the entry id is not a run, e.g. id/length
+func ParseRangeEntryID(id string) (uint64, uint64, bool) {
+	from, to, ok := strings.Cut(id, "-")
+	if !ok {
+		return 0, 0, false
+	}
+	f, err := strconv.ParseUint(from, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	t, err := strconv.ParseUint(to, 10, 64)
+	if err != nil || t < f {
+		return 0, 0, false
+	}
+	return f, t, true
+}
+
+// GetEntryCount returns the number of ids an entry covering a run of ids holds,
+// 0 is returned when the entry id is not a run
+func GetEntryCount(id string) uint64 {
+	from, to, ok := ParseRangeEntryID(id)
+	if !ok {
+		return 0
+	}
+	return to - from + 1
+}
+
+// EntryHasID returns true when the entry id is the id or is a run that holds the id.
+// This provides the exploded view of the runs on request, e.g. using a field selector.
+func EntryHasID(entryID, id string) bool {
+	v, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return entryID == id
+	}
+	if from, to, ok := ParseRangeEntryID(entryID); ok {
+		return from <= v && v <= to
+	}
+	eid, _, _ := strings.Cut(entryID, "/")
+	return eid == id
+}
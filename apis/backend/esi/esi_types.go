@@ -0,0 +1,44 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package esi
+
+type ESIType string
+
+const (
+	ESIType_Invalid ESIType = "invalid"
+	// ESIType_Arbitrary are type 0 ESIs, the 9 octet value is the prefix of the index
+	// followed by a 32bit ID
+	ESIType_Arbitrary ESIType = "arbitrary"
+	// ESIType_LACP are type 1 ESIs, the CE LACP system MAC followed by the 16bit LACP
+	// port key
+	ESIType_LACP ESIType = "lacp"
+	// ESIType_MAC are type 3 ESIs, the system MAC followed by the 24bit local discriminator
+	ESIType_MAC ESIType = "mac"
+)
+
+func GetESIType(s string) ESIType {
+	switch s {
+	case string(ESIType_Arbitrary):
+		return ESIType_Arbitrary
+	case string(ESIType_LACP):
+		return ESIType_LACP
+	case string(ESIType_MAC):
+		return ESIType_MAC
+	default:
+		return ESIType_Invalid
+	}
+}
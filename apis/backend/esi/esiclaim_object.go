@@ -0,0 +1,498 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package esi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/henderiw/idxtable/pkg/table"
+	"github.com/henderiw/idxtable/pkg/tree"
+	"github.com/henderiw/idxtable/pkg/tree/id16"
+	"github.com/henderiw/idxtable/pkg/tree/id32"
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/apis/condition"
+	"github.com/kuidio/kuid/apis/backend"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+var _ backend.ClaimObject = &ESIClaim{}
+var _ backend.NotationClaimObject = &ESIClaim{}
+var _ backend.TypedClaimObject = &ESIClaim{}
+
+func (r *ESIClaim) GetNamespacedName() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.GetNamespace(),
+		Name:      r.GetName(),
+	}
+}
+
+func (r *ESIClaim) GetKey() store.Key {
+	return store.KeyFromNSN(types.NamespacedName{Namespace: r.Namespace, Name: r.Spec.Index})
+}
+
+// GetCondition returns the condition based on the condition kind
+func (r *ESIClaim) GetCondition(t condition.ConditionType) condition.Condition {
+	return r.Status.GetCondition(t)
+}
+
+// SetConditions sets the conditions on the resource. it allows for 0, 1 or more conditions
+// to be set at once
+func (r *ESIClaim) SetConditions(c ...condition.Condition) {
+	r.Status.SetConditions(c...)
+}
+
+// ValidateSyntax validates the claim, the ESI prefix of the index is used to validate the
+// id and the ESI of the claim when provided
+func (r *ESIClaim) ValidateSyntax(s string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if err := r.ValidateESIClaimType(); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath(""),
+			r,
+			err.Error(),
+		))
+		return allErrs
+	}
+	var v SyntaxValidator
+	claimType := r.GetClaimType()
+	switch claimType {
+	case backend.ClaimType_DynamicID:
+		v = &ESIDynamicIDSyntaxValidator{Name: string(claimType)}
+	case backend.ClaimType_StaticID:
+		v = &ESIStaticIDSyntaxValidator{Name: string(claimType), Prefix: s}
+	case backend.ClaimType_Range:
+		v = &ESIRangeSyntaxValidator{Name: string(claimType), Prefix: s}
+	default:
+		return allErrs
+	}
+	return v.Validate(r)
+}
+
+func (r *ESIClaim) ValidateESIRange(prefix string) error {
+	if r.Spec.Range == nil {
+		return fmt.Errorf("no ESI range provided")
+	}
+	var errm error
+	if r.Name == r.Spec.Index {
+		// to be able to check if the entry is reserved we get a parentname (rang name) equal to index
+		// this is because the ownerreference uses the name of the index in its labels in the cache
+		errm = errors.Join(errm, fmt.Errorf("a name of range cannot be the same as the index"))
+	}
+	segments := []backend.IDRange{}
+	for _, segment := range backend.GetRangeSegments(*r.Spec.Range) {
+		start, end, err := ParseESIRange(segment)
+		if err != nil {
+			errm = errors.Join(errm, err)
+			continue
+		}
+		if start > end {
+			errm = errors.Join(errm, fmt.Errorf("invalid ESI range start > end %s", segment))
+			continue
+		}
+		if err := validateESIPrefixID(prefix, end); err != nil {
+			errm = errors.Join(errm, err)
+			continue
+		}
+		segments = append(segments, backend.IDRange{From: uint64(start), To: uint64(end)})
+	}
+	if errm != nil {
+		return errm
+	}
+	return backend.ValidateIDRanges(segments)
+}
+
+func (r *ESIClaim) ValidateESIID(prefix string) error {
+	if r.Spec.ID == nil && r.Spec.ESI == nil {
+		return fmt.Errorf("no id provided")
+	}
+	if r.Spec.ID != nil {
+		if err := validateESIID(int(*r.Spec.ID)); err != nil {
+			return fmt.Errorf("invalid id err %s", err.Error())
+		}
+		if err := validateESIPrefixID(prefix, *r.Spec.ID); err != nil {
+			return err
+		}
+	}
+	if r.Spec.ESI != nil {
+		if _, err := ParseESIID(*r.Spec.ESI); err != nil {
+			return fmt.Errorf("invalid esi err %s", err.Error())
+		}
+		if prefix != "" {
+			if err := validateESIPrefix(prefix, *r.Spec.ESI); err != nil {
+				return fmt.Errorf("invalid esi err %s", err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// validateESIPrefixID validates the id fits in the ESIs of the index, the id is not
+// validated when the prefix of the index is unknown
+func validateESIPrefixID(prefix string, id uint32) error {
+	if prefix == "" {
+		return nil
+	}
+	typ, _ := getPrefixESIType(prefix)
+	if typ == ESIType_Invalid {
+		return fmt.Errorf("invalid ESI prefix %s", prefix)
+	}
+	if id > GetESIMaxID(typ) {
+		return fmt.Errorf("invalid id %d, the max id of the %s type is %d", id, typ, GetESIMaxID(typ))
+	}
+	return nil
+}
+
+// ValidateIndexType validates the id, range or ESI of the claim against the ESI prefix
+// of the index, the id must fit in the ESI type and the ESI must match the prefix
+func (r *ESIClaim) ValidateIndexType(typ string) error {
+	switch r.GetClaimType() {
+	case backend.ClaimType_StaticID:
+		return r.ValidateESIID(typ)
+	case backend.ClaimType_Range:
+		return r.ValidateESIRange(typ)
+	}
+	return nil
+}
+
+func (r *ESIClaim) ValidateESIClaimType() error {
+	var sb strings.Builder
+	count := 0
+	if r.Spec.ID != nil {
+		sb.WriteString(fmt.Sprintf("id: %d", *r.Spec.ID))
+		count++
+
+	}
+	if r.Spec.ESI != nil {
+		if count > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("esi: %s", *r.Spec.ESI))
+		count++
+
+	}
+	if r.Spec.Range != nil {
+		if count > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("range: %s", *r.Spec.Range))
+		count++
+
+	}
+	if count > 1 {
+		return fmt.Errorf("a claim can only have 1 type, got %s", sb.String())
+	}
+	return nil
+}
+
+func (r *ESIClaim) GetIndex() string { return r.Spec.Index }
+
+func (r *ESIClaim) GetSelector() *metav1.LabelSelector { return r.Spec.Selector }
+
+func (r *ESIClaim) IsOwner(labels labels.Set) bool {
+	for k, v := range r.getOwnerLabels() {
+		if val, ok := labels[k]; !ok || val != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *ESIClaim) getOwnerLabels() map[string]string {
+	return map[string]string{
+		backend.KuidClaimNameKey: r.Name,
+		backend.KuidClaimUIDKey:  string(r.UID),
+	}
+}
+
+// GetOwnerSelector selects the route based on the name of the claim
+func (r *ESIClaim) GetOwnerSelector() (labels.Selector, error) {
+	l := r.getOwnerLabels()
+
+	fullselector := labels.NewSelector()
+	for k, v := range l {
+		req, err := labels.NewRequirement(k, selection.Equals, []string{v})
+		if err != nil {
+			return nil, err
+		}
+		fullselector = fullselector.Add(*req)
+	}
+	return fullselector, nil
+}
+
+func (r *ESIClaim) GetLabelSelector() (labels.Selector, error) { return r.Spec.GetLabelSelector() }
+
+func (r *ESIClaim) GetClaimLabels() labels.Set {
+	labels := r.Spec.GetUserDefinedLabels()
+
+	// system defined labels
+	labels[backend.KuidClaimTypeKey] = string(r.GetClaimType())
+	labels[backend.KuidClaimNameKey] = r.Name
+	labels[backend.KuidClaimUIDKey] = string(r.UID)
+	labels[backend.KuidOwnerKindKey] = r.Kind
+	return labels
+}
+
+func (r *ESIClaim) ValidateOwner(labels labels.Set) error {
+	routeClaimName := labels[backend.KuidClaimNameKey]
+	routeClaimUID := labels[backend.KuidClaimUIDKey]
+
+	if string(r.UID) != routeClaimUID && r.Name != routeClaimName {
+		return fmt.Errorf("route owned by different claim got name %s/%s uid %s/%s",
+			r.Name,
+			routeClaimName,
+			string(r.UID),
+			routeClaimUID,
+		)
+	}
+	return nil
+}
+
+func (r *ESIClaim) GetClaimType() backend.ClaimType {
+	claimType := backend.ClaimType_Invalid
+	count := 0
+	if r.Spec.ID != nil || r.Spec.ESI != nil {
+		claimType = backend.ClaimType_StaticID
+		count++
+
+	}
+	if r.Spec.Range != nil {
+		claimType = backend.ClaimType_Range
+		count++
+
+	}
+	if count > 1 {
+		return backend.ClaimType_Invalid
+	}
+	if count == 0 {
+		return backend.ClaimType_DynamicID
+	}
+	return claimType
+}
+
+// getStaticID returns the id or the id of the ESI of the claim
+func (r *ESIClaim) getStaticID() *uint32 {
+	if r.Spec.ID != nil {
+		return r.Spec.ID
+	}
+	if r.Spec.ESI != nil {
+		id, err := ParseESIID(*r.Spec.ESI)
+		if err != nil {
+			return nil
+		}
+		return ptr.To[uint32](id)
+	}
+	return nil
+}
+
+func (r *ESIClaim) GetStaticID() *uint64 {
+	id := r.getStaticID()
+	if id == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*id))
+}
+
+func (r *ESIClaim) GetStaticTreeID(typ string) tree.ID {
+	id := r.getStaticID()
+	if id == nil {
+		return nil
+	}
+	return getTreeID(typ, *id)
+}
+
+func (r *ESIClaim) GetClaimID(typ string, id uint64) tree.ID {
+	return getTreeID(typ, uint32(id))
+}
+
+func (r *ESIClaim) GetStatusClaimID(typ string) tree.ID {
+	if r.Status.ID == nil {
+		return nil
+	}
+	return getTreeID(typ, *r.Status.ID)
+}
+
+// getTreeID returns the id in the tree of the index, the lacp type uses a 16bit tree
+func getTreeID(typ string, id uint32) tree.ID {
+	switch t, _ := getPrefixESIType(typ); t {
+	case ESIType_LACP:
+		return id16.NewID(uint16(id), id16.IDBitSize)
+	case ESIType_Arbitrary, ESIType_MAC:
+		return id32.NewID(id, id32.IDBitSize)
+	default:
+		return nil
+	}
+}
+
+func (r *ESIClaim) GetRange() *string {
+	return r.Spec.Range
+}
+
+func (r *ESIClaim) GetRangeIDs(typ string) ([]tree.Range, error) {
+	if r.Spec.Range == nil {
+		return nil, fmt.Errorf("cannot provide a range without an id")
+	}
+	switch t, _ := getPrefixESIType(typ); t {
+	case ESIType_LACP:
+		return backend.ParseRangeSegments(*r.Spec.Range, id16.ParseRange)
+	case ESIType_Arbitrary, ESIType_MAC:
+		return backend.ParseRangeSegments(*r.Spec.Range, id32.ParseRange)
+	default:
+		return nil, fmt.Errorf("cannot provide a range for an invalid ESI prefix %s", typ)
+	}
+}
+
+func (r *ESIClaim) GetTable(typ string, ranges []backend.IDRange) table.Table {
+	if getTreeID(typ, 0) == nil {
+		return nil
+	}
+	return backend.NewRangeTable(ranges, func(id uint64) tree.ID {
+		return getTreeID(typ, uint32(id))
+	})
+}
+
+func (r *ESIClaim) SetStatusRange(s *string) {
+	r.Status.Range = s
+	r.Status.ESI = nil
+}
+
+func (r *ESIClaim) SetStatusID(s *uint64) {
+	r.Status.ESI = nil
+	if s == nil {
+		r.Status.ID = nil
+		return
+	}
+	r.Status.ID = ptr.To[uint32](uint32(*s))
+}
+
+// SetStatusNotation renders the claimed id or range as ESIs in colon-hex notation using
+// the ESI prefix of the index
+func (r *ESIClaim) SetStatusNotation(typ string) {
+	r.Status.ESI = nil
+	if t, _ := getPrefixESIType(typ); t == ESIType_Invalid {
+		return
+	}
+	if r.Status.ID != nil {
+		r.Status.ESI = ptr.To[string](GetESI(typ, *r.Status.ID))
+	}
+	if r.Status.Range != nil {
+		r.Status.ESI = ptr.To[string](getESIRange(typ, *r.Status.Range))
+	}
+}
+
+func (r *ESIClaim) GetStatusID() *uint64 {
+	if r.Status.ID == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*r.Status.ID))
+}
+
+func (r *ESIClaim) GetClaimRequest() string {
+	if r.Spec.ESI != nil {
+		return *r.Spec.ESI
+	}
+	if r.Spec.ID != nil {
+		return strconv.FormatUint(uint64(*r.Spec.ID), 10)
+	}
+	if r.Spec.Range != nil {
+		return *r.Spec.Range
+	}
+	return ""
+}
+
+func (r *ESIClaim) GetClaimResponse() string {
+	if r.Status.ESI != nil {
+		return *r.Status.ESI
+	}
+	if r.Status.ID != nil {
+		return strconv.FormatUint(uint64(*r.Status.ID), 10)
+	}
+	if r.Status.Range != nil {
+		return *r.Status.Range
+	}
+	return ""
+}
+
+func (r *ESIClaim) GetClaimSet(typ string) (map[string]tree.ID, sets.Set[string], error) {
+	aranges, err := r.GetRangeIDs(typ)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot get range from claim: %v", err)
+	}
+	// claim set represents the new entries
+	newClaimSet := sets.New[string]()
+	newClaimMap := map[string]tree.ID{}
+	for _, arange := range aranges {
+		for _, rangeID := range arange.IDs() {
+			newClaimSet.Insert(rangeID.String())
+			newClaimMap[rangeID.String()] = rangeID
+		}
+	}
+	return newClaimMap, newClaimSet, nil
+}
+
+func (r *ESIClaim) GetChoreoAPIVersion() string {
+	return schema.GroupVersion{Group: GroupName, Version: "esi"}.String()
+}
+
+func ESIClaimFromUnstructured(ru runtime.Unstructured) (backend.ClaimObject, error) {
+	obj := &ESIClaim{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(ru.UnstructuredContent(), obj)
+	if err != nil {
+		return nil, fmt.Errorf("error converting unstructured to esiClaim: %v", err)
+	}
+	return obj, nil
+}
+
+func ESIClaimFromRuntime(ru runtime.Object) (backend.ClaimObject, error) {
+	claim, ok := ru.(*ESIClaim)
+	if !ok {
+		return nil, errors.New("runtime object not ESIClaim")
+	}
+	return claim, nil
+}
+
+// BuildESIClaim returns a reource from a client Object a Spec/Status
+func BuildESIClaim(meta metav1.ObjectMeta, spec *ESIClaimSpec, status *ESIClaimStatus) backend.ClaimObject {
+	aspec := ESIClaimSpec{}
+	if spec != nil {
+		aspec = *spec
+	}
+	astatus := ESIClaimStatus{}
+	if status != nil {
+		astatus = *status
+	}
+	return &ESIClaim{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.Identifier(),
+			Kind:       ESIClaimKind,
+		},
+		ObjectMeta: meta,
+		Spec:       aspec,
+		Status:     astatus,
+	}
+}
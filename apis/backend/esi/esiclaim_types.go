@@ -0,0 +1,94 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package esi
+
+import (
+	"reflect"
+
+	"github.com/kform-dev/choreo/apis/condition"
+	"github.com/kuidio/kuid/apis/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ESIClaimSpec defines the desired state of ESIClaim
+type ESIClaimSpec struct {
+	// Index defines the index for the ESI Claim
+	Index string `json:"index" protobuf:"bytes,1,opt,name=index"`
+	// ID defines the ID of the ESI, the LACP port key for the lacp type and the local
+	// discriminator for the mac type
+	ID *uint32 `json:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
+	// Range defines the range of the resource
+	// The following notation is used: start-end <start-ID>-<end-ID>
+	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
+	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
+	// ClaimLabels define the user defined labels and selector labels used
+	// in resource claim
+	common.ClaimLabels `json:",inline" protobuf:"bytes,4,opt,name=claimLabels"`
+	// ESI defines the 10 octet ESI in colon-hex notation (00:11:22:33:44:55:00:00:00:01),
+	// as an alternative for the id. The ESI must match the type and the prefix or system
+	// MAC of the index
+	// +optional
+	ESI *string `json:"esi,omitempty" protobuf:"bytes,5,opt,name=esi"`
+}
+
+// ESIClaimStatus defines the observed state of ESIClaim
+type ESIClaimStatus struct {
+	// ConditionedStatus provides the status of the ESIClaim using conditions
+	// - a ready condition indicates the overall status of the resource
+	condition.ConditionedStatus `json:",inline" protobuf:"bytes,1,opt,name=conditionedStatus"`
+	// ID defines the ID of the ESI claim
+	// +optional
+	ID *uint32 `json:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
+	// Range defines the ID range of the ESI claim
+	// +optional
+	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
+	// ExpiryTime defines when the claim expires
+	// +optional
+	ExpiryTime *string `json:"expiryTime,omitempty" protobuf:"bytes,4,opt,name=expiryTime"`
+	// ESI defines the claimed ESI or ESI range in colon-hex notation
+	// +optional
+	ESI *string `json:"esi,omitempty" protobuf:"bytes,5,opt,name=esi"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kuid}
+// ESIClaim is the Schema for the ESIClaim API
+type ESIClaim struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   ESIClaimSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status ESIClaimStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// ESIClaimList contains a list of ESIClaims
+type ESIClaimList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []ESIClaim `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+var (
+	ESIClaimKind     = reflect.TypeOf(ESIClaim{}).Name()
+	ESIClaimListKind = reflect.TypeOf(ESIClaimList{}).Name()
+)
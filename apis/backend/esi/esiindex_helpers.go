@@ -0,0 +1,173 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package esi
+
+import (
+	"fmt"
+
+	"github.com/kform-dev/choreo/apis/condition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// GetCondition returns the condition based on the condition kind
+func (r *ESIIndex) GetCondition(t condition.ConditionType) condition.Condition {
+	return r.Status.GetCondition(t)
+}
+
+// SetConditions sets the conditions on the resource. it allows for 0, 1 or more conditions
+// to be set at once
+func (r *ESIIndex) SetConditions(c ...condition.Condition) {
+	r.Status.SetConditions(c...)
+}
+
+func (r *ESIIndex) ValidateSyntax(s string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	typ := GetESIType(r.Spec.Type)
+	if typ == ESIType_Invalid {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.type"),
+			r,
+			fmt.Errorf("invalid ESI type %s, supported: %s, %s, %s", r.Spec.Type, ESIType_Arbitrary, ESIType_LACP, ESIType_MAC).Error(),
+		))
+		return allErrs
+	}
+	allErrs = append(allErrs, r.validatePrefix(typ)...)
+
+	if r.Spec.MinID != nil {
+		if *r.Spec.MinID > GetESIMaxID(typ) {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.minID"),
+				r,
+				fmt.Errorf("invalid ESI ID %d, the max ID of the %s type is %d", *r.Spec.MinID, typ, GetESIMaxID(typ)).Error(),
+			))
+		}
+	}
+	if r.Spec.MaxID != nil {
+		if *r.Spec.MaxID > GetESIMaxID(typ) {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.maxID"),
+				r,
+				fmt.Errorf("invalid ESI ID %d, the max ID of the %s type is %d", *r.Spec.MaxID, typ, GetESIMaxID(typ)).Error(),
+			))
+		}
+	}
+	if r.Spec.MinID != nil && r.Spec.MaxID != nil {
+		if *r.Spec.MinID > *r.Spec.MaxID {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.maxID"),
+				r,
+				fmt.Errorf("min ESI ID %d cannot be bigger than max ESI ID %d", *r.Spec.MinID, *r.Spec.MaxID).Error(),
+			))
+		}
+	}
+	if len(allErrs) != 0 {
+		return allErrs
+	}
+	// the claims are validated against the ESI prefix of the index
+	prefix := r.GetType()
+	for i, claim := range r.Spec.Claims {
+		if errs := r.GetClaim(claim).ValidateSyntax(prefix); len(errs) != 0 {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.claims").Index(i),
+				r,
+				fmt.Errorf("invalid claim %s: %s", claim.Name, errs.ToAggregate().Error()).Error(),
+			))
+		}
+	}
+	return allErrs
+}
+
+// validatePrefix validates the prefix of the arbitrary type and the system MAC of the lacp
+// and mac type
+func (r *ESIIndex) validatePrefix(typ ESIType) field.ErrorList {
+	var allErrs field.ErrorList
+	switch typ {
+	case ESIType_Arbitrary:
+		if r.Spec.SystemMAC != nil {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.systemMAC"),
+				r,
+				fmt.Errorf("a system MAC is not supported for the %s type", typ).Error(),
+			))
+		}
+		if _, err := GetESIPrefix(typ, r.Spec.Prefix, nil); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.prefix"),
+				r,
+				err.Error(),
+			))
+		}
+	case ESIType_LACP, ESIType_MAC:
+		if r.Spec.Prefix != nil {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.prefix"),
+				r,
+				fmt.Errorf("a prefix is not supported for the %s type, the ESIs are derived from the system MAC", typ).Error(),
+			))
+		}
+		if _, err := GetESIPrefix(typ, nil, r.Spec.SystemMAC); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.systemMAC"),
+				r,
+				err.Error(),
+			))
+		}
+	}
+	return allErrs
+}
+
+// validateImmutable validates the ESI prefix of the index does not change, the allocated
+// ESIs are derived from it
+func (r *ESIIndex) validateImmutable(old *ESIIndex) field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.Type != old.Spec.Type {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec.type"),
+			"the ESI type of an index is immutable",
+		))
+	}
+	if r.GetType() != old.GetType() {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec"),
+			"the prefix and system MAC of an index are immutable",
+		))
+	}
+	return allErrs
+}
+
+// BuildESIIndex returns a reource from a client Object a Spec/Status
+func BuildESIIndex(meta metav1.ObjectMeta, spec *ESIIndexSpec, status *ESIIndexStatus) *ESIIndex {
+	aspec := ESIIndexSpec{}
+	if spec != nil {
+		aspec = *spec
+	}
+	astatus := ESIIndexStatus{}
+	if status != nil {
+		astatus = *status
+	}
+	return &ESIIndex{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.Identifier(),
+			Kind:       ESIIndexKind,
+		},
+		ObjectMeta: meta,
+		Spec:       aspec,
+		Status:     astatus,
+	}
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package esi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/henderiw/idxtable/pkg/tree/gtree"
+	"github.com/henderiw/idxtable/pkg/tree/tree16"
+	"github.com/henderiw/idxtable/pkg/tree/tree32"
+	"github.com/henderiw/store"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+var _ backend.IndexObject = &ESIIndex{}
+
+func (r *ESIIndex) GetKey() store.Key {
+	return store.KeyFromNSN(r.GetNamespacedName())
+}
+
+func (r *ESIIndex) GetNamespacedName() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.GetNamespace(),
+		Name:      r.GetName(),
+	}
+}
+
+// GetTree returns the tree of the index, the lacp type uses a 16bit tree for the LACP port
+// keys, the arbitrary and mac type a 32bit tree
+func (r *ESIIndex) GetTree() gtree.GTree {
+	switch GetESIType(r.Spec.Type) {
+	case ESIType_LACP:
+		tree, err := tree16.New(fmt.Sprintf("esiindex.%s", r.Name), 16)
+		if err != nil {
+			return nil
+		}
+		return tree
+	case ESIType_Arbitrary, ESIType_MAC:
+		tree, err := tree32.New(fmt.Sprintf("esiindex.%s", r.Name), 32)
+		if err != nil {
+			return nil
+		}
+		return tree
+	default:
+		return nil
+	}
+}
+
+// GetType returns the ESI prefix of the index, the type octet followed by the prefix or
+// the system MAC in colon-hex notation. The claims derive their ESIs from the prefix
+func (r *ESIIndex) GetType() string {
+	prefix, err := GetESIPrefix(GetESIType(r.Spec.Type), r.Spec.Prefix, r.Spec.SystemMAC)
+	if err != nil {
+		return ""
+	}
+	return prefix
+}
+
+func (r *ESIIndex) GetMinID() *uint64 {
+	if r.Spec.MinID == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*r.Spec.MinID))
+}
+
+func (r *ESIIndex) GetMaxID() *uint64 {
+	if r.Spec.MaxID == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*r.Spec.MaxID))
+}
+
+// GetMax returns the max ID of the type of the index, the 16bit LACP port key, the 24bit
+// local discriminator or the 32bit ID of the arbitrary ESIs
+func (r *ESIIndex) GetMax() uint64 {
+	return uint64(GetESIMaxID(GetESIType(r.Spec.Type)))
+}
+
+func GetMinClaimRange(id uint64) string {
+	return fmt.Sprintf("%d-%d", ESIID_Min, id-1)
+}
+
+func GetMaxClaimRange(id, max uint64) string {
+	return fmt.Sprintf("%d-%d", id+1, max)
+}
+
+func (r *ESIIndex) GetMinClaimNSN() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.Namespace,
+		Name:      fmt.Sprintf("%s.%s", r.Name, backend.IndexReservedMinName),
+	}
+}
+
+func (r *ESIIndex) GetMaxClaimNSN() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.Namespace,
+		Name:      fmt.Sprintf("%s.%s", r.Name, backend.IndexReservedMaxName),
+	}
+}
+
+func (r *ESIIndex) GetClaims() []backend.ClaimObject {
+	claims := []backend.ClaimObject{}
+	if r.GetMinID() != nil && *r.GetMinID() != 0 {
+		claims = append(claims, r.GetMinClaim())
+	}
+	if r.GetMaxID() != nil && *r.GetMaxID() != r.GetMax() {
+		claims = append(claims, r.GetMaxClaim())
+	}
+	if claim := r.GetReservedClaim(); claim != nil {
+		claims = append(claims, claim)
+	}
+	for _, claim := range r.Spec.Claims {
+		claims = append(claims, r.GetClaim(claim))
+	}
+	return claims
+}
+
+func (r *ESIIndex) GetMinClaim() backend.ClaimObject {
+	return BuildESIClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      r.GetMinClaimNSN().Name,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       ESIIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		&ESIClaimSpec{
+			Index: r.Name,
+			Range: ptr.To[string](GetMinClaimRange(*r.GetMinID())),
+		},
+		nil,
+	)
+}
+
+func (r *ESIIndex) GetMaxClaim() backend.ClaimObject {
+	return BuildESIClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      r.GetMaxClaimNSN().Name,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       ESIIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		&ESIClaimSpec{
+			Index: r.Name,
+			Range: ptr.To[string](GetMaxClaimRange(*r.GetMaxID(), r.GetMax())),
+		},
+		nil,
+	)
+}
+
+// GetReservedClaim returns the claim of the ID 0 of an arbitrary index with the zero
+// prefix, the resulting zero ESI is reserved for single-homed segments (RFC7432)
+func (r *ESIIndex) GetReservedClaim() backend.ClaimObject {
+	if GetESIType(r.Spec.Type) != ESIType_Arbitrary || GetESI(r.GetType(), 0) != ESIZero {
+		return nil
+	}
+	if r.GetMinID() != nil && *r.GetMinID() != 0 {
+		return nil
+	}
+	return BuildESIClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      fmt.Sprintf("%s.%s-%s", r.Name, backend.IndexReservedName, "zero"),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       ESIIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		&ESIClaimSpec{
+			Index: r.Name,
+			Range: ptr.To[string]("0-0"),
+		},
+		nil,
+	)
+}
+
+func (r *ESIIndex) GetClaim(claim ESIIndexClaim) backend.ClaimObject {
+	spec := &ESIClaimSpec{
+		Index: r.Name,
+		ClaimLabels: common.ClaimLabels{
+			UserDefinedLabels: claim.UserDefinedLabels,
+		},
+	}
+	switch {
+	case claim.ID != nil:
+		spec.ID = claim.ID
+	case claim.ESI != nil:
+		spec.ESI = claim.ESI
+	default:
+		spec.Range = claim.Range
+	}
+	return BuildESIClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      fmt.Sprintf("%s.%s", r.Name, claim.Name),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       ESIIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		spec,
+		nil,
+	)
+}
+
+func ESIIndexFromRuntime(ru runtime.Object) (backend.IndexObject, error) {
+	index, ok := ru.(*ESIIndex)
+	if !ok {
+		return nil, errors.New("runtime object not ESIIndex")
+	}
+	return index, nil
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package esi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/kuidio/kuid/apis/backend"
+)
+
+const ESIID_Min = 0
+const ESIID_Max = 4294967295
+
+// ESI_Length is the length of an ESI in octets
+const ESI_Length = 10
+
+// ESI type octets (RFC7432), the types 0-5 are defined
+const (
+	ESITypeOctet_Arbitrary byte = 0x00
+	ESITypeOctet_LACP      byte = 0x01
+	ESITypeOctet_MAC       byte = 0x03
+	ESITypeOctet_Max       byte = 0x05
+)
+
+// ESIZero is the ESI of single-homed segments, it cannot be claimed
+const ESIZero = "00:00:00:00:00:00:00:00:00:00"
+
+// ESIDefaultPrefix defines the prefix of the arbitrary ESIs when the index does not define one
+const ESIDefaultPrefix = "00:00:00:00:00"
+
+// ESITypeOctets maps the ESI types of an index to the type octet of the ESIs
+var ESITypeOctets = map[ESIType]byte{
+	ESIType_Arbitrary: ESITypeOctet_Arbitrary,
+	ESIType_LACP:      ESITypeOctet_LACP,
+	ESIType_MAC:       ESITypeOctet_MAC,
+}
+
+// ESIIDBits defines the size of the IDs of the ESI types
+var ESIIDBits = map[ESIType]int{
+	ESIType_Arbitrary: 32,
+	ESIType_LACP:      16,
+	ESIType_MAC:       24,
+}
+
+func validateESIID(id int) error {
+	if id < ESIID_Min {
+		return fmt.Errorf("invalid id, got %d", id)
+	}
+	if id > ESIID_Max {
+		return fmt.Errorf("invalid id, got %d", id)
+	}
+	return nil
+}
+
+// GetESIMaxID returns the max ID of the ESI type
+func GetESIMaxID(typ ESIType) uint32 {
+	bits, ok := ESIIDBits[typ]
+	if !ok {
+		return 0
+	}
+	return uint32(uint64(1)<<bits - 1)
+}
+
+// parseOctets parses n octets in colon-hex notation
+func parseOctets(s string, n int) ([]byte, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != n {
+		return nil, fmt.Errorf("invalid %s, expected %d octets in colon-hex notation", s, n)
+	}
+	b := make([]byte, 0, n)
+	for _, part := range parts {
+		if len(part) != 2 {
+			return nil, fmt.Errorf("invalid %s, expected %d octets in colon-hex notation", s, n)
+		}
+		octet, err := hex.DecodeString(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s, expected %d octets in colon-hex notation", s, n)
+		}
+		b = append(b, octet...)
+	}
+	return b, nil
+}
+
+func formatOctets(b []byte) string {
+	parts := make([]string, 0, len(b))
+	for _, octet := range b {
+		parts = append(parts, fmt.Sprintf("%02x", octet))
+	}
+	return strings.Join(parts, ":")
+}
+
+// ParseESI parses a 10 octet ESI in colon-hex notation, e.g. 00:11:22:33:44:55:00:00:00:01
+// The type octet must be one of the ESI types 0-5
+func ParseESI(s string) ([]byte, error) {
+	b, err := parseOctets(s, ESI_Length)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ESI %s, expected 10 octets in colon-hex notation", s)
+	}
+	if b[0] > ESITypeOctet_Max {
+		return nil, fmt.Errorf("invalid ESI %s, unknown type %d", s, b[0])
+	}
+	return b, nil
+}
+
+// ParseSystemMAC parses the system MAC of an index, the MAC must be a unicast MAC address
+func ParseSystemMAC(s string) ([]byte, error) {
+	if strings.Count(s, ":") != 5 {
+		return nil, fmt.Errorf("invalid system MAC %s, expected colon-hex notation", s)
+	}
+	mac, err := net.ParseMAC(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid system MAC %s, expected colon-hex notation", s)
+	}
+	if mac[0]&0x01 != 0 {
+		return nil, fmt.Errorf("invalid system MAC %s, a multicast MAC address is not allowed", s)
+	}
+	if bytes.Equal(mac, make(net.HardwareAddr, len(mac))) {
+		return nil, fmt.Errorf("invalid system MAC %s, the zero MAC address is not allowed", s)
+	}
+	return mac, nil
+}
+
+// GetESIPrefix returns the octets that precede the IDs in the ESIs of an index in colon-hex
+// notation: the type octet followed by the prefix or the system MAC
+func GetESIPrefix(typ ESIType, prefix, systemMAC *string) (string, error) {
+	switch typ {
+	case ESIType_Arbitrary:
+		p := ESIDefaultPrefix
+		if prefix != nil {
+			p = *prefix
+		}
+		b, err := parseOctets(p, 5)
+		if err != nil {
+			return "", fmt.Errorf("invalid prefix %s, expected 5 octets in colon-hex notation", p)
+		}
+		return formatOctets(append([]byte{ESITypeOctet_Arbitrary}, b...)), nil
+	case ESIType_LACP, ESIType_MAC:
+		if systemMAC == nil {
+			return "", fmt.Errorf("a system MAC is required for the %s type", typ)
+		}
+		mac, err := ParseSystemMAC(*systemMAC)
+		if err != nil {
+			return "", err
+		}
+		return formatOctets(append([]byte{ESITypeOctets[typ]}, mac...)), nil
+	default:
+		return "", fmt.Errorf("invalid ESI type %s", typ)
+	}
+}
+
+// getOctetESIType returns the ESI type of the type octet, the ESI types that are not
+// allocated by an index are invalid
+func getOctetESIType(octet byte) ESIType {
+	for typ, typeOctet := range ESITypeOctets {
+		if typeOctet == octet {
+			return typ
+		}
+	}
+	return ESIType_Invalid
+}
+
+// getESIPrefixLength returns the amount of octets that precede the ID in the ESIs of the type
+func getESIPrefixLength(typ ESIType) int {
+	n := ESI_Length - ESIIDBits[typ]/8
+	if typ == ESIType_LACP {
+		// the LACP port key is followed by a zero octet
+		n--
+	}
+	return n
+}
+
+// getPrefixESIType returns the ESI type and the octets of the prefix of an index
+func getPrefixESIType(prefix string) (ESIType, []byte) {
+	b, err := parseOctets(prefix, strings.Count(prefix, ":")+1)
+	if err != nil || len(b) == 0 {
+		return ESIType_Invalid, nil
+	}
+	typ := getOctetESIType(b[0])
+	if typ == ESIType_Invalid || len(b) != getESIPrefixLength(typ) {
+		return ESIType_Invalid, nil
+	}
+	return typ, b
+}
+
+// GetESI returns the ESI of the id in colon-hex notation, the prefix is the type octet
+// followed by the prefix or the system MAC of the index
+func GetESI(prefix string, id uint32) string {
+	typ, b := getPrefixESIType(prefix)
+	if typ == ESIType_Invalid {
+		return ""
+	}
+	esi := make([]byte, ESI_Length)
+	copy(esi, b)
+	for i := 0; i < ESIIDBits[typ]/8; i++ {
+		esi[len(b)+i] = byte(id >> (ESIIDBits[typ] - 8*(i+1)))
+	}
+	return formatOctets(esi)
+}
+
+// ParseESIID returns the ID of an arbitrary (type 0), lacp (type 1) or mac (type 3) ESI
+func ParseESIID(s string) (uint32, error) {
+	esi, err := ParseESI(s)
+	if err != nil {
+		return 0, err
+	}
+	typ := getOctetESIType(esi[0])
+	if typ == ESIType_Invalid {
+		return 0, fmt.Errorf("invalid ESI %s, type %d cannot be claimed, supported types 0, 1 and 3", s, esi[0])
+	}
+	n := getESIPrefixLength(typ)
+	var id uint32
+	for _, octet := range esi[n : n+ESIIDBits[typ]/8] {
+		id = id<<8 | uint32(octet)
+	}
+	for _, octet := range esi[n+ESIIDBits[typ]/8:] {
+		if octet != 0 {
+			return 0, fmt.Errorf("invalid ESI %s, the octet following the LACP port key must be 0", s)
+		}
+	}
+	return id, nil
+}
+
+// validateESIPrefix validates the ESI starts with the prefix of the index
+func validateESIPrefix(prefix, s string) error {
+	esi, err := ParseESI(s)
+	if err != nil {
+		return err
+	}
+	typ, b := getPrefixESIType(prefix)
+	if typ == ESIType_Invalid {
+		return fmt.Errorf("invalid ESI prefix %s", prefix)
+	}
+	if !bytes.Equal(esi[:len(b)], b) {
+		return fmt.Errorf("invalid ESI %s, does not match the %s ESIs of the index with prefix %s", s, typ, prefix)
+	}
+	return nil
+}
+
+// ParseESIRange parses a range of ESI IDs <start>-<end>
+func ParseESIRange(s string) (uint32, uint32, error) {
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid ESI range, expected <start>-<end>, got: %s", s)
+	}
+	start, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid ESI range start, got: %s, err: %s", s, err.Error())
+	}
+	end, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid ESI range end, got: %s, err: %s", s, err.Error())
+	}
+	return uint32(start), uint32(end), nil
+}
+
+// getESIRange returns the range in colon-hex ESI notation, the segments of the range are
+// returned as is when they cannot be parsed
+func getESIRange(prefix, s string) string {
+	segments := backend.GetRangeSegments(s)
+	for i, segment := range segments {
+		start, end, err := ParseESIRange(segment)
+		if err != nil {
+			continue
+		}
+		segments[i] = fmt.Sprintf("%s-%s", GetESI(prefix, start), GetESI(prefix, end))
+	}
+	return strings.Join(segments, backend.RangeSegmentSeparator)
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package register
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/henderiw/apiserver-builder/pkg/builder"
+	"github.com/henderiw/apiserver-builder/pkg/builder/resource"
+	"github.com/henderiw/apiserver-builder/pkg/builder/rest"
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"github.com/kuidio/kuid/apis/backend/esi"
+	esibev1alpha1 "github.com/kuidio/kuid/apis/backend/esi/v1alpha1"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	genericbackend "github.com/kuidio/kuid/pkg/backend/generic"
+	"github.com/kuidio/kuid/pkg/config"
+	"github.com/kuidio/kuid/pkg/quota"
+	genericregistry "github.com/kuidio/kuid/pkg/registry/generic"
+	"github.com/kuidio/kuid/pkg/registry/options"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	config.Register(
+		esi.SchemeGroupVersion.Group,
+		esibev1alpha1.AddToScheme,
+		NewBackend,
+		ApplyStorageToBackend,
+		ApplyClientToBackend,
+		[]*config.ResourceConfig{
+			{StorageProviderFn: NewIndexStorageProvider, Internal: &esi.ESIIndex{}, ResourceVersions: []resource.Object{&esi.ESIIndex{}, &esibev1alpha1.ESIIndex{}}, Index: true},
+			{StorageProviderFn: NewClaimStorageProvider, Internal: &esi.ESIClaim{}, ResourceVersions: []resource.Object{&esi.ESIClaim{}, &esibev1alpha1.ESIClaim{}}, Claim: true},
+			{StorageProviderFn: NewStorageProvider, Internal: &esi.ESIEntry{}, ResourceVersions: []resource.Object{&esi.ESIEntry{}, &esibev1alpha1.ESIEntry{}}, Entry: true},
+		},
+	)
+}
+
+func NewBackend() bebackend.Backend {
+	return genericbackend.New(
+		esi.ESIIndexKind,
+		esi.ESIClaimKind,
+		esi.ESIIndexFromRuntime,
+		esi.ESIClaimFromRuntime,
+		esi.ESIEntryFromRuntime,
+		esi.GetESIEntry,
+	)
+}
+
+func NewIndexStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *rest.StorageProvider {
+	opts := *options
+	if sync {
+		opts.BackendInvoker = bebackend.NewIndexInvoker(be)
+		return genericregistry.NewStorageProvider(ctx, obj, &opts)
+	}
+	return genericregistry.NewStorageProvider(ctx, obj, &opts)
+}
+
+// NewClaimStorageProvider enforces the claim quotas before the backend allocates the claim
+func NewClaimStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *rest.StorageProvider {
+	opts := *options
+	if sync {
+		opts.BackendInvoker = quota.NewClaimInvoker(obj.GetGroupVersionResource().Group, bebackend.NewClaimInvoker(be))
+		return genericregistry.NewStorageProvider(ctx, obj, &opts)
+	}
+	opts.BackendInvoker = quota.NewClaimInvoker(obj.GetGroupVersionResource().Group, nil)
+	return genericregistry.NewStorageProvider(ctx, obj, &opts)
+}
+
+func NewStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *rest.StorageProvider {
+	return genericregistry.NewStorageProvider(ctx, obj, options)
+}
+
+func ApplyStorageToBackend(ctx context.Context, be bebackend.Backend, apiServer *builder.Server) error {
+	claimStorageProvider := apiServer.StorageProvider[schema.GroupResource{
+		Group:    esi.SchemeGroupVersion.Group,
+		Resource: esi.ESIClaimPlural,
+	}]
+
+	claimStorage, err := claimStorageProvider.Get(ctx, apiServer.Schemes[0], &Getter{})
+	if err != nil {
+		return err
+	}
+	claimStore, ok := claimStorage.(*registry.Store)
+	if !ok {
+		return fmt.Errorf("claimstore is not a registry store")
+	}
+
+	entryStorageProvider := apiServer.StorageProvider[schema.GroupResource{
+		Group:    esi.SchemeGroupVersion.Group,
+		Resource: esi.ESIEntryPlural,
+	}]
+
+	entryStorage, err := entryStorageProvider.Get(ctx, apiServer.Schemes[0], &Getter{})
+	if err != nil {
+		return err
+	}
+	entryStore, ok := entryStorage.(*registry.Store)
+	if !ok {
+		return fmt.Errorf("entrystore is not a registry store")
+	}
+
+	return be.AddStorageInterfaces(genericbackend.NewKuidBackendstorage(entryStore, claimStore))
+}
+
+// ApplyClientToBackend attaches the CRD storage to the backend, the entries and claims
+// are persisted as CRDs using the client
+func ApplyClientToBackend(ctx context.Context, be bebackend.Backend, c client.Client) error {
+	scheme := runtime.NewScheme()
+	if err := esi.AddToScheme(scheme); err != nil {
+		return err
+	}
+	if err := esibev1alpha1.AddToScheme(scheme); err != nil {
+		return err
+	}
+	entryStore := bebackend.NewClientStore(c, scheme, esibev1alpha1.SchemeGroupVersion.WithKind(esi.ESIEntryKind), true)
+	claimStore := bebackend.NewClientStore(c, scheme, esibev1alpha1.SchemeGroupVersion.WithKind(esi.ESIClaimKind), false)
+
+	return be.AddStorageInterfaces(genericbackend.NewClientBackendstorage(entryStore, claimStore))
+}
+
+var _ generic.RESTOptionsGetter = &Getter{}
+
+type Getter struct{}
+
+func (r *Getter) GetRESTOptions(resource schema.GroupResource, example runtime.Object) (generic.RESTOptions, error) {
+	return generic.RESTOptions{}, nil
+}
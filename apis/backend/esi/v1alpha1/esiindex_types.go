@@ -0,0 +1,117 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	commonv1alpha1 "github.com/kuidio/kuid/apis/common/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ESIIndexSpec defines the desired state of ESIIndex
+type ESIIndexSpec struct {
+	// MinID defines the min ID the index supports
+	// +optional
+	MinID *uint32 `json:"minID,omitempty" protobuf:"bytes,1,opt,name=minID"`
+	// MaxID defines the max ID the index supports
+	// +optional
+	MaxID *uint32 `json:"maxID,omitempty" protobuf:"bytes,2,opt,name=maxID"`
+	// UserDefinedLabels define metadata to the resource.
+	// defined in the spec to distingiush metadata labels from user defined labels
+	commonv1alpha1.UserDefinedLabels `json:",inline" protobuf:"bytes,3,opt,name=userDefinedLabels"`
+	// Claims define the embedded claims in the Index
+	Claims []ESIIndexClaim `json:"claims,omitempty" protobuf:"bytes,4,rep,name=claims"`
+	// Type defines the type of the ESIs of the index
+	// arbitrary: type 0 ESIs, the prefix followed by a 32bit ID
+	// lacp: type 1 ESIs, the system MAC followed by the 16bit LACP port key as ID
+	// mac: type 3 ESIs, the system MAC followed by the 24bit local discriminator as ID
+	// +kubebuilder:validation:Enum=arbitrary;lacp;mac
+	Type string `json:"type" protobuf:"bytes,5,opt,name=type"`
+	// Prefix defines the 5 octets following the type octet of the arbitrary ESIs in
+	// colon-hex notation, e.g. 00:11:22:33:44. Defaults to 00:00:00:00:00 and only
+	// applies to the arbitrary type
+	// +optional
+	Prefix *string `json:"prefix,omitempty" protobuf:"bytes,6,opt,name=prefix"`
+	// SystemMAC defines the system MAC address the ESIs are derived from in colon-hex
+	// notation, the CE LACP system MAC for the lacp type or the system MAC for the mac type.
+	// Required for the lacp and mac type
+	// +optional
+	SystemMAC *string `json:"systemMAC,omitempty" protobuf:"bytes,7,opt,name=systemMAC"`
+}
+
+type ESIIndexClaim struct {
+	// Name of the Claim
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ID defines the ID of the ESI, the LACP port key for the lacp type and the local
+	// discriminator for the mac type
+	ID *uint32 `json:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
+	// Range defines the range of the resource
+	// The following notation is used: start-end <start-ID>-<end-ID>
+	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
+	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
+	// UserDefinedLabels define metadata to the resource.
+	// defined in the spec to distingiush metadata labels from user defined labels
+	commonv1alpha1.UserDefinedLabels `json:",inline" protobuf:"bytes,4,opt,name=userDefinedLabels"`
+	// ESI defines the 10 octet ESI in colon-hex notation, as an alternative for the id.
+	// The ESI must match the type and the prefix or system MAC of the index
+	// +optional
+	ESI *string `json:"esi,omitempty" protobuf:"bytes,5,opt,name=esi"`
+}
+
+// ESIIndexStatus defines the observed state of ESIIndex
+type ESIIndexStatus struct {
+	// MinID defines the min ID the index supports
+	// +optional
+	MinID *uint32 `json:"minID,omitempty" protobuf:"bytes,1,opt,name=minID"`
+	// MaxID defines the max ID the index supports
+	// +optional
+	MaxID *uint32 `json:"maxID,omitempty" protobuf:"bytes,2,opt,name=maxID"`
+	// ConditionedStatus provides the status of the ESIIndex using conditions
+	// - a ready condition indicates the overall status of the resource
+	condv1alpha1.ConditionedStatus `json:",inline" protobuf:"bytes,3,opt,name=conditionedStatus"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=esiindices,categories={kuid}
+// ESIIndex is the Schema for the ESIIndex API
+type ESIIndex struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   ESIIndexSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status ESIIndexStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// ESIIndexList contains a list of ESIIndex
+type ESIIndexList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []ESIIndex `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+var (
+	ESIIndexKind     = reflect.TypeOf(ESIIndex{}).Name()
+	ESIIndexListKind = reflect.TypeOf(ESIIndexList{}).Name()
+)
@@ -23,9 +23,6 @@ import (
 	"strings"
 
 	"github.com/henderiw/idxtable/pkg/table"
-	"github.com/henderiw/idxtable/pkg/table/table16"
-	"github.com/henderiw/idxtable/pkg/table/table32"
-	"github.com/henderiw/idxtable/pkg/table/table64"
 	"github.com/henderiw/idxtable/pkg/tree"
 	"github.com/henderiw/idxtable/pkg/tree/id16"
 	"github.com/henderiw/idxtable/pkg/tree/id32"
@@ -107,24 +104,42 @@ func (r *EXTCOMMClaim) ValidateEXTCOMMRange(extCommType ExtendedCommunityType) e
 	if r.Spec.Range == nil {
 		return fmt.Errorf("no EXTCOMM range provided")
 	}
-	parts := strings.SplitN(*r.Spec.Range, "-", 2)
+	var errm error
+	segments := []backend.IDRange{}
+	for _, segment := range backend.GetRangeSegments(*r.Spec.Range) {
+		start, end, err := validateEXTCOMMRangeSegment(extCommType, segment)
+		if err != nil {
+			errm = errors.Join(errm, err)
+			continue
+		}
+		segments = append(segments, backend.IDRange{From: uint64(start), To: uint64(end)})
+	}
+	if errm != nil {
+		return errm
+	}
+	return backend.ValidateIDRanges(segments)
+}
+
+// validateEXTCOMMRangeSegment validates a segment <start>-<end> of a range
+func validateEXTCOMMRangeSegment(extCommType ExtendedCommunityType, segment string) (int, int, error) {
+	parts := strings.SplitN(segment, "-", 2)
 	if len(parts) != 2 {
-		return fmt.Errorf("invalid EXTCOMM range, expected <start>-<end>, got: %s", *r.Spec.Range)
+		return 0, 0, fmt.Errorf("invalid EXTCOMM range, expected <start>-<end>, got: %s", segment)
 	}
 	var errm error
 	start, err := strconv.Atoi(parts[0])
 	if err != nil {
-		errm = errors.Join(errm, fmt.Errorf("invalid EXTCOMM range start, got: %s, err: %s", *r.Spec.Range, err.Error()))
+		errm = errors.Join(errm, fmt.Errorf("invalid EXTCOMM range start, got: %s, err: %s", segment, err.Error()))
 	}
 	end, err := strconv.Atoi(parts[1])
 	if err != nil {
-		errm = errors.Join(errm, fmt.Errorf("invalid EXTCOMM range end, got: %s, err: %s", *r.Spec.Range, err.Error()))
+		errm = errors.Join(errm, fmt.Errorf("invalid EXTCOMM range end, got: %s, err: %s", segment, err.Error()))
 	}
 	if errm != nil {
-		return errm
+		return 0, 0, errm
 	}
 	if start > end {
-		errm = errors.Join(errm, fmt.Errorf("invalid EXTCOMM range start > end %s", *r.Spec.Range))
+		errm = errors.Join(errm, fmt.Errorf("invalid EXTCOMM range start > end %s", segment))
 	}
 	if err := validateEXTCOMMID(extCommType, uint64(start)); err != nil {
 		errm = errors.Join(errm, fmt.Errorf("invalid EXTCOMM start err %s", err.Error()))
@@ -132,7 +147,7 @@ func (r *EXTCOMMClaim) ValidateEXTCOMMRange(extCommType ExtendedCommunityType) e
 	if err := validateEXTCOMMID(extCommType, uint64(end)); err != nil {
 		errm = errors.Join(errm, fmt.Errorf("invalid EXTCOMM end err %s", err.Error()))
 	}
-	return errm
+	return start, end, errm
 }
 
 func (r *EXTCOMMClaim) ValidateEXTCOMMID(extCommType ExtendedCommunityType) error {
@@ -302,33 +317,29 @@ func (r *EXTCOMMClaim) GetRange() *string {
 	return r.Spec.Range
 }
 
-func (r *EXTCOMMClaim) GetRangeID(typ string) (tree.Range, error) {
+func (r *EXTCOMMClaim) GetRangeIDs(typ string) ([]tree.Range, error) {
 	if r.Spec.Range == nil {
 		return nil, fmt.Errorf("cannot provide a range without an id")
 	}
 	switch GetEXTCOMMType(typ) {
 	case ExtendedCommunityType_IPv4Address, ExtendedCommunityType_4byteAS:
-		return id16.ParseRange(*r.Spec.Range)
+		return backend.ParseRangeSegments(*r.Spec.Range, id16.ParseRange)
 	case ExtendedCommunityType_2byteAS:
-		return id32.ParseRange(*r.Spec.Range)
+		return backend.ParseRangeSegments(*r.Spec.Range, id32.ParseRange)
 	case ExtendedCommunityType_Opaque:
-		return id64.ParseRange(*r.Spec.Range)
+		return backend.ParseRangeSegments(*r.Spec.Range, id64.ParseRange)
 	default:
 		return nil, fmt.Errorf("cannot provide a range for invalid type %s", typ)
 	}
 }
 
-func (r *EXTCOMMClaim) GetTable(typ string, to, from uint64) table.Table {
-	switch GetEXTCOMMType(typ) {
-	case ExtendedCommunityType_IPv4Address, ExtendedCommunityType_4byteAS:
-		return table16.New(uint16(to), uint16(from))
-	case ExtendedCommunityType_2byteAS:
-		return table32.New(uint32(to), uint32(from))
-	case ExtendedCommunityType_Opaque:
-		return table64.New(uint64(to), uint64(from))
-	default:
+func (r *EXTCOMMClaim) GetTable(typ string, ranges []backend.IDRange) table.Table {
+	if getTreeID(typ, 0) == nil {
 		return nil
 	}
+	return backend.NewRangeTable(ranges, func(id uint64) tree.ID {
+		return getTreeID(typ, id)
+	})
 }
 
 func (r *EXTCOMMClaim) SetStatusRange(s *string) {
@@ -373,16 +384,18 @@ func (r *EXTCOMMClaim) GetClaimResponse() string {
 }
 
 func (r *EXTCOMMClaim) GetClaimSet(typ string) (map[string]tree.ID, sets.Set[string], error) {
-	arange, err := r.GetRangeID(typ)
+	aranges, err := r.GetRangeIDs(typ)
 	if err != nil {
 		return nil, nil, fmt.Errorf("cannot get range from claim: %v", err)
 	}
 	// claim set represents the new entries
 	newClaimSet := sets.New[string]()
 	newClaimMap := map[string]tree.ID{}
-	for _, rangeID := range arange.IDs() {
-		newClaimSet.Insert(rangeID.String())
-		newClaimMap[rangeID.String()] = rangeID
+	for _, arange := range aranges {
+		for _, rangeID := range arange.IDs() {
+			newClaimSet.Insert(rangeID.String())
+			newClaimMap[rangeID.String()] = rangeID
+		}
 	}
 	return newClaimMap, newClaimSet, nil
 }
@@ -33,6 +33,7 @@ type EXTCOMMClaimSpec struct {
 	// Range defines the range of the resource
 	// The following notation is used: start-end <start-ID>-<end-ID>
 	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
 	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
 	// ClaimLabels define the user defined labels and selector labels used
 	// in resource claim
@@ -38,6 +38,10 @@ type EXTCOMMEntrySpec struct {
 	// ClaimLabels define the user defined labels and selector labels used
 	// in resource claim
 	common.ClaimLabels `json:",inline" protobuf:"bytes,5,opt,name=claimLabels"`
+	// Count defines the number of ids the entry holds when the entry is a contiguous run
+	// of ids claimed by a range
+	// +optional
+	Count uint64 `json:"count,omitempty" protobuf:"varint,6,opt,name=count"`
 	// Claim defines the name of the claim that is the origin of this  entry
 	Claim string `json:"claim" protobuf:"bytes,6,opt,name=claim"`
 }
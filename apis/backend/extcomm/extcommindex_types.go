@@ -60,6 +60,7 @@ type EXTCOMMIndexClaim struct {
 	// Range defines the range of the resource
 	// The following notation is used: start-end <start-ID>-<end-ID>
 	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
 	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
 	// UserDefinedLabels define metadata to the resource.
 	// defined in the spec to distingiush metadata labels from user defined labels
@@ -383,6 +383,7 @@ func autoConvert_v1alpha1_EXTCOMMEntrySpec_To_extcomm_EXTCOMMEntrySpec(in *EXTCO
 	out.IndexEntry = in.IndexEntry
 	out.ClaimType = backend.ClaimType(in.ClaimType)
 	out.ID = in.ID
+	out.Count = in.Count
 	if err := asv1alpha1.Convert_v1alpha1_ClaimLabels_To_common_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
@@ -400,6 +401,7 @@ func autoConvert_extcomm_EXTCOMMEntrySpec_To_v1alpha1_EXTCOMMEntrySpec(in *extco
 	out.IndexEntry = in.IndexEntry
 	out.ClaimType = backend.ClaimType(in.ClaimType)
 	out.ID = in.ID
+	out.Count = in.Count
 	if err := asv1alpha1.Convert_common_ClaimLabels_To_v1alpha1_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
@@ -24,6 +24,8 @@ const (
 	GENIDType_32bit   GENIDType = "32bit"
 	GENIDType_48bit   GENIDType = "48bit"
 	GENIDType_64bit   GENIDType = "64bit"
+	// GENIDType_MAC is a 48bit space of MAC addresses rendered in colon-hex notation
+	GENIDType_MAC GENIDType = "mac"
 )
 
 func GetGenIDType(s string) GENIDType {
@@ -36,6 +38,8 @@ func GetGenIDType(s string) GENIDType {
 		return GENIDType_48bit
 	case string(GENIDType_64bit):
 		return GENIDType_64bit
+	case string(GENIDType_MAC):
+		return GENIDType_MAC
 	default:
 		return GENIDType_Invalid
 	}
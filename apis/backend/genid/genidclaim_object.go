@@ -23,9 +23,6 @@ import (
 	"strings"
 
 	"github.com/henderiw/idxtable/pkg/table"
-	"github.com/henderiw/idxtable/pkg/table/table16"
-	"github.com/henderiw/idxtable/pkg/table/table32"
-	"github.com/henderiw/idxtable/pkg/table/table64"
 	"github.com/henderiw/idxtable/pkg/tree"
 	"github.com/henderiw/idxtable/pkg/tree/id16"
 	"github.com/henderiw/idxtable/pkg/tree/id32"
@@ -98,45 +95,73 @@ func (r *GENIDClaim) ValidateGENIDRange(genidType GENIDType) error {
 	if r.Spec.Range == nil {
 		return fmt.Errorf("no GENID range provided")
 	}
-	parts := strings.SplitN(*r.Spec.Range, "-", 2)
-	if len(parts) != 2 {
-		return fmt.Errorf("invalid GENID range, expected <start>-<end>, got: %s", *r.Spec.Range)
-	}
 	var errm error
 	if r.Name == r.Spec.Index {
 		// to be able to check if the entry is reserved we get a parentname (rang name) equal to index
 		// this is because the ownerreference uses the name of the index in its labels in the cache
 		errm = errors.Join(errm, fmt.Errorf("a name of range cannot be the same as the index"))
 	}
-	start, err := strconv.Atoi(parts[0])
+	segments := []backend.IDRange{}
+	for _, segment := range backend.GetRangeSegments(*r.Spec.Range) {
+		start, end, err := validateGENIDRangeSegment(genidType, segment)
+		if err != nil {
+			errm = errors.Join(errm, err)
+			continue
+		}
+		segments = append(segments, backend.IDRange{From: start, To: end})
+	}
+	if errm != nil {
+		return errm
+	}
+	return backend.ValidateIDRanges(segments)
+}
+
+// validateGENIDRangeSegment validates a segment <start>-<end> of a range
+func validateGENIDRangeSegment(genidType GENIDType, segment string) (uint64, uint64, error) {
+	parts := strings.SplitN(segment, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid GENID range, expected <start>-<end>, got: %s", segment)
+	}
+	var errm error
+	start, err := parseGENID(genidType, parts[0])
 	if err != nil {
-		errm = errors.Join(errm, fmt.Errorf("invalid GENID range start, got: %s, err: %s", *r.Spec.Range, err.Error()))
+		errm = errors.Join(errm, fmt.Errorf("invalid GENID range start, got: %s, err: %s", segment, err.Error()))
 	}
-	end, err := strconv.Atoi(parts[1])
+	end, err := parseGENID(genidType, parts[1])
 	if err != nil {
-		errm = errors.Join(errm, fmt.Errorf("invalid GENID range end, got: %s, err: %s", *r.Spec.Range, err.Error()))
+		errm = errors.Join(errm, fmt.Errorf("invalid GENID range end, got: %s, err: %s", segment, err.Error()))
 	}
 	if errm != nil {
-		return errm
+		return 0, 0, errm
 	}
 	if start > end {
-		errm = errors.Join(errm, fmt.Errorf("invalid GENID range start > end %s", *r.Spec.Range))
+		errm = errors.Join(errm, fmt.Errorf("invalid GENID range start > end %s", segment))
 	}
-	if err := validateGENIDID(genidType, uint64(start)); err != nil {
+	if err := validateGENIDID(genidType, start); err != nil {
 		errm = errors.Join(errm, fmt.Errorf("invalid GENID start err %s", err.Error()))
 	}
-	if err := validateGENIDID(genidType, uint64(end)); err != nil {
+	if err := validateGENIDID(genidType, end); err != nil {
 		errm = errors.Join(errm, fmt.Errorf("invalid GENID end err %s", err.Error()))
 	}
-	return errm
+	return start, end, errm
 }
 
 func (r *GENIDClaim) ValidateGENIDID(genidType GENIDType) error {
-	if r.Spec.ID == nil {
+	if r.Spec.ID == nil && r.Spec.MAC == nil {
 		return fmt.Errorf("no id provided")
 	}
-	if err := validateGENIDID(genidType, *r.Spec.ID); err != nil {
-		return fmt.Errorf("invalid id err %s", err.Error())
+	if r.Spec.ID != nil {
+		if err := validateGENIDID(genidType, *r.Spec.ID); err != nil {
+			return fmt.Errorf("invalid id err %s", err.Error())
+		}
+	}
+	if r.Spec.MAC != nil {
+		if genidType != GENIDType_MAC {
+			return fmt.Errorf("a mac address requires the mac type, got %s", genidType)
+		}
+		if _, err := ParseMAC(*r.Spec.MAC); err != nil {
+			return fmt.Errorf("invalid mac err %s", err.Error())
+		}
 	}
 	return nil
 }
@@ -148,6 +173,14 @@ func (r *GENIDClaim) ValidateGENIDClaimType() error {
 		sb.WriteString(fmt.Sprintf("id: %d", *r.Spec.ID))
 		count++
 
+	}
+	if r.Spec.MAC != nil {
+		if count > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("mac: %s", *r.Spec.MAC))
+		count++
+
 	}
 	if r.Spec.Range != nil {
 		if count > 0 {
@@ -230,7 +263,7 @@ func (r *GENIDClaim) ValidateOwner(labels labels.Set) error {
 func (r *GENIDClaim) GetClaimType() backend.ClaimType {
 	claimType := backend.ClaimType_Invalid
 	count := 0
-	if r.Spec.ID != nil {
+	if r.Spec.ID != nil || r.Spec.MAC != nil {
 		claimType = backend.ClaimType_StaticID
 		count++
 
@@ -248,17 +281,35 @@ func (r *GENIDClaim) GetClaimType() backend.ClaimType {
 	}
 	return claimType
 }
+
+// getStaticID returns the id or the mac address of the claim
+func (r *GENIDClaim) getStaticID() *uint64 {
+	if r.Spec.ID != nil {
+		return r.Spec.ID
+	}
+	if r.Spec.MAC != nil {
+		id, err := ParseMAC(*r.Spec.MAC)
+		if err != nil {
+			return nil
+		}
+		return ptr.To[uint64](id)
+	}
+	return nil
+}
+
 func (r *GENIDClaim) GetStaticID() *uint64 {
-	if r.Spec.ID == nil {
+	id := r.getStaticID()
+	if id == nil {
 		return nil
 	}
-	return ptr.To[uint64](uint64(*r.Spec.ID))
+	return ptr.To[uint64](*id)
 }
 func (r *GENIDClaim) GetStaticTreeID(typ string) tree.ID {
-	if r.Spec.ID == nil {
+	id := r.getStaticID()
+	if id == nil {
 		return nil
 	}
-	return getTreeID(typ, *r.Spec.ID)
+	return getTreeID(typ, *id)
 }
 
 func (r *GENIDClaim) GetClaimID(typ string, id uint64) tree.ID {
@@ -270,7 +321,7 @@ func (r *GENIDClaim) GetStatusClaimID(typ string) tree.ID {
 		return nil
 	}
 	return getTreeID(typ, *r.Status.ID)
-	
+
 }
 
 func getTreeID(typ string, id uint64) tree.ID {
@@ -279,7 +330,7 @@ func getTreeID(typ string, id uint64) tree.ID {
 		return id16.NewID(uint16(id), id16.IDBitSize)
 	case GENIDType_32bit:
 		return id32.NewID(uint32(id), id32.IDBitSize)
-	case GENIDType_48bit:
+	case GENIDType_48bit, GENIDType_MAC:
 		return id64.NewID(uint64(id), id64.IDBitSize)
 	case GENIDType_64bit:
 		return id64.NewID(uint64(id), id64.IDBitSize)
@@ -288,48 +339,50 @@ func getTreeID(typ string, id uint64) tree.ID {
 	}
 }
 
+// GetRange returns the range of the claim in decimal notation
 func (r *GENIDClaim) GetRange() *string {
-	return r.Spec.Range
+	if r.Spec.Range == nil {
+		return nil
+	}
+	return ptr.To[string](getDecimalRange(*r.Spec.Range))
 }
 
-func (r *GENIDClaim) GetRangeID(typ string) (tree.Range, error) {
+func (r *GENIDClaim) GetRangeIDs(typ string) ([]tree.Range, error) {
 	if r.Spec.Range == nil {
 		return nil, fmt.Errorf("cannot provide a range without an id")
 	}
 	switch GetGenIDType(typ) {
 	case GENIDType_16bit:
-		return id16.ParseRange(*r.Spec.Range)
+		return backend.ParseRangeSegments(*r.Spec.Range, id16.ParseRange)
 	case GENIDType_32bit:
-		return id32.ParseRange(*r.Spec.Range)
+		return backend.ParseRangeSegments(*r.Spec.Range, id32.ParseRange)
 	case GENIDType_48bit:
-		return id64.ParseRange(*r.Spec.Range)
+		return backend.ParseRangeSegments(*r.Spec.Range, id64.ParseRange)
 	case GENIDType_64bit:
-		return id64.ParseRange(*r.Spec.Range)
+		return backend.ParseRangeSegments(*r.Spec.Range, id64.ParseRange)
+	case GENIDType_MAC:
+		return backend.ParseRangeSegments(getDecimalRange(*r.Spec.Range), id64.ParseRange)
 	default:
 		return nil, fmt.Errorf("cannot provide a range for an invalid type %s", typ)
 	}
 }
 
-func (r *GENIDClaim) GetTable(typ string, to, from uint64) table.Table {
-	switch GetGenIDType(typ) {
-	case GENIDType_16bit:
-		return table16.New(uint16(to), uint16(from))
-	case GENIDType_32bit:
-		return table32.New(uint32(to), uint32(from))
-	case GENIDType_48bit:
-		return table64.New(uint64(to), uint64(from))
-	case GENIDType_64bit:
-		return table64.New(uint64(to), uint64(from))
-	default:
+func (r *GENIDClaim) GetTable(typ string, ranges []backend.IDRange) table.Table {
+	if getTreeID(typ, 0) == nil {
 		return nil
 	}
+	return backend.NewRangeTable(ranges, func(id uint64) tree.ID {
+		return getTreeID(typ, id)
+	})
 }
 
 func (r *GENIDClaim) SetStatusRange(s *string) {
 	r.Status.Range = s
+	r.Status.MAC = nil
 }
 
 func (r *GENIDClaim) SetStatusID(s *uint64) {
+	r.Status.MAC = nil
 	if s == nil {
 		r.Status.ID = nil
 		return
@@ -337,6 +390,20 @@ func (r *GENIDClaim) SetStatusID(s *uint64) {
 	r.Status.ID = ptr.To(*s)
 }
 
+// SetStatusNotation renders the claimed id or range of a mac index in colon-hex notation
+func (r *GENIDClaim) SetStatusNotation(typ string) {
+	r.Status.MAC = nil
+	if GetGenIDType(typ) != GENIDType_MAC {
+		return
+	}
+	if r.Status.ID != nil {
+		r.Status.MAC = ptr.To[string](GetMAC(*r.Status.ID))
+	}
+	if r.Status.Range != nil {
+		r.Status.MAC = ptr.To[string](getMACRange(*r.Status.Range))
+	}
+}
+
 func (r *GENIDClaim) GetStatusID() *uint64 {
 	if r.Status.ID == nil {
 		return nil
@@ -349,6 +416,9 @@ func (r *GENIDClaim) GetClaimRequest() string {
 	if r.Spec.ID != nil {
 		return strconv.Itoa(int(*r.Spec.ID))
 	}
+	if r.Spec.MAC != nil {
+		return *r.Spec.MAC
+	}
 	if r.Spec.Range != nil {
 		return *r.Spec.Range
 	}
@@ -357,6 +427,9 @@ func (r *GENIDClaim) GetClaimRequest() string {
 
 func (r *GENIDClaim) GetClaimResponse() string {
 	// we assume validation is already done when calling this
+	if r.Status.MAC != nil {
+		return *r.Status.MAC
+	}
 	if r.Status.ID != nil {
 		return strconv.Itoa(int(*r.Status.ID))
 	}
@@ -367,16 +440,18 @@ func (r *GENIDClaim) GetClaimResponse() string {
 }
 
 func (r *GENIDClaim) GetClaimSet(typ string) (map[string]tree.ID, sets.Set[string], error) {
-	arange, err := r.GetRangeID(typ)
+	aranges, err := r.GetRangeIDs(typ)
 	if err != nil {
 		return nil, nil, fmt.Errorf("cannot get range from claim: %v", err)
 	}
 	// claim set represents the new entries
 	newClaimSet := sets.New[string]()
 	newClaimMap := map[string]tree.ID{}
-	for _, rangeID := range arange.IDs() {
-		newClaimSet.Insert(rangeID.String())
-		newClaimMap[rangeID.String()] = rangeID
+	for _, arange := range aranges {
+		for _, rangeID := range arange.IDs() {
+			newClaimSet.Insert(rangeID.String())
+			newClaimMap[rangeID.String()] = rangeID
+		}
 	}
 	return newClaimMap, newClaimSet, nil
 }
@@ -33,10 +33,17 @@ type GENIDClaimSpec struct {
 	// Range defines the range of the resource
 	// The following notation is used: start-end <start-ID>-<end-ID>
 	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
+	// For the mac type the IDs can be provided in colon-hex notation,
+	// e.g. 02:00:5e:00:00:00-02:00:5e:00:00:ff
 	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
 	// ClaimLabels define the user defined labels and selector labels used
 	// in resource claim
 	common.ClaimLabels `json:",inline" protobuf:"bytes,4,opt,name=claimLabels"`
+	// MAC defines the MAC address of the resource in colon-hex notation, e.g. 02:00:5e:00:01:01,
+	// as an alternative for the id. Only applies to the mac type
+	// +optional
+	MAC *string `json:"mac,omitempty" protobuf:"bytes,5,opt,name=mac"`
 }
 
 // GENIDClaimStatus defines the observed state of GENIDClaim
@@ -54,6 +61,10 @@ type GENIDClaimStatus struct {
 	// +kubebuilder:validation:Optional
 	// +optional
 	ExpiryTime *string `json:"expiryTime,omitempty" protobuf:"bytes,4,opt,name=expiryTime"`
+	// MAC defines the claimed MAC address or MAC range in colon-hex notation,
+	// only set for the mac type
+	// +optional
+	MAC *string `json:"mac,omitempty" protobuf:"bytes,5,opt,name=mac"`
 }
 
 // +genclient
@@ -22,6 +22,7 @@ import (
 
 	"github.com/henderiw/apiserver-builder/pkg/builder/resource"
 	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"github.com/kuidio/kuid/apis/backend"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -183,6 +184,7 @@ func (r *GENIDEntry) TableConvertor() func(gr schema.GroupResource) rest.TableCo
 					entry.GetIndex(),
 					entry.GetClaimType(),
 					entry.GetSpecID(),
+					entry.Spec.Count,
 				}
 			},
 			[]metav1.TableColumnDefinition{
@@ -191,6 +193,7 @@ func (r *GENIDEntry) TableConvertor() func(gr schema.GroupResource) rest.TableCo
 				{Name: "Index", Type: "string"},
 				{Name: "ClaimType", Type: "string"},
 				{Name: "ID", Type: "string"},
+				{Name: "Count", Type: "integer"},
 			},
 		)
 	}
@@ -204,6 +207,8 @@ func (r *GENIDEntry) FieldLabelConversion() runtime.FieldLabelConversionFunc {
 			return label, value, nil
 		case "metadata.namespace":
 			return label, value, nil
+		case "spec.id":
+			return label, value, nil
 		default:
 			return "", "", fmt.Errorf("%q is not a known field selector", label)
 		}
@@ -223,8 +228,8 @@ func (r *GENIDEntry) FieldSelector() func(ctx context.Context, fieldSelector fie
 			return filter, nil
 		}
 		requirements := fieldSelector.Requirements()
+		filter = &GENIDEntryFilter{}
 		for _, requirement := range requirements {
-			filter = &GENIDEntryFilter{}
 			switch requirement.Operator {
 			case selection.Equals, selection.DoesNotExist:
 				if requirement.Value == "" {
@@ -239,6 +244,8 @@ func (r *GENIDEntry) FieldSelector() func(ctx context.Context, fieldSelector fie
 				filter.Name = requirement.Value
 			case "metadata.namespace":
 				filter.Namespace = requirement.Value
+			case "spec.id":
+				filter.ID = requirement.Value
 			default:
 				return filter, apierrors.NewBadRequest(fmt.Sprintf("unknown fieldSelector field %q", requirement.Field))
 			}
@@ -264,6 +271,10 @@ type GENIDEntryFilter struct {
 
 	// Namespace filters by the namespace of the objects
 	Namespace string `protobuf:"bytes,2,opt,name=namespace"`
+
+	// ID filters by an id of the objects, an entry holding a run of ids matches every
+	// id of the run
+	ID string `protobuf:"bytes,3,opt,name=id"`
 }
 
 func (r *GENIDEntryFilter) Filter(ctx context.Context, obj runtime.Object) bool {
@@ -289,6 +300,9 @@ func (r *GENIDEntryFilter) Filter(ctx context.Context, obj runtime.Object) bool
 			f = true
 		}
 	}
+	if r.ID != "" && !backend.EntryHasID(o.Spec.ID, r.ID) {
+		f = true
+	}
 	return f
 }
 
@@ -73,9 +73,91 @@ func (r *GENIDIndex) ValidateSyntax(s string) field.ErrorList {
 			))
 		}
 	}
+	allErrs = append(allErrs, r.validateMAC()...)
 	return allErrs
 }
 
+// validateMAC validates the OUI of a mac index, the OUI is required and cannot be a
+// multicast OUI and the min and max ID need to be within the OUI
+func (r *GENIDIndex) validateMAC() field.ErrorList {
+	var allErrs field.ErrorList
+	if GetGenIDType(r.Spec.Type) != GENIDType_MAC {
+		if r.Spec.OUI != nil || r.Spec.LocallyAdministered {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.oui"),
+				r,
+				fmt.Errorf("an oui requires the mac type, got %s", r.Spec.Type).Error(),
+			))
+		}
+		return allErrs
+	}
+	oui, err := r.GetOUI()
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.oui"),
+			r,
+			fmt.Errorf("invalid oui err %s", err.Error()).Error(),
+		))
+		return allErrs
+	}
+	if oui&MAC_OUIMulticastBit != 0 {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.oui"),
+			r,
+			fmt.Errorf("invalid oui %s, the multicast bit is set", *r.Spec.OUI).Error(),
+		))
+		return allErrs
+	}
+	from, to, _ := r.getOUIRange()
+	if r.Spec.MinID != nil && (*r.Spec.MinID < from || *r.Spec.MinID > to) {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.minID"),
+			r,
+			fmt.Errorf("min GENID ID %s is not within the oui %s", GetMAC(*r.Spec.MinID), GetMAC(oui<<MAC_NICBits)[:8]).Error(),
+		))
+	}
+	if r.Spec.MaxID != nil && (*r.Spec.MaxID < from || *r.Spec.MaxID > to) {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.maxID"),
+			r,
+			fmt.Errorf("max GENID ID %s is not within the oui %s", GetMAC(*r.Spec.MaxID), GetMAC(oui<<MAC_NICBits)[:8]).Error(),
+		))
+	}
+	return allErrs
+}
+
+// validateImmutable validates the OUI and the locally administered bit of the index do not
+// change, the allocated MAC addresses are derived from them
+func (r *GENIDIndex) validateImmutable(old *GENIDIndex) field.ErrorList {
+	var allErrs field.ErrorList
+	if !equalOUI(r.Spec.OUI, old.Spec.OUI) {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec.oui"),
+			"the oui of an index is immutable",
+		))
+	}
+	if r.Spec.LocallyAdministered != old.Spec.LocallyAdministered {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec.locallyAdministered"),
+			"the locally administered bit of an index is immutable",
+		))
+	}
+	return allErrs
+}
+
+// equalOUI returns true when the OUIs are equal, the case of the hex digits is ignored
+func equalOUI(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	aOUI, aErr := ParseOUI(*a)
+	bOUI, bErr := ParseOUI(*b)
+	if aErr != nil || bErr != nil {
+		return *a == *b
+	}
+	return aOUI == bOUI
+}
+
 // BuildGENIDIndex returns a reource from a client Object a Spec/Status
 func BuildGENIDIndex(meta metav1.ObjectMeta, spec *GENIDIndexSpec, status *GENIDIndexStatus) *GENIDIndex {
 	aspec := GENIDIndexSpec{}
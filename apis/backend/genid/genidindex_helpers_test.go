@@ -0,0 +1,115 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package genid
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateMAC(t *testing.T) {
+	cases := map[string]struct {
+		spec       *GENIDIndexSpec
+		wantFields []string
+	}{
+		"MAC": {
+			spec:       &GENIDIndexSpec{Type: "mac", OUI: ptr.To("00:00:5e"), LocallyAdministered: true},
+			wantFields: []string{},
+		},
+		"NoOUI": {
+			spec:       &GENIDIndexSpec{Type: "mac"},
+			wantFields: []string{"spec.oui"},
+		},
+		"MulticastOUI": {
+			spec:       &GENIDIndexSpec{Type: "mac", OUI: ptr.To("01:00:5e")},
+			wantFields: []string{"spec.oui"},
+		},
+		"OUIWithoutMAC": {
+			spec:       &GENIDIndexSpec{Type: "32bit", OUI: ptr.To("00:00:5e")},
+			wantFields: []string{"spec.oui"},
+		},
+		"MinMaxInOUI": {
+			spec: &GENIDIndexSpec{Type: "mac", OUI: ptr.To("00:00:5e"), LocallyAdministered: true,
+				MinID: ptr.To[uint64](0x02005e000100), MaxID: ptr.To[uint64](0x02005e0001ff)},
+			wantFields: []string{},
+		},
+		"MinMaxOutsideOUI": {
+			spec: &GENIDIndexSpec{Type: "mac", OUI: ptr.To("00:00:5e"), LocallyAdministered: true,
+				MinID: ptr.To[uint64](0x00005e000100), MaxID: ptr.To[uint64](0x02005f000000)},
+			wantFields: []string{"spec.minID", "spec.maxID"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			index := BuildGENIDIndex(metav1.ObjectMeta{Namespace: "dummy", Name: "a"}, tc.spec, nil)
+
+			got := []string{}
+			for _, err := range index.ValidateSyntax("") {
+				got = append(got, err.Field)
+			}
+			if diff := cmp.Diff(tc.wantFields, got); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateImmutable(t *testing.T) {
+	old := &GENIDIndexSpec{Type: "mac", OUI: ptr.To("00:00:5e"), LocallyAdministered: true}
+
+	cases := map[string]struct {
+		spec       *GENIDIndexSpec
+		wantFields []string
+	}{
+		"Equal": {
+			spec:       &GENIDIndexSpec{Type: "mac", OUI: ptr.To("00:00:5E"), LocallyAdministered: true, MaxID: ptr.To[uint64](0x02005e0001ff)},
+			wantFields: []string{},
+		},
+		"OUI": {
+			spec:       &GENIDIndexSpec{Type: "mac", OUI: ptr.To("00:00:5f"), LocallyAdministered: true},
+			wantFields: []string{"spec.oui"},
+		},
+		"LocallyAdministered": {
+			spec:       &GENIDIndexSpec{Type: "mac", OUI: ptr.To("00:00:5e")},
+			wantFields: []string{"spec.locallyAdministered"},
+		},
+		"NoOUI": {
+			spec:       &GENIDIndexSpec{Type: "48bit"},
+			wantFields: []string{"spec.oui", "spec.locallyAdministered"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			oldIndex := BuildGENIDIndex(metav1.ObjectMeta{Namespace: "dummy", Name: "a"}, old, nil)
+			newIndex := BuildGENIDIndex(metav1.ObjectMeta{Namespace: "dummy", Name: "a"}, tc.spec, nil)
+
+			got := []string{}
+			for _, err := range newIndex.ValidateUpdate(context.Background(), newIndex, oldIndex) {
+				got = append(got, err.Field)
+			}
+			if diff := cmp.Diff(tc.wantFields, got); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
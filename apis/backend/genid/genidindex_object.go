@@ -61,7 +61,7 @@ func (r *GENIDIndex) GetTree() gtree.GTree {
 			return nil
 		}
 		return tree
-	case GENIDType_48bit:
+	case GENIDType_48bit, GENIDType_MAC:
 		tree, err := tree64.New(fmt.Sprintf("genidindex.%s", r.Name), 48)
 		if err != nil {
 			return nil
@@ -82,20 +82,58 @@ func (r *GENIDIndex) GetType() string {
 	return r.Spec.Type
 }
 
+// GetMinID returns the min ID of the index, for the mac type the min ID defaults to the
+// first MAC address of the OUI
 func (r *GENIDIndex) GetMinID() *uint64 {
 	if r.Spec.MinID == nil {
+		if from, _, ok := r.getOUIRange(); ok {
+			return ptr.To(from)
+		}
 		return nil
 	}
 	return ptr.To(uint64(*r.Spec.MinID))
 }
 
+// GetMaxID returns the max ID of the index, for the mac type the max ID defaults to the
+// last MAC address of the OUI
 func (r *GENIDIndex) GetMaxID() *uint64 {
 	if r.Spec.MaxID == nil {
+		if _, to, ok := r.getOUIRange(); ok {
+			return ptr.To(to)
+		}
 		return nil
 	}
 	return ptr.To(uint64(*r.Spec.MaxID))
 }
 
+// GetOUI returns the OUI of a mac index with the locally administered bit applied
+func (r *GENIDIndex) GetOUI() (uint64, error) {
+	if r.Spec.OUI == nil {
+		return 0, fmt.Errorf("no oui provided")
+	}
+	oui, err := ParseOUI(*r.Spec.OUI)
+	if err != nil {
+		return 0, err
+	}
+	if r.Spec.LocallyAdministered {
+		oui |= MAC_OUILocallyAdministeredBit
+	}
+	return oui, nil
+}
+
+// getOUIRange returns the first and last MAC address of the OUI of a mac index
+func (r *GENIDIndex) getOUIRange() (uint64, uint64, bool) {
+	if GetGenIDType(r.Spec.Type) != GENIDType_MAC {
+		return 0, 0, false
+	}
+	oui, err := r.GetOUI()
+	if err != nil {
+		return 0, 0, false
+	}
+	from := oui << MAC_NICBits
+	return from, from | (1<<MAC_NICBits - 1), true
+}
+
 func (r *GENIDIndex) GetMax() uint64 {
 	return GENIDID_MaxValue[GetGenIDType(r.Spec.Type)]
 }
@@ -152,7 +190,7 @@ func (r *GENIDIndex) GetMinClaim() backend.ClaimObject {
 		},
 		&GENIDClaimSpec{
 			Index: r.Name,
-			Range: ptr.To(GetMinClaimRange(*r.Spec.MinID)),
+			Range: ptr.To(GetMinClaimRange(*r.GetMinID())),
 		},
 		nil,
 	)
@@ -174,7 +212,7 @@ func (r *GENIDIndex) GetMaxClaim() backend.ClaimObject {
 		},
 		&GENIDClaimSpec{
 			Index: r.Name,
-			Range: ptr.To(GetMaxClaimRange(GetGenIDType(r.Spec.Type), *r.Spec.MaxID)),
+			Range: ptr.To(GetMaxClaimRange(GetGenIDType(r.Spec.Type), *r.GetMaxID())),
 		},
 		nil,
 	)
@@ -189,6 +227,8 @@ func (r *GENIDIndex) GetClaim(claim GENIDIndexClaim) backend.ClaimObject {
 	}
 	if claim.ID != nil {
 		spec.ID = claim.ID
+	} else if claim.MAC != nil {
+		spec.MAC = claim.MAC
 	} else {
 		spec.Range = claim.Range
 	}
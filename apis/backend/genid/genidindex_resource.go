@@ -299,7 +299,11 @@ func (r *GENIDIndex) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 
 // ValidateCreate statically validates
 func (r *GENIDIndex) ValidateCreate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	return r.ValidateSyntax("")
+	index, ok := obj.(*GENIDIndex)
+	if !ok {
+		return r.ValidateSyntax("")
+	}
+	return index.ValidateSyntax("")
 }
 
 func (r *GENIDIndex) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
@@ -310,5 +314,13 @@ func (r *GENIDIndex) PrepareForUpdate(ctx context.Context, obj, old runtime.Obje
 }
 
 func (r *GENIDIndex) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
-	return r.ValidateSyntax("")
+	index, ok := obj.(*GENIDIndex)
+	if !ok {
+		return r.ValidateSyntax("")
+	}
+	allErrs := index.ValidateSyntax("")
+	if oldIndex, ok := old.(*GENIDIndex); ok {
+		allErrs = append(allErrs, index.validateImmutable(oldIndex)...)
+	}
+	return allErrs
 }
@@ -36,10 +36,19 @@ type GENIDIndexSpec struct {
 	// defined in the spec to distingiush metadata labels from user defined labels
 	common.UserDefinedLabels `json:",inline" protobuf:"bytes,3,opt,name=userDefinedLabels"`
 	// Type defines the type of the GENID
-	// 16bit, 32bit, 48bit, 64bit
+	// 16bit, 32bit, 48bit, 64bit, mac
 	Type string `json:"type,omitempty" protobuf:"bytes,4,opt,name=type"`
 	// Claims define the embedded claims in the Index
 	Claims []GENIDIndexClaim `json:"claims,omitempty" protobuf:"bytes,5,rep,name=claims"`
+	// OUI defines the prefix of the MAC addresses in colon-hex notation, e.g. 00:00:5e.
+	// The index is restricted to the MAC addresses of the OUI, within the min and max ID.
+	// Required for the mac type
+	// +optional
+	OUI *string `json:"oui,omitempty" protobuf:"bytes,6,opt,name=oui"`
+	// LocallyAdministered sets the locally administered bit in the OUI, e.g. the OUI
+	// 00:00:5e results in the MAC addresses 02:00:5e:xx:xx:xx. Only applies to the mac type
+	// +optional
+	LocallyAdministered bool `json:"locallyAdministered,omitempty" protobuf:"varint,7,opt,name=locallyAdministered"`
 }
 
 type GENIDIndexClaim struct {
@@ -50,10 +59,16 @@ type GENIDIndexClaim struct {
 	// Range defines the range of the resource
 	// The following notation is used: start-end <start-ID>-<end-ID>
 	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
+	// For the mac type the IDs can be provided in colon-hex notation
 	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
 	// UserDefinedLabels define metadata to the resource.
 	// defined in the spec to distingiush metadata labels from user defined labels
 	common.UserDefinedLabels `json:",inline" protobuf:"bytes,4,opt,name=userDefinedLabels"`
+	// MAC defines the MAC address of the resource in colon-hex notation, as an alternative
+	// for the id. Only applies to the mac type
+	// +optional
+	MAC *string `json:"mac,omitempty" protobuf:"bytes,5,opt,name=mac"`
 }
 
 // GENIDIndexStatus defines the observed state of GENIDIndex
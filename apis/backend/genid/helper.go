@@ -18,6 +18,11 @@ package genid
 
 import (
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/kuidio/kuid/apis/backend"
 )
 
 const GENIDID_Min = 0
@@ -28,6 +33,7 @@ var GENIDID_MaxBits = map[GENIDType]int{
 	GENIDType_32bit:   32,
 	GENIDType_48bit:   48,
 	GENIDType_64bit:   64,
+	GENIDType_MAC:     48,
 }
 
 var GENIDID_MaxValue = map[GENIDType]uint64{
@@ -36,6 +42,7 @@ var GENIDID_MaxValue = map[GENIDType]uint64{
 	GENIDType_32bit:   1<<GENIDID_MaxBits[GENIDType_32bit] - 1,
 	GENIDType_48bit:   1<<GENIDID_MaxBits[GENIDType_48bit] - 1,
 	GENIDType_64bit:   1<<GENIDID_MaxBits[GENIDType_64bit] - 1,
+	GENIDType_MAC:     1<<GENIDID_MaxBits[GENIDType_MAC] - 1,
 }
 
 func validateGENIDID(genidType GENIDType, id uint64) error {
@@ -47,3 +54,116 @@ func validateGENIDID(genidType GENIDType, id uint64) error {
 	}
 	return nil
 }
+
+const (
+	// MAC_NICBits defines the bits of the MAC address following the OUI
+	MAC_NICBits = 24
+	// MAC_OUIMulticastBit defines the individual/group bit in the first octet of the OUI
+	MAC_OUIMulticastBit = 0x010000
+	// MAC_OUILocallyAdministeredBit defines the universal/local bit in the first octet of the OUI
+	MAC_OUILocallyAdministeredBit = 0x020000
+)
+
+// ParseMAC parses a MAC address in colon-hex notation, e.g. 00:00:5e:00:01:01
+func ParseMAC(s string) (uint64, error) {
+	if strings.Count(s, ":") != 5 {
+		return 0, fmt.Errorf("invalid mac address, expected colon-hex notation, got %s", s)
+	}
+	hw, err := net.ParseMAC(s)
+	if err != nil {
+		return 0, err
+	}
+	var id uint64
+	for _, b := range hw {
+		id = id<<8 | uint64(b)
+	}
+	return id, nil
+}
+
+// ParseOUI parses an OUI in colon-hex notation, e.g. 00:00:5e
+func ParseOUI(s string) (uint64, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid oui, expected colon-hex notation, got %s", s)
+	}
+	var oui uint64
+	for _, part := range parts {
+		if len(part) != 2 {
+			return 0, fmt.Errorf("invalid oui, expected colon-hex notation, got %s", s)
+		}
+		b, err := strconv.ParseUint(part, 16, 8)
+		if err != nil {
+			return 0, fmt.Errorf("invalid oui, expected colon-hex notation, got %s", s)
+		}
+		oui = oui<<8 | b
+	}
+	return oui, nil
+}
+
+// GetMAC returns the id in colon-hex notation
+func GetMAC(id uint64) string {
+	hw := make(net.HardwareAddr, 6)
+	for i := 5; i >= 0; i-- {
+		hw[i] = byte(id)
+		id >>= 8
+	}
+	return hw.String()
+}
+
+// parseGENID parses an id in decimal notation or in colon-hex notation for the mac type
+func parseGENID(genidType GENIDType, s string) (uint64, error) {
+	if strings.Contains(s, ":") {
+		if genidType != GENIDType_MAC {
+			return 0, fmt.Errorf("colon-hex notation requires the mac type, got %s", s)
+		}
+		return ParseMAC(s)
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// getDecimalRange returns the range with the MAC addresses in colon-hex notation replaced
+// by their decimal notation, the range is returned as is when it cannot be parsed
+func getDecimalRange(s string) string {
+	if !strings.Contains(s, ":") {
+		return s
+	}
+	segments := []string{}
+	for _, segment := range backend.GetRangeSegments(s) {
+		parts := strings.SplitN(segment, "-", 2)
+		if len(parts) != 2 {
+			return s
+		}
+		start, err := parseGENID(GENIDType_MAC, parts[0])
+		if err != nil {
+			return s
+		}
+		end, err := parseGENID(GENIDType_MAC, parts[1])
+		if err != nil {
+			return s
+		}
+		segments = append(segments, fmt.Sprintf("%d-%d", start, end))
+	}
+	return strings.Join(segments, backend.RangeSegmentSeparator)
+}
+
+// getMACRange returns the range in colon-hex notation, the range is returned as is when
+// it cannot be parsed
+func getMACRange(s string) string {
+	segments := []string{}
+	for _, segment := range backend.GetRangeSegments(getDecimalRange(s)) {
+		parts := strings.SplitN(segment, "-", 2)
+		if len(parts) != 2 {
+			return s
+		}
+		start, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return s
+		}
+		end, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return s
+		}
+		segments = append(segments, fmt.Sprintf("%s-%s", GetMAC(start), GetMAC(end)))
+	}
+	return strings.Join(segments, backend.RangeSegmentSeparator)
+}
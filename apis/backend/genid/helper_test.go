@@ -0,0 +1,137 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package genid
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseOUI(t *testing.T) {
+	cases := map[string]struct {
+		oui         string
+		want        uint64
+		expectedErr bool
+	}{
+		"OUI": {
+			oui:  "00:00:5e",
+			want: 0x00005e,
+		},
+		"UpperCase": {
+			oui:  "AC:DE:48",
+			want: 0xacde48,
+		},
+		"MAC": {
+			oui:         "00:00:5e:00:01:01",
+			expectedErr: true,
+		},
+		"Digits": {
+			oui:         "0:00:5e",
+			expectedErr: true,
+		},
+		"Hex": {
+			oui:         "00:00:zz",
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseOUI(tc.oui)
+			if diff := cmp.Diff(tc.expectedErr, err != nil); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseMAC(t *testing.T) {
+	cases := map[string]struct {
+		mac         string
+		want        uint64
+		expectedErr bool
+	}{
+		"MAC": {
+			mac:  "02:00:5e:00:01:01",
+			want: 0x02005e000101,
+		},
+		"Dashes": {
+			mac:         "02-00-5e-00-01-01",
+			expectedErr: true,
+		},
+		"EUI64": {
+			mac:         "02:00:5e:ff:fe:00:01:01",
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseMAC(tc.mac)
+			if diff := cmp.Diff(tc.expectedErr, err != nil); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if err == nil {
+				if diff := cmp.Diff(tc.mac, GetMAC(got)); diff != "" {
+					t.Errorf("-want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRangeNotation(t *testing.T) {
+	cases := map[string]struct {
+		r           string
+		wantDecimal string
+		wantMAC     string
+	}{
+		"Decimal": {
+			r:           "100-199,300-399",
+			wantDecimal: "100-199,300-399",
+			wantMAC:     "00:00:00:00:00:64-00:00:00:00:00:c7,00:00:00:00:01:2c-00:00:00:00:01:8f",
+		},
+		"MAC": {
+			r:           "02:00:5e:01:00:00-02:00:5e:01:00:ff,02:00:5e:02:00:00-02:00:5e:02:00:ff",
+			wantDecimal: "2200600379392-2200600379647,2200600444928-2200600445183",
+			wantMAC:     "02:00:5e:01:00:00-02:00:5e:01:00:ff,02:00:5e:02:00:00-02:00:5e:02:00:ff",
+		},
+		"Invalid": {
+			r:           "02:00:5e:01:00:00",
+			wantDecimal: "02:00:5e:01:00:00",
+			wantMAC:     "02:00:5e:01:00:00",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.wantDecimal, getDecimalRange(tc.r)); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantMAC, getMACRange(tc.r)); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
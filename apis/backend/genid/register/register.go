@@ -29,11 +29,13 @@ import (
 	bebackend "github.com/kuidio/kuid/pkg/backend"
 	genericbackend "github.com/kuidio/kuid/pkg/backend/generic"
 	"github.com/kuidio/kuid/pkg/config"
+	"github.com/kuidio/kuid/pkg/quota"
 	genericregistry "github.com/kuidio/kuid/pkg/registry/generic"
 	"github.com/kuidio/kuid/pkg/registry/options"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/registry/generic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func init() {
@@ -42,10 +44,11 @@ func init() {
 		genidbev1alpha1.AddToScheme,
 		NewBackend,
 		ApplyStorageToBackend,
+		ApplyClientToBackend,
 		[]*config.ResourceConfig{
-			{StorageProviderFn: NewIndexStorageProvider, Internal: &genid.GENIDIndex{}, ResourceVersions: []resource.Object{&genid.GENIDIndex{}, &genidbev1alpha1.GENIDIndex{}}},
-			{StorageProviderFn: NewClaimStorageProvider, Internal: &genid.GENIDClaim{}, ResourceVersions: []resource.Object{&genid.GENIDClaim{}, &genidbev1alpha1.GENIDClaim{}}},
-			{StorageProviderFn: NewStorageProvider, Internal: &genid.GENIDEntry{}, ResourceVersions: []resource.Object{&genid.GENIDEntry{}, &genidbev1alpha1.GENIDEntry{}}},
+			{StorageProviderFn: NewIndexStorageProvider, Internal: &genid.GENIDIndex{}, ResourceVersions: []resource.Object{&genid.GENIDIndex{}, &genidbev1alpha1.GENIDIndex{}}, Index: true},
+			{StorageProviderFn: NewClaimStorageProvider, Internal: &genid.GENIDClaim{}, ResourceVersions: []resource.Object{&genid.GENIDClaim{}, &genidbev1alpha1.GENIDClaim{}}, Claim: true},
+			{StorageProviderFn: NewStorageProvider, Internal: &genid.GENIDEntry{}, ResourceVersions: []resource.Object{&genid.GENIDEntry{}, &genidbev1alpha1.GENIDEntry{}}, Entry: true},
 		},
 	)
 }
@@ -70,12 +73,14 @@ func NewIndexStorageProvider(ctx context.Context, obj resource.InternalObject, b
 	return genericregistry.NewStorageProvider(ctx, obj, &opts)
 }
 
+// NewClaimStorageProvider enforces the claim quotas before the backend allocates the claim
 func NewClaimStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *rest.StorageProvider {
 	opts := *options
 	if sync {
-		opts.BackendInvoker = bebackend.NewClaimInvoker(be)
+		opts.BackendInvoker = quota.NewClaimInvoker(obj.GetGroupVersionResource().Group, bebackend.NewClaimInvoker(be))
 		return genericregistry.NewStorageProvider(ctx, obj, &opts)
 	}
+	opts.BackendInvoker = quota.NewClaimInvoker(obj.GetGroupVersionResource().Group, nil)
 	return genericregistry.NewStorageProvider(ctx, obj, &opts)
 }
 
@@ -115,6 +120,22 @@ func ApplyStorageToBackend(ctx context.Context, be bebackend.Backend, apiServer
 	return be.AddStorageInterfaces(genericbackend.NewKuidBackendstorage(entryStore, claimStore))
 }
 
+// ApplyClientToBackend attaches the CRD storage to the backend, the entries and claims
+// are persisted as CRDs using the client
+func ApplyClientToBackend(ctx context.Context, be bebackend.Backend, c client.Client) error {
+	scheme := runtime.NewScheme()
+	if err := genid.AddToScheme(scheme); err != nil {
+		return err
+	}
+	if err := genidbev1alpha1.AddToScheme(scheme); err != nil {
+		return err
+	}
+	entryStore := bebackend.NewClientStore(c, scheme, genidbev1alpha1.SchemeGroupVersion.WithKind(genid.GENIDEntryKind), true)
+	claimStore := bebackend.NewClientStore(c, scheme, genidbev1alpha1.SchemeGroupVersion.WithKind(genid.GENIDClaimKind), false)
+
+	return be.AddStorageInterfaces(genericbackend.NewClientBackendstorage(entryStore, claimStore))
+}
+
 var _ generic.RESTOptionsGetter = &Getter{}
 
 type Getter struct{}
@@ -251,6 +251,7 @@ func autoConvert_v1alpha1_GENIDClaimSpec_To_genid_GENIDClaimSpec(in *GENIDClaimS
 	if err := asv1alpha1.Convert_v1alpha1_ClaimLabels_To_common_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
+	out.MAC = (*string)(unsafe.Pointer(in.MAC))
 	return nil
 }
 
@@ -266,6 +267,7 @@ func autoConvert_genid_GENIDClaimSpec_To_v1alpha1_GENIDClaimSpec(in *genid.GENID
 	if err := asv1alpha1.Convert_common_ClaimLabels_To_v1alpha1_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
+	out.MAC = (*string)(unsafe.Pointer(in.MAC))
 	return nil
 }
 
@@ -281,6 +283,7 @@ func autoConvert_v1alpha1_GENIDClaimStatus_To_genid_GENIDClaimStatus(in *GENIDCl
 	out.ID = (*uint64)(unsafe.Pointer(in.ID))
 	out.Range = (*string)(unsafe.Pointer(in.Range))
 	out.ExpiryTime = (*string)(unsafe.Pointer(in.ExpiryTime))
+	out.MAC = (*string)(unsafe.Pointer(in.MAC))
 	return nil
 }
 
@@ -296,6 +299,7 @@ func autoConvert_genid_GENIDClaimStatus_To_v1alpha1_GENIDClaimStatus(in *genid.G
 	out.ID = (*uint64)(unsafe.Pointer(in.ID))
 	out.Range = (*string)(unsafe.Pointer(in.Range))
 	out.ExpiryTime = (*string)(unsafe.Pointer(in.ExpiryTime))
+	out.MAC = (*string)(unsafe.Pointer(in.MAC))
 	return nil
 }
 
@@ -383,6 +387,7 @@ func autoConvert_v1alpha1_GENIDEntrySpec_To_genid_GENIDEntrySpec(in *GENIDEntryS
 	out.IndexEntry = in.IndexEntry
 	out.ClaimType = backend.ClaimType(in.ClaimType)
 	out.ID = in.ID
+	out.Count = in.Count
 	if err := asv1alpha1.Convert_v1alpha1_ClaimLabels_To_common_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
@@ -399,6 +404,7 @@ func autoConvert_genid_GENIDEntrySpec_To_v1alpha1_GENIDEntrySpec(in *genid.GENID
 	out.IndexEntry = in.IndexEntry
 	out.ClaimType = backend.ClaimType(in.ClaimType)
 	out.ID = in.ID
+	out.Count = in.Count
 	if err := asv1alpha1.Convert_common_ClaimLabels_To_v1alpha1_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
@@ -473,6 +479,7 @@ func autoConvert_v1alpha1_GENIDIndexClaim_To_genid_GENIDIndexClaim(in *GENIDInde
 	if err := asv1alpha1.Convert_v1alpha1_UserDefinedLabels_To_common_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
 		return err
 	}
+	out.MAC = (*string)(unsafe.Pointer(in.MAC))
 	return nil
 }
 
@@ -488,6 +495,7 @@ func autoConvert_genid_GENIDIndexClaim_To_v1alpha1_GENIDIndexClaim(in *genid.GEN
 	if err := asv1alpha1.Convert_common_UserDefinedLabels_To_v1alpha1_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
 		return err
 	}
+	out.MAC = (*string)(unsafe.Pointer(in.MAC))
 	return nil
 }
 
@@ -556,6 +564,8 @@ func autoConvert_v1alpha1_GENIDIndexSpec_To_genid_GENIDIndexSpec(in *GENIDIndexS
 	} else {
 		out.Claims = nil
 	}
+	out.OUI = (*string)(unsafe.Pointer(in.OUI))
+	out.LocallyAdministered = in.LocallyAdministered
 	return nil
 }
 
@@ -582,6 +592,8 @@ func autoConvert_genid_GENIDIndexSpec_To_v1alpha1_GENIDIndexSpec(in *genid.GENID
 	} else {
 		out.Claims = nil
 	}
+	out.OUI = (*string)(unsafe.Pointer(in.OUI))
+	out.LocallyAdministered = in.LocallyAdministered
 	return nil
 }
 
@@ -97,6 +97,11 @@ func (in *GENIDClaimSpec) DeepCopyInto(out *GENIDClaimSpec) {
 		**out = **in
 	}
 	in.ClaimLabels.DeepCopyInto(&out.ClaimLabels)
+	if in.MAC != nil {
+		in, out := &in.MAC, &out.MAC
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GENIDClaimSpec.
@@ -128,6 +133,11 @@ func (in *GENIDClaimStatus) DeepCopyInto(out *GENIDClaimStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.MAC != nil {
+		in, out := &in.MAC, &out.MAC
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GENIDClaimStatus.
@@ -304,6 +314,11 @@ func (in *GENIDIndexSpec) DeepCopyInto(out *GENIDIndexSpec) {
 		**out = **in
 	}
 	in.UserDefinedLabels.DeepCopyInto(&out.UserDefinedLabels)
+	if in.OUI != nil {
+		in, out := &in.OUI, &out.OUI
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GENIDIndexSpec.
@@ -112,6 +112,11 @@ func (in *GENIDClaimSpec) DeepCopyInto(out *GENIDClaimSpec) {
 		**out = **in
 	}
 	in.ClaimLabels.DeepCopyInto(&out.ClaimLabels)
+	if in.MAC != nil {
+		in, out := &in.MAC, &out.MAC
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GENIDClaimSpec.
@@ -143,6 +148,11 @@ func (in *GENIDClaimStatus) DeepCopyInto(out *GENIDClaimStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.MAC != nil {
+		in, out := &in.MAC, &out.MAC
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GENIDClaimStatus.
@@ -364,6 +374,11 @@ func (in *GENIDIndexSpec) DeepCopyInto(out *GENIDIndexSpec) {
 		**out = **in
 	}
 	in.UserDefinedLabels.DeepCopyInto(&out.UserDefinedLabels)
+	if in.OUI != nil {
+		in, out := &in.OUI, &out.OUI
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GENIDIndexSpec.
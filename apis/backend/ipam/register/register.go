@@ -29,11 +29,13 @@ import (
 	bebackend "github.com/kuidio/kuid/pkg/backend"
 	ipambe "github.com/kuidio/kuid/pkg/backend/ipam"
 	"github.com/kuidio/kuid/pkg/config"
+	"github.com/kuidio/kuid/pkg/quota"
 	genericregistry "github.com/kuidio/kuid/pkg/registry/generic"
 	"github.com/kuidio/kuid/pkg/registry/options"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/registry/generic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func init() {
@@ -42,10 +44,11 @@ func init() {
 		ipambev1alpha1.AddToScheme,
 		NewBackend,
 		ApplyStorageToBackend,
+		ApplyClientToBackend,
 		[]*config.ResourceConfig{
-			{StorageProviderFn: NewIndexStorageProvider, Internal: &ipam.IPIndex{}, ResourceVersions: []resource.Object{&ipam.IPIndex{}, &ipambev1alpha1.IPIndex{}}},
-			{StorageProviderFn: NewClaimStorageProvider, Internal: &ipam.IPClaim{}, ResourceVersions: []resource.Object{&ipam.IPClaim{}, &ipambev1alpha1.IPClaim{}}},
-			{StorageProviderFn: NewStorageProvider, Internal: &ipam.IPEntry{}, ResourceVersions: []resource.Object{&ipam.IPEntry{}, &ipambev1alpha1.IPEntry{}}},
+			{StorageProviderFn: NewIndexStorageProvider, Internal: &ipam.IPIndex{}, ResourceVersions: []resource.Object{&ipam.IPIndex{}, &ipambev1alpha1.IPIndex{}}, Index: true},
+			{StorageProviderFn: NewClaimStorageProvider, Internal: &ipam.IPClaim{}, ResourceVersions: []resource.Object{&ipam.IPClaim{}, &ipambev1alpha1.IPClaim{}}, Claim: true},
+			{StorageProviderFn: NewStorageProvider, Internal: &ipam.IPEntry{}, ResourceVersions: []resource.Object{&ipam.IPEntry{}, &ipambev1alpha1.IPEntry{}}, Entry: true},
 		},
 	)
 }
@@ -63,12 +66,14 @@ func NewIndexStorageProvider(ctx context.Context, obj resource.InternalObject, b
 	return genericregistry.NewStorageProvider(ctx, obj, &opts)
 }
 
+// NewClaimStorageProvider enforces the claim quotas before the backend allocates the claim
 func NewClaimStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *rest.StorageProvider {
 	opts := *options
 	if sync {
-		opts.BackendInvoker = bebackend.NewClaimInvoker(be)
+		opts.BackendInvoker = quota.NewClaimInvoker(obj.GetGroupVersionResource().Group, bebackend.NewClaimInvoker(be))
 		return genericregistry.NewStorageProvider(ctx, obj, &opts)
 	}
+	opts.BackendInvoker = quota.NewClaimInvoker(obj.GetGroupVersionResource().Group, nil)
 	return genericregistry.NewStorageProvider(ctx, obj, &opts)
 }
 
@@ -108,6 +113,22 @@ func ApplyStorageToBackend(ctx context.Context, be bebackend.Backend, apiServer
 	return be.AddStorageInterfaces(ipambe.NewKuidBackendstorage(entryStore, claimStore))
 }
 
+// ApplyClientToBackend attaches the CRD storage to the backend, the entries and claims
+// are persisted as CRDs using the client
+func ApplyClientToBackend(ctx context.Context, be bebackend.Backend, c client.Client) error {
+	scheme := runtime.NewScheme()
+	if err := ipam.AddToScheme(scheme); err != nil {
+		return err
+	}
+	if err := ipambev1alpha1.AddToScheme(scheme); err != nil {
+		return err
+	}
+	entryStore := bebackend.NewClientStore(c, scheme, ipambev1alpha1.SchemeGroupVersion.WithKind(ipam.IPEntryKind), true)
+	claimStore := bebackend.NewClientStore(c, scheme, ipambev1alpha1.SchemeGroupVersion.WithKind(ipam.IPClaimKind), false)
+
+	return be.AddStorageInterfaces(ipambe.NewClientBackendstorage(entryStore, claimStore))
+}
+
 var _ generic.RESTOptionsGetter = &Getter{}
 
 type Getter struct{}
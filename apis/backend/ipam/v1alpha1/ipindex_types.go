@@ -59,7 +59,7 @@ type IPIndexStatus struct {
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:categories={kuid}
+// +kubebuilder:resource:path=ipindices,categories={kuid}
 // IPIndex is the Schema for the IPIndex API
 type IPIndex struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -0,0 +1,130 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package label
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const LabelID_Min = 0
+const LabelID_Max = 1048575
+
+// LabelIDBits defines the size of the MPLS label space (RFC3032)
+const LabelIDBits = 20
+
+const (
+	// LabelBlock_SRGB is the name of the index claim of the segment routing global block
+	LabelBlock_SRGB = "srgb"
+	// LabelBlock_SRLB is the name of the index claim of the segment routing local block
+	LabelBlock_SRLB = "srlb"
+)
+
+// LabelReservedRange defines a range of labels that are reserved
+type LabelReservedRange struct {
+	Name string
+	From uint32
+	To   uint32
+}
+
+// LabelReservedRanges define the labels an index always reserves
+var LabelReservedRanges = []LabelReservedRange{
+	{Name: "special", From: 0, To: 15}, // RFC3032, RFC7274
+}
+
+func validateLabelID(id int) error {
+	if id < LabelID_Min {
+		return fmt.Errorf("invalid id, got %d", id)
+	}
+	if id > LabelID_Max {
+		return fmt.Errorf("invalid id, got %d, the max label is %d", id, LabelID_Max)
+	}
+	return nil
+}
+
+// ParseLabelBlock parses a block of labels <start>-<end>, e.g. the SRGB or SRLB of an index
+func ParseLabelBlock(s string) (uint32, uint32, error) {
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid label block, expected <start>-<end>, got: %s", s)
+	}
+	start, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid label block start, got: %s, err: %s", s, err.Error())
+	}
+	end, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid label block end, got: %s, err: %s", s, err.Error())
+	}
+	if start > end {
+		return 0, 0, fmt.Errorf("invalid label block %s, the start must not be larger than the end", s)
+	}
+	if end > LabelID_Max {
+		return 0, 0, fmt.Errorf("invalid label block %s, the max label is %d", s, LabelID_Max)
+	}
+	for _, reserved := range LabelReservedRanges {
+		if uint32(start) <= reserved.To && uint32(end) >= reserved.From {
+			return 0, 0, fmt.Errorf("invalid label block %s, overlaps with the reserved labels %d-%d", s, reserved.From, reserved.To)
+		}
+	}
+	return uint32(start), uint32(end), nil
+}
+
+// GetSRGBType returns the type of an index with an SRGB, srgb:<start>-<end>
+func GetSRGBType(srgb string) (string, error) {
+	start, end, err := ParseLabelBlock(srgb)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s:%d-%d", LabelBlock_SRGB, start, end), nil
+}
+
+// getTypeSRGB returns the start and end of the SRGB of the type of an index, false when the
+// index has no SRGB
+func getTypeSRGB(typ string) (uint32, uint32, bool) {
+	t, srgb, ok := strings.Cut(typ, ":")
+	if !ok || t != LabelBlock_SRGB {
+		return 0, 0, false
+	}
+	start, end, err := ParseLabelBlock(srgb)
+	if err != nil {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+// GetPrefixSIDLabel returns the label of the SID index within the SRGB of the type of the index
+func GetPrefixSIDLabel(typ string, sidIndex uint32) (uint32, error) {
+	start, end, ok := getTypeSRGB(typ)
+	if !ok {
+		return 0, fmt.Errorf("a prefix SID requires an index with an SRGB")
+	}
+	if uint64(sidIndex) > uint64(end-start) {
+		return 0, fmt.Errorf("invalid SID index %d, the SRGB %d-%d supports the SID indexes 0-%d", sidIndex, start, end, end-start)
+	}
+	return start + sidIndex, nil
+}
+
+// GetPrefixSIDIndex returns the SID index of the label within the SRGB of the type of the index
+func GetPrefixSIDIndex(typ string, label uint32) (uint32, bool) {
+	start, end, ok := getTypeSRGB(typ)
+	if !ok || label < start || label > end {
+		return 0, false
+	}
+	return label - start, true
+}
@@ -0,0 +1,506 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package label
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/henderiw/idxtable/pkg/table"
+	"github.com/henderiw/idxtable/pkg/tree"
+	"github.com/henderiw/idxtable/pkg/tree/id32"
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/apis/condition"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+var _ backend.ClaimObject = &LabelClaim{}
+var _ backend.NotationClaimObject = &LabelClaim{}
+var _ backend.TypedClaimObject = &LabelClaim{}
+var _ backend.TypedStaticIDClaimObject = &LabelClaim{}
+
+func (r *LabelClaim) GetNamespacedName() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.GetNamespace(),
+		Name:      r.GetName(),
+	}
+}
+
+func (r *LabelClaim) GetKey() store.Key {
+	return store.KeyFromNSN(types.NamespacedName{Namespace: r.Namespace, Name: r.Spec.Index})
+}
+
+// GetCondition returns the condition based on the condition kind
+func (r *LabelClaim) GetCondition(t condition.ConditionType) condition.Condition {
+	return r.Status.GetCondition(t)
+}
+
+// SetConditions sets the conditions on the resource. it allows for 0, 1 or more conditions
+// to be set at once
+func (r *LabelClaim) SetConditions(c ...condition.Condition) {
+	r.Status.SetConditions(c...)
+}
+
+// ValidateSyntax validates the claim, a prefix SID is validated against the SRGB of the
+// index when the claim is applied
+func (r *LabelClaim) ValidateSyntax(s string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if err := r.ValidateLabelClaimType(); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath(""),
+			r,
+			err.Error(),
+		))
+		return allErrs
+	}
+	var v SyntaxValidator
+	claimType := r.GetClaimType()
+	switch claimType {
+	case backend.ClaimType_DynamicID:
+		v = &LabelDynamicIDSyntaxValidator{name: string(claimType)}
+	case backend.ClaimType_StaticID:
+		v = &LabelStaticIDSyntaxValidator{name: string(claimType)}
+	case backend.ClaimType_Range:
+		v = &LabelRangeSyntaxValidator{name: string(claimType)}
+	default:
+		return allErrs
+	}
+	return v.Validate(r)
+}
+
+func (r *LabelClaim) ValidateLabelRange() error {
+	if r.Spec.Range == nil {
+		return fmt.Errorf("no label range provided")
+	}
+	var errm error
+	if r.Name == r.Spec.Index {
+		// to be able to check if the entry is reserved we get a parentname (rang name) equal to index
+		// this is because the ownerreference uses the name of the index in its labels in the cache
+		errm = errors.Join(errm, fmt.Errorf("a name of range cannot be the same as the index"))
+	}
+	segments := []backend.IDRange{}
+	for _, segment := range backend.GetRangeSegments(*r.Spec.Range) {
+		start, end, err := validateLabelRangeSegment(segment)
+		if err != nil {
+			errm = errors.Join(errm, err)
+			continue
+		}
+		segments = append(segments, backend.IDRange{From: uint64(start), To: uint64(end)})
+	}
+	if errm != nil {
+		return errm
+	}
+	return backend.ValidateIDRanges(segments)
+}
+
+// validateLabelRangeSegment validates a segment <start>-<end> of a range
+func validateLabelRangeSegment(segment string) (int, int, error) {
+	parts := strings.SplitN(segment, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid label range, expected <start>-<end>, got: %s", segment)
+	}
+	var errm error
+	start, err := strconv.Atoi(parts[0])
+	if err != nil {
+		errm = errors.Join(errm, fmt.Errorf("invalid label range start, got: %s, err: %s", segment, err.Error()))
+	}
+	end, err := strconv.Atoi(parts[1])
+	if err != nil {
+		errm = errors.Join(errm, fmt.Errorf("invalid label range end, got: %s, err: %s", segment, err.Error()))
+	}
+	if errm != nil {
+		return 0, 0, errm
+	}
+	if start > end {
+		errm = errors.Join(errm, fmt.Errorf("invalid label range start > end %s", segment))
+	}
+	if err := validateLabelID(start); err != nil {
+		errm = errors.Join(errm, fmt.Errorf("invalid label start err %s", err.Error()))
+	}
+	if err := validateLabelID(end); err != nil {
+		errm = errors.Join(errm, fmt.Errorf("invalid label end err %s", err.Error()))
+	}
+	return start, end, errm
+}
+
+func (r *LabelClaim) ValidateLabelID() error {
+	if r.Spec.ID == nil && r.Spec.SIDIndex == nil {
+		return fmt.Errorf("no label id provided")
+	}
+	if r.Spec.ID != nil {
+		if err := validateLabelID(int(*r.Spec.ID)); err != nil {
+			return fmt.Errorf("invalid label id err %s", err.Error())
+		}
+	}
+	if r.Spec.SIDIndex != nil {
+		if err := validateLabelID(int(*r.Spec.SIDIndex)); err != nil {
+			return fmt.Errorf("invalid sid index err %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// ValidateIndexType validates the claim against the type of the index, a prefix SID requires
+// an index with an SRGB and the SID index must fit in the SRGB
+func (r *LabelClaim) ValidateIndexType(typ string) error {
+	if !r.IsPrefixSID() {
+		return nil
+	}
+	if _, _, ok := getTypeSRGB(typ); !ok {
+		return fmt.Errorf("a prefix SID requires an index with an SRGB")
+	}
+	if r.Spec.SIDIndex != nil {
+		if _, err := GetPrefixSIDLabel(typ, *r.Spec.SIDIndex); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *LabelClaim) ValidateLabelClaimType() error {
+	var sb strings.Builder
+	count := 0
+	if r.Spec.ID != nil {
+		sb.WriteString(fmt.Sprintf("id: %d", *r.Spec.ID))
+		count++
+
+	}
+	if r.Spec.SIDIndex != nil {
+		if count > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("sidIndex: %d", *r.Spec.SIDIndex))
+		count++
+
+	}
+	if r.Spec.Range != nil {
+		if count > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("range: %s", *r.Spec.Range))
+		count++
+
+	}
+	if count > 1 {
+		return fmt.Errorf("a claim can only have 1 type, got %s", sb.String())
+	}
+	if r.Spec.PrefixSID && (r.Spec.ID != nil || r.Spec.Range != nil) {
+		return fmt.Errorf("a prefix SID is claimed by sid index, got %s", sb.String())
+	}
+	if r.IsPrefixSID() && r.Spec.Selector != nil {
+		return fmt.Errorf("a prefix SID is claimed from the SRGB of the index and cannot have a selector")
+	}
+	return nil
+}
+
+// IsPrefixSID returns true when the claim claims a prefix SID from the SRGB of the index
+func (r *LabelClaim) IsPrefixSID() bool {
+	return r.Spec.PrefixSID || r.Spec.SIDIndex != nil
+}
+
+func (r *LabelClaim) GetIndex() string { return r.Spec.Index }
+
+// GetSelector returns the selector of the claim, a dynamic prefix SID selects the SRGB of the
+// index
+func (r *LabelClaim) GetSelector() *metav1.LabelSelector {
+	if r.IsPrefixSID() && r.Spec.SIDIndex == nil {
+		return &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				backend.KuidClaimNameKey: fmt.Sprintf("%s.%s", r.Spec.Index, LabelBlock_SRGB),
+			},
+		}
+	}
+	return r.Spec.Selector
+}
+
+func (r *LabelClaim) IsOwner(labels labels.Set) bool {
+	for k, v := range r.getOwnerLabels() {
+		if val, ok := labels[k]; !ok || val != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *LabelClaim) getOwnerLabels() map[string]string {
+	return map[string]string{
+		backend.KuidClaimNameKey: r.Name,
+		backend.KuidClaimUIDKey:  string(r.UID),
+	}
+}
+
+// GetOwnerSelector selects the route based on the name of the claim
+func (r *LabelClaim) GetOwnerSelector() (labels.Selector, error) {
+	l := r.getOwnerLabels()
+
+	fullselector := labels.NewSelector()
+	for k, v := range l {
+		req, err := labels.NewRequirement(k, selection.Equals, []string{v})
+		if err != nil {
+			return nil, err
+		}
+		fullselector = fullselector.Add(*req)
+	}
+	return fullselector, nil
+}
+
+func (r *LabelClaim) GetLabelSelector() (labels.Selector, error) {
+	claimLabels := common.ClaimLabels{Selector: r.GetSelector()}
+	return claimLabels.GetLabelSelector()
+}
+
+func (r *LabelClaim) GetClaimLabels() labels.Set {
+	labels := r.Spec.GetUserDefinedLabels()
+
+	// system defined labels
+	labels[backend.KuidClaimTypeKey] = string(r.GetClaimType())
+	labels[backend.KuidClaimNameKey] = r.Name
+	labels[backend.KuidClaimUIDKey] = string(r.UID)
+	labels[backend.KuidOwnerKindKey] = r.Kind
+	return labels
+}
+
+func (r *LabelClaim) ValidateOwner(labels labels.Set) error {
+	routeClaimName := labels[backend.KuidClaimNameKey]
+	routeClaimUID := labels[backend.KuidClaimUIDKey]
+
+	if string(r.UID) != routeClaimUID && r.Name != routeClaimName {
+		return fmt.Errorf("route owned by different claim got name %s/%s uid %s/%s",
+			r.Name,
+			routeClaimName,
+			string(r.UID),
+			routeClaimUID,
+		)
+	}
+	return nil
+}
+
+func (r *LabelClaim) GetClaimType() backend.ClaimType {
+	claimType := backend.ClaimType_Invalid
+	count := 0
+	if r.Spec.ID != nil || r.Spec.SIDIndex != nil {
+		claimType = backend.ClaimType_StaticID
+		count++
+
+	}
+	if r.Spec.Range != nil {
+		claimType = backend.ClaimType_Range
+		count++
+
+	}
+	if count > 1 {
+		return backend.ClaimType_Invalid
+	}
+	if count == 0 {
+		return backend.ClaimType_DynamicID
+	}
+	return claimType
+}
+
+// GetStaticID returns the label or the SID index of the claim
+func (r *LabelClaim) GetStaticID() *uint64 {
+	if r.Spec.ID != nil {
+		return ptr.To[uint64](uint64(*r.Spec.ID))
+	}
+	if r.Spec.SIDIndex != nil {
+		return ptr.To[uint64](uint64(*r.Spec.SIDIndex))
+	}
+	return nil
+}
+
+// GetTypedStaticID returns the label of the claim, the label of a SID index is the SRGB base
+// of the index + the SID index
+func (r *LabelClaim) GetTypedStaticID(typ string) *uint64 {
+	if r.Spec.SIDIndex != nil {
+		label, err := GetPrefixSIDLabel(typ, *r.Spec.SIDIndex)
+		if err != nil {
+			return nil
+		}
+		return ptr.To[uint64](uint64(label))
+	}
+	return r.GetStaticID()
+}
+
+func (r *LabelClaim) GetStaticTreeID(typ string) tree.ID {
+	id := r.GetTypedStaticID(typ)
+	if id == nil {
+		return nil
+	}
+	return id32.NewID(uint32(*id), id32.IDBitSize)
+}
+
+func (r *LabelClaim) GetClaimID(typ string, id uint64) tree.ID {
+	return id32.NewID(uint32(id), id32.IDBitSize)
+}
+
+func (r *LabelClaim) GetStatusClaimID(typ string) tree.ID {
+	if r.Status.ID == nil {
+		return nil
+	}
+	return id32.NewID(*r.Status.ID, id32.IDBitSize)
+}
+
+func (r *LabelClaim) GetRange() *string {
+	return r.Spec.Range
+}
+
+func (r *LabelClaim) GetRangeIDs(typ string) ([]tree.Range, error) {
+	if r.Spec.Range == nil {
+		return nil, fmt.Errorf("cannot provide a range without an id")
+	}
+	return backend.ParseRangeSegments(*r.Spec.Range, id32.ParseRange)
+}
+
+func (r *LabelClaim) GetTable(typ string, ranges []backend.IDRange) table.Table {
+	return backend.NewRangeTable(ranges, func(id uint64) tree.ID {
+		return id32.NewID(uint32(id), id32.IDBitSize)
+	})
+}
+
+func (r *LabelClaim) SetStatusRange(s *string) {
+	r.Status.Range = s
+	r.Status.SIDIndex = nil
+}
+
+func (r *LabelClaim) SetStatusID(s *uint64) {
+	r.Status.SIDIndex = nil
+	if s == nil {
+		r.Status.ID = nil
+		return
+	}
+	r.Status.ID = ptr.To[uint32](uint32(*s))
+}
+
+// SetStatusNotation renders the SID index of the claimed label of a prefix SID using the
+// SRGB of the index
+func (r *LabelClaim) SetStatusNotation(typ string) {
+	r.Status.SIDIndex = nil
+	if !r.IsPrefixSID() || r.Status.ID == nil {
+		return
+	}
+	if sidIndex, ok := GetPrefixSIDIndex(typ, *r.Status.ID); ok {
+		r.Status.SIDIndex = ptr.To[uint32](sidIndex)
+	}
+}
+
+func (r *LabelClaim) GetStatusID() *uint64 {
+	if r.Status.ID == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*r.Status.ID))
+}
+
+func (r *LabelClaim) GetClaimRequest() string {
+	if r.Spec.SIDIndex != nil {
+		return fmt.Sprintf("sid index %d", *r.Spec.SIDIndex)
+	}
+	if r.Spec.ID != nil {
+		return strconv.FormatUint(uint64(*r.Spec.ID), 10)
+	}
+	if r.Spec.Range != nil {
+		return *r.Spec.Range
+	}
+	if r.Spec.PrefixSID {
+		return "prefix sid"
+	}
+	return ""
+}
+
+func (r *LabelClaim) GetClaimResponse() string {
+	if r.Status.ID != nil {
+		if r.Status.SIDIndex != nil {
+			return fmt.Sprintf("%d (sid index %d)", *r.Status.ID, *r.Status.SIDIndex)
+		}
+		return strconv.FormatUint(uint64(*r.Status.ID), 10)
+	}
+	if r.Status.Range != nil {
+		return *r.Status.Range
+	}
+	return ""
+}
+
+func (r *LabelClaim) GetClaimSet(typ string) (map[string]tree.ID, sets.Set[string], error) {
+	aranges, err := r.GetRangeIDs(typ)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot get range from claim: %v", err)
+	}
+	// claim set represents the new entries
+	newClaimSet := sets.New[string]()
+	newClaimMap := map[string]tree.ID{}
+	for _, arange := range aranges {
+		for _, rangeID := range arange.IDs() {
+			newClaimSet.Insert(rangeID.String())
+			newClaimMap[rangeID.String()] = rangeID
+		}
+	}
+	return newClaimMap, newClaimSet, nil
+}
+
+func (r *LabelClaim) GetChoreoAPIVersion() string {
+	return schema.GroupVersion{Group: GroupName, Version: "label"}.String()
+}
+
+func LabelClaimFromUnstructured(ru runtime.Unstructured) (backend.ClaimObject, error) {
+	obj := &LabelClaim{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(ru.UnstructuredContent(), obj)
+	if err != nil {
+		return nil, fmt.Errorf("error converting unstructured to labelClaim: %v", err)
+	}
+	return obj, nil
+}
+
+func LabelClaimFromRuntime(ru runtime.Object) (backend.ClaimObject, error) {
+	claim, ok := ru.(*LabelClaim)
+	if !ok {
+		return nil, errors.New("runtime object not LabelClaim")
+	}
+	return claim, nil
+}
+
+// BuildLabelClaim returns a reource from a client Object a Spec/Status
+func BuildLabelClaim(meta metav1.ObjectMeta, spec *LabelClaimSpec, status *LabelClaimStatus) backend.ClaimObject {
+	aspec := LabelClaimSpec{}
+	if spec != nil {
+		aspec = *spec
+	}
+	astatus := LabelClaimStatus{}
+	if status != nil {
+		astatus = *status
+	}
+	return &LabelClaim{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.Identifier(),
+			Kind:       LabelClaimKind,
+		},
+		ObjectMeta: meta,
+		Spec:       aspec,
+		Status:     astatus,
+	}
+}
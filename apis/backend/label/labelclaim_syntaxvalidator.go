@@ -0,0 +1,69 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package label
+
+import (
+	fmt "fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// +kubebuilder:object:generate=false
+type SyntaxValidator interface {
+	Validate(claim *LabelClaim) field.ErrorList
+}
+
+type LabelDynamicIDSyntaxValidator struct {
+	name string
+}
+
+func (r *LabelDynamicIDSyntaxValidator) Validate(claim *LabelClaim) field.ErrorList {
+	var allErrs field.ErrorList
+	return allErrs
+}
+
+type LabelStaticIDSyntaxValidator struct {
+	name string
+}
+
+func (r *LabelStaticIDSyntaxValidator) Validate(claim *LabelClaim) field.ErrorList {
+	var allErrs field.ErrorList
+	if err := claim.ValidateLabelID(); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.id"),
+			claim,
+			fmt.Errorf("invalid label id %s, err: %s", r.name, err.Error()).Error(),
+		))
+	}
+	return allErrs
+}
+
+type LabelRangeSyntaxValidator struct {
+	name string
+}
+
+func (r *LabelRangeSyntaxValidator) Validate(claim *LabelClaim) field.ErrorList {
+	var allErrs field.ErrorList
+	if err := claim.ValidateLabelRange(); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.range"),
+			claim,
+			fmt.Errorf("invalid label range %s, err: %s", r.name, err.Error()).Error(),
+		))
+	}
+	return allErrs
+}
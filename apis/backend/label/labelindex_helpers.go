@@ -0,0 +1,193 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package label
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/kform-dev/choreo/apis/condition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// GetCondition returns the condition based on the condition kind
+func (r *LabelIndex) GetCondition(t condition.ConditionType) condition.Condition {
+	return r.Status.GetCondition(t)
+}
+
+// SetConditions sets the conditions on the resource. it allows for 0, 1 or more conditions
+// to be set at once
+func (r *LabelIndex) SetConditions(c ...condition.Condition) {
+	r.Status.SetConditions(c...)
+}
+
+func (r *LabelIndex) ValidateSyntax(s string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, r.validateBlocks()...)
+
+	if r.Spec.MinID != nil {
+		if err := validateLabelID(int(*r.Spec.MinID)); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.minID"),
+				r,
+				fmt.Errorf("invalid label ID %d, err: %s", *r.Spec.MinID, err.Error()).Error(),
+			))
+		}
+	}
+	if r.Spec.MaxID != nil {
+		if err := validateLabelID(int(*r.Spec.MaxID)); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.maxID"),
+				r,
+				fmt.Errorf("invalid label ID %d, err: %s", *r.Spec.MaxID, err.Error()).Error(),
+			))
+		}
+	}
+	if r.Spec.MinID != nil && r.Spec.MaxID != nil {
+		if *r.Spec.MinID > *r.Spec.MaxID {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.maxID"),
+				r,
+				fmt.Errorf("min label ID %d cannot be bigger than max label ID %d", *r.Spec.MinID, *r.Spec.MaxID).Error(),
+			))
+		}
+	}
+	for i, claim := range r.Spec.Claims {
+		if claim.Name == LabelBlock_SRGB || claim.Name == LabelBlock_SRLB {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.claims").Index(i),
+				r,
+				fmt.Errorf("invalid claim %s, the name is reserved for the %s block of the index", claim.Name, claim.Name).Error(),
+			))
+			continue
+		}
+		if errs := r.GetClaim(claim).ValidateSyntax(s); len(errs) != 0 {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.claims").Index(i),
+				r,
+				fmt.Errorf("invalid claim %s: %s", claim.Name, errs.ToAggregate().Error()).Error(),
+			))
+		}
+	}
+	return allErrs
+}
+
+// validateBlocks validates the SRGB and SRLB of the index, the blocks cannot overlap and a
+// domain requires an SRGB
+func (r *LabelIndex) validateBlocks() field.ErrorList {
+	var allErrs field.ErrorList
+	var srgbStart, srgbEnd uint32
+	var srgbErr error
+	if r.Spec.SRGB != nil {
+		srgbStart, srgbEnd, srgbErr = ParseLabelBlock(*r.Spec.SRGB)
+		if srgbErr != nil {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.srgb"),
+				r,
+				srgbErr.Error(),
+			))
+		}
+	}
+	if r.Spec.SRLB != nil {
+		srlbStart, srlbEnd, err := ParseLabelBlock(*r.Spec.SRLB)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.srlb"),
+				r,
+				err.Error(),
+			))
+		} else if r.Spec.SRGB != nil && srgbErr == nil && srlbStart <= srgbEnd && srlbEnd >= srgbStart {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.srlb"),
+				r,
+				fmt.Errorf("the srlb %s overlaps with the srgb %s", *r.Spec.SRLB, *r.Spec.SRGB).Error(),
+			))
+		}
+	}
+	if r.Spec.Domain != nil && r.Spec.SRGB == nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.domain"),
+			r,
+			fmt.Errorf("a domain requires an srgb").Error(),
+		))
+	}
+	return allErrs
+}
+
+// validateImmutable validates the SRGB and the domain of the index do not change, the labels
+// of the allocated prefix SIDs are derived from the SRGB
+func (r *LabelIndex) validateImmutable(old *LabelIndex) field.ErrorList {
+	var allErrs field.ErrorList
+	if !reflect.DeepEqual(r.Spec.SRGB, old.Spec.SRGB) {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec.srgb"),
+			"the srgb of an index is immutable",
+		))
+	}
+	if !reflect.DeepEqual(r.Spec.Domain, old.Spec.Domain) {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec.domain"),
+			"the domain of an index is immutable",
+		))
+	}
+	return allErrs
+}
+
+// ValidateDomain validates the SRGB of the index is consistent with the SRGB of the other
+// indexes in the same namespace and segment routing domain, a prefix SID resolves to the
+// same label on all the nodes of the domain
+func (r *LabelIndex) ValidateDomain(indexes []*LabelIndex) error {
+	if r.Spec.Domain == nil || r.Spec.SRGB == nil {
+		return nil
+	}
+	for _, index := range indexes {
+		if index.Namespace != r.Namespace || index.Name == r.Name {
+			continue
+		}
+		if index.Spec.Domain == nil || *index.Spec.Domain != *r.Spec.Domain || index.Spec.SRGB == nil {
+			continue
+		}
+		if index.GetType() != r.GetType() {
+			return fmt.Errorf("inconsistent srgb %s in domain %s, index %s uses srgb %s",
+				*r.Spec.SRGB, *r.Spec.Domain, index.Name, *index.Spec.SRGB)
+		}
+	}
+	return nil
+}
+
+// BuildLabelIndex returns a reource from a client Object a Spec/Status
+func BuildLabelIndex(meta metav1.ObjectMeta, spec *LabelIndexSpec, status *LabelIndexStatus) *LabelIndex {
+	aspec := LabelIndexSpec{}
+	if spec != nil {
+		aspec = *spec
+	}
+	astatus := LabelIndexStatus{}
+	if status != nil {
+		astatus = *status
+	}
+	return &LabelIndex{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.Identifier(),
+			Kind:       LabelIndexKind,
+		},
+		ObjectMeta: meta,
+		Spec:       aspec,
+		Status:     astatus,
+	}
+}
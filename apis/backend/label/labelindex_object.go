@@ -0,0 +1,283 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package label
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/henderiw/idxtable/pkg/tree/gtree"
+	"github.com/henderiw/idxtable/pkg/tree/tree32"
+	"github.com/henderiw/store"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+var _ backend.IndexObject = &LabelIndex{}
+
+func (r *LabelIndex) GetKey() store.Key {
+	return store.KeyFromNSN(r.GetNamespacedName())
+}
+
+func (r *LabelIndex) GetNamespacedName() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.GetNamespace(),
+		Name:      r.GetName(),
+	}
+}
+
+// GetTree returns the tree of the index, the tree covers the 20bit MPLS label space
+func (r *LabelIndex) GetTree() gtree.GTree {
+	tree, err := tree32.New(fmt.Sprintf("labelindex.%s", r.Name), LabelIDBits)
+	if err != nil {
+		return nil
+	}
+	return tree
+}
+
+// GetType returns the SRGB of the index, srgb:<start>-<end>, the prefix SIDs are claimed
+// relative to the start of the SRGB. The type is empty when the index has no SRGB
+func (r *LabelIndex) GetType() string {
+	if r.Spec.SRGB == nil {
+		return ""
+	}
+	typ, err := GetSRGBType(*r.Spec.SRGB)
+	if err != nil {
+		return ""
+	}
+	return typ
+}
+
+func (r *LabelIndex) GetMinID() *uint64 {
+	if r.Spec.MinID == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*r.Spec.MinID))
+}
+
+func (r *LabelIndex) GetMaxID() *uint64 {
+	if r.Spec.MaxID == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*r.Spec.MaxID))
+}
+
+func (r *LabelIndex) GetMax() uint64 {
+	return LabelID_Max
+}
+
+func GetMinClaimRange(id uint64) string {
+	return fmt.Sprintf("%d-%d", LabelID_Min, id-1)
+}
+
+func GetMaxClaimRange(id, max uint64) string {
+	return fmt.Sprintf("%d-%d", id+1, max)
+}
+
+func (r *LabelIndex) GetMinClaimNSN() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.Namespace,
+		Name:      fmt.Sprintf("%s.%s", r.Name, backend.IndexReservedMinName),
+	}
+}
+
+func (r *LabelIndex) GetMaxClaimNSN() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.Namespace,
+		Name:      fmt.Sprintf("%s.%s", r.Name, backend.IndexReservedMaxName),
+	}
+}
+
+func (r *LabelIndex) GetClaims() []backend.ClaimObject {
+	claims := []backend.ClaimObject{}
+	if r.GetMinID() != nil && *r.GetMinID() != 0 {
+		claims = append(claims, r.GetMinClaim())
+	}
+	if r.GetMaxID() != nil && *r.GetMaxID() != r.GetMax() {
+		claims = append(claims, r.GetMaxClaim())
+	}
+	claims = append(claims, r.GetReservedClaims()...)
+	claims = append(claims, r.GetBlockClaims()...)
+	for _, claim := range r.Spec.Claims {
+		claims = append(claims, r.GetClaim(claim))
+	}
+	return claims
+}
+
+func (r *LabelIndex) GetMinClaim() backend.ClaimObject {
+	return BuildLabelClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      r.GetMinClaimNSN().Name,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       LabelIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		&LabelClaimSpec{
+			Index: r.Name,
+			Range: ptr.To[string](GetMinClaimRange(*r.GetMinID())),
+		},
+		nil,
+	)
+}
+
+func (r *LabelIndex) GetMaxClaim() backend.ClaimObject {
+	return BuildLabelClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      r.GetMaxClaimNSN().Name,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       LabelIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		&LabelClaimSpec{
+			Index: r.Name,
+			Range: ptr.To[string](GetMaxClaimRange(*r.GetMaxID(), r.GetMax())),
+		},
+		nil,
+	)
+}
+
+func (r *LabelIndex) GetClaim(claim LabelIndexClaim) backend.ClaimObject {
+	spec := &LabelClaimSpec{
+		Index: r.Name,
+		ClaimLabels: common.ClaimLabels{
+			UserDefinedLabels: claim.UserDefinedLabels,
+		},
+	}
+	if claim.ID != nil {
+		spec.ID = claim.ID
+	} else {
+		spec.Range = claim.Range
+	}
+	return BuildLabelClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      fmt.Sprintf("%s.%s", r.Name, claim.Name),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       LabelIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		spec,
+		nil,
+	)
+}
+
+// GetReservedClaims returns the claims of the reserved labels within the min and max ID of the
+// index, they are clipped to the min and max ID
+func (r *LabelIndex) GetReservedClaims() []backend.ClaimObject {
+	minID, maxID := uint32(LabelID_Min), uint32(LabelID_Max)
+	if r.Spec.MinID != nil {
+		minID = *r.Spec.MinID
+	}
+	if r.Spec.MaxID != nil {
+		maxID = *r.Spec.MaxID
+	}
+	claims := []backend.ClaimObject{}
+	for _, reserved := range LabelReservedRanges {
+		if reserved.To < minID || reserved.From > maxID {
+			continue
+		}
+		claims = append(claims, r.getRangeClaim(
+			fmt.Sprintf("%s.%s-%s", r.Name, backend.IndexReservedName, reserved.Name),
+			fmt.Sprintf("%d-%d", max(reserved.From, minID), min(reserved.To, maxID)),
+		))
+	}
+	return claims
+}
+
+// GetBlockClaims returns the range claims of the SRGB and SRLB of the index, named
+// <index>.srgb and <index>.srlb. The prefix SIDs are claimed from the SRGB, the adjacency
+// SIDs select the SRLB
+func (r *LabelIndex) GetBlockClaims() []backend.ClaimObject {
+	claims := []backend.ClaimObject{}
+	for _, block := range []struct {
+		name  string
+		block *string
+	}{
+		{name: LabelBlock_SRGB, block: r.Spec.SRGB},
+		{name: LabelBlock_SRLB, block: r.Spec.SRLB},
+	} {
+		if block.block == nil {
+			continue
+		}
+		start, end, err := ParseLabelBlock(*block.block)
+		if err != nil {
+			continue
+		}
+		claims = append(claims, r.getRangeClaim(
+			r.GetBlockClaimName(block.name),
+			fmt.Sprintf("%d-%d", start, end),
+		))
+	}
+	return claims
+}
+
+// GetBlockClaimName returns the name of the range claim of a block of the index
+func (r *LabelIndex) GetBlockClaimName(block string) string {
+	return fmt.Sprintf("%s.%s", r.Name, block)
+}
+
+func (r *LabelIndex) getRangeClaim(name, rng string) backend.ClaimObject {
+	return BuildLabelClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      name,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       LabelIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		&LabelClaimSpec{
+			Index: r.Name,
+			Range: ptr.To[string](rng),
+		},
+		nil,
+	)
+}
+
+func LabelIndexFromRuntime(ru runtime.Object) (backend.IndexObject, error) {
+	index, ok := ru.(*LabelIndex)
+	if !ok {
+		return nil, errors.New("runtime object not LabelIndex")
+	}
+	return index, nil
+}
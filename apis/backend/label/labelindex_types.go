@@ -0,0 +1,109 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package label
+
+import (
+	"reflect"
+
+	"github.com/kform-dev/choreo/apis/condition"
+	"github.com/kuidio/kuid/apis/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LabelIndexSpec defines the desired state of LabelIndex
+type LabelIndexSpec struct {
+	// MinID defines the min ID the index supports
+	// +optional
+	MinID *uint32 `json:"minID,omitempty" protobuf:"bytes,1,opt,name=minID"`
+	// MaxID defines the max ID the index supports
+	// +optional
+	MaxID *uint32 `json:"maxID,omitempty" protobuf:"bytes,2,opt,name=maxID"`
+	// UserDefinedLabels define metadata to the resource.
+	// defined in the spec to distingiush metadata labels from user defined labels
+	common.UserDefinedLabels `json:",inline" protobuf:"bytes,3,opt,name=userDefinedLabels"`
+	// Claims define the embedded claims in the Index
+	Claims []LabelIndexClaim `json:"claims,omitempty" protobuf:"bytes,4,rep,name=claims"`
+	// SRGB defines the segment routing global block of the index, <start>-<end>, e.g. 16000-23999.
+	// The prefix SIDs are allocated within the SRGB, the label of a prefix SID is the start of the
+	// SRGB plus the SID index
+	// +optional
+	SRGB *string `json:"srgb,omitempty" protobuf:"bytes,5,opt,name=srgb"`
+	// SRLB defines the segment routing local block of the index, <start>-<end>, e.g. 15000-15999.
+	// The adjacency SIDs are allocated within the SRLB
+	// +optional
+	SRLB *string `json:"srlb,omitempty" protobuf:"bytes,6,opt,name=srlb"`
+	// Domain defines the segment routing domain the SRGB is shared in, the indexes of the nodes
+	// in the same domain and namespace must define the same SRGB
+	// +optional
+	Domain *string `json:"domain,omitempty" protobuf:"bytes,7,opt,name=domain"`
+}
+
+type LabelIndexClaim struct {
+	// Name of the Claim
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ID defines the id of the resource
+	ID *uint32 `json:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
+	// Range defines the range of the resource
+	// The following notation is used: start-end <start-ID>-<end-ID>
+	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
+	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
+	// UserDefinedLabels define metadata to the resource.
+	// defined in the spec to distingiush metadata labels from user defined labels
+	common.UserDefinedLabels `json:",inline" protobuf:"bytes,4,opt,name=userDefinedLabels"`
+}
+
+// LabelIndexStatus defines the observed state of LabelIndex
+type LabelIndexStatus struct {
+	// MinID defines the min ID the index supports
+	// +optional
+	MinID *uint32 `json:"minID,omitempty" protobuf:"bytes,1,opt,name=minID"`
+	// MaxID defines the max ID the index supports
+	// +optional
+	MaxID *uint32 `json:"maxID,omitempty" protobuf:"bytes,2,opt,name=maxID"`
+	// ConditionedStatus provides the status of the LabelIndex using conditions
+	// - a ready condition indicates the overall status of the resource
+	condition.ConditionedStatus `json:",inline" protobuf:"bytes,3,opt,name=conditionedStatus"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kuid}
+// LabelIndex is the Schema for the LabelIndex API
+type LabelIndex struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   LabelIndexSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status LabelIndexStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// LabelIndexList contains a list of LabelIndexs
+type LabelIndexList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []LabelIndex `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+var (
+	LabelIndexKind     = reflect.TypeOf(LabelIndex{}).Name()
+	LabelIndexListKind = reflect.TypeOf(LabelIndexList{}).Name()
+)
@@ -0,0 +1,151 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package register
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"github.com/kuidio/kuid/apis/backend/label"
+	"github.com/kuidio/kuid/pkg/registry/options"
+	"github.com/kuidio/kuid/pkg/reservation"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// domainStorage provides the indexes the SRGB of an index is validated against. The store
+// is added once the apiserver storage is initialized.
+var domainStorage = &indexStorage{}
+
+type indexStorage struct {
+	m       sync.RWMutex
+	indexes *registry.Store
+}
+
+func (r *indexStorage) add(store *registry.Store) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.indexes = store
+}
+
+// list returns the indexes in the namespace of the context
+func (r *indexStorage) list(ctx context.Context) ([]*label.LabelIndex, error) {
+	r.m.RLock()
+	store := r.indexes
+	r.m.RUnlock()
+	if store == nil {
+		return nil, nil
+	}
+	list, err := store.List(ctx, &internalversion.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make([]*label.LabelIndex, 0, len(items))
+	for _, item := range items {
+		index, ok := item.(*label.LabelIndex)
+		if !ok {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes, nil
+}
+
+// newDomainInvoker returns an invoker that validates the SRGB of the index is consistent
+// with the SRGB of the other indexes in the segment routing domain of the index before the
+// invoker creates the index in the backend.
+func newDomainInvoker(invoker options.BackendInvoker) options.BackendInvoker {
+	return &domainInvoker{
+		invoker:      invoker,
+		reservations: reservation.New[*label.LabelIndex](),
+	}
+}
+
+// domainInvoker serializes the domain checks of the indexes and reserves the SRGB of an
+// index in its domain until the index is persisted
+type domainInvoker struct {
+	invoker      options.BackendInvoker
+	reservations *reservation.Reservations[*label.LabelIndex]
+}
+
+func (r *domainInvoker) InvokeCreate(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	index, ok := obj.(*label.LabelIndex)
+	if !ok {
+		return obj, fmt.Errorf("expecting %s, got %s", label.LabelIndexKind, reflect.TypeOf(obj).Name())
+	}
+	if err := r.reserve(ctx, index, time.Now()); err != nil {
+		return obj, err
+	}
+	newObj, err := r.invoker.InvokeCreate(ctx, obj, recursion)
+	if err != nil {
+		r.reservations.Release(getReservationKey(index))
+	}
+	return newObj, err
+}
+
+func (r *domainInvoker) InvokeUpdate(ctx context.Context, obj, old runtime.Object, recursion bool) (runtime.Object, runtime.Object, error) {
+	return r.invoker.InvokeUpdate(ctx, obj, old, recursion)
+}
+
+func (r *domainInvoker) InvokeDelete(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	r.release(obj)
+	return r.invoker.InvokeDelete(ctx, obj, recursion)
+}
+
+// Persisted releases the reservation of the index, the store holds the index or the index
+// failed to persist
+func (r *domainInvoker) Persisted(ctx context.Context, obj runtime.Object, err error) {
+	r.release(obj)
+	options.Persisted(ctx, r.invoker, obj, err)
+}
+
+// reserve validates the SRGB of the index against the stored and the reserved indexes and
+// reserves the SRGB of the index in its domain
+func (r *domainInvoker) reserve(ctx context.Context, index *label.LabelIndex, now time.Time) error {
+	return r.reservations.Reserve(getReservationKey(index), index.DeepCopy(), now, func(reserved map[string]*label.LabelIndex) (bool, error) {
+		indexes, err := domainStorage.list(ctx)
+		if err != nil {
+			return false, fmt.Errorf("cannot validate the srgb domain: %s", err.Error())
+		}
+		for _, reservedIndex := range reserved {
+			indexes = append(indexes, reservedIndex)
+		}
+		if err := index.ValidateDomain(indexes); err != nil {
+			return false, err
+		}
+		return index.Spec.Domain != nil && index.Spec.SRGB != nil, nil
+	})
+}
+
+func (r *domainInvoker) release(obj runtime.Object) {
+	if index, ok := obj.(*label.LabelIndex); ok {
+		r.reservations.Release(getReservationKey(index))
+	}
+}
+
+func getReservationKey(index *label.LabelIndex) string {
+	return index.GetNamespacedName().String()
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package register
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kuidio/kuid/apis/backend/label"
+	"github.com/kuidio/kuid/pkg/reservation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+// fakeInvoker accepts the objects unless an error is defined
+type fakeInvoker struct {
+	err error
+}
+
+func (r *fakeInvoker) InvokeCreate(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	return obj, r.err
+}
+
+func (r *fakeInvoker) InvokeUpdate(ctx context.Context, obj, old runtime.Object, recursion bool) (runtime.Object, runtime.Object, error) {
+	return obj, old, r.err
+}
+
+func (r *fakeInvoker) InvokeDelete(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	return obj, r.err
+}
+
+func getIndex(name, srgb string) *label.LabelIndex {
+	return label.BuildLabelIndex(
+		metav1.ObjectMeta{Namespace: "default", Name: name},
+		&label.LabelIndexSpec{SRGB: ptr.To(srgb), Domain: ptr.To("isis")},
+		nil,
+	)
+}
+
+func newInvoker(err error) *domainInvoker {
+	return newDomainInvoker(&fakeInvoker{err: err}).(*domainInvoker)
+}
+
+func TestDomainInvoker(t *testing.T) {
+	ctx := context.Background()
+	invoker := newInvoker(nil)
+
+	if _, err := invoker.InvokeCreate(ctx, getIndex("node1", "16000-23999"), false); err != nil {
+		t.Fatalf("node1: unexpected error: %v", err)
+	}
+	// node1 is not persisted yet, its srgb is reserved in the domain
+	if _, err := invoker.InvokeCreate(ctx, getIndex("node2", "20000-27999"), false); err == nil {
+		t.Fatalf("node2: expected an inconsistent srgb error")
+	}
+	if _, err := invoker.InvokeCreate(ctx, getIndex("node2", "16000-23999"), false); err != nil {
+		t.Fatalf("node2: unexpected error: %v", err)
+	}
+
+	// a deleted index releases its reservation
+	for _, name := range []string{"node1", "node2"} {
+		if _, err := invoker.InvokeDelete(ctx, getIndex(name, "16000-23999"), false); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+	}
+	if invoker.reservations.Len() != 0 {
+		t.Errorf("want the reservations released, got %d", invoker.reservations.Len())
+	}
+	if _, err := invoker.InvokeCreate(ctx, getIndex("node3", "20000-27999"), false); err != nil {
+		t.Fatalf("node3: unexpected error: %v", err)
+	}
+}
+
+func TestDomainInvokerError(t *testing.T) {
+	invoker := newInvoker(fmt.Errorf("backend error"))
+
+	// an index the backend rejects does not hold the srgb of the domain
+	if _, err := invoker.InvokeCreate(context.Background(), getIndex("node1", "16000-23999"), false); err == nil {
+		t.Fatalf("expected the backend error")
+	}
+	if invoker.reservations.Len() != 0 {
+		t.Errorf("want the reservation released, got %d", invoker.reservations.Len())
+	}
+}
+
+func TestDomainInvokerPersisted(t *testing.T) {
+	for name, persistErr := range map[string]error{
+		"Persisted":     nil,
+		"AlreadyExists": fmt.Errorf("already exists"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			invoker := newInvoker(nil)
+
+			index := getIndex("node1", "16000-23999")
+			if _, err := invoker.InvokeCreate(ctx, index, false); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// the store holds the index or the index failed to persist, either way the
+			// reservation is released
+			invoker.Persisted(ctx, index, persistErr)
+			if invoker.reservations.Len() != 0 {
+				t.Errorf("want the reservation released, got %d", invoker.reservations.Len())
+			}
+			if _, err := invoker.InvokeCreate(ctx, getIndex("node2", "20000-27999"), false); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestDomainInvokerExpires(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	invoker := newInvoker(nil)
+
+	if err := invoker.reserve(ctx, getIndex("node1", "16000-23999"), now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := invoker.reserve(ctx, getIndex("node2", "20000-27999"), now); err == nil {
+		t.Fatalf("expected an inconsistent srgb error")
+	}
+	// an index whose store write is never reported does not hold the srgb beyond the timeout
+	if err := invoker.reserve(ctx, getIndex("node2", "20000-27999"), now.Add(reservation.Timeout+time.Second)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestDomainInvokerConcurrent validates the concurrent indexes that are not yet persisted
+// cannot define different srgbs in the same domain
+func TestDomainInvokerConcurrent(t *testing.T) {
+	invoker := newInvoker(nil)
+	srgbs := []string{"16000-23999", "20000-27999"}
+
+	var wg sync.WaitGroup
+	var m sync.Mutex
+	accepted := map[string]int{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			srgb := srgbs[i%len(srgbs)]
+			if _, err := invoker.InvokeCreate(context.Background(), getIndex(fmt.Sprintf("node%d", i), srgb), false); err == nil {
+				m.Lock()
+				accepted[srgb]++
+				m.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	if len(accepted) != 1 {
+		t.Errorf("want the accepted indexes to share a single srgb, got %v", accepted)
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	commonv1alpha1 "github.com/kuidio/kuid/apis/common/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LabelClaimSpec defines the desired state of LabelClaim
+type LabelClaimSpec struct {
+	// Index defines the index for the Label Claim
+	Index string `json:"index" protobuf:"bytes,1,opt,name=index"`
+	// ID defines the label
+	ID *uint32 `json:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
+	// Range defines the range of the resource
+	// The following notation is used: start-end <start-ID>-<end-ID>
+	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
+	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
+	// ClaimLabels define the user defined labels and selector labels used
+	// in resource claim
+	commonv1alpha1.ClaimLabels `json:",inline" protobuf:"bytes,4,opt,name=claimLabels"`
+	// PrefixSID allocates a prefix SID within the SRGB of the index, a free SID index is
+	// allocated unless the SID index is defined
+	// +optional
+	PrefixSID bool `json:"prefixSID,omitempty" protobuf:"varint,5,opt,name=prefixSID"`
+	// SIDIndex defines the index of the prefix SID within the SRGB of the index, the label
+	// is the start of the SRGB plus the SID index
+	// +optional
+	SIDIndex *uint32 `json:"sidIndex,omitempty" protobuf:"bytes,6,opt,name=sidIndex"`
+}
+
+// LabelClaimStatus defines the observed state of LabelClaim
+type LabelClaimStatus struct {
+	// ConditionedStatus provides the status of the LabelClaim using conditions
+	// - a ready condition indicates the overall status of the resource
+	condv1alpha1.ConditionedStatus `json:",inline" protobuf:"bytes,1,opt,name=conditionedStatus"`
+	// ID defines the label of the Label claim
+	// +optional
+	ID *uint32 `json:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
+	// Range defines the label range of the Label claim
+	// +optional
+	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
+	// ExpiryTime defines when the claim expires
+	// +kubebuilder:validation:Optional
+	// +optional
+	ExpiryTime *string `json:"expiryTime,omitempty" protobuf:"bytes,4,opt,name=expiryTime"`
+	// SIDIndex defines the index of the prefix SID within the SRGB of the index
+	// +optional
+	SIDIndex *uint32 `json:"sidIndex,omitempty" protobuf:"bytes,5,opt,name=sidIndex"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kuid}
+// LabelClaim is the Schema for the LabelClaim API
+type LabelClaim struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   LabelClaimSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status LabelClaimStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// LabelClaimList contains a list of LabelClaims
+type LabelClaimList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []LabelClaim `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+var (
+	LabelClaimKind     = reflect.TypeOf(LabelClaim{}).Name()
+	LabelClaimListKind = reflect.TypeOf(LabelClaimList{}).Name()
+)
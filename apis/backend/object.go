@@ -42,6 +42,18 @@ type IndexObject interface {
 	GetMax() uint64
 }
 
+// DynamicRangeIndexObject is implemented by the indexes that restrict the ids the dynamic
+// claims without a selector allocate to a set of ranges
+type DynamicRangeIndexObject interface {
+	GetDynamicRanges() []IDRange
+}
+
+// IDRange defines the ids from-to, both included
+type IDRange struct {
+	From uint64
+	To   uint64
+}
+
 type ClaimObject interface {
 	Object
 	GetIndex() string
@@ -56,8 +68,8 @@ type ClaimObject interface {
 	GetClaimID(typ string, id uint64) tree.ID
 	GetStatusClaimID(typ string) tree.ID
 	GetRange() *string
-	GetRangeID(typ string) (tree.Range, error)
-	GetTable(typ string, to, from uint64) table.Table
+	GetRangeIDs(typ string) ([]tree.Range, error)
+	GetTable(typ string, ranges []IDRange) table.Table
 	SetStatusRange(*string)
 	SetStatusID(*uint64)
 	GetStatusID() *uint64
@@ -68,6 +80,31 @@ type ClaimObject interface {
 	GetChoreoAPIVersion() string // a trick to translate the apiversion as per crd
 }
 
+// TypedClaimObject is implemented by the claims that depend on the type of the index, the
+// claim is validated against the type before it is applied
+type TypedClaimObject interface {
+	ValidateIndexType(typ string) error
+}
+
+// NotationClaimObject is implemented by the claims that render the claimed id or range in
+// the notation of the type of the index, e.g. colon-hex for MAC addresses
+type NotationClaimObject interface {
+	SetStatusNotation(typ string)
+}
+
+// DynamicRangeClaimObject is implemented by the claims that restrict the ids a dynamic claim
+// without a selector allocates to a set of ranges, the ranges are combined with the dynamic
+// ranges of the index
+type DynamicRangeClaimObject interface {
+	GetDynamicRanges(typ string) []IDRange
+}
+
+// TypedStaticIDClaimObject is implemented by the claims whose static id depends on the type
+// of the index, e.g. a SID index relative to the SRGB of the index
+type TypedStaticIDClaimObject interface {
+	GetTypedStaticID(typ string) *uint64
+}
+
 type EntryObject interface {
 	Object
 	GetIndex() string
@@ -0,0 +1,164 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/henderiw/iputil"
+	"github.com/kform-dev/choreo/apis/condition"
+	"github.com/kuidio/kuid/apis/backend/ipam"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// GetCondition returns the condition based on the condition kind
+func (r *ClaimQuota) GetCondition(t condition.ConditionType) condition.Condition {
+	return r.Status.GetCondition(t)
+}
+
+// SetConditions sets the conditions on the resource. it allows for 0, 1 or more conditions
+// to be set at once
+func (r *ClaimQuota) SetConditions(c ...condition.Condition) {
+	r.Status.SetConditions(c...)
+}
+
+// GetIndex returns the index the quota is restricted to, an empty string indicates
+// the quota applies to all the indexes of the group
+func (r *ClaimQuota) GetIndex() string {
+	if r.Spec.Index == nil {
+		return ""
+	}
+	return *r.Spec.Index
+}
+
+// GetUsedClaims returns the amount of claims used and the claim limit, if any
+func (r *ClaimQuota) GetUsedClaims() string {
+	used := int64(0)
+	if r.Status.Used != nil {
+		used = r.Status.Used.Claims
+	}
+	if r.Spec.Claims == nil {
+		return fmt.Sprintf("%d", used)
+	}
+	return fmt.Sprintf("%d/%d", used, *r.Spec.Claims)
+}
+
+// AppliesTo returns true when the quota applies to the claims of the group and index
+func (r *ClaimQuota) AppliesTo(group, index string) bool {
+	if r.Spec.Group != group {
+		return false
+	}
+	return r.Spec.Index == nil || *r.Spec.Index == index
+}
+
+// CheckUsage returns an error when the usage exceeds one of the limits of the quota
+func (r *ClaimQuota) CheckUsage(used ClaimQuotaUsage) error {
+	var exceeded []string
+	if r.Spec.Claims != nil && used.Claims > *r.Spec.Claims {
+		exceeded = append(exceeded, fmt.Sprintf("claims %d/%d", used.Claims, *r.Spec.Claims))
+	}
+	if r.Spec.IPv4Addresses != nil && used.IPv4Addresses > *r.Spec.IPv4Addresses {
+		exceeded = append(exceeded, fmt.Sprintf("ipv4Addresses %d/%d", used.IPv4Addresses, *r.Spec.IPv4Addresses))
+	}
+	if r.Spec.IDs != nil && used.IDs > *r.Spec.IDs {
+		exceeded = append(exceeded, fmt.Sprintf("ids %d/%d", used.IDs, *r.Spec.IDs))
+	}
+	if len(exceeded) != 0 {
+		return fmt.Errorf("quota %s exceeded: %s", r.GetName(), strings.Join(exceeded, ", "))
+	}
+	return nil
+}
+
+// CheckPrefixLength returns an error when a prefix of the prefixLength is larger than the
+// quota allows for the address family
+func (r *ClaimQuota) CheckPrefixLength(af iputil.AddressFamily, prefixLength uint32) error {
+	minPrefixLength := r.Spec.MinIPv4PrefixLength
+	if af == iputil.AddressFamilyIpv6 {
+		minPrefixLength = r.Spec.MinIPv6PrefixLength
+	}
+	if minPrefixLength != nil && prefixLength < *minPrefixLength {
+		return fmt.Errorf("quota %s does not allow %s prefixes larger than /%d, got /%d", r.GetName(), af, *minPrefixLength, prefixLength)
+	}
+	return nil
+}
+
+// Add returns the sum of both usages
+func (r ClaimQuotaUsage) Add(usage ClaimQuotaUsage) ClaimQuotaUsage {
+	return ClaimQuotaUsage{
+		Claims:        r.Claims + usage.Claims,
+		IPv4Addresses: r.IPv4Addresses + usage.IPv4Addresses,
+		IDs:           r.IDs + usage.IDs,
+	}
+}
+
+func (r *ClaimQuota) ValidateSyntax() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.Group == "" {
+		allErrs = append(allErrs, field.Required(
+			field.NewPath("spec.group"),
+			"a claimQuota requires a group",
+		))
+	}
+	for _, limit := range []struct {
+		path  string
+		value *int64
+	}{
+		{path: "spec.claims", value: r.Spec.Claims},
+		{path: "spec.ipv4Addresses", value: r.Spec.IPv4Addresses},
+		{path: "spec.ids", value: r.Spec.IDs},
+	} {
+		if limit.value != nil && *limit.value < 0 {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath(limit.path),
+				*limit.value,
+				"a limit cannot be negative",
+			))
+		}
+	}
+	if r.Spec.MinIPv4PrefixLength != nil && *r.Spec.MinIPv4PrefixLength > 32 {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.minIPv4PrefixLength"),
+			*r.Spec.MinIPv4PrefixLength,
+			"an ipv4 prefix length cannot exceed 32",
+		))
+	}
+	if r.Spec.MinIPv6PrefixLength != nil && *r.Spec.MinIPv6PrefixLength > 128 {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.minIPv6PrefixLength"),
+			*r.Spec.MinIPv6PrefixLength,
+			"an ipv6 prefix length cannot exceed 128",
+		))
+	}
+	if r.Spec.Group == ipam.GroupName {
+		if r.Spec.IDs != nil {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.ids"),
+				*r.Spec.IDs,
+				fmt.Sprintf("ids do not apply to group %s", r.Spec.Group),
+			))
+		}
+	} else if r.Spec.IPv4Addresses != nil || r.Spec.MinIPv4PrefixLength != nil || r.Spec.MinIPv6PrefixLength != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec"),
+			r.Spec.Group,
+			fmt.Sprintf("ipv4Addresses and prefix lengths only apply to group %s", ipam.GroupName),
+		))
+	}
+	return allErrs
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package register
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/henderiw/apiserver-builder/pkg/builder"
+	"github.com/henderiw/apiserver-builder/pkg/builder/resource"
+	builderrest "github.com/henderiw/apiserver-builder/pkg/builder/rest"
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"github.com/kuidio/kuid/apis/backend/quota"
+	quotabev1alpha1 "github.com/kuidio/kuid/apis/backend/quota/v1alpha1"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	"github.com/kuidio/kuid/pkg/config"
+	claimquota "github.com/kuidio/kuid/pkg/quota"
+	genericregistry "github.com/kuidio/kuid/pkg/registry/generic"
+	"github.com/kuidio/kuid/pkg/registry/options"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/generic"
+)
+
+func init() {
+	config.Register(
+		quota.SchemeGroupVersion.Group,
+		quotabev1alpha1.AddToScheme,
+		nil,
+		ApplyStorageToQuota,
+		nil,
+		[]*config.ResourceConfig{
+			{StorageProviderFn: NewStorageProvider, Internal: &quota.ClaimQuota{}, ResourceVersions: []resource.Object{&quota.ClaimQuota{}, &quotabev1alpha1.ClaimQuota{}}},
+		},
+	)
+}
+
+func NewStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *builderrest.StorageProvider {
+	return genericregistry.NewStorageProvider(ctx, obj, options)
+}
+
+// ApplyStorageToQuota provides the quota store and the claim stores of the enabled backend
+// groups to the quota invokers of the claims. Quota has no backend, the backend is ignored.
+func ApplyStorageToQuota(ctx context.Context, _ bebackend.Backend, apiServer *builder.Server) error {
+	store, err := getStore(ctx, apiServer, quota.Resource(quota.ClaimQuotaPlural))
+	if err != nil {
+		return err
+	}
+	claimquota.AddQuotaStore(store)
+
+	for group, gr := range claimquota.ClaimResources() {
+		if _, ok := apiServer.StorageProvider[gr]; !ok {
+			// the group is not enabled
+			continue
+		}
+		store, err := getStore(ctx, apiServer, gr)
+		if err != nil {
+			return err
+		}
+		claimquota.AddClaimStore(group, store)
+	}
+	return nil
+}
+
+func getStore(ctx context.Context, apiServer *builder.Server, gr schema.GroupResource) (*registry.Store, error) {
+	storageProvider, ok := apiServer.StorageProvider[gr]
+	if !ok {
+		return nil, fmt.Errorf("storage for %s not registered", gr.String())
+	}
+	storage, err := storageProvider.Get(ctx, apiServer.Schemes[0], &Getter{})
+	if err != nil {
+		return nil, err
+	}
+	store, ok := storage.(*registry.Store)
+	if !ok {
+		return nil, fmt.Errorf("%s store is not a registry store", gr.String())
+	}
+	return store, nil
+}
+
+var _ generic.RESTOptionsGetter = &Getter{}
+
+type Getter struct{}
+
+func (r *Getter) GetRESTOptions(resource schema.GroupResource, example runtime.Object) (generic.RESTOptions, error) {
+	return generic.RESTOptions{}, nil
+}
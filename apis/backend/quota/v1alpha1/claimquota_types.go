@@ -0,0 +1,111 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClaimQuotaSpec defines the desired state of ClaimQuota
+type ClaimQuotaSpec struct {
+	// Group defines the api group of the claims the quota applies to, e.g. ipam.be.kuid.dev
+	Group string `json:"group" yaml:"group" protobuf:"bytes,1,opt,name=group"`
+	// Index restricts the quota to the claims of the index.
+	// When not specified the quota applies to all the claims of the group in the namespace
+	// +optional
+	Index *string `json:"index,omitempty" yaml:"index,omitempty" protobuf:"bytes,2,opt,name=index"`
+	// Claims defines the maximum amount of claims
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Claims *int64 `json:"claims,omitempty" yaml:"claims,omitempty" protobuf:"varint,3,opt,name=claims"`
+	// IPv4Addresses defines the maximum amount of IPv4 addresses the address, prefix and range
+	// claims consume together. Only applies to the ipam group.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	IPv4Addresses *int64 `json:"ipv4Addresses,omitempty" yaml:"ipv4Addresses,omitempty" protobuf:"varint,4,opt,name=ipv4Addresses"`
+	// MinIPv4PrefixLength defines the shortest IPv4 prefix that can be claimed, e.g. 24
+	// rejects prefix claims larger than a /24. Only applies to the ipam group.
+	// +kubebuilder:validation:Maximum=32
+	// +optional
+	MinIPv4PrefixLength *uint32 `json:"minIPv4PrefixLength,omitempty" yaml:"minIPv4PrefixLength,omitempty" protobuf:"varint,5,opt,name=minIPv4PrefixLength"`
+	// MinIPv6PrefixLength defines the shortest IPv6 prefix that can be claimed, e.g. 64
+	// rejects prefix claims larger than a /64. Only applies to the ipam group.
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	MinIPv6PrefixLength *uint32 `json:"minIPv6PrefixLength,omitempty" yaml:"minIPv6PrefixLength,omitempty" protobuf:"varint,6,opt,name=minIPv6PrefixLength"`
+	// IDs defines the maximum amount of IDs the id and range claims consume together.
+	// Does not apply to the ipam group.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	IDs *int64 `json:"ids,omitempty" yaml:"ids,omitempty" protobuf:"varint,7,opt,name=ids"`
+}
+
+// ClaimQuotaStatus defines the observed state of ClaimQuota
+type ClaimQuotaStatus struct {
+	// ConditionedStatus provides the status of the ClaimQuota using conditions
+	// - a ready condition indicates the overall status of the resource
+	condv1alpha1.ConditionedStatus `json:",inline" yaml:",inline" protobuf:"bytes,1,opt,name=conditionedStatus"`
+	// Used defines the current usage of the claims the quota applies to
+	// +optional
+	Used *ClaimQuotaUsage `json:"used,omitempty" yaml:"used,omitempty" protobuf:"bytes,2,opt,name=used"`
+}
+
+// ClaimQuotaUsage defines the capacity consumed by the claims
+type ClaimQuotaUsage struct {
+	// Claims defines the amount of claims
+	Claims int64 `json:"claims" yaml:"claims" protobuf:"varint,1,opt,name=claims"`
+	// IPv4Addresses defines the amount of IPv4 addresses claimed
+	// +optional
+	IPv4Addresses int64 `json:"ipv4Addresses,omitempty" yaml:"ipv4Addresses,omitempty" protobuf:"varint,2,opt,name=ipv4Addresses"`
+	// IDs defines the amount of IDs claimed
+	// +optional
+	IDs int64 `json:"ids,omitempty" yaml:"ids,omitempty" protobuf:"varint,3,opt,name=ids"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kuid}
+
+// A ClaimQuota limits the claims of a group in a namespace, optionally restricted to an index.
+// The limits are enforced when a claim is created or updated, before the backend allocates.
+// +k8s:openapi-gen=true
+type ClaimQuota struct {
+	metav1.TypeMeta   `json:",inline" yaml:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" yaml:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   ClaimQuotaSpec   `json:"spec,omitempty" yaml:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status ClaimQuotaStatus `json:"status,omitempty" yaml:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// ClaimQuotaList contains a list of ClaimQuotas
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClaimQuotaList struct {
+	metav1.TypeMeta `json:",inline" yaml:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" yaml:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []ClaimQuota `json:"items" yaml:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+var (
+	ClaimQuotaKind     = reflect.TypeOf(ClaimQuota{}).Name()
+	ClaimQuotaKindList = reflect.TypeOf(ClaimQuotaList{}).Name()
+)
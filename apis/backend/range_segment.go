@@ -0,0 +1,104 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/henderiw/idxtable/pkg/tree"
+)
+
+// RangeSegmentSeparator separates the segments of a range claim, e.g. 100-199,300-399
+const RangeSegmentSeparator = ","
+
+// GetRangeSegments returns the segments of a range, a range without separator is a single
+// segment
+func GetRangeSegments(s string) []string {
+	segments := strings.Split(s, RangeSegmentSeparator)
+	for i := range segments {
+		segments[i] = strings.TrimSpace(segments[i])
+	}
+	return segments
+}
+
+// ParseRangeSegments parses every segment of the range with the parse function of the id type
+func ParseRangeSegments(s string, parse func(s string) (tree.Range, error)) ([]tree.Range, error) {
+	segments := GetRangeSegments(s)
+	ranges := make([]tree.Range, 0, len(segments))
+	for _, segment := range segments {
+		r, err := parse(segment)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+// GetIDRanges returns the ids from-to of the ranges sorted by the first id
+func GetIDRanges(ranges []tree.Range) []IDRange {
+	idRanges := make([]IDRange, 0, len(ranges))
+	for _, r := range ranges {
+		idRanges = append(idRanges, IDRange{From: r.From().ID(), To: r.To().ID()})
+	}
+	sort.Slice(idRanges, func(i, j int) bool { return idRanges[i].From < idRanges[j].From })
+	return idRanges
+}
+
+// ValidateIDRanges validates the segments of a range do not overlap
+func ValidateIDRanges(ranges []IDRange) error {
+	sorted := make([]IDRange, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].From < sorted[j].From })
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].From <= sorted[i-1].To {
+			return fmt.Errorf("range segment %d-%d overlaps with segment %d-%d",
+				sorted[i].From, sorted[i].To, sorted[i-1].From, sorted[i-1].To)
+		}
+	}
+	return nil
+}
+
+// GetIDRangesSize returns the amount of ids in the ranges
+func GetIDRangesSize(ranges []IDRange) uint64 {
+	var size uint64
+	for _, r := range ranges {
+		size += r.To - r.From + 1
+	}
+	return size
+}
+
+// IntersectIDRanges returns the ids of the ranges a that are also in the ranges b, both
+// ranges need to be sorted
+func IntersectIDRanges(a, b []IDRange) []IDRange {
+	ranges := []IDRange{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		from, to := max(a[i].From, b[j].From), min(a[i].To, b[j].To)
+		if from <= to {
+			ranges = append(ranges, IDRange{From: from, To: to})
+		}
+		if a[i].To < b[j].To {
+			i++
+		} else {
+			j++
+		}
+	}
+	return ranges
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/henderiw/idxtable/pkg/table"
+	"github.com/henderiw/idxtable/pkg/tree"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// NewRangeTable returns the table of the ids claimed from a range, the segments of the
+// range are kept as intervals and only the claimed ids are stored, such that finding a free
+// id does not depend on the size of the range. newID returns the tree id of the id type of
+// the index.
+func NewRangeTable(ranges []IDRange, newID func(id uint64) tree.ID) table.Table {
+	segments := make([]IDRange, len(ranges))
+	copy(segments, ranges)
+	sort.Slice(segments, func(i, j int) bool { return segments[i].From < segments[j].From })
+	return &rangeTable{
+		segments: segments,
+		newID:    newID,
+		entries:  map[uint64]labels.Set{},
+	}
+}
+
+type rangeTable struct {
+	m        sync.RWMutex
+	segments []IDRange
+	newID    func(id uint64) tree.ID
+	entries  map[uint64]labels.Set
+}
+
+// RangeTablesEqual returns true when both tables are range tables holding the same ids. The
+// segments are compared as runs of ids, such that adjacent segments equal the segment
+// covering both of them. The claimed ids of the tables are not compared.
+func RangeTablesEqual(a, b table.Table) bool {
+	ra, ok := a.(*rangeTable)
+	if !ok {
+		return false
+	}
+	rb, ok := b.(*rangeTable)
+	if !ok {
+		return false
+	}
+	return slices.Equal(ra.idRuns(), rb.idRuns())
+}
+
+// idRuns returns the segments of the range where adjacent segments are merged in a single
+// run of ids
+func (r *rangeTable) idRuns() []IDRange {
+	runs := make([]IDRange, 0, len(r.segments))
+	for _, segment := range r.segments {
+		if n := len(runs); n > 0 && runs[n-1].To+1 == segment.From {
+			runs[n-1].To = segment.To
+			continue
+		}
+		runs = append(runs, segment)
+	}
+	return runs
+}
+
+func (r *rangeTable) validate(id uint64) error {
+	for _, segment := range r.segments {
+		if id >= segment.From && id <= segment.To {
+			return nil
+		}
+	}
+	return fmt.Errorf("id %d, does not fit in the range %s", id, r.String())
+}
+
+func (r *rangeTable) String() string {
+	segments := make([]string, 0, len(r.segments))
+	for _, segment := range r.segments {
+		segments = append(segments, RangeEntryID(segment.From, segment.To))
+	}
+	return strings.Join(segments, RangeSegmentSeparator)
+}
+
+func (r *rangeTable) Get(id uint64) (tree.Entry, error) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	if err := r.validate(id); err != nil {
+		return nil, err
+	}
+	l, ok := r.entries[id]
+	if !ok {
+		return nil, fmt.Errorf("no entry found for: %d", id)
+	}
+	return tree.NewEntry(r.newID(id), l), nil
+}
+
+func (r *rangeTable) Claim(id uint64, labels labels.Set) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.claim(id, labels)
+}
+
+func (r *rangeTable) claim(id uint64, labels labels.Set) error {
+	if err := r.validate(id); err != nil {
+		return err
+	}
+	if _, ok := r.entries[id]; ok {
+		return fmt.Errorf("claim failed id %d already claimed", id)
+	}
+	r.entries[id] = labels
+	return nil
+}
+
+func (r *rangeTable) ClaimFree(labels labels.Set) (tree.Entry, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	id, err := r.findFree()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.claim(id, labels); err != nil {
+		return nil, err
+	}
+	return tree.NewEntry(r.newID(id), labels), nil
+}
+
+func (r *rangeTable) Release(id uint64) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if err := r.validate(id); err != nil {
+		return err
+	}
+	delete(r.entries, id)
+	return nil
+}
+
+func (r *rangeTable) Update(id uint64, labels labels.Set) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if err := r.validate(id); err != nil {
+		return err
+	}
+	if _, ok := r.entries[id]; !ok {
+		return fmt.Errorf("entry %d not created", id)
+	}
+	r.entries[id] = labels
+	return nil
+}
+
+func (r *rangeTable) Size() int {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	return len(r.entries)
+}
+
+func (r *rangeTable) Has(id uint64) bool {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	if err := r.validate(id); err != nil {
+		return false
+	}
+	_, ok := r.entries[id]
+	return ok
+}
+
+func (r *rangeTable) IsFree(id uint64) bool {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	if err := r.validate(id); err != nil {
+		return false
+	}
+	_, ok := r.entries[id]
+	return !ok
+}
+
+func (r *rangeTable) FindFree() (uint64, error) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	return r.findFree()
+}
+
+// findFree returns the lowest free id of the segments of the range, at most one id more
+// than the amount of claimed ids is visited per segment
+func (r *rangeTable) findFree() (uint64, error) {
+	for _, segment := range r.segments {
+		for id := segment.From; ; id++ {
+			if _, ok := r.entries[id]; !ok {
+				return id, nil
+			}
+			if id == segment.To {
+				break
+			}
+		}
+	}
+	return 0, fmt.Errorf("no free entry found")
+}
+
+func (r *rangeTable) GetAll() tree.Entries {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	entries := make(tree.Entries, 0, len(r.entries))
+	for _, id := range r.sortedIDs() {
+		entries = append(entries, tree.NewEntry(r.newID(id), r.entries[id]))
+	}
+	return entries
+}
+
+func (r *rangeTable) GetByLabel(selector labels.Selector) tree.Entries {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	entries := tree.Entries{}
+	for _, id := range r.sortedIDs() {
+		if selector.Matches(r.entries[id]) {
+			entries = append(entries, tree.NewEntry(r.newID(id), r.entries[id]))
+		}
+	}
+	return entries
+}
+
+func (r *rangeTable) sortedIDs() []uint64 {
+	ids := make([]uint64, 0, len(r.entries))
+	for id := range r.entries {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/henderiw/idxtable/pkg/tree"
+	"github.com/henderiw/idxtable/pkg/tree/id32"
+)
+
+func newTestRangeTable(ranges ...IDRange) *rangeTable {
+	return NewRangeTable(ranges, func(id uint64) tree.ID {
+		return id32.NewID(uint32(id), id32.IDBitSize)
+	}).(*rangeTable)
+}
+
+func TestRangeTablesEqual(t *testing.T) {
+	cases := map[string]struct {
+		a    []IDRange
+		b    []IDRange
+		want bool
+	}{
+		"Equal": {
+			a:    []IDRange{{From: 100, To: 199}},
+			b:    []IDRange{{From: 100, To: 199}},
+			want: true,
+		},
+		"Order": {
+			a:    []IDRange{{From: 300, To: 399}, {From: 100, To: 199}},
+			b:    []IDRange{{From: 100, To: 199}, {From: 300, To: 399}},
+			want: true,
+		},
+		"AdjacentSegments": {
+			a:    []IDRange{{From: 100, To: 149}, {From: 150, To: 199}},
+			b:    []IDRange{{From: 100, To: 199}},
+			want: true,
+		},
+		"Extended": {
+			a: []IDRange{{From: 100, To: 199}},
+			b: []IDRange{{From: 100, To: 299}},
+		},
+		"Segments": {
+			a: []IDRange{{From: 100, To: 149}, {From: 160, To: 199}},
+			b: []IDRange{{From: 100, To: 199}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			a, b := newTestRangeTable(tc.a...), newTestRangeTable(tc.b...)
+			// the claimed ids are not compared
+			if err := a.Claim(100, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, RangeTablesEqual(a, b)); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, RangeTablesEqual(b, a)); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
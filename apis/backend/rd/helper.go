@@ -0,0 +1,232 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rd
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/as"
+)
+
+const RDID_Min = 0
+const RDID_Max = 4294967295
+
+// RouteTargetPrefix is the prefix of the route targets in the textual form, e.g. target:65000:100
+const RouteTargetPrefix = "target:"
+
+// RDIDBits defines the size of the assigned numbers of the RD types (RFC4364)
+var RDIDBits = map[RDType]int{
+	RDType_2byteAS:     32,
+	RDType_IPv4Address: 16,
+	RDType_4byteAS:     16,
+}
+
+func validateRDID(id int) error {
+	if id < RDID_Min {
+		return fmt.Errorf("invalid id, got %d", id)
+	}
+	if id > RDID_Max {
+		return fmt.Errorf("invalid id, got %d", id)
+	}
+	return nil
+}
+
+// GetRDMaxID returns the max assigned number of the RD type
+func GetRDMaxID(typ RDType) uint32 {
+	bits, ok := RDIDBits[typ]
+	if !ok {
+		return 0
+	}
+	return uint32(uint64(1)<<bits - 1)
+}
+
+// ParseAdministrator parses the administrator of the RD type, a 2 byte AS, an IPv4 address
+// or a 4 byte AS in asplain or asdot notation. The administrator is returned in its
+// canonical notation, the ASs in asplain
+func ParseAdministrator(typ RDType, s string) (string, error) {
+	switch typ {
+	case RDType_2byteAS:
+		asn, err := strconv.ParseUint(s, 10, 16)
+		if err != nil {
+			return "", fmt.Errorf("invalid administrator %s, expected a 2 byte AS", s)
+		}
+		return strconv.FormatUint(asn, 10), nil
+	case RDType_IPv4Address:
+		ip := net.ParseIP(s)
+		if ip == nil || ip.To4() == nil {
+			return "", fmt.Errorf("invalid administrator %s, expected an IPv4 address", s)
+		}
+		return ip.To4().String(), nil
+	case RDType_4byteAS:
+		asn, err := as.ParseASN(s)
+		if err != nil {
+			return "", fmt.Errorf("invalid administrator %s, expected a 4 byte AS in asplain or asdot notation", s)
+		}
+		return strconv.FormatUint(uint64(asn), 10), nil
+	default:
+		return "", fmt.Errorf("invalid RD type %s", typ)
+	}
+}
+
+// GetRDPrefix returns the type and the administrator of the RDs of an index, <type>:<administrator>
+func GetRDPrefix(typ RDType, administrator string) (string, error) {
+	admin, err := ParseAdministrator(typ, administrator)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s:%s", typ, admin), nil
+}
+
+// getPrefixRDType returns the RD type and the administrator of the prefix of an index
+func getPrefixRDType(prefix string) (RDType, string) {
+	t, admin, ok := strings.Cut(prefix, ":")
+	typ := GetRDType(t)
+	if !ok || typ == RDType_Invalid || admin == "" {
+		return RDType_Invalid, ""
+	}
+	return typ, admin
+}
+
+// GetRD returns the RD of the assigned number in the textual form
+// <administrator>:<assigned number>, the prefix is the type and the administrator of the index
+func GetRD(prefix string, id uint32) string {
+	typ, admin := getPrefixRDType(prefix)
+	if typ == RDType_Invalid {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", admin, id)
+}
+
+// GetRouteTarget returns the route target of the assigned number in the textual form
+// target:<administrator>:<assigned number>
+func GetRouteTarget(prefix string, id uint32) string {
+	rd := GetRD(prefix, id)
+	if rd == "" {
+		return ""
+	}
+	return RouteTargetPrefix + rd
+}
+
+// ParseRD parses an RD in the textual form <administrator>:<assigned number>, the
+// administrator is returned as is
+func ParseRD(s string) (string, uint32, error) {
+	i := strings.LastIndex(s, ":")
+	if i <= 0 {
+		return "", 0, fmt.Errorf("invalid RD %s, expected <administrator>:<assigned number>", s)
+	}
+	id, err := strconv.ParseUint(s[i+1:], 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid RD %s, the assigned number must be between 0 and %d", s, RDID_Max)
+	}
+	return s[:i], uint32(id), nil
+}
+
+// ParseRDID returns the assigned number of an RD
+func ParseRDID(s string) (uint32, error) {
+	_, id, err := ParseRD(s)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// validateRDPrefix validates the administrator of the RD is the administrator of the index
+// and the assigned number fits in the RD type
+func validateRDPrefix(prefix, s string) error {
+	admin, id, err := ParseRD(s)
+	if err != nil {
+		return err
+	}
+	typ, indexAdmin := getPrefixRDType(prefix)
+	if typ == RDType_Invalid {
+		return fmt.Errorf("invalid RD prefix %s", prefix)
+	}
+	a, err := ParseAdministrator(typ, admin)
+	if err != nil {
+		return fmt.Errorf("invalid RD %s, %s", s, err.Error())
+	}
+	if a != indexAdmin {
+		return fmt.Errorf("invalid RD %s, does not match the administrator %s of the index", s, indexAdmin)
+	}
+	if id > GetRDMaxID(typ) {
+		return fmt.Errorf("invalid RD %s, the max assigned number of the %s type is %d", s, typ, GetRDMaxID(typ))
+	}
+	return nil
+}
+
+// ParseRouteTarget parses a route target in the textual form
+// target:<administrator>:<assigned number>, the target: prefix is optional. The administrator
+// is an AS in asplain or asdot notation or an IPv4 address, the route target is returned in
+// the canonical form
+func ParseRouteTarget(s string) (string, error) {
+	admin, id, err := ParseRD(strings.TrimPrefix(s, RouteTargetPrefix))
+	if err != nil {
+		return "", fmt.Errorf("invalid route target %s, expected target:<administrator>:<assigned number>", s)
+	}
+	if ip := net.ParseIP(admin); ip != nil {
+		if ip.To4() == nil {
+			return "", fmt.Errorf("invalid route target %s, the administrator must be an IPv4 address", s)
+		}
+		if id > GetRDMaxID(RDType_IPv4Address) {
+			return "", fmt.Errorf("invalid route target %s, the max assigned number of an IPv4 address is %d", s, GetRDMaxID(RDType_IPv4Address))
+		}
+		return fmt.Sprintf("%s%s:%d", RouteTargetPrefix, ip.To4().String(), id), nil
+	}
+	asn, err := as.ParseASN(admin)
+	if err != nil {
+		return "", fmt.Errorf("invalid route target %s, the administrator must be an AS or an IPv4 address", s)
+	}
+	if asn > 65535 && id > GetRDMaxID(RDType_4byteAS) {
+		return "", fmt.Errorf("invalid route target %s, the max assigned number of a 4 byte AS is %d", s, GetRDMaxID(RDType_4byteAS))
+	}
+	return fmt.Sprintf("%s%d:%d", RouteTargetPrefix, asn, id), nil
+}
+
+// ParseRDRange parses a range of assigned numbers <start>-<end>
+func ParseRDRange(s string) (uint32, uint32, error) {
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid RD range, expected <start>-<end>, got: %s", s)
+	}
+	start, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid RD range start, got: %s, err: %s", s, err.Error())
+	}
+	end, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid RD range end, got: %s, err: %s", s, err.Error())
+	}
+	return uint32(start), uint32(end), nil
+}
+
+// getRDRange returns the range in RD notation, the segments of the range are returned
+// as is when they cannot be parsed
+func getRDRange(prefix, s string) string {
+	segments := backend.GetRangeSegments(s)
+	for i, segment := range segments {
+		start, end, err := ParseRDRange(segment)
+		if err != nil {
+			continue
+		}
+		segments[i] = fmt.Sprintf("%s-%s", GetRD(prefix, start), GetRD(prefix, end))
+	}
+	return strings.Join(segments, backend.RangeSegmentSeparator)
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rd
+
+type RDType string
+
+const (
+	RDType_Invalid RDType = "invalid"
+	// RDType_2byteAS are type 0 RDs, a 2 byte AS administrator followed by a 32bit
+	// assigned number
+	RDType_2byteAS RDType = "2byteAS"
+	// RDType_IPv4Address are type 1 RDs, an IPv4 address administrator followed by a
+	// 16bit assigned number
+	RDType_IPv4Address RDType = "ipv4Address"
+	// RDType_4byteAS are type 2 RDs, a 4 byte AS administrator followed by a 16bit
+	// assigned number
+	RDType_4byteAS RDType = "4byteAS"
+)
+
+func GetRDType(s string) RDType {
+	switch s {
+	case string(RDType_2byteAS):
+		return RDType_2byteAS
+	case string(RDType_IPv4Address):
+		return RDType_IPv4Address
+	case string(RDType_4byteAS):
+		return RDType_4byteAS
+	default:
+		return RDType_Invalid
+	}
+}
@@ -0,0 +1,552 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/henderiw/idxtable/pkg/table"
+	"github.com/henderiw/idxtable/pkg/tree"
+	"github.com/henderiw/idxtable/pkg/tree/id16"
+	"github.com/henderiw/idxtable/pkg/tree/id32"
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/apis/condition"
+	"github.com/kuidio/kuid/apis/backend"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+var _ backend.ClaimObject = &RDClaim{}
+var _ backend.NotationClaimObject = &RDClaim{}
+var _ backend.TypedClaimObject = &RDClaim{}
+
+func (r *RDClaim) GetNamespacedName() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.GetNamespace(),
+		Name:      r.GetName(),
+	}
+}
+
+func (r *RDClaim) GetKey() store.Key {
+	return store.KeyFromNSN(types.NamespacedName{Namespace: r.Namespace, Name: r.Spec.Index})
+}
+
+// GetCondition returns the condition based on the condition kind
+func (r *RDClaim) GetCondition(t condition.ConditionType) condition.Condition {
+	return r.Status.GetCondition(t)
+}
+
+// SetConditions sets the conditions on the resource. it allows for 0, 1 or more conditions
+// to be set at once
+func (r *RDClaim) SetConditions(c ...condition.Condition) {
+	r.Status.SetConditions(c...)
+}
+
+// ValidateSyntax validates the claim, the type and administrator of the index are used to
+// validate the id and the RD of the claim when provided
+func (r *RDClaim) ValidateSyntax(s string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if err := r.ValidateRDClaimType(); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath(""),
+			r,
+			err.Error(),
+		))
+		return allErrs
+	}
+	if err := r.ValidateRouteTargets(); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec"),
+			r,
+			err.Error(),
+		))
+		return allErrs
+	}
+	var v SyntaxValidator
+	claimType := r.GetClaimType()
+	switch claimType {
+	case backend.ClaimType_DynamicID:
+		v = &RDDynamicIDSyntaxValidator{Name: string(claimType)}
+	case backend.ClaimType_StaticID:
+		v = &RDStaticIDSyntaxValidator{Name: string(claimType), Prefix: s}
+	case backend.ClaimType_Range:
+		v = &RDRangeSyntaxValidator{Name: string(claimType), Prefix: s}
+	default:
+		return allErrs
+	}
+	return v.Validate(r)
+}
+
+func (r *RDClaim) ValidateRDRange(prefix string) error {
+	if r.Spec.Range == nil {
+		return fmt.Errorf("no RD range provided")
+	}
+	var errm error
+	if r.Name == r.Spec.Index {
+		// to be able to check if the entry is reserved we get a parentname (rang name) equal to index
+		// this is because the ownerreference uses the name of the index in its labels in the cache
+		errm = errors.Join(errm, fmt.Errorf("a name of range cannot be the same as the index"))
+	}
+	segments := []backend.IDRange{}
+	for _, segment := range backend.GetRangeSegments(*r.Spec.Range) {
+		start, end, err := ParseRDRange(segment)
+		if err != nil {
+			errm = errors.Join(errm, err)
+			continue
+		}
+		if start > end {
+			errm = errors.Join(errm, fmt.Errorf("invalid RD range start > end %s", segment))
+			continue
+		}
+		if err := validateRDPrefixID(prefix, end); err != nil {
+			errm = errors.Join(errm, err)
+			continue
+		}
+		segments = append(segments, backend.IDRange{From: uint64(start), To: uint64(end)})
+	}
+	if errm != nil {
+		return errm
+	}
+	return backend.ValidateIDRanges(segments)
+}
+
+func (r *RDClaim) ValidateRDID(prefix string) error {
+	if r.Spec.ID == nil && r.Spec.RD == nil {
+		return fmt.Errorf("no id provided")
+	}
+	if r.Spec.ID != nil {
+		if err := validateRDID(int(*r.Spec.ID)); err != nil {
+			return fmt.Errorf("invalid id err %s", err.Error())
+		}
+		if err := validateRDPrefixID(prefix, *r.Spec.ID); err != nil {
+			return err
+		}
+	}
+	if r.Spec.RD != nil {
+		if _, err := ParseRDID(*r.Spec.RD); err != nil {
+			return fmt.Errorf("invalid rd err %s", err.Error())
+		}
+		if prefix != "" {
+			if err := validateRDPrefix(prefix, *r.Spec.RD); err != nil {
+				return fmt.Errorf("invalid rd err %s", err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// validateRDPrefixID validates the id fits in the assigned number of the RD type of the
+// index, the id is not validated when the type and administrator of the index are unknown
+func validateRDPrefixID(prefix string, id uint32) error {
+	if prefix == "" {
+		return nil
+	}
+	typ, _ := getPrefixRDType(prefix)
+	if typ == RDType_Invalid {
+		return fmt.Errorf("invalid RD prefix %s", prefix)
+	}
+	if id > GetRDMaxID(typ) {
+		return fmt.Errorf("invalid id %d, the max id of the %s type is %d", id, typ, GetRDMaxID(typ))
+	}
+	return nil
+}
+
+// ValidateRouteTargets validates the additional import and export route targets, the route
+// targets only apply to the id claims of a VRF
+func (r *RDClaim) ValidateRouteTargets() error {
+	if len(r.Spec.ImportRouteTargets) == 0 && len(r.Spec.ExportRouteTargets) == 0 {
+		return nil
+	}
+	if r.GetClaimType() == backend.ClaimType_Range {
+		return fmt.Errorf("route targets cannot be provided for a range claim")
+	}
+	var errm error
+	for _, rt := range append(append([]string{}, r.Spec.ImportRouteTargets...), r.Spec.ExportRouteTargets...) {
+		if _, err := ParseRouteTarget(rt); err != nil {
+			errm = errors.Join(errm, err)
+		}
+	}
+	return errm
+}
+
+// ValidateIndexType validates the id, range or RD of the claim against the type and the
+// administrator of the index, the id must fit in the RD type and the RD must match the
+// administrator
+func (r *RDClaim) ValidateIndexType(typ string) error {
+	switch r.GetClaimType() {
+	case backend.ClaimType_StaticID:
+		return r.ValidateRDID(typ)
+	case backend.ClaimType_Range:
+		return r.ValidateRDRange(typ)
+	}
+	return nil
+}
+
+func (r *RDClaim) ValidateRDClaimType() error {
+	var sb strings.Builder
+	count := 0
+	if r.Spec.ID != nil {
+		sb.WriteString(fmt.Sprintf("id: %d", *r.Spec.ID))
+		count++
+
+	}
+	if r.Spec.RD != nil {
+		if count > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("rd: %s", *r.Spec.RD))
+		count++
+
+	}
+	if r.Spec.Range != nil {
+		if count > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("range: %s", *r.Spec.Range))
+		count++
+
+	}
+	if count > 1 {
+		return fmt.Errorf("a claim can only have 1 type, got %s", sb.String())
+	}
+	return nil
+}
+
+func (r *RDClaim) GetIndex() string { return r.Spec.Index }
+
+func (r *RDClaim) GetSelector() *metav1.LabelSelector { return r.Spec.Selector }
+
+func (r *RDClaim) IsOwner(labels labels.Set) bool {
+	for k, v := range r.getOwnerLabels() {
+		if val, ok := labels[k]; !ok || val != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *RDClaim) getOwnerLabels() map[string]string {
+	return map[string]string{
+		backend.KuidClaimNameKey: r.Name,
+		backend.KuidClaimUIDKey:  string(r.UID),
+	}
+}
+
+// GetOwnerSelector selects the route based on the name of the claim
+func (r *RDClaim) GetOwnerSelector() (labels.Selector, error) {
+	l := r.getOwnerLabels()
+
+	fullselector := labels.NewSelector()
+	for k, v := range l {
+		req, err := labels.NewRequirement(k, selection.Equals, []string{v})
+		if err != nil {
+			return nil, err
+		}
+		fullselector = fullselector.Add(*req)
+	}
+	return fullselector, nil
+}
+
+func (r *RDClaim) GetLabelSelector() (labels.Selector, error) { return r.Spec.GetLabelSelector() }
+
+func (r *RDClaim) GetClaimLabels() labels.Set {
+	labels := r.Spec.GetUserDefinedLabels()
+
+	// system defined labels
+	labels[backend.KuidClaimTypeKey] = string(r.GetClaimType())
+	labels[backend.KuidClaimNameKey] = r.Name
+	labels[backend.KuidClaimUIDKey] = string(r.UID)
+	labels[backend.KuidOwnerKindKey] = r.Kind
+	return labels
+}
+
+func (r *RDClaim) ValidateOwner(labels labels.Set) error {
+	routeClaimName := labels[backend.KuidClaimNameKey]
+	routeClaimUID := labels[backend.KuidClaimUIDKey]
+
+	if string(r.UID) != routeClaimUID && r.Name != routeClaimName {
+		return fmt.Errorf("route owned by different claim got name %s/%s uid %s/%s",
+			r.Name,
+			routeClaimName,
+			string(r.UID),
+			routeClaimUID,
+		)
+	}
+	return nil
+}
+
+func (r *RDClaim) GetClaimType() backend.ClaimType {
+	claimType := backend.ClaimType_Invalid
+	count := 0
+	if r.Spec.ID != nil || r.Spec.RD != nil {
+		claimType = backend.ClaimType_StaticID
+		count++
+
+	}
+	if r.Spec.Range != nil {
+		claimType = backend.ClaimType_Range
+		count++
+
+	}
+	if count > 1 {
+		return backend.ClaimType_Invalid
+	}
+	if count == 0 {
+		return backend.ClaimType_DynamicID
+	}
+	return claimType
+}
+
+// getStaticID returns the id or the id of the RD of the claim
+func (r *RDClaim) getStaticID() *uint32 {
+	if r.Spec.ID != nil {
+		return r.Spec.ID
+	}
+	if r.Spec.RD != nil {
+		id, err := ParseRDID(*r.Spec.RD)
+		if err != nil {
+			return nil
+		}
+		return ptr.To[uint32](id)
+	}
+	return nil
+}
+
+func (r *RDClaim) GetStaticID() *uint64 {
+	id := r.getStaticID()
+	if id == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*id))
+}
+
+func (r *RDClaim) GetStaticTreeID(typ string) tree.ID {
+	id := r.getStaticID()
+	if id == nil {
+		return nil
+	}
+	return getTreeID(typ, *id)
+}
+
+func (r *RDClaim) GetClaimID(typ string, id uint64) tree.ID {
+	return getTreeID(typ, uint32(id))
+}
+
+func (r *RDClaim) GetStatusClaimID(typ string) tree.ID {
+	if r.Status.ID == nil {
+		return nil
+	}
+	return getTreeID(typ, *r.Status.ID)
+}
+
+// getTreeID returns the id in the tree of the index, the types with a 16bit assigned
+// number use a 16bit tree
+func getTreeID(typ string, id uint32) tree.ID {
+	switch t, _ := getPrefixRDType(typ); t {
+	case RDType_IPv4Address, RDType_4byteAS:
+		return id16.NewID(uint16(id), id16.IDBitSize)
+	case RDType_2byteAS:
+		return id32.NewID(id, id32.IDBitSize)
+	default:
+		return nil
+	}
+}
+
+func (r *RDClaim) GetRange() *string {
+	return r.Spec.Range
+}
+
+func (r *RDClaim) GetRangeIDs(typ string) ([]tree.Range, error) {
+	if r.Spec.Range == nil {
+		return nil, fmt.Errorf("cannot provide a range without an id")
+	}
+	switch t, _ := getPrefixRDType(typ); t {
+	case RDType_IPv4Address, RDType_4byteAS:
+		return backend.ParseRangeSegments(*r.Spec.Range, id16.ParseRange)
+	case RDType_2byteAS:
+		return backend.ParseRangeSegments(*r.Spec.Range, id32.ParseRange)
+	default:
+		return nil, fmt.Errorf("cannot provide a range for an invalid RD type %s", typ)
+	}
+}
+
+func (r *RDClaim) GetTable(typ string, ranges []backend.IDRange) table.Table {
+	if getTreeID(typ, 0) == nil {
+		return nil
+	}
+	return backend.NewRangeTable(ranges, func(id uint64) tree.ID {
+		return getTreeID(typ, uint32(id))
+	})
+}
+
+func (r *RDClaim) SetStatusRange(s *string) {
+	r.Status.Range = s
+	r.resetStatusNotation()
+}
+
+func (r *RDClaim) SetStatusID(s *uint64) {
+	r.resetStatusNotation()
+	if s == nil {
+		r.Status.ID = nil
+		return
+	}
+	r.Status.ID = ptr.To[uint32](uint32(*s))
+}
+
+func (r *RDClaim) resetStatusNotation() {
+	r.Status.RD = nil
+	r.Status.ImportRouteTargets = nil
+	r.Status.ExportRouteTargets = nil
+}
+
+// SetStatusNotation renders the claimed id or range as RDs using the type and the
+// administrator of the index. The route targets of the VRF are the route target of the
+// claimed RD and the additional route targets of the claim
+func (r *RDClaim) SetStatusNotation(typ string) {
+	r.resetStatusNotation()
+	if t, _ := getPrefixRDType(typ); t == RDType_Invalid {
+		return
+	}
+	if r.Status.ID != nil {
+		r.Status.RD = ptr.To[string](GetRD(typ, *r.Status.ID))
+		rt := GetRouteTarget(typ, *r.Status.ID)
+		r.Status.ImportRouteTargets = getRouteTargets(rt, r.Spec.ImportRouteTargets)
+		r.Status.ExportRouteTargets = getRouteTargets(rt, r.Spec.ExportRouteTargets)
+	}
+	if r.Status.Range != nil {
+		r.Status.RD = ptr.To[string](getRDRange(typ, *r.Status.Range))
+	}
+}
+
+// getRouteTargets returns the route target followed by the additional route targets in
+// the canonical form, duplicates are removed
+func getRouteTargets(rt string, additional []string) []string {
+	rts := []string{rt}
+	seen := sets.New[string](rt)
+	for _, s := range additional {
+		additionalRT, err := ParseRouteTarget(s)
+		if err != nil || seen.Has(additionalRT) {
+			continue
+		}
+		seen.Insert(additionalRT)
+		rts = append(rts, additionalRT)
+	}
+	return rts
+}
+
+func (r *RDClaim) GetStatusID() *uint64 {
+	if r.Status.ID == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*r.Status.ID))
+}
+
+func (r *RDClaim) GetClaimRequest() string {
+	if r.Spec.RD != nil {
+		return *r.Spec.RD
+	}
+	if r.Spec.ID != nil {
+		return strconv.FormatUint(uint64(*r.Spec.ID), 10)
+	}
+	if r.Spec.Range != nil {
+		return *r.Spec.Range
+	}
+	return ""
+}
+
+func (r *RDClaim) GetClaimResponse() string {
+	if r.Status.RD != nil {
+		return *r.Status.RD
+	}
+	if r.Status.ID != nil {
+		return strconv.FormatUint(uint64(*r.Status.ID), 10)
+	}
+	if r.Status.Range != nil {
+		return *r.Status.Range
+	}
+	return ""
+}
+
+func (r *RDClaim) GetClaimSet(typ string) (map[string]tree.ID, sets.Set[string], error) {
+	aranges, err := r.GetRangeIDs(typ)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot get range from claim: %v", err)
+	}
+	// claim set represents the new entries
+	newClaimSet := sets.New[string]()
+	newClaimMap := map[string]tree.ID{}
+	for _, arange := range aranges {
+		for _, rangeID := range arange.IDs() {
+			newClaimSet.Insert(rangeID.String())
+			newClaimMap[rangeID.String()] = rangeID
+		}
+	}
+	return newClaimMap, newClaimSet, nil
+}
+
+func (r *RDClaim) GetChoreoAPIVersion() string {
+	return schema.GroupVersion{Group: GroupName, Version: "rd"}.String()
+}
+
+func RDClaimFromUnstructured(ru runtime.Unstructured) (backend.ClaimObject, error) {
+	obj := &RDClaim{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(ru.UnstructuredContent(), obj)
+	if err != nil {
+		return nil, fmt.Errorf("error converting unstructured to rdClaim: %v", err)
+	}
+	return obj, nil
+}
+
+func RDClaimFromRuntime(ru runtime.Object) (backend.ClaimObject, error) {
+	claim, ok := ru.(*RDClaim)
+	if !ok {
+		return nil, errors.New("runtime object not RDClaim")
+	}
+	return claim, nil
+}
+
+// BuildRDClaim returns a reource from a client Object a Spec/Status
+func BuildRDClaim(meta metav1.ObjectMeta, spec *RDClaimSpec, status *RDClaimStatus) backend.ClaimObject {
+	aspec := RDClaimSpec{}
+	if spec != nil {
+		aspec = *spec
+	}
+	astatus := RDClaimStatus{}
+	if status != nil {
+		astatus = *status
+	}
+	return &RDClaim{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.Identifier(),
+			Kind:       RDClaimKind,
+		},
+		ObjectMeta: meta,
+		Spec:       aspec,
+		Status:     astatus,
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rd
+
+import (
+	fmt "fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// +kubebuilder:object:generate=false
+// +k8s:deepcopy-gen:false
+type SyntaxValidator interface {
+	Validate(claim *RDClaim) field.ErrorList
+}
+
+// +k8s:deepcopy-gen:false
+type RDRangeSyntaxValidator struct {
+	Name string
+	// Prefix is the type and the administrator of the index
+	Prefix string
+}
+
+func (r *RDRangeSyntaxValidator) Validate(claim *RDClaim) field.ErrorList {
+	var allErrs field.ErrorList
+	if err := claim.ValidateRDRange(r.Prefix); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.range"),
+			claim,
+			fmt.Errorf("invalid RD range %s: %s", r.Name, err.Error()).Error(),
+		))
+	}
+	return allErrs
+}
+
+type RDDynamicIDSyntaxValidator struct {
+	Name string
+}
+
+func (r *RDDynamicIDSyntaxValidator) Validate(claim *RDClaim) field.ErrorList {
+	var allErrs field.ErrorList
+	return allErrs
+}
+
+type RDStaticIDSyntaxValidator struct {
+	Name string
+	// Prefix is the type and the administrator of the index
+	Prefix string
+}
+
+func (r *RDStaticIDSyntaxValidator) Validate(claim *RDClaim) field.ErrorList {
+	var allErrs field.ErrorList
+	if err := claim.ValidateRDID(r.Prefix); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.id"),
+			claim,
+			fmt.Errorf("invalid RD id %s: %s", r.Name, err.Error()).Error(),
+		))
+	}
+	return allErrs
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rd
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/kform-dev/choreo/apis/condition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// GetCondition returns the condition based on the condition kind
+func (r *RDIndex) GetCondition(t condition.ConditionType) condition.Condition {
+	return r.Status.GetCondition(t)
+}
+
+// SetConditions sets the conditions on the resource. it allows for 0, 1 or more conditions
+// to be set at once
+func (r *RDIndex) SetConditions(c ...condition.Condition) {
+	r.Status.SetConditions(c...)
+}
+
+func (r *RDIndex) ValidateSyntax(s string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	typ := GetRDType(r.Spec.Type)
+	if typ == RDType_Invalid {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.type"),
+			r,
+			fmt.Errorf("invalid RD type %s, supported: %s, %s, %s", r.Spec.Type, RDType_2byteAS, RDType_IPv4Address, RDType_4byteAS).Error(),
+		))
+		return allErrs
+	}
+	allErrs = append(allErrs, r.validateAdministrator(typ)...)
+
+	if r.Spec.MinID != nil {
+		if *r.Spec.MinID > GetRDMaxID(typ) {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.minID"),
+				r,
+				fmt.Errorf("invalid RD ID %d, the max ID of the %s type is %d", *r.Spec.MinID, typ, GetRDMaxID(typ)).Error(),
+			))
+		}
+	}
+	if r.Spec.MaxID != nil {
+		if *r.Spec.MaxID > GetRDMaxID(typ) {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.maxID"),
+				r,
+				fmt.Errorf("invalid RD ID %d, the max ID of the %s type is %d", *r.Spec.MaxID, typ, GetRDMaxID(typ)).Error(),
+			))
+		}
+	}
+	if r.Spec.MinID != nil && r.Spec.MaxID != nil {
+		if *r.Spec.MinID > *r.Spec.MaxID {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.maxID"),
+				r,
+				fmt.Errorf("min RD ID %d cannot be bigger than max RD ID %d", *r.Spec.MinID, *r.Spec.MaxID).Error(),
+			))
+		}
+	}
+	if len(allErrs) != 0 {
+		return allErrs
+	}
+	// the claims are validated against the type and the administrator of the index
+	prefix := r.GetType()
+	for i, claim := range r.Spec.Claims {
+		if errs := r.GetClaim(claim).ValidateSyntax(prefix); len(errs) != 0 {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.claims").Index(i),
+				r,
+				fmt.Errorf("invalid claim %s: %s", claim.Name, errs.ToAggregate().Error()).Error(),
+			))
+		}
+	}
+	return allErrs
+}
+
+// validateAdministrator validates the administrator of the index is provided or derived
+// from a claim that matches the type
+func (r *RDIndex) validateAdministrator(typ RDType) field.ErrorList {
+	var allErrs field.ErrorList
+	switch {
+	case r.Spec.Administrator != nil && r.Spec.AdministratorFrom != nil:
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.administratorFrom"),
+			r,
+			fmt.Errorf("an administrator and administratorFrom cannot be combined").Error(),
+		))
+	case r.Spec.Administrator != nil:
+		if _, err := ParseAdministrator(typ, *r.Spec.Administrator); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.administrator"),
+				r,
+				err.Error(),
+			))
+		}
+	case r.Spec.AdministratorFrom != nil:
+		from := r.Spec.AdministratorFrom
+		switch {
+		case (from.IPClaim == nil) == (from.ASClaim == nil):
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.administratorFrom"),
+				r,
+				fmt.Errorf("the administrator is derived from either an ipClaim or an asClaim").Error(),
+			))
+		case from.IPClaim != nil && typ != RDType_IPv4Address:
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.administratorFrom.ipClaim"),
+				r,
+				fmt.Errorf("an ipClaim is only supported for the %s type", RDType_IPv4Address).Error(),
+			))
+		case from.ASClaim != nil && typ == RDType_IPv4Address:
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.administratorFrom.asClaim"),
+				r,
+				fmt.Errorf("an asClaim is only supported for the %s and %s type", RDType_2byteAS, RDType_4byteAS).Error(),
+			))
+		}
+	default:
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.administrator"),
+			r,
+			fmt.Errorf("an administrator or administratorFrom is required").Error(),
+		))
+	}
+	return allErrs
+}
+
+// validateImmutable validates the type and the administrator of the index do not change,
+// the allocated RDs and route targets are derived from them
+func (r *RDIndex) validateImmutable(old *RDIndex) field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.Type != old.Spec.Type {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec.type"),
+			"the RD type of an index is immutable",
+		))
+	}
+	if !reflect.DeepEqual(r.Spec.Administrator, old.Spec.Administrator) ||
+		!reflect.DeepEqual(r.Spec.AdministratorFrom, old.Spec.AdministratorFrom) {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec"),
+			"the administrator and administratorFrom of an index are immutable",
+		))
+	}
+	return allErrs
+}
+
+// BuildRDIndex returns a reource from a client Object a Spec/Status
+func BuildRDIndex(meta metav1.ObjectMeta, spec *RDIndexSpec, status *RDIndexStatus) *RDIndex {
+	aspec := RDIndexSpec{}
+	if spec != nil {
+		aspec = *spec
+	}
+	astatus := RDIndexStatus{}
+	if status != nil {
+		astatus = *status
+	}
+	return &RDIndex{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.Identifier(),
+			Kind:       RDIndexKind,
+		},
+		ObjectMeta: meta,
+		Spec:       aspec,
+		Status:     astatus,
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rd
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/henderiw/idxtable/pkg/tree/gtree"
+	"github.com/henderiw/idxtable/pkg/tree/tree16"
+	"github.com/henderiw/idxtable/pkg/tree/tree32"
+	"github.com/henderiw/store"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+var _ backend.IndexObject = &RDIndex{}
+
+func (r *RDIndex) GetKey() store.Key {
+	return store.KeyFromNSN(r.GetNamespacedName())
+}
+
+func (r *RDIndex) GetNamespacedName() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.GetNamespace(),
+		Name:      r.GetName(),
+	}
+}
+
+// GetTree returns the tree of the index, the ipv4Address and 4byteAS type use a 16bit tree
+// for their 16bit assigned numbers, the 2byteAS type a 32bit tree
+func (r *RDIndex) GetTree() gtree.GTree {
+	switch GetRDType(r.Spec.Type) {
+	case RDType_IPv4Address, RDType_4byteAS:
+		tree, err := tree16.New(fmt.Sprintf("rdindex.%s", r.Name), 16)
+		if err != nil {
+			return nil
+		}
+		return tree
+	case RDType_2byteAS:
+		tree, err := tree32.New(fmt.Sprintf("rdindex.%s", r.Name), 32)
+		if err != nil {
+			return nil
+		}
+		return tree
+	default:
+		return nil
+	}
+}
+
+// GetType returns the type and the administrator of the index, <type>:<administrator>.
+// The claims derive their RDs and route targets from the administrator, the type is empty
+// as long as the administrator derived from a claim is not resolved
+func (r *RDIndex) GetType() string {
+	prefix, err := GetRDPrefix(GetRDType(r.Spec.Type), r.GetAdministrator())
+	if err != nil {
+		return ""
+	}
+	return prefix
+}
+
+// GetAdministrator returns the administrator of the spec or the administrator derived from
+// the administratorFrom claim
+func (r *RDIndex) GetAdministrator() string {
+	if r.Spec.Administrator != nil {
+		return *r.Spec.Administrator
+	}
+	if r.Status.Administrator != nil {
+		return *r.Status.Administrator
+	}
+	return ""
+}
+
+// IsAdministratorResolved returns false when the administrator is derived from a claim
+// and was not resolved yet
+func (r *RDIndex) IsAdministratorResolved() bool {
+	return r.Spec.Administrator != nil || r.Spec.AdministratorFrom == nil || r.Status.Administrator != nil
+}
+
+// SetAdministratorFromAddress sets the administrator derived from the IPv4 address claimed
+// by the ipam claim of the router ID, the address is in address or prefix notation
+func (r *RDIndex) SetAdministratorFromAddress(address *string) error {
+	if address == nil {
+		return fmt.Errorf("the administrator cannot be derived, the ipam claim has no address")
+	}
+	addr := *address
+	if ip, _, err := net.ParseCIDR(addr); err == nil {
+		addr = ip.String()
+	}
+	admin, err := ParseAdministrator(GetRDType(r.Spec.Type), addr)
+	if err != nil {
+		return fmt.Errorf("the administrator cannot be derived from the ipam claim: %s", err.Error())
+	}
+	r.Status.Administrator = ptr.To[string](admin)
+	return nil
+}
+
+// SetAdministratorFromAS sets the administrator derived from the AS claimed by the as claim
+func (r *RDIndex) SetAdministratorFromAS(id *uint32) error {
+	if id == nil {
+		return fmt.Errorf("the administrator cannot be derived, the as claim has no id")
+	}
+	admin, err := ParseAdministrator(GetRDType(r.Spec.Type), strconv.FormatUint(uint64(*id), 10))
+	if err != nil {
+		return fmt.Errorf("the administrator cannot be derived from the as claim: %s", err.Error())
+	}
+	r.Status.Administrator = ptr.To[string](admin)
+	return nil
+}
+
+func (r *RDIndex) GetMinID() *uint64 {
+	if r.Spec.MinID == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*r.Spec.MinID))
+}
+
+func (r *RDIndex) GetMaxID() *uint64 {
+	if r.Spec.MaxID == nil {
+		return nil
+	}
+	return ptr.To[uint64](uint64(*r.Spec.MaxID))
+}
+
+// GetMax returns the max assigned number of the type of the index
+func (r *RDIndex) GetMax() uint64 {
+	return uint64(GetRDMaxID(GetRDType(r.Spec.Type)))
+}
+
+func GetMinClaimRange(id uint64) string {
+	return fmt.Sprintf("%d-%d", RDID_Min, id-1)
+}
+
+func GetMaxClaimRange(id, max uint64) string {
+	return fmt.Sprintf("%d-%d", id+1, max)
+}
+
+func (r *RDIndex) GetMinClaimNSN() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.Namespace,
+		Name:      fmt.Sprintf("%s.%s", r.Name, backend.IndexReservedMinName),
+	}
+}
+
+func (r *RDIndex) GetMaxClaimNSN() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.Namespace,
+		Name:      fmt.Sprintf("%s.%s", r.Name, backend.IndexReservedMaxName),
+	}
+}
+
+func (r *RDIndex) GetClaims() []backend.ClaimObject {
+	claims := []backend.ClaimObject{}
+	if r.GetMinID() != nil && *r.GetMinID() != 0 {
+		claims = append(claims, r.GetMinClaim())
+	}
+	if r.GetMaxID() != nil && *r.GetMaxID() != r.GetMax() {
+		claims = append(claims, r.GetMaxClaim())
+	}
+	for _, claim := range r.Spec.Claims {
+		claims = append(claims, r.GetClaim(claim))
+	}
+	return claims
+}
+
+func (r *RDIndex) GetMinClaim() backend.ClaimObject {
+	return BuildRDClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      r.GetMinClaimNSN().Name,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       RDIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		&RDClaimSpec{
+			Index: r.Name,
+			Range: ptr.To[string](GetMinClaimRange(*r.GetMinID())),
+		},
+		nil,
+	)
+}
+
+func (r *RDIndex) GetMaxClaim() backend.ClaimObject {
+	return BuildRDClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      r.GetMaxClaimNSN().Name,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       RDIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		&RDClaimSpec{
+			Index: r.Name,
+			Range: ptr.To[string](GetMaxClaimRange(*r.GetMaxID(), r.GetMax())),
+		},
+		nil,
+	)
+}
+
+func (r *RDIndex) GetClaim(claim RDIndexClaim) backend.ClaimObject {
+	spec := &RDClaimSpec{
+		Index: r.Name,
+		ClaimLabels: common.ClaimLabels{
+			UserDefinedLabels: claim.UserDefinedLabels,
+		},
+	}
+	switch {
+	case claim.ID != nil:
+		spec.ID = claim.ID
+	case claim.RD != nil:
+		spec.RD = claim.RD
+	default:
+		spec.Range = claim.Range
+	}
+	return BuildRDClaim(
+		metav1.ObjectMeta{
+			Namespace: r.GetNamespace(),
+			Name:      fmt.Sprintf("%s.%s", r.Name, claim.Name),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+					Kind:       RDIndexKind,
+					Name:       r.Name,
+					UID:        r.UID,
+				},
+			},
+		},
+		spec,
+		nil,
+	)
+}
+
+func RDIndexFromRuntime(ru runtime.Object) (backend.IndexObject, error) {
+	index, ok := ru.(*RDIndex)
+	if !ok {
+		return nil, errors.New("runtime object not RDIndex")
+	}
+	return index, nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package register
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/henderiw/apiserver-builder/pkg/builder"
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"github.com/kuidio/kuid/apis/backend/as"
+	"github.com/kuidio/kuid/apis/backend/ipam"
+	"github.com/kuidio/kuid/apis/backend/rd"
+	"github.com/kuidio/kuid/pkg/registry/options"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// administratorStorage provides the ipam and as claims the administrator of an index is
+// derived from. The stores are added once the apiserver storage is initialized and are
+// only available when the ipam and as groups are enabled.
+var administratorStorage = &claimStorage{}
+
+type claimStorage struct {
+	m        sync.RWMutex
+	ipClaims *registry.Store
+	asClaims *registry.Store
+}
+
+func (r *claimStorage) add(ctx context.Context, apiServer *builder.Server) error {
+	ipClaims, err := getOptionalStore(ctx, apiServer, ipam.Resource(ipam.IPClaimPlural))
+	if err != nil {
+		return err
+	}
+	asClaims, err := getOptionalStore(ctx, apiServer, as.Resource(as.ASClaimPlural))
+	if err != nil {
+		return err
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.ipClaims = ipClaims
+	r.asClaims = asClaims
+	return nil
+}
+
+func (r *claimStorage) get(ctx context.Context, gr schema.GroupResource, name string) (runtime.Object, error) {
+	r.m.RLock()
+	store := r.ipClaims
+	if gr == as.Resource(as.ASClaimPlural) {
+		store = r.asClaims
+	}
+	r.m.RUnlock()
+	if store == nil {
+		return nil, fmt.Errorf("cannot get %s %s, the %s group is not enabled", gr.Resource, name, gr.Group)
+	}
+	return store.Get(ctx, name, &metav1.GetOptions{})
+}
+
+// getOptionalStore returns the store of the resource, nil when the group is not enabled
+func getOptionalStore(ctx context.Context, apiServer *builder.Server, gr schema.GroupResource) (*registry.Store, error) {
+	storageProvider, ok := apiServer.StorageProvider[gr]
+	if !ok {
+		return nil, nil
+	}
+	storage, err := storageProvider.Get(ctx, apiServer.Schemes[0], &Getter{})
+	if err != nil {
+		return nil, err
+	}
+	store, ok := storage.(*registry.Store)
+	if !ok {
+		return nil, fmt.Errorf("%s store is not a registry store", gr.String())
+	}
+	return store, nil
+}
+
+// newAdministratorInvoker returns an invoker that resolves the administrator of the index
+// from the ipam or as claim in the namespace of the index before the invoker creates the
+// index in the backend. The administrator is resolved once, updates keep the administrator
+// in the status.
+func newAdministratorInvoker(invoker options.BackendInvoker) options.BackendInvoker {
+	return &administratorInvoker{
+		invoker: invoker,
+	}
+}
+
+type administratorInvoker struct {
+	invoker options.BackendInvoker
+}
+
+func (r *administratorInvoker) InvokeCreate(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	if err := r.resolve(ctx, obj); err != nil {
+		return obj, err
+	}
+	return r.invoker.InvokeCreate(ctx, obj, recursion)
+}
+
+func (r *administratorInvoker) InvokeUpdate(ctx context.Context, obj, old runtime.Object, recursion bool) (runtime.Object, runtime.Object, error) {
+	if err := r.resolve(ctx, obj); err != nil {
+		return obj, old, err
+	}
+	return r.invoker.InvokeUpdate(ctx, obj, old, recursion)
+}
+
+func (r *administratorInvoker) InvokeDelete(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	return r.invoker.InvokeDelete(ctx, obj, recursion)
+}
+
+func (r *administratorInvoker) resolve(ctx context.Context, obj runtime.Object) error {
+	index, ok := obj.(*rd.RDIndex)
+	if !ok {
+		return fmt.Errorf("expecting %s, got %s", rd.RDIndexKind, reflect.TypeOf(obj).Name())
+	}
+	if index.IsAdministratorResolved() {
+		return nil
+	}
+	from := index.Spec.AdministratorFrom
+	switch {
+	case from.IPClaim != nil:
+		o, err := administratorStorage.get(ctx, ipam.Resource(ipam.IPClaimPlural), *from.IPClaim)
+		if err != nil {
+			return fmt.Errorf("cannot resolve the administrator: %s", err.Error())
+		}
+		claim, ok := o.(*ipam.IPClaim)
+		if !ok {
+			return fmt.Errorf("cannot resolve the administrator, expecting %s, got %s", ipam.IPClaimKind, reflect.TypeOf(o).Name())
+		}
+		return index.SetAdministratorFromAddress(claim.Status.Address)
+	case from.ASClaim != nil:
+		o, err := administratorStorage.get(ctx, as.Resource(as.ASClaimPlural), *from.ASClaim)
+		if err != nil {
+			return fmt.Errorf("cannot resolve the administrator: %s", err.Error())
+		}
+		claim, ok := o.(*as.ASClaim)
+		if !ok {
+			return fmt.Errorf("cannot resolve the administrator, expecting %s, got %s", as.ASClaimKind, reflect.TypeOf(o).Name())
+		}
+		return index.SetAdministratorFromAS(claim.Status.ID)
+	default:
+		return fmt.Errorf("cannot resolve the administrator, an ipClaim or asClaim is required")
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	commonv1alpha1 "github.com/kuidio/kuid/apis/common/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RDClaimSpec defines the desired state of RDClaim
+type RDClaimSpec struct {
+	// Index defines the index for the RD Claim
+	Index string `json:"index" protobuf:"bytes,1,opt,name=index"`
+	// ID defines the assigned number of the RD
+	ID *uint32 `json:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
+	// Range defines the range of the resource
+	// The following notation is used: start-end <start-ID>-<end-ID>
+	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
+	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
+	// ClaimLabels define the user defined labels and selector labels used
+	// in resource claim
+	commonv1alpha1.ClaimLabels `json:",inline" protobuf:"bytes,4,opt,name=claimLabels"`
+	// RD defines the RD in the textual form <administrator>:<assigned number>, e.g.
+	// 65000:100, as an alternative for the id. The administrator must be the administrator
+	// of the index
+	// +optional
+	RD *string `json:"rd,omitempty" protobuf:"bytes,5,opt,name=rd"`
+	// ImportRouteTargets defines additional route targets imported by the VRF, the route
+	// target of the claimed RD is always imported
+	// +optional
+	ImportRouteTargets []string `json:"importRouteTargets,omitempty" protobuf:"bytes,6,rep,name=importRouteTargets"`
+	// ExportRouteTargets defines additional route targets exported by the VRF, the route
+	// target of the claimed RD is always exported
+	// +optional
+	ExportRouteTargets []string `json:"exportRouteTargets,omitempty" protobuf:"bytes,7,rep,name=exportRouteTargets"`
+}
+
+// RDClaimStatus defines the observed state of RDClaim
+type RDClaimStatus struct {
+	// ConditionedStatus provides the status of the RDClaim using conditions
+	// - a ready condition indicates the overall status of the resource
+	condv1alpha1.ConditionedStatus `json:",inline" protobuf:"bytes,1,opt,name=conditionedStatus"`
+	// ID defines the ID of the RD claim
+	// +optional
+	ID *uint32 `json:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
+	// Range defines the ID range of the RD claim
+	// +optional
+	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
+	// ExpiryTime defines when the claim expires
+	// +kubebuilder:validation:Optional
+	// +optional
+	ExpiryTime *string `json:"expiryTime,omitempty" protobuf:"bytes,4,opt,name=expiryTime"`
+	// RD defines the claimed RD or RD range in the textual form
+	// +optional
+	RD *string `json:"rd,omitempty" protobuf:"bytes,5,opt,name=rd"`
+	// ImportRouteTargets defines the route targets imported by the VRF in the textual form
+	// target:<administrator>:<assigned number>
+	// +optional
+	ImportRouteTargets []string `json:"importRouteTargets,omitempty" protobuf:"bytes,6,rep,name=importRouteTargets"`
+	// ExportRouteTargets defines the route targets exported by the VRF in the textual form
+	// target:<administrator>:<assigned number>
+	// +optional
+	ExportRouteTargets []string `json:"exportRouteTargets,omitempty" protobuf:"bytes,7,rep,name=exportRouteTargets"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kuid}
+// RDClaim is the Schema for the RDClaim API
+type RDClaim struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   RDClaimSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status RDClaimStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// RDClaimList contains a list of RDClaims
+type RDClaimList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []RDClaim `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+var (
+	RDClaimKind     = reflect.TypeOf(RDClaim{}).Name()
+	RDClaimListKind = reflect.TypeOf(RDClaimList{}).Name()
+)
@@ -0,0 +1,130 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	commonv1alpha1 "github.com/kuidio/kuid/apis/common/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RDIndexSpec defines the desired state of RDIndex
+type RDIndexSpec struct {
+	// MinID defines the min ID the index supports
+	// +optional
+	MinID *uint32 `json:"minID,omitempty" protobuf:"bytes,1,opt,name=minID"`
+	// MaxID defines the max ID the index supports
+	// +optional
+	MaxID *uint32 `json:"maxID,omitempty" protobuf:"bytes,2,opt,name=maxID"`
+	// UserDefinedLabels define metadata to the resource.
+	// defined in the spec to distingiush metadata labels from user defined labels
+	commonv1alpha1.UserDefinedLabels `json:",inline" protobuf:"bytes,3,opt,name=userDefinedLabels"`
+	// Claims define the embedded claims in the Index
+	Claims []RDIndexClaim `json:"claims,omitempty" protobuf:"bytes,4,rep,name=claims"`
+	// Type defines the type of the RDs and route targets of the index
+	// 2byteAS: type 0 RDs, a 2 byte AS administrator followed by a 32bit assigned number
+	// ipv4Address: type 1 RDs, an IPv4 address administrator followed by a 16bit assigned number
+	// 4byteAS: type 2 RDs, a 4 byte AS administrator followed by a 16bit assigned number
+	// +kubebuilder:validation:Enum="2byteAS";ipv4Address;"4byteAS"
+	Type string `json:"type" protobuf:"bytes,5,opt,name=type"`
+	// Administrator defines the administrator of the RDs and route targets, an AS for the
+	// AS types (asplain or asdot for the 4byteAS type) or an IPv4 address for the
+	// ipv4Address type
+	// +optional
+	Administrator *string `json:"administrator,omitempty" protobuf:"bytes,6,opt,name=administrator"`
+	// AdministratorFrom derives the administrator from a claim in the namespace of the
+	// index, e.g. the router ID of a node, as an alternative for the administrator
+	// +optional
+	AdministratorFrom *RDAdministratorSource `json:"administratorFrom,omitempty" protobuf:"bytes,7,opt,name=administratorFrom"`
+}
+
+// RDAdministratorSource defines the claim the administrator of an index is derived from
+type RDAdministratorSource struct {
+	// IPClaim defines the name of the ipam claim of the router ID, the claimed IPv4 address
+	// is the administrator of the ipv4Address type
+	// +optional
+	IPClaim *string `json:"ipClaim,omitempty" protobuf:"bytes,1,opt,name=ipClaim"`
+	// ASClaim defines the name of the as claim, the claimed AS is the administrator of the
+	// 2byteAS and 4byteAS types
+	// +optional
+	ASClaim *string `json:"asClaim,omitempty" protobuf:"bytes,2,opt,name=asClaim"`
+}
+
+type RDIndexClaim struct {
+	// Name of the Claim
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ID defines the assigned number of the RD
+	ID *uint32 `json:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
+	// Range defines the range of the resource
+	// The following notation is used: start-end <start-ID>-<end-ID>
+	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
+	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
+	// UserDefinedLabels define metadata to the resource.
+	// defined in the spec to distingiush metadata labels from user defined labels
+	commonv1alpha1.UserDefinedLabels `json:",inline" protobuf:"bytes,4,opt,name=userDefinedLabels"`
+	// RD defines the RD in the textual form <administrator>:<assigned number>, as an
+	// alternative for the id. The administrator must be the administrator of the index
+	// +optional
+	RD *string `json:"rd,omitempty" protobuf:"bytes,5,opt,name=rd"`
+}
+
+// RDIndexStatus defines the observed state of RDIndex
+type RDIndexStatus struct {
+	// MinID defines the min ID the index supports
+	// +optional
+	MinID *uint32 `json:"minID,omitempty" protobuf:"bytes,1,opt,name=minID"`
+	// MaxID defines the max ID the index supports
+	// +optional
+	MaxID *uint32 `json:"maxID,omitempty" protobuf:"bytes,2,opt,name=maxID"`
+	// ConditionedStatus provides the status of the RDIndex using conditions
+	// - a ready condition indicates the overall status of the resource
+	condv1alpha1.ConditionedStatus `json:",inline" protobuf:"bytes,3,opt,name=conditionedStatus"`
+	// Administrator defines the administrator derived from the administratorFrom claim
+	// +optional
+	Administrator *string `json:"administrator,omitempty" protobuf:"bytes,4,opt,name=administrator"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=rdindices,categories={kuid}
+// RDIndex is the Schema for the RDIndex API
+type RDIndex struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   RDIndexSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status RDIndexStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// RDIndexList contains a list of RDIndex
+type RDIndexList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []RDIndex `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+var (
+	RDIndexKind     = reflect.TypeOf(RDIndex{}).Name()
+	RDIndexListKind = reflect.TypeOf(RDIndexList{}).Name()
+)
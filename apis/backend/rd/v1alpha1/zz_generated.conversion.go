@@ -0,0 +1,716 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	condition "github.com/kform-dev/choreo/apis/condition"
+	conditionv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	backend "github.com/kuidio/kuid/apis/backend"
+	rd "github.com/kuidio/kuid/apis/backend/rd"
+	common "github.com/kuidio/kuid/apis/common"
+	commonv1alpha1 "github.com/kuidio/kuid/apis/common/v1alpha1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*RDAdministratorSource)(nil), (*rd.RDAdministratorSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDAdministratorSource_To_rd_RDAdministratorSource(a.(*RDAdministratorSource), b.(*rd.RDAdministratorSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDAdministratorSource)(nil), (*RDAdministratorSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDAdministratorSource_To_v1alpha1_RDAdministratorSource(a.(*rd.RDAdministratorSource), b.(*RDAdministratorSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RDClaim)(nil), (*rd.RDClaim)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDClaim_To_rd_RDClaim(a.(*RDClaim), b.(*rd.RDClaim), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDClaim)(nil), (*RDClaim)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDClaim_To_v1alpha1_RDClaim(a.(*rd.RDClaim), b.(*RDClaim), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RDClaimList)(nil), (*rd.RDClaimList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDClaimList_To_rd_RDClaimList(a.(*RDClaimList), b.(*rd.RDClaimList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDClaimList)(nil), (*RDClaimList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDClaimList_To_v1alpha1_RDClaimList(a.(*rd.RDClaimList), b.(*RDClaimList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RDClaimSpec)(nil), (*rd.RDClaimSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDClaimSpec_To_rd_RDClaimSpec(a.(*RDClaimSpec), b.(*rd.RDClaimSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDClaimSpec)(nil), (*RDClaimSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDClaimSpec_To_v1alpha1_RDClaimSpec(a.(*rd.RDClaimSpec), b.(*RDClaimSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RDClaimStatus)(nil), (*rd.RDClaimStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDClaimStatus_To_rd_RDClaimStatus(a.(*RDClaimStatus), b.(*rd.RDClaimStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDClaimStatus)(nil), (*RDClaimStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDClaimStatus_To_v1alpha1_RDClaimStatus(a.(*rd.RDClaimStatus), b.(*RDClaimStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RDEntry)(nil), (*rd.RDEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDEntry_To_rd_RDEntry(a.(*RDEntry), b.(*rd.RDEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDEntry)(nil), (*RDEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDEntry_To_v1alpha1_RDEntry(a.(*rd.RDEntry), b.(*RDEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RDEntryList)(nil), (*rd.RDEntryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDEntryList_To_rd_RDEntryList(a.(*RDEntryList), b.(*rd.RDEntryList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDEntryList)(nil), (*RDEntryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDEntryList_To_v1alpha1_RDEntryList(a.(*rd.RDEntryList), b.(*RDEntryList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RDEntrySpec)(nil), (*rd.RDEntrySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDEntrySpec_To_rd_RDEntrySpec(a.(*RDEntrySpec), b.(*rd.RDEntrySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDEntrySpec)(nil), (*RDEntrySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDEntrySpec_To_v1alpha1_RDEntrySpec(a.(*rd.RDEntrySpec), b.(*RDEntrySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RDEntryStatus)(nil), (*rd.RDEntryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDEntryStatus_To_rd_RDEntryStatus(a.(*RDEntryStatus), b.(*rd.RDEntryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDEntryStatus)(nil), (*RDEntryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDEntryStatus_To_v1alpha1_RDEntryStatus(a.(*rd.RDEntryStatus), b.(*RDEntryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RDIndex)(nil), (*rd.RDIndex)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDIndex_To_rd_RDIndex(a.(*RDIndex), b.(*rd.RDIndex), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDIndex)(nil), (*RDIndex)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDIndex_To_v1alpha1_RDIndex(a.(*rd.RDIndex), b.(*RDIndex), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RDIndexClaim)(nil), (*rd.RDIndexClaim)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDIndexClaim_To_rd_RDIndexClaim(a.(*RDIndexClaim), b.(*rd.RDIndexClaim), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDIndexClaim)(nil), (*RDIndexClaim)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDIndexClaim_To_v1alpha1_RDIndexClaim(a.(*rd.RDIndexClaim), b.(*RDIndexClaim), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RDIndexList)(nil), (*rd.RDIndexList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDIndexList_To_rd_RDIndexList(a.(*RDIndexList), b.(*rd.RDIndexList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDIndexList)(nil), (*RDIndexList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDIndexList_To_v1alpha1_RDIndexList(a.(*rd.RDIndexList), b.(*RDIndexList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RDIndexSpec)(nil), (*rd.RDIndexSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDIndexSpec_To_rd_RDIndexSpec(a.(*RDIndexSpec), b.(*rd.RDIndexSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDIndexSpec)(nil), (*RDIndexSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDIndexSpec_To_v1alpha1_RDIndexSpec(a.(*rd.RDIndexSpec), b.(*RDIndexSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RDIndexStatus)(nil), (*rd.RDIndexStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RDIndexStatus_To_rd_RDIndexStatus(a.(*RDIndexStatus), b.(*rd.RDIndexStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rd.RDIndexStatus)(nil), (*RDIndexStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rd_RDIndexStatus_To_v1alpha1_RDIndexStatus(a.(*rd.RDIndexStatus), b.(*RDIndexStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*common.ClaimLabels)(nil), (*commonv1alpha1.ClaimLabels)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_common_ClaimLabels_To_v1alpha1_ClaimLabels(a.(*common.ClaimLabels), b.(*commonv1alpha1.ClaimLabels), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*common.UserDefinedLabels)(nil), (*commonv1alpha1.UserDefinedLabels)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_common_UserDefinedLabels_To_v1alpha1_UserDefinedLabels(a.(*common.UserDefinedLabels), b.(*commonv1alpha1.UserDefinedLabels), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*condition.Condition)(nil), (*conditionv1alpha1.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_condition_Condition_To_v1alpha1_Condition(a.(*condition.Condition), b.(*conditionv1alpha1.Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*condition.ConditionedStatus)(nil), (*conditionv1alpha1.ConditionedStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_condition_ConditionedStatus_To_v1alpha1_ConditionedStatus(a.(*condition.ConditionedStatus), b.(*conditionv1alpha1.ConditionedStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*commonv1alpha1.ClaimLabels)(nil), (*common.ClaimLabels)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClaimLabels_To_common_ClaimLabels(a.(*commonv1alpha1.ClaimLabels), b.(*common.ClaimLabels), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*conditionv1alpha1.Condition)(nil), (*condition.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Condition_To_condition_Condition(a.(*conditionv1alpha1.Condition), b.(*condition.Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*conditionv1alpha1.ConditionedStatus)(nil), (*condition.ConditionedStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ConditionedStatus_To_condition_ConditionedStatus(a.(*conditionv1alpha1.ConditionedStatus), b.(*condition.ConditionedStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*commonv1alpha1.UserDefinedLabels)(nil), (*common.UserDefinedLabels)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UserDefinedLabels_To_common_UserDefinedLabels(a.(*commonv1alpha1.UserDefinedLabels), b.(*common.UserDefinedLabels), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_RDAdministratorSource_To_rd_RDAdministratorSource(in *RDAdministratorSource, out *rd.RDAdministratorSource, s conversion.Scope) error {
+	out.IPClaim = (*string)(unsafe.Pointer(in.IPClaim))
+	out.ASClaim = (*string)(unsafe.Pointer(in.ASClaim))
+	return nil
+}
+
+// Convert_v1alpha1_RDAdministratorSource_To_rd_RDAdministratorSource is an autogenerated conversion function.
+func Convert_v1alpha1_RDAdministratorSource_To_rd_RDAdministratorSource(in *RDAdministratorSource, out *rd.RDAdministratorSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDAdministratorSource_To_rd_RDAdministratorSource(in, out, s)
+}
+
+func autoConvert_rd_RDAdministratorSource_To_v1alpha1_RDAdministratorSource(in *rd.RDAdministratorSource, out *RDAdministratorSource, s conversion.Scope) error {
+	out.IPClaim = (*string)(unsafe.Pointer(in.IPClaim))
+	out.ASClaim = (*string)(unsafe.Pointer(in.ASClaim))
+	return nil
+}
+
+// Convert_rd_RDAdministratorSource_To_v1alpha1_RDAdministratorSource is an autogenerated conversion function.
+func Convert_rd_RDAdministratorSource_To_v1alpha1_RDAdministratorSource(in *rd.RDAdministratorSource, out *RDAdministratorSource, s conversion.Scope) error {
+	return autoConvert_rd_RDAdministratorSource_To_v1alpha1_RDAdministratorSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_RDClaim_To_rd_RDClaim(in *RDClaim, out *rd.RDClaim, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_RDClaimSpec_To_rd_RDClaimSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_RDClaimStatus_To_rd_RDClaimStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RDClaim_To_rd_RDClaim is an autogenerated conversion function.
+func Convert_v1alpha1_RDClaim_To_rd_RDClaim(in *RDClaim, out *rd.RDClaim, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDClaim_To_rd_RDClaim(in, out, s)
+}
+
+func autoConvert_rd_RDClaim_To_v1alpha1_RDClaim(in *rd.RDClaim, out *RDClaim, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_rd_RDClaimSpec_To_v1alpha1_RDClaimSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_rd_RDClaimStatus_To_v1alpha1_RDClaimStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_rd_RDClaim_To_v1alpha1_RDClaim is an autogenerated conversion function.
+func Convert_rd_RDClaim_To_v1alpha1_RDClaim(in *rd.RDClaim, out *RDClaim, s conversion.Scope) error {
+	return autoConvert_rd_RDClaim_To_v1alpha1_RDClaim(in, out, s)
+}
+
+func autoConvert_v1alpha1_RDClaimList_To_rd_RDClaimList(in *RDClaimList, out *rd.RDClaimList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]rd.RDClaim, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_RDClaim_To_rd_RDClaim(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RDClaimList_To_rd_RDClaimList is an autogenerated conversion function.
+func Convert_v1alpha1_RDClaimList_To_rd_RDClaimList(in *RDClaimList, out *rd.RDClaimList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDClaimList_To_rd_RDClaimList(in, out, s)
+}
+
+func autoConvert_rd_RDClaimList_To_v1alpha1_RDClaimList(in *rd.RDClaimList, out *RDClaimList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RDClaim, len(*in))
+		for i := range *in {
+			if err := Convert_rd_RDClaim_To_v1alpha1_RDClaim(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_rd_RDClaimList_To_v1alpha1_RDClaimList is an autogenerated conversion function.
+func Convert_rd_RDClaimList_To_v1alpha1_RDClaimList(in *rd.RDClaimList, out *RDClaimList, s conversion.Scope) error {
+	return autoConvert_rd_RDClaimList_To_v1alpha1_RDClaimList(in, out, s)
+}
+
+func autoConvert_v1alpha1_RDClaimSpec_To_rd_RDClaimSpec(in *RDClaimSpec, out *rd.RDClaimSpec, s conversion.Scope) error {
+	out.Index = in.Index
+	out.ID = (*uint32)(unsafe.Pointer(in.ID))
+	out.Range = (*string)(unsafe.Pointer(in.Range))
+	if err := Convert_v1alpha1_ClaimLabels_To_common_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
+		return err
+	}
+	out.RD = (*string)(unsafe.Pointer(in.RD))
+	out.ImportRouteTargets = *(*[]string)(unsafe.Pointer(&in.ImportRouteTargets))
+	out.ExportRouteTargets = *(*[]string)(unsafe.Pointer(&in.ExportRouteTargets))
+	return nil
+}
+
+// Convert_v1alpha1_RDClaimSpec_To_rd_RDClaimSpec is an autogenerated conversion function.
+func Convert_v1alpha1_RDClaimSpec_To_rd_RDClaimSpec(in *RDClaimSpec, out *rd.RDClaimSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDClaimSpec_To_rd_RDClaimSpec(in, out, s)
+}
+
+func autoConvert_rd_RDClaimSpec_To_v1alpha1_RDClaimSpec(in *rd.RDClaimSpec, out *RDClaimSpec, s conversion.Scope) error {
+	out.Index = in.Index
+	out.ID = (*uint32)(unsafe.Pointer(in.ID))
+	out.Range = (*string)(unsafe.Pointer(in.Range))
+	if err := Convert_common_ClaimLabels_To_v1alpha1_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
+		return err
+	}
+	out.RD = (*string)(unsafe.Pointer(in.RD))
+	out.ImportRouteTargets = *(*[]string)(unsafe.Pointer(&in.ImportRouteTargets))
+	out.ExportRouteTargets = *(*[]string)(unsafe.Pointer(&in.ExportRouteTargets))
+	return nil
+}
+
+// Convert_rd_RDClaimSpec_To_v1alpha1_RDClaimSpec is an autogenerated conversion function.
+func Convert_rd_RDClaimSpec_To_v1alpha1_RDClaimSpec(in *rd.RDClaimSpec, out *RDClaimSpec, s conversion.Scope) error {
+	return autoConvert_rd_RDClaimSpec_To_v1alpha1_RDClaimSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_RDClaimStatus_To_rd_RDClaimStatus(in *RDClaimStatus, out *rd.RDClaimStatus, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ConditionedStatus_To_condition_ConditionedStatus(&in.ConditionedStatus, &out.ConditionedStatus, s); err != nil {
+		return err
+	}
+	out.ID = (*uint32)(unsafe.Pointer(in.ID))
+	out.Range = (*string)(unsafe.Pointer(in.Range))
+	out.ExpiryTime = (*string)(unsafe.Pointer(in.ExpiryTime))
+	out.RD = (*string)(unsafe.Pointer(in.RD))
+	out.ImportRouteTargets = *(*[]string)(unsafe.Pointer(&in.ImportRouteTargets))
+	out.ExportRouteTargets = *(*[]string)(unsafe.Pointer(&in.ExportRouteTargets))
+	return nil
+}
+
+// Convert_v1alpha1_RDClaimStatus_To_rd_RDClaimStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RDClaimStatus_To_rd_RDClaimStatus(in *RDClaimStatus, out *rd.RDClaimStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDClaimStatus_To_rd_RDClaimStatus(in, out, s)
+}
+
+func autoConvert_rd_RDClaimStatus_To_v1alpha1_RDClaimStatus(in *rd.RDClaimStatus, out *RDClaimStatus, s conversion.Scope) error {
+	if err := Convert_condition_ConditionedStatus_To_v1alpha1_ConditionedStatus(&in.ConditionedStatus, &out.ConditionedStatus, s); err != nil {
+		return err
+	}
+	out.ID = (*uint32)(unsafe.Pointer(in.ID))
+	out.Range = (*string)(unsafe.Pointer(in.Range))
+	out.ExpiryTime = (*string)(unsafe.Pointer(in.ExpiryTime))
+	out.RD = (*string)(unsafe.Pointer(in.RD))
+	out.ImportRouteTargets = *(*[]string)(unsafe.Pointer(&in.ImportRouteTargets))
+	out.ExportRouteTargets = *(*[]string)(unsafe.Pointer(&in.ExportRouteTargets))
+	return nil
+}
+
+// Convert_rd_RDClaimStatus_To_v1alpha1_RDClaimStatus is an autogenerated conversion function.
+func Convert_rd_RDClaimStatus_To_v1alpha1_RDClaimStatus(in *rd.RDClaimStatus, out *RDClaimStatus, s conversion.Scope) error {
+	return autoConvert_rd_RDClaimStatus_To_v1alpha1_RDClaimStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RDEntry_To_rd_RDEntry(in *RDEntry, out *rd.RDEntry, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_RDEntrySpec_To_rd_RDEntrySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_RDEntryStatus_To_rd_RDEntryStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RDEntry_To_rd_RDEntry is an autogenerated conversion function.
+func Convert_v1alpha1_RDEntry_To_rd_RDEntry(in *RDEntry, out *rd.RDEntry, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDEntry_To_rd_RDEntry(in, out, s)
+}
+
+func autoConvert_rd_RDEntry_To_v1alpha1_RDEntry(in *rd.RDEntry, out *RDEntry, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_rd_RDEntrySpec_To_v1alpha1_RDEntrySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_rd_RDEntryStatus_To_v1alpha1_RDEntryStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_rd_RDEntry_To_v1alpha1_RDEntry is an autogenerated conversion function.
+func Convert_rd_RDEntry_To_v1alpha1_RDEntry(in *rd.RDEntry, out *RDEntry, s conversion.Scope) error {
+	return autoConvert_rd_RDEntry_To_v1alpha1_RDEntry(in, out, s)
+}
+
+func autoConvert_v1alpha1_RDEntryList_To_rd_RDEntryList(in *RDEntryList, out *rd.RDEntryList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]rd.RDEntry, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_RDEntry_To_rd_RDEntry(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RDEntryList_To_rd_RDEntryList is an autogenerated conversion function.
+func Convert_v1alpha1_RDEntryList_To_rd_RDEntryList(in *RDEntryList, out *rd.RDEntryList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDEntryList_To_rd_RDEntryList(in, out, s)
+}
+
+func autoConvert_rd_RDEntryList_To_v1alpha1_RDEntryList(in *rd.RDEntryList, out *RDEntryList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RDEntry, len(*in))
+		for i := range *in {
+			if err := Convert_rd_RDEntry_To_v1alpha1_RDEntry(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_rd_RDEntryList_To_v1alpha1_RDEntryList is an autogenerated conversion function.
+func Convert_rd_RDEntryList_To_v1alpha1_RDEntryList(in *rd.RDEntryList, out *RDEntryList, s conversion.Scope) error {
+	return autoConvert_rd_RDEntryList_To_v1alpha1_RDEntryList(in, out, s)
+}
+
+func autoConvert_v1alpha1_RDEntrySpec_To_rd_RDEntrySpec(in *RDEntrySpec, out *rd.RDEntrySpec, s conversion.Scope) error {
+	out.Index = in.Index
+	out.IndexEntry = in.IndexEntry
+	out.ClaimType = backend.ClaimType(in.ClaimType)
+	out.ID = in.ID
+	if err := Convert_v1alpha1_ClaimLabels_To_common_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
+		return err
+	}
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_v1alpha1_RDEntrySpec_To_rd_RDEntrySpec is an autogenerated conversion function.
+func Convert_v1alpha1_RDEntrySpec_To_rd_RDEntrySpec(in *RDEntrySpec, out *rd.RDEntrySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDEntrySpec_To_rd_RDEntrySpec(in, out, s)
+}
+
+func autoConvert_rd_RDEntrySpec_To_v1alpha1_RDEntrySpec(in *rd.RDEntrySpec, out *RDEntrySpec, s conversion.Scope) error {
+	out.Index = in.Index
+	out.IndexEntry = in.IndexEntry
+	out.ClaimType = backend.ClaimType(in.ClaimType)
+	out.ID = in.ID
+	if err := Convert_common_ClaimLabels_To_v1alpha1_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
+		return err
+	}
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_rd_RDEntrySpec_To_v1alpha1_RDEntrySpec is an autogenerated conversion function.
+func Convert_rd_RDEntrySpec_To_v1alpha1_RDEntrySpec(in *rd.RDEntrySpec, out *RDEntrySpec, s conversion.Scope) error {
+	return autoConvert_rd_RDEntrySpec_To_v1alpha1_RDEntrySpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_RDEntryStatus_To_rd_RDEntryStatus(in *RDEntryStatus, out *rd.RDEntryStatus, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ConditionedStatus_To_condition_ConditionedStatus(&in.ConditionedStatus, &out.ConditionedStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RDEntryStatus_To_rd_RDEntryStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RDEntryStatus_To_rd_RDEntryStatus(in *RDEntryStatus, out *rd.RDEntryStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDEntryStatus_To_rd_RDEntryStatus(in, out, s)
+}
+
+func autoConvert_rd_RDEntryStatus_To_v1alpha1_RDEntryStatus(in *rd.RDEntryStatus, out *RDEntryStatus, s conversion.Scope) error {
+	if err := Convert_condition_ConditionedStatus_To_v1alpha1_ConditionedStatus(&in.ConditionedStatus, &out.ConditionedStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_rd_RDEntryStatus_To_v1alpha1_RDEntryStatus is an autogenerated conversion function.
+func Convert_rd_RDEntryStatus_To_v1alpha1_RDEntryStatus(in *rd.RDEntryStatus, out *RDEntryStatus, s conversion.Scope) error {
+	return autoConvert_rd_RDEntryStatus_To_v1alpha1_RDEntryStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RDIndex_To_rd_RDIndex(in *RDIndex, out *rd.RDIndex, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_RDIndexSpec_To_rd_RDIndexSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_RDIndexStatus_To_rd_RDIndexStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RDIndex_To_rd_RDIndex is an autogenerated conversion function.
+func Convert_v1alpha1_RDIndex_To_rd_RDIndex(in *RDIndex, out *rd.RDIndex, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDIndex_To_rd_RDIndex(in, out, s)
+}
+
+func autoConvert_rd_RDIndex_To_v1alpha1_RDIndex(in *rd.RDIndex, out *RDIndex, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_rd_RDIndexSpec_To_v1alpha1_RDIndexSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_rd_RDIndexStatus_To_v1alpha1_RDIndexStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_rd_RDIndex_To_v1alpha1_RDIndex is an autogenerated conversion function.
+func Convert_rd_RDIndex_To_v1alpha1_RDIndex(in *rd.RDIndex, out *RDIndex, s conversion.Scope) error {
+	return autoConvert_rd_RDIndex_To_v1alpha1_RDIndex(in, out, s)
+}
+
+func autoConvert_v1alpha1_RDIndexClaim_To_rd_RDIndexClaim(in *RDIndexClaim, out *rd.RDIndexClaim, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ID = (*uint32)(unsafe.Pointer(in.ID))
+	out.Range = (*string)(unsafe.Pointer(in.Range))
+	if err := Convert_v1alpha1_UserDefinedLabels_To_common_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
+		return err
+	}
+	out.RD = (*string)(unsafe.Pointer(in.RD))
+	return nil
+}
+
+// Convert_v1alpha1_RDIndexClaim_To_rd_RDIndexClaim is an autogenerated conversion function.
+func Convert_v1alpha1_RDIndexClaim_To_rd_RDIndexClaim(in *RDIndexClaim, out *rd.RDIndexClaim, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDIndexClaim_To_rd_RDIndexClaim(in, out, s)
+}
+
+func autoConvert_rd_RDIndexClaim_To_v1alpha1_RDIndexClaim(in *rd.RDIndexClaim, out *RDIndexClaim, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ID = (*uint32)(unsafe.Pointer(in.ID))
+	out.Range = (*string)(unsafe.Pointer(in.Range))
+	if err := Convert_common_UserDefinedLabels_To_v1alpha1_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
+		return err
+	}
+	out.RD = (*string)(unsafe.Pointer(in.RD))
+	return nil
+}
+
+// Convert_rd_RDIndexClaim_To_v1alpha1_RDIndexClaim is an autogenerated conversion function.
+func Convert_rd_RDIndexClaim_To_v1alpha1_RDIndexClaim(in *rd.RDIndexClaim, out *RDIndexClaim, s conversion.Scope) error {
+	return autoConvert_rd_RDIndexClaim_To_v1alpha1_RDIndexClaim(in, out, s)
+}
+
+func autoConvert_v1alpha1_RDIndexList_To_rd_RDIndexList(in *RDIndexList, out *rd.RDIndexList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]rd.RDIndex, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_RDIndex_To_rd_RDIndex(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RDIndexList_To_rd_RDIndexList is an autogenerated conversion function.
+func Convert_v1alpha1_RDIndexList_To_rd_RDIndexList(in *RDIndexList, out *rd.RDIndexList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDIndexList_To_rd_RDIndexList(in, out, s)
+}
+
+func autoConvert_rd_RDIndexList_To_v1alpha1_RDIndexList(in *rd.RDIndexList, out *RDIndexList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RDIndex, len(*in))
+		for i := range *in {
+			if err := Convert_rd_RDIndex_To_v1alpha1_RDIndex(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_rd_RDIndexList_To_v1alpha1_RDIndexList is an autogenerated conversion function.
+func Convert_rd_RDIndexList_To_v1alpha1_RDIndexList(in *rd.RDIndexList, out *RDIndexList, s conversion.Scope) error {
+	return autoConvert_rd_RDIndexList_To_v1alpha1_RDIndexList(in, out, s)
+}
+
+func autoConvert_v1alpha1_RDIndexSpec_To_rd_RDIndexSpec(in *RDIndexSpec, out *rd.RDIndexSpec, s conversion.Scope) error {
+	out.MinID = (*uint32)(unsafe.Pointer(in.MinID))
+	out.MaxID = (*uint32)(unsafe.Pointer(in.MaxID))
+	if err := Convert_v1alpha1_UserDefinedLabels_To_common_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
+		return err
+	}
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make([]rd.RDIndexClaim, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_RDIndexClaim_To_rd_RDIndexClaim(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Claims = nil
+	}
+	out.Type = in.Type
+	out.Administrator = (*string)(unsafe.Pointer(in.Administrator))
+	out.AdministratorFrom = (*rd.RDAdministratorSource)(unsafe.Pointer(in.AdministratorFrom))
+	return nil
+}
+
+// Convert_v1alpha1_RDIndexSpec_To_rd_RDIndexSpec is an autogenerated conversion function.
+func Convert_v1alpha1_RDIndexSpec_To_rd_RDIndexSpec(in *RDIndexSpec, out *rd.RDIndexSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDIndexSpec_To_rd_RDIndexSpec(in, out, s)
+}
+
+func autoConvert_rd_RDIndexSpec_To_v1alpha1_RDIndexSpec(in *rd.RDIndexSpec, out *RDIndexSpec, s conversion.Scope) error {
+	out.MinID = (*uint32)(unsafe.Pointer(in.MinID))
+	out.MaxID = (*uint32)(unsafe.Pointer(in.MaxID))
+	if err := Convert_common_UserDefinedLabels_To_v1alpha1_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
+		return err
+	}
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make([]RDIndexClaim, len(*in))
+		for i := range *in {
+			if err := Convert_rd_RDIndexClaim_To_v1alpha1_RDIndexClaim(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Claims = nil
+	}
+	out.Type = in.Type
+	out.Administrator = (*string)(unsafe.Pointer(in.Administrator))
+	out.AdministratorFrom = (*RDAdministratorSource)(unsafe.Pointer(in.AdministratorFrom))
+	return nil
+}
+
+// Convert_rd_RDIndexSpec_To_v1alpha1_RDIndexSpec is an autogenerated conversion function.
+func Convert_rd_RDIndexSpec_To_v1alpha1_RDIndexSpec(in *rd.RDIndexSpec, out *RDIndexSpec, s conversion.Scope) error {
+	return autoConvert_rd_RDIndexSpec_To_v1alpha1_RDIndexSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_RDIndexStatus_To_rd_RDIndexStatus(in *RDIndexStatus, out *rd.RDIndexStatus, s conversion.Scope) error {
+	out.MinID = (*uint32)(unsafe.Pointer(in.MinID))
+	out.MaxID = (*uint32)(unsafe.Pointer(in.MaxID))
+	if err := Convert_v1alpha1_ConditionedStatus_To_condition_ConditionedStatus(&in.ConditionedStatus, &out.ConditionedStatus, s); err != nil {
+		return err
+	}
+	out.Administrator = (*string)(unsafe.Pointer(in.Administrator))
+	return nil
+}
+
+// Convert_v1alpha1_RDIndexStatus_To_rd_RDIndexStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RDIndexStatus_To_rd_RDIndexStatus(in *RDIndexStatus, out *rd.RDIndexStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RDIndexStatus_To_rd_RDIndexStatus(in, out, s)
+}
+
+func autoConvert_rd_RDIndexStatus_To_v1alpha1_RDIndexStatus(in *rd.RDIndexStatus, out *RDIndexStatus, s conversion.Scope) error {
+	out.MinID = (*uint32)(unsafe.Pointer(in.MinID))
+	out.MaxID = (*uint32)(unsafe.Pointer(in.MaxID))
+	if err := Convert_condition_ConditionedStatus_To_v1alpha1_ConditionedStatus(&in.ConditionedStatus, &out.ConditionedStatus, s); err != nil {
+		return err
+	}
+	out.Administrator = (*string)(unsafe.Pointer(in.Administrator))
+	return nil
+}
+
+// Convert_rd_RDIndexStatus_To_v1alpha1_RDIndexStatus is an autogenerated conversion function.
+func Convert_rd_RDIndexStatus_To_v1alpha1_RDIndexStatus(in *rd.RDIndexStatus, out *RDIndexStatus, s conversion.Scope) error {
+	return autoConvert_rd_RDIndexStatus_To_v1alpha1_RDIndexStatus(in, out, s)
+}
@@ -18,6 +18,9 @@ package vlan
 
 import (
 	"fmt"
+	"sort"
+
+	"github.com/kuidio/kuid/apis/backend"
 )
 
 const VLANID_Min = 0
@@ -32,3 +35,92 @@ func validateVLANID(id int) error {
 	}
 	return nil
 }
+
+// VLANType_QinQ is the type of a QinQ index, the ids of the index combine the outer and
+// inner tag: <outer-tag> << 12 | <inner-tag>
+const VLANType_QinQ = "qinq"
+
+// VLANIDBits is the amount of bits of a VLAN tag
+const VLANIDBits = 12
+
+// QinQID_Max is the max id of a QinQ index
+const QinQID_Max = VLANID_Max<<VLANIDBits | VLANID_Max
+
+// VLANProtocolReservedRanges are the VLANs reserved by the protocol: 0 is used by priority
+// tagged frames and 4095 is reserved for implementations
+var VLANProtocolReservedRanges = []backend.IDRange{{From: 0, To: 0}, {From: VLANID_Max, To: VLANID_Max}}
+
+// VLANDefaultReservedRange is the default VLAN
+var VLANDefaultReservedRange = backend.IDRange{From: 1, To: 1}
+
+// validateVLANTag validates a VLAN tag of a QinQ claim, the protocol VLANs cannot be used
+// as a tag of a double tagged frame
+func validateVLANTag(id int) error {
+	if id <= VLANID_Min || id >= VLANID_Max {
+		return fmt.Errorf("invalid vlan tag, expected %d-%d, got %d", VLANID_Min+1, VLANID_Max-1, id)
+	}
+	return nil
+}
+
+// getQinQID returns the id of the outer and inner tag in a QinQ index
+func getQinQID(outer, inner uint64) uint64 {
+	return outer<<VLANIDBits | inner
+}
+
+// getQinQTags returns the outer and inner tag of an id in a QinQ index
+func getQinQTags(id uint64) (uint32, uint32) {
+	return uint32(id >> VLANIDBits), uint32(id & VLANID_Max)
+}
+
+// getQinQOuterRange returns the ids of all the inner tags of the outer tags from-to
+func getQinQOuterRange(from, to uint64) backend.IDRange {
+	return backend.IDRange{From: getQinQID(from, 0), To: getQinQID(to, VLANID_Max)}
+}
+
+// sortIDRanges returns a copy of the ranges sorted by the first id
+func sortIDRanges(ranges []backend.IDRange) []backend.IDRange {
+	sorted := make([]backend.IDRange, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].From < sorted[j].From })
+	return sorted
+}
+
+// subtractIDRanges returns the ids of the range that are not in the sorted excluded ranges
+func subtractIDRanges(r backend.IDRange, excluded []backend.IDRange) []backend.IDRange {
+	ranges := []backend.IDRange{}
+	from := r.From
+	for _, e := range excluded {
+		if e.To < from {
+			continue
+		}
+		if e.From > r.To {
+			break
+		}
+		if e.From > from {
+			ranges = append(ranges, backend.IDRange{From: from, To: e.From - 1})
+		}
+		from = e.To + 1
+		if from > r.To {
+			return ranges
+		}
+	}
+	return append(ranges, backend.IDRange{From: from, To: r.To})
+}
+
+// getQinQTagRanges returns the ranges split per outer tag without the protocol VLANs as
+// inner tag
+func getQinQTagRanges(ranges []backend.IDRange) []backend.IDRange {
+	tagRanges := []backend.IDRange{}
+	for _, r := range ranges {
+		fromOuter, _ := getQinQTags(r.From)
+		toOuter, _ := getQinQTags(r.To)
+		for outer := uint64(fromOuter); outer <= uint64(toOuter); outer++ {
+			from := max(r.From, getQinQID(outer, VLANID_Min+1))
+			to := min(r.To, getQinQID(outer, VLANID_Max-1))
+			if from <= to {
+				tagRanges = append(tagRanges, backend.IDRange{From: from, To: to})
+			}
+		}
+	}
+	return tagRanges
+}
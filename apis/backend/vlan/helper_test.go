@@ -0,0 +1,134 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vlan
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kuidio/kuid/apis/backend"
+)
+
+func TestGetQinQID(t *testing.T) {
+	cases := map[string]struct {
+		outer uint64
+		inner uint64
+		want  uint64
+	}{
+		"Min": {
+			outer: 1,
+			inner: 1,
+			want:  4097,
+		},
+		"Tags": {
+			outer: 100,
+			inner: 200,
+			want:  100*4096 + 200,
+		},
+		"Max": {
+			outer: VLANID_Max,
+			inner: VLANID_Max,
+			want:  QinQID_Max,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := getQinQID(tc.outer, tc.inner)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			outer, inner := getQinQTags(got)
+			if diff := cmp.Diff([]uint64{tc.outer, tc.inner}, []uint64{uint64(outer), uint64(inner)}); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSubtractIDRanges(t *testing.T) {
+	cases := map[string]struct {
+		r        backend.IDRange
+		excluded []backend.IDRange
+		want     []backend.IDRange
+	}{
+		"NoExcluded": {
+			r:    backend.IDRange{From: 1, To: 4094},
+			want: []backend.IDRange{{From: 1, To: 4094}},
+		},
+		"Reserved": {
+			r:        backend.IDRange{From: 1, To: 4094},
+			excluded: []backend.IDRange{{From: 0, To: 0}, {From: 1, To: 1}, {From: 1002, To: 1005}, {From: 4095, To: 4095}},
+			want:     []backend.IDRange{{From: 2, To: 1001}, {From: 1006, To: 4094}},
+		},
+		"Overlapping": {
+			r:        backend.IDRange{From: 10, To: 100},
+			excluded: []backend.IDRange{{From: 5, To: 20}, {From: 15, To: 30}, {From: 90, To: 200}},
+			want:     []backend.IDRange{{From: 31, To: 89}},
+		},
+		"All": {
+			r:        backend.IDRange{From: 10, To: 100},
+			excluded: []backend.IDRange{{From: 0, To: 4095}},
+			want:     []backend.IDRange{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := subtractIDRanges(tc.r, sortIDRanges(tc.excluded))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetQinQTagRanges(t *testing.T) {
+	cases := map[string]struct {
+		ranges []backend.IDRange
+		want   []backend.IDRange
+	}{
+		"OuterTags": {
+			ranges: []backend.IDRange{getQinQOuterRange(1, 2)},
+			want: []backend.IDRange{
+				{From: getQinQID(1, 1), To: getQinQID(1, 4094)},
+				{From: getQinQID(2, 1), To: getQinQID(2, 4094)},
+			},
+		},
+		"InnerTags": {
+			ranges: []backend.IDRange{{From: getQinQID(10, 100), To: getQinQID(10, 199)}},
+			want: []backend.IDRange{
+				{From: getQinQID(10, 100), To: getQinQID(10, 199)},
+			},
+		},
+		"ProtocolInnerTags": {
+			ranges: []backend.IDRange{{From: getQinQID(10, 0), To: getQinQID(11, 0)}},
+			want: []backend.IDRange{
+				{From: getQinQID(10, 1), To: getQinQID(10, 4094)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := getQinQTagRanges(tc.ranges)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
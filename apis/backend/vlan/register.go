@@ -47,6 +47,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VLANClaimList{},
 		&VLANEntry{},
 		&VLANEntryList{},
+		&VLANIndexTemplate{},
+		&VLANIndexTemplateList{},
 	)
 	return nil
 }
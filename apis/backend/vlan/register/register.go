@@ -28,12 +28,14 @@ import (
 	bebackend "github.com/kuidio/kuid/pkg/backend"
 	genericbackend "github.com/kuidio/kuid/pkg/backend/generic"
 	"github.com/kuidio/kuid/pkg/config"
+	"github.com/kuidio/kuid/pkg/quota"
 	genericregistry "github.com/kuidio/kuid/pkg/registry/generic"
 	"github.com/kuidio/kuid/pkg/registry/options"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/registry/generic"
 	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func init() {
@@ -42,10 +44,12 @@ func init() {
 		vlanbev1alpha1.AddToScheme,
 		NewBackend,
 		ApplyStorageToBackend,
+		ApplyClientToBackend,
 		[]*config.ResourceConfig{
-			{StorageProviderFn: NewIndexStorageProvider, Internal: &vlan.VLANIndex{}, ResourceVersions: []resource.Object{&vlan.VLANIndex{}, &vlanbev1alpha1.VLANIndex{}}},
-			{StorageProviderFn: NewClaimStorageProvider, Internal: &vlan.VLANClaim{}, ResourceVersions: []resource.Object{&vlan.VLANClaim{}, &vlanbev1alpha1.VLANClaim{}}},
-			{StorageProviderFn: NewStorageProvider, Internal: &vlan.VLANEntry{}, ResourceVersions: []resource.Object{&vlan.VLANEntry{}, &vlanbev1alpha1.VLANEntry{}}},
+			{StorageProviderFn: NewIndexStorageProvider, Internal: &vlan.VLANIndex{}, ResourceVersions: []resource.Object{&vlan.VLANIndex{}, &vlanbev1alpha1.VLANIndex{}}, Index: true},
+			{StorageProviderFn: NewClaimStorageProvider, Internal: &vlan.VLANClaim{}, ResourceVersions: []resource.Object{&vlan.VLANClaim{}, &vlanbev1alpha1.VLANClaim{}}, Claim: true},
+			{StorageProviderFn: NewStorageProvider, Internal: &vlan.VLANEntry{}, ResourceVersions: []resource.Object{&vlan.VLANEntry{}, &vlanbev1alpha1.VLANEntry{}}, Entry: true},
+			{StorageProviderFn: NewStorageProvider, Internal: &vlan.VLANIndexTemplate{}, ResourceVersions: []resource.Object{&vlan.VLANIndexTemplate{}, &vlanbev1alpha1.VLANIndexTemplate{}}},
 		},
 	)
 }
@@ -70,12 +74,14 @@ func NewIndexStorageProvider(ctx context.Context, obj resource.InternalObject, b
 	return genericregistry.NewStorageProvider(ctx, obj, &opts)
 }
 
+// NewClaimStorageProvider enforces the claim quotas before the backend allocates the claim
 func NewClaimStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *rest.StorageProvider {
 	opts := *options
 	if sync {
-		opts.BackendInvoker = bebackend.NewClaimInvoker(be)
+		opts.BackendInvoker = quota.NewClaimInvoker(obj.GetGroupVersionResource().Group, bebackend.NewClaimInvoker(be))
 		return genericregistry.NewStorageProvider(ctx, obj, &opts)
 	}
+	opts.BackendInvoker = quota.NewClaimInvoker(obj.GetGroupVersionResource().Group, nil)
 	return genericregistry.NewStorageProvider(ctx, obj, &opts)
 }
 
@@ -115,6 +121,22 @@ func ApplyStorageToBackend(ctx context.Context, be bebackend.Backend, apiServer
 	return be.AddStorageInterfaces(genericbackend.NewKuidBackendstorage(entryStore, claimStore))
 }
 
+// ApplyClientToBackend attaches the CRD storage to the backend, the entries and claims
+// are persisted as CRDs using the client
+func ApplyClientToBackend(ctx context.Context, be bebackend.Backend, c client.Client) error {
+	scheme := runtime.NewScheme()
+	if err := vlan.AddToScheme(scheme); err != nil {
+		return err
+	}
+	if err := vlanbev1alpha1.AddToScheme(scheme); err != nil {
+		return err
+	}
+	entryStore := bebackend.NewClientStore(c, scheme, vlanbev1alpha1.SchemeGroupVersion.WithKind(vlan.VLANEntryKind), true)
+	claimStore := bebackend.NewClientStore(c, scheme, vlanbev1alpha1.SchemeGroupVersion.WithKind(vlan.VLANClaimKind), false)
+
+	return be.AddStorageInterfaces(genericbackend.NewClientBackendstorage(entryStore, claimStore))
+}
+
 var _ generic.RESTOptionsGetter = &Getter{}
 
 type Getter struct{}
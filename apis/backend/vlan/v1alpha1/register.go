@@ -60,6 +60,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VLANClaimList{},
 		&VLANEntry{},
 		&VLANEntryList{},
+		&VLANIndexTemplate{},
+		&VLANIndexTemplateList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -0,0 +1,38 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "VLAN IS" BVLANIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/vlan"
+)
+
+// GetCondition returns the condition based on the condition kind
+func (r *VLANIndexTemplate) GetCondition(t condv1alpha1.ConditionType) condv1alpha1.Condition {
+	return r.Status.GetCondition(t)
+}
+
+// SetConditions sets the conditions on the resource. it allows for 0, 1 or more conditions
+// to be set at once
+func (r *VLANIndexTemplate) SetConditions(c ...condv1alpha1.Condition) {
+	r.Status.SetConditions(c...)
+}
+
+// GetIndexName returns the name of the index the template creates for the infra resource
+func (r *VLANIndexTemplate) GetIndexName(owner string) string {
+	return vlan.GetTemplateIndexName(r.GetName(), owner)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "VLAN IS" BVLANIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VLANIndexTemplateSpec defines the desired state of VLANIndexTemplate
+type VLANIndexTemplateSpec struct {
+	// Kind defines the kind of the infra resources the indexes are created for:
+	// Node, Endpoint or EndpointSet
+	// +kubebuilder:validation:Enum=Node;Endpoint;EndpointSet
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Selector selects the infra resources of the kind in the namespace of the template
+	// by their labels. When not specified an index is created for every resource of the kind
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,2,opt,name=selector"`
+	// Index defines the spec of the indexes created from the template, e.g. the min and
+	// max ID, the reserved VLANs and the embedded claims
+	Index VLANIndexSpec `json:"index" protobuf:"bytes,3,opt,name=index"`
+}
+
+// VLANIndexTemplateStatus defines the observed state of VLANIndexTemplate
+type VLANIndexTemplateStatus struct {
+	// ConditionedStatus provides the status of the VLANIndexTemplate using conditions
+	// - a ready condition indicates the overall status of the resource
+	condv1alpha1.ConditionedStatus `json:",inline" protobuf:"bytes,1,opt,name=conditionedStatus"`
+	// Indexes defines the amount of indexes created from the template
+	// +optional
+	Indexes int64 `json:"indexes,omitempty" protobuf:"varint,2,opt,name=indexes"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kuid}
+// A VLANIndexTemplate creates a VLANIndex for every infra Node, Endpoint or EndpointSet
+// matching the selector, as VLAN IDs are locally significant per node or port.
+// The index is named <owner>.<template>, is labeled with the owner and the template and is
+// garbage collected with its owner.
+type VLANIndexTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   VLANIndexTemplateSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status VLANIndexTemplateStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// VLANIndexTemplateList contains a list of VLANIndexTemplates
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VLANIndexTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []VLANIndexTemplate `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+var (
+	VLANIndexTemplateKind     = reflect.TypeOf(VLANIndexTemplate{}).Name()
+	VLANIndexTemplateListKind = reflect.TypeOf(VLANIndexTemplateList{}).Name()
+)
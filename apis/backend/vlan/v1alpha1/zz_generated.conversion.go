@@ -26,6 +26,7 @@ import (
 	backend "github.com/kuidio/kuid/apis/backend"
 	asv1alpha1 "github.com/kuidio/kuid/apis/backend/as/v1alpha1"
 	vlan "github.com/kuidio/kuid/apis/backend/vlan"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -167,6 +168,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VLANIndexTemplate)(nil), (*vlan.VLANIndexTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VLANIndexTemplate_To_vlan_VLANIndexTemplate(a.(*VLANIndexTemplate), b.(*vlan.VLANIndexTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*vlan.VLANIndexTemplate)(nil), (*VLANIndexTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_vlan_VLANIndexTemplate_To_v1alpha1_VLANIndexTemplate(a.(*vlan.VLANIndexTemplate), b.(*VLANIndexTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VLANIndexTemplateList)(nil), (*vlan.VLANIndexTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VLANIndexTemplateList_To_vlan_VLANIndexTemplateList(a.(*VLANIndexTemplateList), b.(*vlan.VLANIndexTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*vlan.VLANIndexTemplateList)(nil), (*VLANIndexTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_vlan_VLANIndexTemplateList_To_v1alpha1_VLANIndexTemplateList(a.(*vlan.VLANIndexTemplateList), b.(*VLANIndexTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VLANIndexTemplateSpec)(nil), (*vlan.VLANIndexTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VLANIndexTemplateSpec_To_vlan_VLANIndexTemplateSpec(a.(*VLANIndexTemplateSpec), b.(*vlan.VLANIndexTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*vlan.VLANIndexTemplateSpec)(nil), (*VLANIndexTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_vlan_VLANIndexTemplateSpec_To_v1alpha1_VLANIndexTemplateSpec(a.(*vlan.VLANIndexTemplateSpec), b.(*VLANIndexTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VLANIndexTemplateStatus)(nil), (*vlan.VLANIndexTemplateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VLANIndexTemplateStatus_To_vlan_VLANIndexTemplateStatus(a.(*VLANIndexTemplateStatus), b.(*vlan.VLANIndexTemplateStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*vlan.VLANIndexTemplateStatus)(nil), (*VLANIndexTemplateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_vlan_VLANIndexTemplateStatus_To_v1alpha1_VLANIndexTemplateStatus(a.(*vlan.VLANIndexTemplateStatus), b.(*VLANIndexTemplateStatus), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -251,6 +292,7 @@ func autoConvert_v1alpha1_VLANClaimSpec_To_vlan_VLANClaimSpec(in *VLANClaimSpec,
 	if err := asv1alpha1.Convert_v1alpha1_ClaimLabels_To_common_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
+	out.OuterID = (*uint32)(unsafe.Pointer(in.OuterID))
 	return nil
 }
 
@@ -266,6 +308,7 @@ func autoConvert_vlan_VLANClaimSpec_To_v1alpha1_VLANClaimSpec(in *vlan.VLANClaim
 	if err := asv1alpha1.Convert_common_ClaimLabels_To_v1alpha1_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
+	out.OuterID = (*uint32)(unsafe.Pointer(in.OuterID))
 	return nil
 }
 
@@ -281,6 +324,7 @@ func autoConvert_v1alpha1_VLANClaimStatus_To_vlan_VLANClaimStatus(in *VLANClaimS
 	out.ID = (*uint32)(unsafe.Pointer(in.ID))
 	out.Range = (*string)(unsafe.Pointer(in.Range))
 	out.ExpiryTime = (*string)(unsafe.Pointer(in.ExpiryTime))
+	out.OuterID = (*uint32)(unsafe.Pointer(in.OuterID))
 	return nil
 }
 
@@ -296,6 +340,7 @@ func autoConvert_vlan_VLANClaimStatus_To_v1alpha1_VLANClaimStatus(in *vlan.VLANC
 	out.ID = (*uint32)(unsafe.Pointer(in.ID))
 	out.Range = (*string)(unsafe.Pointer(in.Range))
 	out.ExpiryTime = (*string)(unsafe.Pointer(in.ExpiryTime))
+	out.OuterID = (*uint32)(unsafe.Pointer(in.OuterID))
 	return nil
 }
 
@@ -383,6 +428,7 @@ func autoConvert_v1alpha1_VLANEntrySpec_To_vlan_VLANEntrySpec(in *VLANEntrySpec,
 	out.IndexEntry = in.IndexEntry
 	out.ClaimType = backend.ClaimType(in.ClaimType)
 	out.ID = in.ID
+	out.Count = in.Count
 	if err := asv1alpha1.Convert_v1alpha1_ClaimLabels_To_common_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
@@ -399,6 +445,7 @@ func autoConvert_vlan_VLANEntrySpec_To_v1alpha1_VLANEntrySpec(in *vlan.VLANEntry
 	out.IndexEntry = in.IndexEntry
 	out.ClaimType = backend.ClaimType(in.ClaimType)
 	out.ID = in.ID
+	out.Count = in.Count
 	if err := asv1alpha1.Convert_common_ClaimLabels_To_v1alpha1_ClaimLabels(&in.ClaimLabels, &out.ClaimLabels, s); err != nil {
 		return err
 	}
@@ -473,6 +520,7 @@ func autoConvert_v1alpha1_VLANIndexClaim_To_vlan_VLANIndexClaim(in *VLANIndexCla
 	if err := asv1alpha1.Convert_v1alpha1_UserDefinedLabels_To_common_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
 		return err
 	}
+	out.OuterID = (*uint32)(unsafe.Pointer(in.OuterID))
 	return nil
 }
 
@@ -488,6 +536,7 @@ func autoConvert_vlan_VLANIndexClaim_To_v1alpha1_VLANIndexClaim(in *vlan.VLANInd
 	if err := asv1alpha1.Convert_common_UserDefinedLabels_To_v1alpha1_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
 		return err
 	}
+	out.OuterID = (*uint32)(unsafe.Pointer(in.OuterID))
 	return nil
 }
 
@@ -555,6 +604,10 @@ func autoConvert_v1alpha1_VLANIndexSpec_To_vlan_VLANIndexSpec(in *VLANIndexSpec,
 	} else {
 		out.Claims = nil
 	}
+	out.ReserveProtocolVLANs = in.ReserveProtocolVLANs
+	out.ReserveDefaultVLAN = in.ReserveDefaultVLAN
+	out.ReservedRanges = *(*[]string)(unsafe.Pointer(&in.ReservedRanges))
+	out.QinQ = in.QinQ
 	return nil
 }
 
@@ -580,6 +633,10 @@ func autoConvert_vlan_VLANIndexSpec_To_v1alpha1_VLANIndexSpec(in *vlan.VLANIndex
 	} else {
 		out.Claims = nil
 	}
+	out.ReserveProtocolVLANs = in.ReserveProtocolVLANs
+	out.ReserveDefaultVLAN = in.ReserveDefaultVLAN
+	out.ReservedRanges = *(*[]string)(unsafe.Pointer(&in.ReservedRanges))
+	out.QinQ = in.QinQ
 	return nil
 }
 
@@ -615,3 +672,131 @@ func autoConvert_vlan_VLANIndexStatus_To_v1alpha1_VLANIndexStatus(in *vlan.VLANI
 func Convert_vlan_VLANIndexStatus_To_v1alpha1_VLANIndexStatus(in *vlan.VLANIndexStatus, out *VLANIndexStatus, s conversion.Scope) error {
 	return autoConvert_vlan_VLANIndexStatus_To_v1alpha1_VLANIndexStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_VLANIndexTemplate_To_vlan_VLANIndexTemplate(in *VLANIndexTemplate, out *vlan.VLANIndexTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_VLANIndexTemplateSpec_To_vlan_VLANIndexTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VLANIndexTemplateStatus_To_vlan_VLANIndexTemplateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VLANIndexTemplate_To_vlan_VLANIndexTemplate is an autogenerated conversion function.
+func Convert_v1alpha1_VLANIndexTemplate_To_vlan_VLANIndexTemplate(in *VLANIndexTemplate, out *vlan.VLANIndexTemplate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VLANIndexTemplate_To_vlan_VLANIndexTemplate(in, out, s)
+}
+
+func autoConvert_vlan_VLANIndexTemplate_To_v1alpha1_VLANIndexTemplate(in *vlan.VLANIndexTemplate, out *VLANIndexTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_vlan_VLANIndexTemplateSpec_To_v1alpha1_VLANIndexTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_vlan_VLANIndexTemplateStatus_To_v1alpha1_VLANIndexTemplateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_vlan_VLANIndexTemplate_To_v1alpha1_VLANIndexTemplate is an autogenerated conversion function.
+func Convert_vlan_VLANIndexTemplate_To_v1alpha1_VLANIndexTemplate(in *vlan.VLANIndexTemplate, out *VLANIndexTemplate, s conversion.Scope) error {
+	return autoConvert_vlan_VLANIndexTemplate_To_v1alpha1_VLANIndexTemplate(in, out, s)
+}
+
+func autoConvert_v1alpha1_VLANIndexTemplateList_To_vlan_VLANIndexTemplateList(in *VLANIndexTemplateList, out *vlan.VLANIndexTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]vlan.VLANIndexTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_VLANIndexTemplate_To_vlan_VLANIndexTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VLANIndexTemplateList_To_vlan_VLANIndexTemplateList is an autogenerated conversion function.
+func Convert_v1alpha1_VLANIndexTemplateList_To_vlan_VLANIndexTemplateList(in *VLANIndexTemplateList, out *vlan.VLANIndexTemplateList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VLANIndexTemplateList_To_vlan_VLANIndexTemplateList(in, out, s)
+}
+
+func autoConvert_vlan_VLANIndexTemplateList_To_v1alpha1_VLANIndexTemplateList(in *vlan.VLANIndexTemplateList, out *VLANIndexTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VLANIndexTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_vlan_VLANIndexTemplate_To_v1alpha1_VLANIndexTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_vlan_VLANIndexTemplateList_To_v1alpha1_VLANIndexTemplateList is an autogenerated conversion function.
+func Convert_vlan_VLANIndexTemplateList_To_v1alpha1_VLANIndexTemplateList(in *vlan.VLANIndexTemplateList, out *VLANIndexTemplateList, s conversion.Scope) error {
+	return autoConvert_vlan_VLANIndexTemplateList_To_v1alpha1_VLANIndexTemplateList(in, out, s)
+}
+
+func autoConvert_v1alpha1_VLANIndexTemplateSpec_To_vlan_VLANIndexTemplateSpec(in *VLANIndexTemplateSpec, out *vlan.VLANIndexTemplateSpec, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	if err := Convert_v1alpha1_VLANIndexSpec_To_vlan_VLANIndexSpec(&in.Index, &out.Index, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VLANIndexTemplateSpec_To_vlan_VLANIndexTemplateSpec is an autogenerated conversion function.
+func Convert_v1alpha1_VLANIndexTemplateSpec_To_vlan_VLANIndexTemplateSpec(in *VLANIndexTemplateSpec, out *vlan.VLANIndexTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VLANIndexTemplateSpec_To_vlan_VLANIndexTemplateSpec(in, out, s)
+}
+
+func autoConvert_vlan_VLANIndexTemplateSpec_To_v1alpha1_VLANIndexTemplateSpec(in *vlan.VLANIndexTemplateSpec, out *VLANIndexTemplateSpec, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	if err := Convert_vlan_VLANIndexSpec_To_v1alpha1_VLANIndexSpec(&in.Index, &out.Index, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_vlan_VLANIndexTemplateSpec_To_v1alpha1_VLANIndexTemplateSpec is an autogenerated conversion function.
+func Convert_vlan_VLANIndexTemplateSpec_To_v1alpha1_VLANIndexTemplateSpec(in *vlan.VLANIndexTemplateSpec, out *VLANIndexTemplateSpec, s conversion.Scope) error {
+	return autoConvert_vlan_VLANIndexTemplateSpec_To_v1alpha1_VLANIndexTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_VLANIndexTemplateStatus_To_vlan_VLANIndexTemplateStatus(in *VLANIndexTemplateStatus, out *vlan.VLANIndexTemplateStatus, s conversion.Scope) error {
+	if err := asv1alpha1.Convert_v1alpha1_ConditionedStatus_To_condition_ConditionedStatus(&in.ConditionedStatus, &out.ConditionedStatus, s); err != nil {
+		return err
+	}
+	out.Indexes = in.Indexes
+	return nil
+}
+
+// Convert_v1alpha1_VLANIndexTemplateStatus_To_vlan_VLANIndexTemplateStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VLANIndexTemplateStatus_To_vlan_VLANIndexTemplateStatus(in *VLANIndexTemplateStatus, out *vlan.VLANIndexTemplateStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VLANIndexTemplateStatus_To_vlan_VLANIndexTemplateStatus(in, out, s)
+}
+
+func autoConvert_vlan_VLANIndexTemplateStatus_To_v1alpha1_VLANIndexTemplateStatus(in *vlan.VLANIndexTemplateStatus, out *VLANIndexTemplateStatus, s conversion.Scope) error {
+	if err := asv1alpha1.Convert_condition_ConditionedStatus_To_v1alpha1_ConditionedStatus(&in.ConditionedStatus, &out.ConditionedStatus, s); err != nil {
+		return err
+	}
+	out.Indexes = in.Indexes
+	return nil
+}
+
+// Convert_vlan_VLANIndexTemplateStatus_To_v1alpha1_VLANIndexTemplateStatus is an autogenerated conversion function.
+func Convert_vlan_VLANIndexTemplateStatus_To_v1alpha1_VLANIndexTemplateStatus(in *vlan.VLANIndexTemplateStatus, out *VLANIndexTemplateStatus, s conversion.Scope) error {
+	return autoConvert_vlan_VLANIndexTemplateStatus_To_v1alpha1_VLANIndexTemplateStatus(in, out, s)
+}
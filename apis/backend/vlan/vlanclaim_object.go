@@ -23,9 +23,9 @@ import (
 	"strings"
 
 	"github.com/henderiw/idxtable/pkg/table"
-	"github.com/henderiw/idxtable/pkg/table/table16"
 	"github.com/henderiw/idxtable/pkg/tree"
 	"github.com/henderiw/idxtable/pkg/tree/id16"
+	"github.com/henderiw/idxtable/pkg/tree/id32"
 	"github.com/henderiw/store"
 	"github.com/kform-dev/choreo/apis/condition"
 	"github.com/kuidio/kuid/apis/backend"
@@ -94,29 +94,47 @@ func (r *VLANClaim) ValidateVLANRange() error {
 	if r.Spec.Range == nil {
 		return fmt.Errorf("no vlan range provided")
 	}
-	parts := strings.SplitN(*r.Spec.Range, "-", 2)
-	if len(parts) != 2 {
-		return fmt.Errorf("invalid vlan range, expected <start>-<end>, got: %s", *r.Spec.Range)
-	}
 	var errm error
 	if r.Name == r.Spec.Index {
 		// to be able to check if the entry is reserved we get a parentname (rang name) equal to index
 		// this is because the ownerreference uses the name of the index in its labels in the cache
 		errm = errors.Join(errm, fmt.Errorf("a name of range cannot be the same as the index"))
 	}
+	segments := []backend.IDRange{}
+	for _, segment := range backend.GetRangeSegments(*r.Spec.Range) {
+		start, end, err := validateVLANRangeSegment(segment)
+		if err != nil {
+			errm = errors.Join(errm, err)
+			continue
+		}
+		segments = append(segments, backend.IDRange{From: uint64(start), To: uint64(end)})
+	}
+	if errm != nil {
+		return errm
+	}
+	return backend.ValidateIDRanges(segments)
+}
+
+// validateVLANRangeSegment validates a segment <start>-<end> of a range
+func validateVLANRangeSegment(segment string) (int, int, error) {
+	parts := strings.SplitN(segment, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid vlan range, expected <start>-<end>, got: %s", segment)
+	}
+	var errm error
 	start, err := strconv.Atoi(parts[0])
 	if err != nil {
-		errm = errors.Join(errm, fmt.Errorf("invalid vlan range start, got: %s, err: %s", *r.Spec.Range, err.Error()))
+		errm = errors.Join(errm, fmt.Errorf("invalid vlan range start, got: %s, err: %s", segment, err.Error()))
 	}
 	end, err := strconv.Atoi(parts[1])
 	if err != nil {
-		errm = errors.Join(errm, fmt.Errorf("invalid vlan range end, got: %s, err: %s", *r.Spec.Range, err.Error()))
+		errm = errors.Join(errm, fmt.Errorf("invalid vlan range end, got: %s, err: %s", segment, err.Error()))
 	}
 	if errm != nil {
-		return errm
+		return 0, 0, errm
 	}
 	if start > end {
-		errm = errors.Join(errm, fmt.Errorf("invalid vlan range start > end %s", *r.Spec.Range))
+		errm = errors.Join(errm, fmt.Errorf("invalid vlan range start > end %s", segment))
 	}
 	if err := validateVLANID(start); err != nil {
 		errm = errors.Join(errm, fmt.Errorf("invalid vlan start err %s", err.Error()))
@@ -124,19 +142,51 @@ func (r *VLANClaim) ValidateVLANRange() error {
 	if err := validateVLANID(end); err != nil {
 		errm = errors.Join(errm, fmt.Errorf("invalid vlan end err %s", err.Error()))
 	}
-	return errm
+	return start, end, errm
 }
 
 func (r *VLANClaim) ValidateVLANID() error {
 	if r.Spec.ID == nil {
 		return fmt.Errorf("no vlan id provided")
 	}
+	if r.Spec.OuterID != nil {
+		if err := validateVLANTag(int(*r.Spec.ID)); err != nil {
+			return fmt.Errorf("invalid inner vlan id err %s", err.Error())
+		}
+		return nil
+	}
 	if err := validateVLANID(int(*r.Spec.ID)); err != nil {
 		return fmt.Errorf("invalid vlan id err %s", err.Error())
 	}
 	return nil
 }
 
+// ValidateVLANOuterID validates the outer tag of a QinQ claim
+func (r *VLANClaim) ValidateVLANOuterID() error {
+	if r.Spec.OuterID == nil {
+		return nil
+	}
+	if err := validateVLANTag(int(*r.Spec.OuterID)); err != nil {
+		return fmt.Errorf("invalid outer vlan id err %s", err.Error())
+	}
+	return nil
+}
+
+// ValidateIndexType validates the claim against the type of the index, an outer ID requires
+// a QinQ index and a static claim in a QinQ index requires an outer ID
+func (r *VLANClaim) ValidateIndexType(typ string) error {
+	if typ != VLANType_QinQ {
+		if r.Spec.OuterID != nil {
+			return fmt.Errorf("an outer vlan id requires a qinq index")
+		}
+		return nil
+	}
+	if r.GetClaimType() == backend.ClaimType_StaticID && r.Spec.OuterID == nil {
+		return fmt.Errorf("a static claim in a qinq index requires an outer vlan id")
+	}
+	return nil
+}
+
 func (r *VLANClaim) ValidateVLANClaimType() error {
 	var sb strings.Builder
 	count := 0
@@ -243,84 +293,164 @@ func (r *VLANClaim) GetClaimType() backend.ClaimType {
 	}
 	return claimType
 }
+
+// GetStaticID returns the id of the claim, in a QinQ claim the id combines the outer and
+// inner tag
 func (r *VLANClaim) GetStaticID() *uint64 {
 	if r.Spec.ID == nil {
 		return nil
 	}
+	if r.Spec.OuterID != nil {
+		return ptr.To[uint64](getQinQID(uint64(*r.Spec.OuterID), uint64(*r.Spec.ID)))
+	}
 	return ptr.To[uint64](uint64(*r.Spec.ID))
 }
 func (r *VLANClaim) GetStaticTreeID(t string) tree.ID {
 	if r.Spec.ID == nil {
 		return nil
 	}
-	return id16.NewID(uint16(*r.Spec.ID), id16.IDBitSize)
+	return getTreeID(t, *r.GetStaticID())
 }
 
 func (r *VLANClaim) GetClaimID(t string, id uint64) tree.ID {
-	return id16.NewID(uint16(id), id16.IDBitSize)
+	return getTreeID(t, id)
 }
 
 func (r *VLANClaim) GetStatusClaimID(typ string) tree.ID {
 	if r.Status.ID == nil {
 		return nil
 	}
-	return id16.NewID(uint16(*r.Status.ID), id16.IDBitSize) 
+	return getTreeID(typ, *r.GetStatusID())
+}
+
+// getTreeID returns the tree id, the ids of a QinQ index combine the outer and inner tag
+func getTreeID(t string, id uint64) tree.ID {
+	if t == VLANType_QinQ {
+		return id32.NewID(uint32(id), id32.IDBitSize)
+	}
+	return id16.NewID(uint16(id), id16.IDBitSize)
 }
 
 func (r *VLANClaim) GetRange() *string {
 	return r.Spec.Range
 }
 
-func (r *VLANClaim) GetRangeID(t string) (tree.Range, error) {
+// GetRangeIDs returns the ranges of the claim, in a QinQ index a range with an outer ID
+// defines inner tags of the outer tag and a range without an outer ID defines outer tags
+// with all their inner tags
+func (r *VLANClaim) GetRangeIDs(t string) ([]tree.Range, error) {
 	if r.Spec.Range == nil {
 		return nil, fmt.Errorf("cannot provide a range without an id")
 	}
-	return id16.ParseRange(*r.Spec.Range)
+	ranges, err := backend.ParseRangeSegments(*r.Spec.Range, id16.ParseRange)
+	if err != nil || t != VLANType_QinQ {
+		return ranges, err
+	}
+	qinqRanges := make([]tree.Range, 0, len(ranges))
+	for _, arange := range ranges {
+		from, to := arange.From().ID(), arange.To().ID()
+		qinqRange := getQinQOuterRange(from, to)
+		if r.Spec.OuterID != nil {
+			outer := uint64(*r.Spec.OuterID)
+			qinqRange = backend.IDRange{From: getQinQID(outer, from), To: getQinQID(outer, to)}
+		}
+		qinqRanges = append(qinqRanges, id32.RangeFrom(uint32(qinqRange.From), uint32(qinqRange.To)))
+	}
+	return qinqRanges, nil
 }
 
-func (r *VLANClaim) GetTable(t string, to, from uint64) table.Table {
-	return table16.New(uint16(to), uint16(from))
+// GetTable returns the table of the ids claimed from the range, in a QinQ index the
+// protocol VLANs are excluded from the inner tags of every outer tag
+func (r *VLANClaim) GetTable(t string, ranges []backend.IDRange) table.Table {
+	if t == VLANType_QinQ {
+		ranges = getQinQTagRanges(ranges)
+	}
+	return backend.NewRangeTable(ranges, func(id uint64) tree.ID {
+		return getTreeID(t, id)
+	})
+}
+
+// GetDynamicRanges restricts a dynamic claim with an outer ID to the inner tags of the
+// outer tag
+func (r *VLANClaim) GetDynamicRanges(t string) []backend.IDRange {
+	if t != VLANType_QinQ || r.Spec.OuterID == nil {
+		return nil
+	}
+	outer := uint64(*r.Spec.OuterID)
+	return []backend.IDRange{getQinQOuterRange(outer, outer)}
 }
 
 func (r *VLANClaim) SetStatusRange(s *string) {
 	r.Status.Range = s
+	r.Status.OuterID = nil
+	if s != nil {
+		r.Status.OuterID = r.Spec.OuterID
+	}
 }
 
+// SetStatusID sets the id of the claim, an id that combines the outer and inner tag
+// sets the outer and inner tag
 func (r *VLANClaim) SetStatusID(s *uint64) {
 	if s == nil {
 		r.Status.ID = nil
+		r.Status.OuterID = nil
+		return
+	}
+	if r.Spec.OuterID != nil || *s > VLANID_Max {
+		outer, inner := getQinQTags(*s)
+		r.Status.ID = ptr.To[uint32](inner)
+		r.Status.OuterID = ptr.To[uint32](outer)
 		return
 	}
 	r.Status.ID = ptr.To[uint32](uint32(*s))
+	r.Status.OuterID = nil
 }
 
 func (r *VLANClaim) GetStatusID() *uint64 {
 	if r.Status.ID == nil {
 		return nil
 	}
+	if r.Status.OuterID != nil {
+		return ptr.To[uint64](getQinQID(uint64(*r.Status.OuterID), uint64(*r.Status.ID)))
+	}
 	return ptr.To[uint64](uint64(*r.Status.ID))
 }
 
+// GetClaimRequest returns the requested id or range, the outer tag of a QinQ claim is
+// prefixed: <outer-tag>.<inner-tag>
 func (r *VLANClaim) GetClaimRequest() string {
 	// we assume validation is already done when calling this
+	request := ""
 	if r.Spec.ID != nil {
-		return strconv.Itoa(int(*r.Spec.ID))
+		request = strconv.Itoa(int(*r.Spec.ID))
 	}
 	if r.Spec.Range != nil {
-		return *r.Spec.Range
+		request = *r.Spec.Range
+	}
+	if r.Spec.OuterID != nil {
+		if request == "" {
+			request = "*"
+		}
+		return fmt.Sprintf("%d.%s", *r.Spec.OuterID, request)
 	}
-	return ""
+	return request
 }
 
+// GetClaimResponse returns the claimed id or range, the outer tag of a QinQ claim is
+// prefixed: <outer-tag>.<inner-tag>
 func (r *VLANClaim) GetClaimResponse() string {
 	// we assume validation is already done when calling this
+	response := ""
 	if r.Status.ID != nil {
-		return strconv.Itoa(int(*r.Status.ID))
+		response = strconv.Itoa(int(*r.Status.ID))
 	}
 	if r.Status.Range != nil {
-		return *r.Status.Range
+		response = *r.Status.Range
+	}
+	if r.Status.OuterID != nil && response != "" {
+		return fmt.Sprintf("%d.%s", *r.Status.OuterID, response)
 	}
-	return ""
+	return response
 }
 
 func (r *VLANClaim) GetChoreoAPIVersion() string {
@@ -328,16 +458,18 @@ func (r *VLANClaim) GetChoreoAPIVersion() string {
 }
 
 func (r *VLANClaim) GetClaimSet(typ string) (map[string]tree.ID, sets.Set[string], error) {
-	arange, err := r.GetRangeID(typ)
+	aranges, err := r.GetRangeIDs(typ)
 	if err != nil {
 		return nil, nil, fmt.Errorf("cannot get range from claim: %v", err)
 	}
 	// claim set represents the new entries
 	newClaimSet := sets.New[string]()
 	newClaimMap := map[string]tree.ID{}
-	for _, rangeID := range arange.IDs() {
-		newClaimSet.Insert(rangeID.String())
-		newClaimMap[rangeID.String()] = rangeID
+	for _, arange := range aranges {
+		for _, rangeID := range arange.IDs() {
+			newClaimSet.Insert(rangeID.String())
+			newClaimMap[rangeID.String()] = rangeID
+		}
 	}
 	return newClaimMap, newClaimSet, nil
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/henderiw/apiserver-builder/pkg/builder/resource"
 	"github.com/henderiw/apiserver-store/pkg/generic/registry"
 	"github.com/kform-dev/choreo/apis/condition"
+	"github.com/kuidio/kuid/apis/backend"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -299,11 +300,12 @@ func (r *VLANClaim) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 	// status cannot be set upon create -> reset it
 	newobj := obj.(*VLANClaim)
 	newobj.Status = VLANClaimStatus{}
+	newobj.ResolveIndex()
 }
 
 // ValidateCreate statically validates
 func (r *VLANClaim) ValidateCreate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	return r.ValidateSyntax("")
+	return append(r.ValidateSyntax(""), validateIndex(obj)...)
 }
 
 func (r *VLANClaim) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
@@ -311,8 +313,23 @@ func (r *VLANClaim) PrepareForUpdate(ctx context.Context, obj, old runtime.Objec
 	newobj := obj.(*VLANClaim)
 	oldObj := old.(*VLANClaim)
 	newobj.Status = oldObj.Status
+	newobj.ResolveIndex()
 }
 
 func (r *VLANClaim) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
-	return r.ValidateSyntax("")
+	return append(r.ValidateSyntax(""), validateIndex(obj)...)
+}
+
+// validateIndex validates the claim has an index or an index template label that resolves
+// to an index
+func validateIndex(obj runtime.Object) field.ErrorList {
+	var allErrs field.ErrorList
+	claim, ok := obj.(*VLANClaim)
+	if !ok || claim.Spec.Index != "" {
+		return allErrs
+	}
+	return append(allErrs, field.Required(
+		field.NewPath("spec.index"),
+		fmt.Sprintf("an index or an %s label with an infra owner is required", backend.KuidIndexTemplateKey),
+	))
 }
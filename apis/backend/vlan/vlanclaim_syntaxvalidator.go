@@ -33,6 +33,13 @@ type VLANDynamicIDSyntaxValidator struct {
 
 func (r *VLANDynamicIDSyntaxValidator) Validate(claim *VLANClaim) field.ErrorList {
 	var allErrs field.ErrorList
+	if err := claim.ValidateVLANOuterID(); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.outerID"),
+			claim,
+			fmt.Errorf("invalid outer vlan id %s, err: %s", r.name, err.Error()).Error(),
+		))
+	}
 	return allErrs
 }
 
@@ -49,6 +56,13 @@ func (r *VLANStaticIDSyntaxValidator) Validate(claim *VLANClaim) field.ErrorList
 			fmt.Errorf("invalid vlan id %s", r.name).Error(),
 		))
 	}
+	if err := claim.ValidateVLANOuterID(); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.outerID"),
+			claim,
+			fmt.Errorf("invalid outer vlan id %s, err: %s", r.name, err.Error()).Error(),
+		))
+	}
 	return allErrs
 }
 
@@ -65,5 +79,12 @@ func (r *VLANRangeSyntaxValidator) Validate(claim *VLANClaim) field.ErrorList {
 			fmt.Errorf("invalid vlan range %s, err: %s", r.name, err.Error()).Error(),
 		))
 	}
+	if err := claim.ValidateVLANOuterID(); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.outerID"),
+			claim,
+			fmt.Errorf("invalid outer vlan id %s, err: %s", r.name, err.Error()).Error(),
+		))
+	}
 	return allErrs
 }
@@ -26,17 +26,26 @@ import (
 
 // VLANClaimSpec defines the desired state of VLANClaim
 type VLANClaimSpec struct {
-	// Index defines the index for the resource
-	Index string `json:"index" yaml:"index" protobuf:"bytes,1,opt,name=index"`
+	// Index defines the index for the resource, a claim without index claims from the index
+	// created by the template in the be.kuid.dev/index-template label for the infra resource
+	// in the be.kuid.dev/index-owner-name label or the infra owner reference of the claim
+	// +optional
+	Index string `json:"index,omitempty" yaml:"index,omitempty" protobuf:"bytes,1,opt,name=index"`
 	// ID defines the id of the resource
 	ID *uint32 `json:"id,omitempty" yaml:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
 	// Range defines the range of the resource
 	// The following notation is used: start-end <start-ID>-<end-ID>
 	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
 	Range *string `json:"range,omitempty" yaml:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
 	// ClaimLabels define the user defined labels and selector labels used
 	// in resource claim
 	common.ClaimLabels `json:",inline" yaml:",inline" protobuf:"bytes,4,opt,name=claimLabels"`
+	// OuterID defines the outer (S-VLAN) tag of a claim in a QinQ index, the ID and Range
+	// define the inner (C-VLAN) tags scoped to the outer tag. A dynamic claim without
+	// outer ID in a QinQ index allocates an outer and inner tag pair.
+	// +optional
+	OuterID *uint32 `json:"outerID,omitempty" yaml:"outerID,omitempty" protobuf:"varint,5,opt,name=outerID"`
 }
 
 // VLANClaimStatus defines the observed state of VLANClaim
@@ -54,6 +63,9 @@ type VLANClaimStatus struct {
 	// +kubebuilder:validation:Optional
 	// +optional
 	ExpiryTime *string `json:"expiryTime,omitempty" yaml:"expiryTime,omitempty" protobuf:"bytes,4,opt,name=expiryTime"`
+	// OuterID defines the outer (S-VLAN) tag of the QinQ claim, ID defines the inner tag
+	// +optional
+	OuterID *uint32 `json:"outerID,omitempty" yaml:"outerID,omitempty" protobuf:"varint,5,opt,name=outerID"`
 }
 
 // +genclient
@@ -93,6 +93,7 @@ func GetVLANEntry(k store.Key, vrange, id string, labels map[string]string) back
 		Index:     index,
 		ClaimType: backend.GetClaimTypeFromString(labels[backend.KuidClaimTypeKey]),
 		ID:        id,
+		Count:     backend.GetEntryCount(id),
 	}
 	// filter the system defined labels from the labels to prepare for the user defined labels
 	udLabels := map[string]string{}
@@ -17,9 +17,11 @@ limitations under the License.
 package vlan
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/kform-dev/choreo/apis/condition"
+	"github.com/kuidio/kuid/apis/backend"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -65,6 +67,57 @@ func (r *VLANIndex) ValidateSyntax(s string) field.ErrorList {
 			))
 		}
 	}
+	if err := r.validateReservedRanges(); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.reservedRanges"),
+			r,
+			fmt.Errorf("invalid reserved vlan ranges, err: %s", err.Error()).Error(),
+		))
+	}
+	for i, claim := range r.Spec.Claims {
+		if claim.OuterID != nil && !r.Spec.QinQ {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath(fmt.Sprintf("spec.claims[%d].outerID", i)),
+				r,
+				fmt.Errorf("an outer vlan ID requires a qinq index").Error(),
+			))
+		}
+	}
+	return allErrs
+}
+
+// validateReservedRanges validates the syntax of the reserved ranges and validates the
+// reserved VLANs do not overlap
+func (r *VLANIndex) validateReservedRanges() error {
+	var errm error
+	for _, reservedRange := range r.Spec.ReservedRanges {
+		for _, segment := range backend.GetRangeSegments(reservedRange) {
+			if _, _, err := validateVLANRangeSegment(segment); err != nil {
+				errm = errors.Join(errm, err)
+			}
+		}
+	}
+	if errm != nil {
+		return errm
+	}
+	reserved := []backend.IDRange{}
+	for _, ranges := range r.getReservedRanges() {
+		reserved = append(reserved, ranges...)
+	}
+	return backend.ValidateIDRanges(reserved)
+}
+
+// ValidateQinQUpdate validates the qinq mode of the index does not change, the ids of the
+// claims depend on it
+func (r *VLANIndex) ValidateQinQUpdate(old *VLANIndex) field.ErrorList {
+	var allErrs field.ErrorList
+	if old != nil && r.Spec.QinQ != old.Spec.QinQ {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.qinq"),
+			r,
+			fmt.Errorf("qinq cannot be changed once the index is created").Error(),
+		))
+	}
 	return allErrs
 }
 
@@ -19,9 +19,11 @@ package vlan
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/henderiw/idxtable/pkg/tree/gtree"
 	"github.com/henderiw/idxtable/pkg/tree/tree16"
+	"github.com/henderiw/idxtable/pkg/tree/tree32"
 	"github.com/henderiw/store"
 	"github.com/kuidio/kuid/apis/backend"
 	"github.com/kuidio/kuid/apis/common"
@@ -46,6 +48,14 @@ func (r *VLANIndex) GetNamespacedName() types.NamespacedName {
 }
 
 func (r *VLANIndex) GetTree() gtree.GTree {
+	if r.Spec.QinQ {
+		// the ids of a qinq index combine the outer and inner tag
+		tree, err := tree32.New(fmt.Sprintf("vlanidindex.%s", r.Name), 2*VLANIDBits)
+		if err != nil {
+			panic(err)
+		}
+		return tree
+	}
 	//tree, err := tree32.New(32)
 	tree, err := tree16.New(fmt.Sprintf("vlanidindex.%s", r.Name), 12)
 	if err != nil {
@@ -55,6 +65,9 @@ func (r *VLANIndex) GetTree() gtree.GTree {
 }
 
 func (r *VLANIndex) GetType() string {
+	if r.Spec.QinQ {
+		return VLANType_QinQ
+	}
 	return ""
 }
 
@@ -73,6 +86,9 @@ func (r *VLANIndex) GetMaxID() *uint64 {
 }
 
 func (r *VLANIndex) GetMax() uint64 {
+	if r.Spec.QinQ {
+		return QinQID_Max
+	}
 	return VLANID_Max
 }
 
@@ -103,9 +119,10 @@ func (r *VLANIndex) GetClaims() []backend.ClaimObject {
 	if r.GetMinID() != nil && *r.GetMinID() != 0 {
 		claims = append(claims, r.GetMinClaim())
 	}
-	if r.GetMaxID() != nil && *r.GetMaxID() != r.GetMax() {
+	if r.GetMaxID() != nil && *r.GetMaxID() != VLANID_Max {
 		claims = append(claims, r.GetMaxClaim())
 	}
+	claims = append(claims, r.GetReservedClaims()...)
 	for _, claim := range r.Spec.Claims {
 		claims = append(claims, r.GetClaim(claim))
 	}
@@ -156,12 +173,104 @@ func (r *VLANIndex) GetMaxClaim() backend.ClaimObject {
 	)
 }
 
+// getReservedRanges returns the reserved VLANs of the index by name of the reserved claim
+func (r *VLANIndex) getReservedRanges() map[string][]backend.IDRange {
+	reserved := map[string][]backend.IDRange{}
+	if r.Spec.ReserveProtocolVLANs {
+		reserved["protocol"] = VLANProtocolReservedRanges
+	}
+	if r.Spec.ReserveDefaultVLAN {
+		reserved["default"] = []backend.IDRange{VLANDefaultReservedRange}
+	}
+	vendor := []backend.IDRange{}
+	for _, reservedRange := range r.Spec.ReservedRanges {
+		for _, segment := range backend.GetRangeSegments(reservedRange) {
+			start, end, err := validateVLANRangeSegment(segment)
+			if err != nil {
+				continue
+			}
+			vendor = append(vendor, backend.IDRange{From: uint64(start), To: uint64(end)})
+		}
+	}
+	if len(vendor) != 0 {
+		reserved["vendor"] = vendor
+	}
+	return reserved
+}
+
+// GetReservedClaims returns the claims of the reserved VLANs within the min and max ID of
+// the index, they are clipped to the min and max ID. In a QinQ index the reserved VLANs
+// are reserved as outer tags.
+func (r *VLANIndex) GetReservedClaims() []backend.ClaimObject {
+	minID, maxID := uint64(VLANID_Min), uint64(VLANID_Max)
+	if r.Spec.MinID != nil {
+		minID = uint64(*r.Spec.MinID)
+	}
+	if r.Spec.MaxID != nil {
+		maxID = uint64(*r.Spec.MaxID)
+	}
+	claims := []backend.ClaimObject{}
+	reserved := r.getReservedRanges()
+	for _, name := range []string{"protocol", "default", "vendor"} {
+		ranges := backend.IntersectIDRanges(sortIDRanges(reserved[name]), []backend.IDRange{{From: minID, To: maxID}})
+		if len(ranges) == 0 {
+			continue
+		}
+		segments := make([]string, 0, len(ranges))
+		for _, reservedRange := range ranges {
+			segments = append(segments, fmt.Sprintf("%d-%d", reservedRange.From, reservedRange.To))
+		}
+		claims = append(claims, BuildVLANClaim(
+			metav1.ObjectMeta{
+				Namespace: r.GetNamespace(),
+				Name:      fmt.Sprintf("%s.%s-%s", r.Name, backend.IndexReservedName, name),
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: schema.GroupVersion{Group: SchemeGroupVersion.Group, Version: "v1alpha1"}.Identifier(),
+						Kind:       VLANIndexKind,
+						Name:       r.Name,
+						UID:        r.UID,
+					},
+				},
+			},
+			&VLANClaimSpec{
+				Index: r.Name,
+				Range: ptr.To[string](strings.Join(segments, backend.RangeSegmentSeparator)),
+			},
+			nil,
+		))
+	}
+	return claims
+}
+
+// GetDynamicRanges returns the outer and inner tag pairs the dynamic claims allocate in a
+// QinQ index, the protocol VLANs and the reserved VLANs are excluded from the inner tags.
+// The reserved outer tags are claimed by the reserved claims of the index.
+func (r *VLANIndex) GetDynamicRanges() []backend.IDRange {
+	if !r.Spec.QinQ {
+		return nil
+	}
+	excluded := []backend.IDRange{}
+	for _, reserved := range r.getReservedRanges() {
+		excluded = append(excluded, reserved...)
+	}
+	inner := subtractIDRanges(backend.IDRange{From: VLANID_Min + 1, To: VLANID_Max - 1}, sortIDRanges(excluded))
+	ranges := make([]backend.IDRange, 0, (VLANID_Max-1)*len(inner))
+	for outer := uint64(VLANID_Min + 1); outer < VLANID_Max; outer++ {
+		for _, innerRange := range inner {
+			ranges = append(ranges, backend.IDRange{From: getQinQID(outer, innerRange.From), To: getQinQID(outer, innerRange.To)})
+		}
+	}
+	return ranges
+}
+
 func (r *VLANIndex) GetClaim(claim VLANIndexClaim) backend.ClaimObject {
 	spec := &VLANClaimSpec{
 		Index: r.Name,
 		ClaimLabels: common.ClaimLabels{
 			UserDefinedLabels: claim.UserDefinedLabels,
 		},
+		OuterID: claim.OuterID,
 	}
 	if claim.ID != nil {
 		spec.ID = claim.ID
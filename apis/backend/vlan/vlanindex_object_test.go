@@ -0,0 +1,142 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vlan
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kuidio/kuid/apis/backend"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestGetReservedClaims(t *testing.T) {
+	cases := map[string]struct {
+		spec *VLANIndexSpec
+		want map[string]string
+	}{
+		"None": {
+			spec: &VLANIndexSpec{},
+			want: map[string]string{},
+		},
+		"All": {
+			spec: &VLANIndexSpec{
+				ReserveProtocolVLANs: true,
+				ReserveDefaultVLAN:   true,
+				ReservedRanges:       []string{"1002-1005"},
+			},
+			want: map[string]string{
+				"a.rangereserved-protocol": "0-0,4095-4095",
+				"a.rangereserved-default":  "1-1",
+				"a.rangereserved-vendor":   "1002-1005",
+			},
+		},
+		"Clipped": {
+			spec: &VLANIndexSpec{
+				MinID:                ptr.To[uint32](10),
+				MaxID:                ptr.To[uint32](1003),
+				ReserveProtocolVLANs: true,
+				ReserveDefaultVLAN:   true,
+				ReservedRanges:       []string{"1002-1005", "5-20"},
+			},
+			want: map[string]string{
+				"a.rangereserved-vendor": "10-20,1002-1003",
+			},
+		},
+		"QinQ": {
+			spec: &VLANIndexSpec{
+				QinQ:                 true,
+				ReserveProtocolVLANs: true,
+			},
+			want: map[string]string{
+				"a.rangereserved-protocol": "0-0,4095-4095",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			index := BuildVLANIndex(metav1.ObjectMeta{Namespace: "dummy", Name: "a"}, tc.spec, nil)
+
+			got := map[string]string{}
+			for _, claim := range index.GetReservedClaims() {
+				if diff := cmp.Diff("a", claim.GetIndex()); diff != "" {
+					t.Errorf("-want, +got:\n%s", diff)
+				}
+				got[claim.GetName()] = ptr.Deref(claim.GetRange(), "")
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetDynamicRanges(t *testing.T) {
+	cases := map[string]struct {
+		spec *VLANIndexSpec
+		// want are the inner tag ranges of every outer tag
+		want []backend.IDRange
+	}{
+		"Single": {
+			spec: &VLANIndexSpec{},
+			want: nil,
+		},
+		"QinQ": {
+			spec: &VLANIndexSpec{QinQ: true},
+			want: []backend.IDRange{{From: 1, To: 4094}},
+		},
+		"QinQReserved": {
+			spec: &VLANIndexSpec{
+				QinQ:                 true,
+				ReserveProtocolVLANs: true,
+				ReserveDefaultVLAN:   true,
+				ReservedRanges:       []string{"1002-1005"},
+			},
+			want: []backend.IDRange{{From: 2, To: 1001}, {From: 1006, To: 4094}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			index := BuildVLANIndex(metav1.ObjectMeta{Namespace: "dummy", Name: "a"}, tc.spec, nil)
+
+			got := index.GetDynamicRanges()
+			if tc.want == nil {
+				if diff := cmp.Diff([]backend.IDRange(nil), got); diff != "" {
+					t.Errorf("-want, +got:\n%s", diff)
+				}
+				return
+			}
+			if diff := cmp.Diff((VLANID_Max-1)*len(tc.want), len(got)); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			// the outer tags 0 and 4095 are never allocated
+			for _, outer := range []uint64{1, 100, VLANID_Max - 1} {
+				want := make([]backend.IDRange, 0, len(tc.want))
+				for _, inner := range tc.want {
+					want = append(want, backend.IDRange{From: getQinQID(outer, inner.From), To: getQinQID(outer, inner.To)})
+				}
+				start := int(outer-1) * len(tc.want)
+				if diff := cmp.Diff(want, got[start:start+len(tc.want)]); diff != "" {
+					t.Errorf("outer %d -want, +got:\n%s", outer, diff)
+				}
+			}
+		})
+	}
+}
@@ -310,5 +310,14 @@ func (r *VLANIndex) PrepareForUpdate(ctx context.Context, obj, old runtime.Objec
 }
 
 func (r *VLANIndex) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
-	return r.ValidateSyntax("")
+	allErrs := r.ValidateSyntax("")
+	newIndex, ok := obj.(*VLANIndex)
+	if !ok {
+		return allErrs
+	}
+	oldIndex, ok := old.(*VLANIndex)
+	if !ok {
+		return allErrs
+	}
+	return append(allErrs, newIndex.ValidateQinQUpdate(oldIndex)...)
 }
@@ -37,6 +37,23 @@ type VLANIndexSpec struct {
 	common.UserDefinedLabels `json:",inline" protobuf:"bytes,3,opt,name=userDefinedLabels"`
 	// Claims define the embedded claims in the Index
 	Claims []VLANIndexClaim `json:"claims,omitempty" protobuf:"bytes,4,rep,name=claims"`
+	// ReserveProtocolVLANs reserves the VLANs 0 and 4095 as claims owned by the index,
+	// they cannot be claimed
+	// +optional
+	ReserveProtocolVLANs bool `json:"reserveProtocolVLANs,omitempty" protobuf:"varint,5,opt,name=reserveProtocolVLANs"`
+	// ReserveDefaultVLAN reserves the default VLAN 1 as a claim owned by the index
+	// +optional
+	ReserveDefaultVLAN bool `json:"reserveDefaultVLAN,omitempty" protobuf:"varint,6,opt,name=reserveDefaultVLAN"`
+	// ReservedRanges reserves vendor specific VLAN ranges as claims owned by the index,
+	// e.g. 1002-1005. The following notation is used: start-end <start-ID>-<end-ID>
+	// +optional
+	ReservedRanges []string `json:"reservedRanges,omitempty" protobuf:"bytes,7,rep,name=reservedRanges"`
+	// QinQ enables 802.1ad double tagging, the claims allocate an outer (S-VLAN) and
+	// inner (C-VLAN) tag pair and the inner tags are scoped per outer tag.
+	// The min and max ID, the reserved VLANs and the ranges without an outer ID apply to
+	// the outer tags. QinQ cannot be changed once the index is created.
+	// +optional
+	QinQ bool `json:"qinq,omitempty" protobuf:"varint,8,opt,name=qinq"`
 }
 
 type VLANIndexClaim struct {
@@ -47,10 +64,14 @@ type VLANIndexClaim struct {
 	// Range defines the range of the resource
 	// The following notation is used: start-end <start-ID>-<end-ID>
 	// the IDs in the range must be consecutive
+	// multiple segments are separated by a comma, e.g. 100-199,300-399
 	Range *string `json:"range,omitempty" protobuf:"bytes,3,opt,name=range"`
 	// UserDefinedLabels define metadata to the resource.
 	// defined in the spec to distingiush metadata labels from user defined labels
 	common.UserDefinedLabels `json:",inline" protobuf:"bytes,4,opt,name=userDefinedLabels"`
+	// OuterID defines the outer (S-VLAN) tag of the claim in a QinQ index
+	// +optional
+	OuterID *uint32 `json:"outerID,omitempty" protobuf:"varint,5,opt,name=outerID"`
 }
 
 // VLANIndexStatus defines the observed state of VLANIndex
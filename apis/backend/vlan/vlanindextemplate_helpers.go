@@ -0,0 +1,143 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "VLAN IS" BVLANIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vlan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kform-dev/choreo/apis/condition"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/infra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// VLANIndexTemplateKinds defines the kinds of the infra resources an index template applies to
+var VLANIndexTemplateKinds = []string{infra.NodeKind, infra.EndpointKind, infra.EndpointSetKind}
+
+// GetCondition returns the condition based on the condition kind
+func (r *VLANIndexTemplate) GetCondition(t condition.ConditionType) condition.Condition {
+	return r.Status.GetCondition(t)
+}
+
+// SetConditions sets the conditions on the resource. it allows for 0, 1 or more conditions
+// to be set at once
+func (r *VLANIndexTemplate) SetConditions(c ...condition.Condition) {
+	r.Status.SetConditions(c...)
+}
+
+// GetIndexName returns the name of the index the template creates for the infra resource
+func (r *VLANIndexTemplate) GetIndexName(owner string) string {
+	return GetTemplateIndexName(r.GetName(), owner)
+}
+
+// GetTemplateIndexName returns the name of the index created from the template for the
+// infra resource owner: <owner>.<template>
+func GetTemplateIndexName(template, owner string) string {
+	return fmt.Sprintf("%s.%s", owner, template)
+}
+
+// ResolveIndex sets the index of a claim without index to the index created from the
+// template in the index template label of the claim. The infra resource is defined by the
+// index owner name label or else by the infra owner reference of the claim.
+func (r *VLANClaim) ResolveIndex() {
+	if r.Spec.Index != "" {
+		return
+	}
+	template := r.GetLabels()[backend.KuidIndexTemplateKey]
+	if template == "" {
+		return
+	}
+	owner := r.GetLabels()[backend.KuidIndexOwnerNameKey]
+	if owner == "" {
+		for _, ref := range r.GetOwnerReferences() {
+			gv, err := schema.ParseGroupVersion(ref.APIVersion)
+			if err != nil || gv.Group != infra.SchemeGroupVersion.Group || !isVLANIndexTemplateKind(ref.Kind) {
+				continue
+			}
+			owner = ref.Name
+			break
+		}
+	}
+	if owner == "" {
+		return
+	}
+	r.Spec.Index = GetTemplateIndexName(template, owner)
+}
+
+func (r *VLANIndexTemplate) ValidateSyntax() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !isVLANIndexTemplateKind(r.Spec.Kind) {
+		allErrs = append(allErrs, field.NotSupported(
+			field.NewPath("spec.kind"),
+			r.Spec.Kind,
+			VLANIndexTemplateKinds,
+		))
+	}
+	if r.Spec.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(r.Spec.Selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec.selector"),
+				r,
+				fmt.Errorf("invalid selector, err: %s", err.Error()).Error(),
+			))
+		}
+	}
+	// the index spec is validated as the spec of the indexes created from the template
+	index := BuildVLANIndex(metav1.ObjectMeta{Name: r.GetName(), Namespace: r.GetNamespace()}, &r.Spec.Index, nil)
+	for _, err := range index.ValidateSyntax("") {
+		err.Field = strings.Replace(err.Field, "spec.", "spec.index.", 1)
+		allErrs = append(allErrs, err)
+	}
+	return allErrs
+}
+
+// ValidateUpdateSyntax validates the kind and the qinq mode of the template do not change,
+// the indexes created from the template depend on them
+func (r *VLANIndexTemplate) ValidateUpdateSyntax(old *VLANIndexTemplate) field.ErrorList {
+	var allErrs field.ErrorList
+	if old == nil {
+		return allErrs
+	}
+	if r.Spec.Kind != old.Spec.Kind {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.kind"),
+			r,
+			fmt.Errorf("kind cannot be changed once the template is created").Error(),
+		))
+	}
+	if r.Spec.Index.QinQ != old.Spec.Index.QinQ {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.index.qinq"),
+			r,
+			fmt.Errorf("qinq cannot be changed once the template is created").Error(),
+		))
+	}
+	return allErrs
+}
+
+func isVLANIndexTemplateKind(kind string) bool {
+	for _, templateKind := range VLANIndexTemplateKinds {
+		if kind == templateKind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vlan
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/infra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResolveIndex(t *testing.T) {
+	nodeRef := metav1.OwnerReference{APIVersion: infra.SchemeGroupVersion.Identifier(), Kind: infra.NodeKind, Name: "leaf1"}
+
+	cases := map[string]struct {
+		index  string
+		labels map[string]string
+		owners []metav1.OwnerReference
+		want   string
+	}{
+		"Index": {
+			index:  "a",
+			labels: map[string]string{backend.KuidIndexTemplateKey: "access", backend.KuidIndexOwnerNameKey: "leaf1"},
+			want:   "a",
+		},
+		"OwnerLabel": {
+			labels: map[string]string{backend.KuidIndexTemplateKey: "access", backend.KuidIndexOwnerNameKey: "leaf2"},
+			owners: []metav1.OwnerReference{nodeRef},
+			want:   "leaf2.access",
+		},
+		"OwnerReference": {
+			labels: map[string]string{backend.KuidIndexTemplateKey: "access"},
+			owners: []metav1.OwnerReference{
+				{APIVersion: "v1", Kind: "ConfigMap", Name: "cm"},
+				{APIVersion: "other.dev/v1alpha1", Kind: infra.NodeKind, Name: "other"},
+				nodeRef,
+			},
+			want: "leaf1.access",
+		},
+		"NoTemplate": {
+			labels: map[string]string{backend.KuidIndexOwnerNameKey: "leaf1"},
+			owners: []metav1.OwnerReference{nodeRef},
+			want:   "",
+		},
+		"NoOwner": {
+			labels: map[string]string{backend.KuidIndexTemplateKey: "access"},
+			owners: []metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: "cm"}},
+			want:   "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			claim := BuildVLANClaim(
+				metav1.ObjectMeta{Namespace: "dummy", Name: "claim1", Labels: tc.labels, OwnerReferences: tc.owners},
+				&VLANClaimSpec{Index: tc.index},
+				nil,
+			).(*VLANClaim)
+
+			claim.ResolveIndex()
+			if diff := cmp.Diff(tc.want, claim.GetIndex()); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want == "", len(validateIndex(claim)) != 0); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,7 +21,8 @@ limitations under the License.
 package vlan
 
 import (
-	"k8s.io/apimachinery/pkg/runtime"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -112,6 +113,11 @@ func (in *VLANClaimSpec) DeepCopyInto(out *VLANClaimSpec) {
 		**out = **in
 	}
 	in.ClaimLabels.DeepCopyInto(&out.ClaimLabels)
+	if in.OuterID != nil {
+		in, out := &in.OuterID, &out.OuterID
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANClaimSpec.
@@ -143,6 +149,11 @@ func (in *VLANClaimStatus) DeepCopyInto(out *VLANClaimStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OuterID != nil {
+		in, out := &in.OuterID, &out.OuterID
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANClaimStatus.
@@ -364,6 +375,11 @@ func (in *VLANIndexSpec) DeepCopyInto(out *VLANIndexSpec) {
 		**out = **in
 	}
 	in.UserDefinedLabels.DeepCopyInto(&out.UserDefinedLabels)
+	if in.ReservedRanges != nil {
+		in, out := &in.ReservedRanges, &out.ReservedRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANIndexSpec.
@@ -402,6 +418,102 @@ func (in *VLANIndexStatus) DeepCopy() *VLANIndexStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLANIndexTemplate) DeepCopyInto(out *VLANIndexTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANIndexTemplate.
+func (in *VLANIndexTemplate) DeepCopy() *VLANIndexTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(VLANIndexTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VLANIndexTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLANIndexTemplateList) DeepCopyInto(out *VLANIndexTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VLANIndexTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANIndexTemplateList.
+func (in *VLANIndexTemplateList) DeepCopy() *VLANIndexTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(VLANIndexTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VLANIndexTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLANIndexTemplateSpec) DeepCopyInto(out *VLANIndexTemplateSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Index.DeepCopyInto(&out.Index)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANIndexTemplateSpec.
+func (in *VLANIndexTemplateSpec) DeepCopy() *VLANIndexTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VLANIndexTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLANIndexTemplateStatus) DeepCopyInto(out *VLANIndexTemplateStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANIndexTemplateStatus.
+func (in *VLANIndexTemplateStatus) DeepCopy() *VLANIndexTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VLANIndexTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VLANRangeSyntaxValidator) DeepCopyInto(out *VLANRangeSyntaxValidator) {
 	*out = *in
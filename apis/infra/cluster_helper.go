@@ -18,6 +18,7 @@ package infra
 
 import (
 	"github.com/kform-dev/choreo/apis/condition"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // GetCondition returns the condition based on the condition kind
@@ -30,3 +31,7 @@ func (r *Cluster) GetCondition(t condition.ConditionType) condition.Condition {
 func (r *Cluster) SetConditions(c ...condition.Condition) {
 	r.Status.SetConditions(c...)
 }
+
+func (r *Cluster) ValidateSyntax() field.ErrorList {
+	return r.Spec.Location.ValidateSyntax(field.NewPath("spec.location"))
+}
@@ -295,8 +295,8 @@ func (r *Cluster) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 
 // ValidateCreate statically validates
 func (r *Cluster) ValidateCreate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	var allErrs field.ErrorList
-	return allErrs
+	newobj := obj.(*Cluster)
+	return newobj.ValidateSyntax()
 }
 
 func (r *Cluster) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
@@ -307,6 +307,6 @@ func (r *Cluster) PrepareForUpdate(ctx context.Context, obj, old runtime.Object)
 }
 
 func (r *Cluster) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
-	var allErrs field.ErrorList
-	return allErrs
+	newobj := obj.(*Cluster)
+	return newobj.ValidateSyntax()
 }
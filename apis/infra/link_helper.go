@@ -17,7 +17,11 @@ limitations under the License.
 package infra
 
 import (
+	"fmt"
+
 	"github.com/kform-dev/choreo/apis/condition"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // GetCondition returns the condition based on the condition kind
@@ -30,3 +34,33 @@ func (r *Link) GetCondition(t condition.ConditionType) condition.Condition {
 func (r *Link) SetConditions(c ...condition.Condition) {
 	r.Status.SetConditions(c...)
 }
+
+// ValidateSyntax validates a point to point link has exactly 2 distinct endpoints
+func (r *Link) ValidateSyntax() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(r.Spec.Endpoints) != 2 {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.endpoints"),
+			len(r.Spec.Endpoints),
+			fmt.Sprintf("a link requires exactly 2 endpoints, got %d", len(r.Spec.Endpoints)),
+		))
+		return allErrs
+	}
+	for i, ep := range r.Spec.Endpoints {
+		if ep == nil {
+			allErrs = append(allErrs, field.Required(
+				field.NewPath("spec.endpoints").Index(i),
+				"a link endpoint cannot be empty",
+			))
+		}
+	}
+	if len(allErrs) == 0 && equality.Semantic.DeepEqual(r.Spec.Endpoints[0], r.Spec.Endpoints[1]) {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.endpoints"),
+			r.Spec.Endpoints,
+			"a link cannot connect an endpoint to itself",
+		))
+	}
+	return allErrs
+}
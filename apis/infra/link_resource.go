@@ -295,8 +295,8 @@ func (r *Link) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 
 // ValidateCreate statically validates
 func (r *Link) ValidateCreate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	var allErrs field.ErrorList
-	return allErrs
+	newobj := obj.(*Link)
+	return newobj.ValidateSyntax()
 }
 
 func (r *Link) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
@@ -307,6 +307,6 @@ func (r *Link) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 }
 
 func (r *Link) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
-	var allErrs field.ErrorList
-	return allErrs
+	newobj := obj.(*Link)
+	return newobj.ValidateSyntax()
 }
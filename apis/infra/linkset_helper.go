@@ -18,8 +18,13 @@ package infra
 
 import (
 	"github.com/kform-dev/choreo/apis/condition"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+// DefaultLinkSetMaxNodes defines the maximum number of nodes a LinkSet can span
+// when not specified in the spec
+const DefaultLinkSetMaxNodes = 2
+
 // GetCondition returns the condition based on the condition kind
 func (r *LinkSet) GetCondition(t condition.ConditionType) condition.Condition {
 	return r.Status.GetCondition(t)
@@ -30,3 +35,32 @@ func (r *LinkSet) GetCondition(t condition.ConditionType) condition.Condition {
 func (r *LinkSet) SetConditions(c ...condition.Condition) {
 	r.Status.SetConditions(c...)
 }
+
+// GetMaxNodes returns the maximum number of nodes the LinkSet can span
+func (r *LinkSet) GetMaxNodes() int {
+	if r.Spec.MaxNodes != nil {
+		return int(*r.Spec.MaxNodes)
+	}
+	return DefaultLinkSetMaxNodes
+}
+
+func (r *LinkSet) ValidateSyntax() field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, ep := range r.Spec.Endpoints {
+		if ep == nil {
+			allErrs = append(allErrs, field.Required(
+				field.NewPath("spec.endpoints").Index(i),
+				"a linkSet endpoint cannot be empty",
+			))
+		}
+	}
+	if r.Spec.MaxNodes != nil && *r.Spec.MaxNodes < 2 {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec.maxNodes"),
+			*r.Spec.MaxNodes,
+			"a linkSet spans at least 2 nodes",
+		))
+	}
+	return allErrs
+}
@@ -295,8 +295,8 @@ func (r *LinkSet) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 
 // ValidateCreate statically validates
 func (r *LinkSet) ValidateCreate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	var allErrs field.ErrorList
-	return allErrs
+	newobj := obj.(*LinkSet)
+	return newobj.ValidateSyntax()
 }
 
 func (r *LinkSet) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
@@ -307,6 +307,6 @@ func (r *LinkSet) PrepareForUpdate(ctx context.Context, obj, old runtime.Object)
 }
 
 func (r *LinkSet) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
-	var allErrs field.ErrorList
-	return allErrs
+	newobj := obj.(*LinkSet)
+	return newobj.ValidateSyntax()
 }
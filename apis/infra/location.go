@@ -16,7 +16,72 @@ limitations under the License.
 
 package infra
 
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// EarthRadiusKm is the mean radius of the earth used for distance calculations
+const EarthRadiusKm = 6371.0
+
 type Location struct {
 	Latitude  string `json:"latitude" yaml:"latitude" protobuf:"bytes,1,opt,name=latitude"`
 	Longitude string `json:"longitude" yaml:"longitude" protobuf:"bytes,2,opt,name=longitude"`
 }
+
+// Coordinates returns the latitude and longitude of the location in decimal degrees
+func (r *Location) Coordinates() (float64, float64, error) {
+	lat, err := strconv.ParseFloat(strings.TrimSpace(r.Latitude), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid latitude %q, must be decimal degrees", r.Latitude)
+	}
+	if lat < -90 || lat > 90 {
+		return 0, 0, fmt.Errorf("invalid latitude %q, must be between -90 and 90", r.Latitude)
+	}
+	lon, err := strconv.ParseFloat(strings.TrimSpace(r.Longitude), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid longitude %q, must be decimal degrees", r.Longitude)
+	}
+	if lon < -180 || lon > 180 {
+		return 0, 0, fmt.Errorf("invalid longitude %q, must be between -180 and 180", r.Longitude)
+	}
+	return lat, lon, nil
+}
+
+// ValidateSyntax validates the coordinates of the location
+func (r *Location) ValidateSyntax(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if r == nil {
+		return allErrs
+	}
+	if _, _, err := r.Coordinates(); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, *r, err.Error()))
+	}
+	return allErrs
+}
+
+// DistanceKm returns the great-circle distance in km between 2 locations
+// using the haversine formula
+func (r *Location) DistanceKm(other *Location) (float64, error) {
+	lat1, lon1, err := r.Coordinates()
+	if err != nil {
+		return 0, err
+	}
+	lat2, lon2, err := other.Coordinates()
+	if err != nil {
+		return 0, err
+	}
+	dLat := toRadians(lat2 - lat1)
+	dLon := toRadians(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadiusKm * math.Atan2(math.Sqrt(a), math.Sqrt(1-a)), nil
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
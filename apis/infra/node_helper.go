@@ -18,6 +18,7 @@ package infra
 
 import (
 	"github.com/kform-dev/choreo/apis/condition"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // GetCondition returns the condition based on the condition kind
@@ -30,3 +31,55 @@ func (r *Node) GetCondition(t condition.ConditionType) condition.Condition {
 func (r *Node) SetConditions(c ...condition.Condition) {
 	r.Status.SetConditions(c...)
 }
+
+func (r *Node) ValidateSyntax() field.ErrorList {
+	allErrs := r.Spec.Location.ValidateSyntax(field.NewPath("spec.location"))
+	if _, err := r.GetRackPosition(); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.position"), *r.Spec.Position, err.Error()))
+	}
+	if r.Spec.Position != nil && (r.Spec.Rack == nil || *r.Spec.Rack == "") {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec.rack"), "a position requires a rack"))
+	}
+	if r.Spec.Units != nil && *r.Spec.Units == 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.units"), *r.Spec.Units, "a node occupies at least 1 rack unit"))
+	}
+	return allErrs
+}
+
+// GetRackPosition returns the lowest rack unit the node occupies in the rack,
+// nil is returned when no position is specified
+func (r *Node) GetRackPosition() (*uint32, error) {
+	if r.Spec.Position == nil {
+		return nil, nil
+	}
+	position, err := ParseRackUnits(*r.Spec.Position)
+	if err != nil {
+		return nil, err
+	}
+	return &position, nil
+}
+
+// GetRackUnits returns the number of rack units the node occupies, defaults to 1
+func (r *Node) GetRackUnits() uint32 {
+	if r.Spec.Units != nil {
+		return *r.Spec.Units
+	}
+	return 1
+}
+
+// GetRackSlot returns the rack slot the node occupies, nil is returned when the node is
+// not positioned in a rack
+func (r *Node) GetRackSlot() (*RackSlot, error) {
+	if r.Spec.Rack == nil || *r.Spec.Rack == "" {
+		return nil, nil
+	}
+	position, err := r.GetRackPosition()
+	if err != nil || position == nil {
+		return nil, err
+	}
+	return &RackSlot{
+		Node:     r.Name,
+		Position: *position,
+		Units:    r.GetRackUnits(),
+	}, nil
+}
@@ -295,8 +295,8 @@ func (r *Node) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 
 // ValidateCreate statically validates
 func (r *Node) ValidateCreate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	var allErrs field.ErrorList
-	return allErrs
+	newobj := obj.(*Node)
+	return newobj.ValidateSyntax()
 }
 
 func (r *Node) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
@@ -307,6 +307,6 @@ func (r *Node) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 }
 
 func (r *Node) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
-	var allErrs field.ErrorList
-	return allErrs
+	newobj := obj.(*Node)
+	return newobj.ValidateSyntax()
 }
@@ -0,0 +1,123 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infra
+
+import (
+	"fmt"
+
+	"github.com/kform-dev/choreo/apis/condition"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// GetCondition returns the condition based on the condition kind
+func (r *PlatformProfile) GetCondition(t condition.ConditionType) condition.Condition {
+	return r.Status.GetCondition(t)
+}
+
+// SetConditions sets the conditions on the resource. it allows for 0, 1 or more conditions
+// to be set at once
+func (r *PlatformProfile) SetConditions(c ...condition.Condition) {
+	r.Status.SetConditions(c...)
+}
+
+func (r *PlatformProfile) ValidateSyntax() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.Provider == "" {
+		allErrs = append(allErrs, field.Required(
+			field.NewPath("spec.provider"),
+			"a platformProfile requires a provider",
+		))
+	}
+	if r.Spec.PlatformType == "" {
+		allErrs = append(allErrs, field.Required(
+			field.NewPath("spec.platformType"),
+			"a platformProfile requires a platformType",
+		))
+	}
+	allErrs = append(allErrs, validatePortProfiles(field.NewPath("spec.ports"), r.Spec.Ports)...)
+
+	moduleBays := sets.New[uint32]()
+	for i, moduleBay := range r.Spec.ModuleBays {
+		path := field.NewPath("spec.moduleBays").Index(i)
+		if moduleBays.Has(moduleBay.ModuleBay) {
+			allErrs = append(allErrs, field.Duplicate(
+				path.Child("moduleBay"),
+				moduleBay.ModuleBay,
+			))
+		}
+		moduleBays.Insert(moduleBay.ModuleBay)
+		allErrs = append(allErrs, validatePortProfiles(path.Child("ports"), moduleBay.Ports)...)
+	}
+	return allErrs
+}
+
+func validatePortProfiles(path *field.Path, portProfiles []PortProfile) field.ErrorList {
+	var allErrs field.ErrorList
+
+	ports := sets.New[uint32]()
+	for i, portProfile := range portProfiles {
+		if portProfile.Count == 0 {
+			allErrs = append(allErrs, field.Invalid(
+				path.Index(i).Child("count"),
+				portProfile.Count,
+				"a port range requires at least 1 port",
+			))
+		}
+		for _, port := range portProfile.GetPorts() {
+			if ports.Has(port) {
+				allErrs = append(allErrs, field.Invalid(
+					path.Index(i),
+					portProfile,
+					fmt.Errorf("port %d overlaps with another port range", port).Error(),
+				))
+				break
+			}
+			ports.Insert(port)
+		}
+		if portProfile.Breakout != nil {
+			if portProfile.Breakout.Adaptor == "" {
+				allErrs = append(allErrs, field.Required(
+					path.Index(i).Child("breakout.adaptor"),
+					"a breakout requires an adaptor name",
+				))
+			}
+			if portProfile.Breakout.Endpoints == 0 {
+				allErrs = append(allErrs, field.Invalid(
+					path.Index(i).Child("breakout.endpoints"),
+					portProfile.Breakout.Endpoints,
+					"a breakout requires at least 1 endpoint",
+				))
+			}
+		}
+	}
+	return allErrs
+}
+
+// GetPorts returns the port ids of the port range
+func (r PortProfile) GetPorts() []uint32 {
+	start := uint32(1)
+	if r.Start != nil {
+		start = *r.Start
+	}
+	ports := make([]uint32, 0, r.Count)
+	for i := uint32(0); i < r.Count; i++ {
+		ports = append(ports, start+i)
+	}
+	return ports
+}
@@ -17,7 +17,13 @@ limitations under the License.
 package infra
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
 	"github.com/kform-dev/choreo/apis/condition"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // GetCondition returns the condition based on the condition kind
@@ -30,3 +36,102 @@ func (r *Rack) GetCondition(t condition.ConditionType) condition.Condition {
 func (r *Rack) SetConditions(c ...condition.Condition) {
 	r.Status.SetConditions(c...)
 }
+
+func (r *Rack) ValidateSyntax() field.ErrorList {
+	allErrs := r.Spec.Location.ValidateSyntax(field.NewPath("spec.location"))
+	if _, err := r.GetHeightUnits(); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.height"), r.Spec.Height, err.Error()))
+	}
+	return allErrs
+}
+
+// GetHeightUnits returns the height of the rack in rack units, 0 is returned when
+// the height is not specified
+func (r *Rack) GetHeightUnits() (uint32, error) {
+	if r.Spec.Height == "" {
+		return 0, nil
+	}
+	return ParseRackUnits(r.Spec.Height)
+}
+
+// ParseRackUnits parses a rack unit value, e.g. 42, 42U or U42
+func ParseRackUnits(s string) (uint32, error) {
+	v := strings.TrimSpace(strings.ToUpper(s))
+	v = strings.TrimSuffix(strings.TrimPrefix(v, "U"), "U")
+	u, err := strconv.ParseUint(v, 10, 32)
+	if err != nil || u == 0 {
+		return 0, fmt.Errorf("invalid rack units %q, expecting a positive number of rack units, e.g. 42 or 42U", s)
+	}
+	return uint32(u), nil
+}
+
+// RackSlot defines the rack units a node occupies in a rack
+type RackSlot struct {
+	Node     string
+	Position uint32
+	Units    uint32
+}
+
+// Top returns the highest rack unit occupied by the slot
+func (r RackSlot) Top() uint32 {
+	return r.Position + r.Units - 1
+}
+
+func (r RackSlot) overlaps(other RackSlot) bool {
+	return r.Position <= other.Top() && other.Position <= r.Top()
+}
+
+// CheckRackSlot validates the slot fits in a rack of the given height and does not overlap
+// with the slots of the other nodes in the rack. A height of 0 indicates the rack height is unknown.
+func CheckRackSlot(height uint32, slot RackSlot, others []RackSlot) error {
+	if height != 0 && slot.Top() > height {
+		return fmt.Errorf("node %s occupies U%d-U%d which exceeds the rack height of %dU", slot.Node, slot.Position, slot.Top(), height)
+	}
+	for _, other := range others {
+		if other.Node == slot.Node {
+			continue
+		}
+		if slot.overlaps(other) {
+			return fmt.Errorf("node %s at U%d-U%d overlaps with node %s at U%d-U%d",
+				slot.Node, slot.Position, slot.Top(), other.Node, other.Position, other.Top())
+		}
+	}
+	return nil
+}
+
+// BuildRackElevation returns the occupied and free ranges of a rack of the given height, ordered from
+// the bottom to the top of the rack, and the number of used rack units.
+// Slots that exceed the rack height or overlap with a lower slot are returned as conflicts.
+func BuildRackElevation(height uint32, slots []RackSlot) ([]RackElevationEntry, uint32, []error) {
+	sorted := make([]RackSlot, len(slots))
+	copy(sorted, slots)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Position != sorted[j].Position {
+			return sorted[i].Position < sorted[j].Position
+		}
+		return sorted[i].Node < sorted[j].Node
+	})
+
+	elevation := []RackElevationEntry{}
+	conflicts := []error{}
+	used := uint32(0)
+	next := uint32(1)
+	placed := []RackSlot{}
+	for _, slot := range sorted {
+		if err := CheckRackSlot(height, slot, placed); err != nil {
+			conflicts = append(conflicts, err)
+			continue
+		}
+		if slot.Position > next {
+			elevation = append(elevation, RackElevationEntry{Position: next, Units: slot.Position - next})
+		}
+		elevation = append(elevation, RackElevationEntry{Position: slot.Position, Units: slot.Units, Node: slot.Node})
+		placed = append(placed, slot)
+		used += slot.Units
+		next = slot.Top() + 1
+	}
+	if height >= next {
+		elevation = append(elevation, RackElevationEntry{Position: next, Units: height - next + 1})
+	}
+	return elevation, used, conflicts
+}
@@ -295,8 +295,8 @@ func (r *Rack) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 
 // ValidateCreate statically validates
 func (r *Rack) ValidateCreate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	var allErrs field.ErrorList
-	return allErrs
+	newobj := obj.(*Rack)
+	return newobj.ValidateSyntax()
 }
 
 func (r *Rack) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
@@ -307,6 +307,6 @@ func (r *Rack) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 }
 
 func (r *Rack) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
-	var allErrs field.ErrorList
-	return allErrs
+	newobj := obj.(*Rack)
+	return newobj.ValidateSyntax()
 }
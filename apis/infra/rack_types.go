@@ -32,7 +32,7 @@ type RackSpec struct {
 	// Location defines the location information where this resource is located
 	// in lon/lat coordinates
 	Location *Location `json:"location,omitempty" yaml:"location,omitempty" protobuf:"bytes,2,opt,name=location"`
-	// The height of the rack, measured in units.
+	// The height of the rack, measured in rack units (U), e.g. 42 or 42U
 	Height string `json:"height,omitempty" yaml:"height,omitempty" protobuf:"bytes,3,opt,name=height"`
 	// The canonical distance between the two vertical rails on a face. In inch
 	Width string `json:"width,omitempty" yaml:"width,omitempty" protobuf:"bytes,4,opt,name=width"`
@@ -46,6 +46,27 @@ type RackStatus struct {
 	// ConditionedStatus provides the status of the IPClain using conditions
 	// - a ready condition indicates the overall status of the resource
 	condition.ConditionedStatus `json:",inline" yaml:",inline" protobuf:"bytes,1,opt,name=conditionedStatus"`
+	// UsedUnits defines the number of rack units occupied by nodes
+	// +optional
+	UsedUnits *uint32 `json:"usedUnits,omitempty" yaml:"usedUnits,omitempty" protobuf:"varint,2,opt,name=usedUnits"`
+	// FreeUnits defines the number of rack units not occupied by nodes
+	// +optional
+	FreeUnits *uint32 `json:"freeUnits,omitempty" yaml:"freeUnits,omitempty" protobuf:"varint,3,opt,name=freeUnits"`
+	// Elevation defines the occupied and free rack unit ranges of the rack, ordered from the bottom
+	// to the top of the rack
+	// +optional
+	Elevation []RackElevationEntry `json:"elevation,omitempty" yaml:"elevation,omitempty" protobuf:"bytes,4,rep,name=elevation"`
+}
+
+// RackElevationEntry defines a range of rack units in the rack
+type RackElevationEntry struct {
+	// Position defines the lowest rack unit of the range, starting from 1 at the bottom of the rack
+	Position uint32 `json:"position" yaml:"position" protobuf:"varint,1,opt,name=position"`
+	// Units defines the number of rack units of the range
+	Units uint32 `json:"units" yaml:"units" protobuf:"varint,2,opt,name=units"`
+	// Node defines the node occupying the range, empty when the range is free
+	// +optional
+	Node string `json:"node,omitempty" yaml:"node,omitempty" protobuf:"bytes,3,opt,name=node"`
 }
 
 // +genclient
@@ -45,6 +45,8 @@ var (
 
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&Adaptor{},
+		&AdaptorList{},
 		&Cluster{},
 		&ClusterList{},
 		&Endpoint{},
@@ -67,6 +69,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NodeSetList{},
 		&Partition{},
 		&PartitionList{},
+		&PlatformProfile{},
+		&PlatformProfileList{},
+		&Port{},
+		&PortList{},
 		&Rack{},
 		&RackList{},
 		&Region{},
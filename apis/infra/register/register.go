@@ -18,42 +18,145 @@ package register
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/henderiw/apiserver-builder/pkg/builder"
 	"github.com/henderiw/apiserver-builder/pkg/builder/resource"
-	"github.com/henderiw/apiserver-builder/pkg/builder/rest"
+	builderrest "github.com/henderiw/apiserver-builder/pkg/builder/rest"
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
 	"github.com/kuidio/kuid/apis/infra"
 	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
 	bebackend "github.com/kuidio/kuid/pkg/backend"
 	"github.com/kuidio/kuid/pkg/config"
+	"github.com/kuidio/kuid/pkg/elevation"
+	"github.com/kuidio/kuid/pkg/geo"
 	genericregistry "github.com/kuidio/kuid/pkg/registry/generic"
 	"github.com/kuidio/kuid/pkg/registry/options"
+	"github.com/kuidio/kuid/pkg/topology"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
 )
 
+// topologyStorage provides the infra stores to the node topology and the nearby subresources
+var topologyStorage = topology.NewStorage()
+
 func init() {
 	config.Register(
 		infra.SchemeGroupVersion.Group,
 		infrav1alpha1.AddToScheme,
 		nil,
+		ApplyStorageToTopology,
 		nil,
 		[]*config.ResourceConfig{
-			{StorageProviderFn: NewStorageProvider, Internal: &infra.Cluster{}, ResourceVersions: []resource.Object{&infra.Cluster{}, &infrav1alpha1.Cluster{}}},
+			{StorageProviderFn: NewStorageProvider, Internal: &infra.Adaptor{}, ResourceVersions: []resource.Object{&infra.Adaptor{}, &infrav1alpha1.Adaptor{}}},
+			{StorageProviderFn: NewLocationStorageProvider, Internal: &infra.Cluster{}, ResourceVersions: []resource.Object{&infra.Cluster{}, &infrav1alpha1.Cluster{}}},
 			{StorageProviderFn: NewStorageProvider, Internal: &infra.Endpoint{}, ResourceVersions: []resource.Object{&infra.Endpoint{}, &infrav1alpha1.Endpoint{}}},
 			{StorageProviderFn: NewStorageProvider, Internal: &infra.EndpointSet{}, ResourceVersions: []resource.Object{&infra.EndpointSet{}, &infrav1alpha1.EndpointSet{}}},
-			{StorageProviderFn: NewStorageProvider, Internal: &infra.Link{}, ResourceVersions: []resource.Object{&infra.Link{}, &infrav1alpha1.Link{}}},
-			{StorageProviderFn: NewStorageProvider, Internal: &infra.LinkSet{}, ResourceVersions: []resource.Object{&infra.LinkSet{}, &infrav1alpha1.LinkSet{}}},
+			{StorageProviderFn: NewLinkStorageProvider, Internal: &infra.Link{}, ResourceVersions: []resource.Object{&infra.Link{}, &infrav1alpha1.Link{}}},
+			{StorageProviderFn: NewLinkSetStorageProvider, Internal: &infra.LinkSet{}, ResourceVersions: []resource.Object{&infra.LinkSet{}, &infrav1alpha1.LinkSet{}}},
 			{StorageProviderFn: NewStorageProvider, Internal: &infra.Module{}, ResourceVersions: []resource.Object{&infra.Module{}, &infrav1alpha1.Module{}}},
 			{StorageProviderFn: NewStorageProvider, Internal: &infra.ModuleBay{}, ResourceVersions: []resource.Object{&infra.ModuleBay{}, &infrav1alpha1.ModuleBay{}}},
-			{StorageProviderFn: NewStorageProvider, Internal: &infra.Node{}, ResourceVersions: []resource.Object{&infra.Node{}, &infrav1alpha1.Node{}}},
+			{StorageProviderFn: NewNodeStorageProvider, Internal: &infra.Node{}, ResourceVersions: []resource.Object{&infra.Node{}, &infrav1alpha1.Node{}}},
 			{StorageProviderFn: NewStorageProvider, Internal: &infra.NodeItem{}, ResourceVersions: []resource.Object{&infra.NodeItem{}, &infrav1alpha1.NodeItem{}}},
 			{StorageProviderFn: NewStorageProvider, Internal: &infra.NodeSet{}, ResourceVersions: []resource.Object{&infra.NodeSet{}, &infrav1alpha1.NodeSet{}}},
 			{StorageProviderFn: NewStorageProvider, Internal: &infra.Partition{}, ResourceVersions: []resource.Object{&infra.Partition{}, &infrav1alpha1.Partition{}}},
-			{StorageProviderFn: NewStorageProvider, Internal: &infra.Rack{}, ResourceVersions: []resource.Object{&infra.Rack{}, &infrav1alpha1.Rack{}}},
+			{StorageProviderFn: NewStorageProvider, Internal: &infra.PlatformProfile{}, ResourceVersions: []resource.Object{&infra.PlatformProfile{}, &infrav1alpha1.PlatformProfile{}}},
+			{StorageProviderFn: NewStorageProvider, Internal: &infra.Port{}, ResourceVersions: []resource.Object{&infra.Port{}, &infrav1alpha1.Port{}}},
+			{StorageProviderFn: NewRackStorageProvider, Internal: &infra.Rack{}, ResourceVersions: []resource.Object{&infra.Rack{}, &infrav1alpha1.Rack{}}},
 			{StorageProviderFn: NewStorageProvider, Internal: &infra.Region{}, ResourceVersions: []resource.Object{&infra.Region{}, &infrav1alpha1.Region{}}},
-			{StorageProviderFn: NewStorageProvider, Internal: &infra.Site{}, ResourceVersions: []resource.Object{&infra.Site{}, &infrav1alpha1.Site{}}},
+			{StorageProviderFn: NewLocationStorageProvider, Internal: &infra.Site{}, ResourceVersions: []resource.Object{&infra.Site{}, &infrav1alpha1.Site{}}},
 		},
 	)
 }
 
-func NewStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *rest.StorageProvider {
+func NewStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *builderrest.StorageProvider {
 	return genericregistry.NewStorageProvider(ctx, obj, options)
 }
+
+// NewNodeStorageProvider adds the read-only topology and nearby subresources to the node storage
+// and checks the position of the node in its rack
+func NewNodeStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *builderrest.StorageProvider {
+	opts := *options
+	opts.BackendInvoker = elevation.NewNodeInvoker(topologyStorage)
+	sp := NewLocationStorageProvider(ctx, obj, be, sync, &opts)
+	sp.ArbitrarySubresourceHandlerProviders[topology.SubResourceName] = func(scheme *runtime.Scheme, store rest.Storage) (rest.Storage, error) {
+		return topology.NewREST(topologyStorage, store)
+	}
+	return sp
+}
+
+// NewRackStorageProvider checks the nodes in the rack still fit when the rack height changes
+func NewRackStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *builderrest.StorageProvider {
+	opts := *options
+	opts.BackendInvoker = elevation.NewRackInvoker(topologyStorage)
+	return NewLocationStorageProvider(ctx, obj, be, sync, &opts)
+}
+
+// NewLocationStorageProvider adds the read-only nearby subresource to the storage of
+// resources with a location
+func NewLocationStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *builderrest.StorageProvider {
+	sp := genericregistry.NewStorageProvider(ctx, obj, options)
+	sp.ArbitrarySubresourceHandlerProviders = map[string]builderrest.SubResourceStorageProviderFn{
+		geo.SubResourceName: func(scheme *runtime.Scheme, store rest.Storage) (rest.Storage, error) {
+			return geo.NewREST(topologyStorage, store, obj.GetGroupVersionResource().Resource, obj.New)
+		},
+	}
+	return sp
+}
+
+// NewLinkStorageProvider checks endpoint exclusivity and endpoint consistency of a link
+func NewLinkStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *builderrest.StorageProvider {
+	opts := *options
+	opts.BackendInvoker = topology.NewLinkInvoker(topologyStorage)
+	return genericregistry.NewStorageProvider(ctx, obj, &opts)
+}
+
+// NewLinkSetStorageProvider checks the number of nodes a linkSet spans
+func NewLinkSetStorageProvider(ctx context.Context, obj resource.InternalObject, be bebackend.Backend, sync bool, options *options.Options) *builderrest.StorageProvider {
+	opts := *options
+	opts.BackendInvoker = topology.NewLinkSetInvoker()
+	return genericregistry.NewStorageProvider(ctx, obj, &opts)
+}
+
+// ApplyStorageToTopology provides the stores the topology graph and the location
+// queries are built from to the topology and nearby subresources. Infra has no backend, the backend is ignored.
+func ApplyStorageToTopology(ctx context.Context, _ bebackend.Backend, apiServer *builder.Server) error {
+	for _, plural := range []string{
+		infra.NodePlural,
+		infra.EndpointPlural,
+		infra.LinkPlural,
+		infra.LinkSetPlural,
+		infra.EndpointSetPlural,
+		infra.SitePlural,
+		infra.RackPlural,
+		infra.ClusterPlural,
+	} {
+		storageProvider, ok := apiServer.StorageProvider[schema.GroupResource{
+			Group:    infra.SchemeGroupVersion.Group,
+			Resource: plural,
+		}]
+		if !ok {
+			return fmt.Errorf("storage for %s not registered", plural)
+		}
+		storage, err := storageProvider.Get(ctx, apiServer.Schemes[0], &Getter{})
+		if err != nil {
+			return err
+		}
+		store, ok := storage.(*registry.Store)
+		if !ok {
+			return fmt.Errorf("%s store is not a registry store", plural)
+		}
+		topologyStorage.AddStore(plural, store)
+	}
+	return nil
+}
+
+var _ generic.RESTOptionsGetter = &Getter{}
+
+type Getter struct{}
+
+func (r *Getter) GetRESTOptions(resource schema.GroupResource, example runtime.Object) (generic.RESTOptions, error) {
+	return generic.RESTOptions{}, nil
+}
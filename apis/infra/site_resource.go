@@ -295,8 +295,8 @@ func (r *Site) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 
 // ValidateCreate statically validates
 func (r *Site) ValidateCreate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	var allErrs field.ErrorList
-	return allErrs
+	newobj := obj.(*Site)
+	return newobj.ValidateSyntax()
 }
 
 func (r *Site) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
@@ -307,6 +307,6 @@ func (r *Site) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 }
 
 func (r *Site) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
-	var allErrs field.ErrorList
-	return allErrs
+	newobj := obj.(*Site)
+	return newobj.ValidateSyntax()
 }
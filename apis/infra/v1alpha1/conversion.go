@@ -355,7 +355,13 @@ func autoConvert_v1alpha1_EndpointSetSpec_To_infra_EndpointSetSpec(in *EndpointS
 		in, out := &in.Endpoints, &out.Endpoints
 		*out = make([]*id.PartitionEndpointID, len(*in))
 		for i := range *in {
-			autoConvert_v1alpha1_PartitionEndpointID_To_id_PartitionEndpointID((*in)[i], (*out)[i], s)
+			if (*in)[i] == nil {
+				continue
+			}
+			(*out)[i] = new(id.PartitionEndpointID)
+			if err := autoConvert_v1alpha1_PartitionEndpointID_To_id_PartitionEndpointID((*in)[i], (*out)[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
 		out.Endpoints = nil
@@ -377,7 +383,13 @@ func autoConvert_infra_EndpointSetSpec_To_v1alpha1_EndpointSetSpec(in *infra.End
 		in, out := &in.Endpoints, &out.Endpoints
 		*out = make([]*idv1alpha1.PartitionEndpointID, len(*in))
 		for i := range *in {
-			autoConvert_id_PartitionEndpointID_To_v1alpha1_PartitionEndpointID((*in)[i], (*out)[i], s)
+			if (*in)[i] == nil {
+				continue
+			}
+			(*out)[i] = new(idv1alpha1.PartitionEndpointID)
+			if err := autoConvert_id_PartitionEndpointID_To_v1alpha1_PartitionEndpointID((*in)[i], (*out)[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
 		out.Endpoints = nil
@@ -399,7 +411,13 @@ func autoConvert_v1alpha1_LinkSetSpec_To_infra_LinkSetSpec(in *LinkSetSpec, out
 		in, out := &in.Endpoints, &out.Endpoints
 		*out = make([]*id.PartitionEndpointID, len(*in))
 		for i := range *in {
-			autoConvert_v1alpha1_PartitionEndpointID_To_id_PartitionEndpointID((*in)[i], (*out)[i], s)
+			if (*in)[i] == nil {
+				continue
+			}
+			(*out)[i] = new(id.PartitionEndpointID)
+			if err := autoConvert_v1alpha1_PartitionEndpointID_To_id_PartitionEndpointID((*in)[i], (*out)[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
 		out.Endpoints = nil
@@ -407,6 +425,7 @@ func autoConvert_v1alpha1_LinkSetSpec_To_infra_LinkSetSpec(in *LinkSetSpec, out
 	if err := asv1alpha1.Convert_v1alpha1_UserDefinedLabels_To_common_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
 		return err
 	}
+	out.MaxNodes = (*uint32)(unsafe.Pointer(in.MaxNodes))
 	return nil
 }
 
@@ -420,7 +439,13 @@ func autoConvert_infra_LinkSetSpec_To_v1alpha1_LinkSetSpec(in *infra.LinkSetSpec
 		in, out := &in.Endpoints, &out.Endpoints
 		*out = make([]*idv1alpha1.PartitionEndpointID, len(*in))
 		for i := range *in {
-			autoConvert_id_PartitionEndpointID_To_v1alpha1_PartitionEndpointID((*in)[i], (*out)[i], s)
+			if (*in)[i] == nil {
+				continue
+			}
+			(*out)[i] = new(idv1alpha1.PartitionEndpointID)
+			if err := autoConvert_id_PartitionEndpointID_To_v1alpha1_PartitionEndpointID((*in)[i], (*out)[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
 		out.Endpoints = nil
@@ -428,6 +453,7 @@ func autoConvert_infra_LinkSetSpec_To_v1alpha1_LinkSetSpec(in *infra.LinkSetSpec
 	if err := asv1alpha1.Convert_common_UserDefinedLabels_To_v1alpha1_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
 		return err
 	}
+	out.MaxNodes = (*uint32)(unsafe.Pointer(in.MaxNodes))
 	return nil
 }
 
@@ -441,7 +467,13 @@ func autoConvert_infra_LinkSpec_To_v1alpha1_LinkSpec(in *infra.LinkSpec, out *Li
 		in, out := &in.Endpoints, &out.Endpoints
 		*out = make([]*idv1alpha1.PartitionEndpointID, len(*in))
 		for i := range *in {
-			autoConvert_id_PartitionEndpointID_To_v1alpha1_PartitionEndpointID((*in)[i], (*out)[i], s)
+			if (*in)[i] == nil {
+				continue
+			}
+			(*out)[i] = new(idv1alpha1.PartitionEndpointID)
+			if err := autoConvert_id_PartitionEndpointID_To_v1alpha1_PartitionEndpointID((*in)[i], (*out)[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
 		out.Endpoints = nil
@@ -462,7 +494,13 @@ func autoConvert_v1alpha1_LinkSpec_To_infra_LinkSpec(in *LinkSpec, out *infra.Li
 		in, out := &in.Endpoints, &out.Endpoints
 		*out = make([]*id.PartitionEndpointID, len(*in))
 		for i := range *in {
-			autoConvert_v1alpha1_PartitionEndpointID_To_id_PartitionEndpointID((*in)[i], (*out)[i], s)
+			if (*in)[i] == nil {
+				continue
+			}
+			(*out)[i] = new(id.PartitionEndpointID)
+			if err := autoConvert_v1alpha1_PartitionEndpointID_To_id_PartitionEndpointID((*in)[i], (*out)[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
 		out.Endpoints = nil
@@ -32,6 +32,10 @@ type LinkSetSpec struct {
 	// UserDefinedLabels define metadata to the resource.
 	// defined in the spec to distingiush metadata labels from user defined labels
 	commonv1alpha1.UserDefinedLabels `json:",inline" yaml:",inline" protobuf:"bytes,2,opt,name=userDefinedLabels"`
+	// MaxNodes defines the maximum number of nodes the endpoints of the LinkSet can span.
+	// When not specified the LinkSet can span 2 nodes
+	// +optional
+	MaxNodes *uint32 `json:"maxNodes,omitempty" yaml:"maxNodes,omitempty" protobuf:"bytes,3,opt,name=maxNodes"`
 }
 
 // LinkSetStatus defines the observed state of LinkSet
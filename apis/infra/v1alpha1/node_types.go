@@ -32,7 +32,7 @@ type NodeSpec struct {
 	// Rack defines the rack in which the node is deployed
 	// +optional
 	Rack *string `json:"rack,omitempty" protobuf:"bytes,2,opt,name=rack"`
-	// relative position in the rack
+	// Position defines the lowest rack unit (U) the node occupies in the rack, e.g. 10 or U10
 	// +optional
 	Position *string `json:"position,omitempty" protobuf:"bytes,3,opt,name=position"`
 	// Location defines the location information where this resource is located
@@ -48,6 +48,10 @@ type NodeSpec struct {
 	// UserDefinedLabels define metadata to the resource.
 	// defined in the spec to distingiush metadata labels from user defined labels
 	commonv1alpha1.UserDefinedLabels `json:",inline" protobuf:"bytes,8,opt,name=userDefinedLabels"`
+	// Units defines the number of rack units (U) the node occupies in the rack, defaults to 1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Units *uint32 `json:"units,omitempty" protobuf:"varint,9,opt,name=units"`
 
 	// TBD
 	// Serial number
@@ -0,0 +1,126 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	commonv1alpha1 "github.com/kuidio/kuid/apis/common/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PlatformProfileSpec defines the desired state of PlatformProfile
+type PlatformProfileSpec struct {
+	// Provider defines the provider implementing the platform.
+	Provider string `json:"provider" yaml:"provider" protobuf:"bytes,1,opt,name=provider"`
+	// PlatformType defines the type of platform this profile describes.
+	// Nodes with a matching provider and platformType are expanded using this profile.
+	PlatformType string `json:"platformType" yaml:"platformType" protobuf:"bytes,2,opt,name=platformType"`
+	// Ports define the fixed ports of the platform, which are not part of a module
+	// +optional
+	Ports []PortProfile `json:"ports,omitempty" yaml:"ports,omitempty" protobuf:"bytes,3,rep,name=ports"`
+	// ModuleBays define the module bays of the platform
+	// +optional
+	ModuleBays []ModuleBayProfile `json:"moduleBays,omitempty" yaml:"moduleBays,omitempty" protobuf:"bytes,4,rep,name=moduleBays"`
+	// UserDefinedLabels define metadata to the resource.
+	// defined in the spec to distingiush metadata labels from user defined labels
+	commonv1alpha1.UserDefinedLabels `json:",inline" yaml:",inline" protobuf:"bytes,5,opt,name=userDefinedLabels"`
+}
+
+// ModuleBayProfile describes a module bay of the platform and the module deployed in it
+type ModuleBayProfile struct {
+	// ModuleBay defines the id of the moduleBay
+	ModuleBay uint32 `json:"moduleBay" yaml:"moduleBay" protobuf:"bytes,1,opt,name=moduleBay"`
+	// Module defines the id of the module deployed in the moduleBay.
+	// When not specified the ports are attached to the moduleBay directly
+	// +optional
+	Module *uint32 `json:"module,omitempty" yaml:"module,omitempty" protobuf:"bytes,2,opt,name=module"`
+	// Ports define the ports provided by the module
+	// +optional
+	Ports []PortProfile `json:"ports,omitempty" yaml:"ports,omitempty" protobuf:"bytes,3,rep,name=ports"`
+}
+
+// PortProfile describes a range of identical ports
+type PortProfile struct {
+	// Start defines the id of the first port in the range, defaults to 1
+	// +optional
+	Start *uint32 `json:"start,omitempty" yaml:"start,omitempty" protobuf:"bytes,1,opt,name=start"`
+	// Count defines the amount of ports in the range
+	Count uint32 `json:"count" yaml:"count" protobuf:"bytes,2,opt,name=count"`
+	// Speed defines the default speed of the endpoints of the port (Gbps)
+	// +optional
+	Speed *string `json:"speed,omitempty" yaml:"speed,omitempty" protobuf:"bytes,3,opt,name=speed"`
+	// VLANTagging defines if VLAN tagging is enabled on the endpoints of the port
+	// +optional
+	VLANTagging bool `json:"vlanTagging,omitempty" yaml:"vlanTagging,omitempty" protobuf:"bytes,4,opt,name=vlanTagging"`
+	// Breakout defines the breakout adaptor inserted in every port of the range.
+	// When not specified every port provides a single endpoint
+	// +optional
+	Breakout *BreakoutProfile `json:"breakout,omitempty" yaml:"breakout,omitempty" protobuf:"bytes,5,opt,name=breakout"`
+}
+
+// BreakoutProfile describes a breakout adaptor splitting a port in multiple endpoints
+type BreakoutProfile struct {
+	// Adaptor defines the name of the adaptor
+	Adaptor string `json:"adaptor" yaml:"adaptor" protobuf:"bytes,1,opt,name=adaptor"`
+	// Endpoints defines the amount of endpoints the adaptor provides
+	Endpoints uint32 `json:"endpoints" yaml:"endpoints" protobuf:"bytes,2,opt,name=endpoints"`
+	// Speed defines the speed of the breakout endpoints (Gbps), overrides the speed of the port
+	// +optional
+	Speed *string `json:"speed,omitempty" yaml:"speed,omitempty" protobuf:"bytes,3,opt,name=speed"`
+}
+
+// PlatformProfileStatus defines the observed state of PlatformProfile
+type PlatformProfileStatus struct {
+	// ConditionedStatus provides the status of the PlatformProfile using conditions
+	// - a ready condition indicates the overall status of the resource
+	condv1alpha1.ConditionedStatus `json:",inline" yaml:",inline" protobuf:"bytes,1,opt,name=conditionedStatus"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kuid}
+
+// A PlatformProfile describes a device model: its module bays, the ports per module,
+// the breakout adaptors and the default speeds. Every Node referencing the provider and
+// platformType of the profile is expanded in the ModuleBays, Modules, Ports, Adaptors and
+// Endpoints the profile describes.
+// +k8s:openapi-gen=true
+type PlatformProfile struct {
+	metav1.TypeMeta   `json:",inline" yaml:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" yaml:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   PlatformProfileSpec   `json:"spec,omitempty" yaml:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status PlatformProfileStatus `json:"status,omitempty" yaml:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// PlatformProfileList contains a list of PlatformProfiles
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type PlatformProfileList struct {
+	metav1.TypeMeta `json:",inline" yaml:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" yaml:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []PlatformProfile `json:"items" yaml:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+var (
+	PlatformProfileKind     = reflect.TypeOf(PlatformProfile{}).Name()
+	PlatformProfileKindList = reflect.TypeOf(PlatformProfileList{}).Name()
+)
@@ -54,6 +54,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	// +kubebuilder:scaffold:install
 
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&Adaptor{},
+		&AdaptorList{},
 		&Cluster{},
 		&ClusterList{},
 		&Endpoint{},
@@ -76,6 +78,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NodeSetList{},
 		&Partition{},
 		&PartitionList{},
+		&PlatformProfile{},
+		&PlatformProfileList{},
+		&Port{},
+		&PortList{},
 		&Rack{},
 		&RackList{},
 		&Region{},
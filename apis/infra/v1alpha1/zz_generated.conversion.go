@@ -78,6 +78,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BreakoutProfile)(nil), (*infra.BreakoutProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BreakoutProfile_To_infra_BreakoutProfile(a.(*BreakoutProfile), b.(*infra.BreakoutProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infra.BreakoutProfile)(nil), (*BreakoutProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infra_BreakoutProfile_To_v1alpha1_BreakoutProfile(a.(*infra.BreakoutProfile), b.(*BreakoutProfile), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Cluster)(nil), (*infra.Cluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Cluster_To_infra_Cluster(a.(*Cluster), b.(*infra.Cluster), scope)
 	}); err != nil {
@@ -288,6 +298,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ModuleBayProfile)(nil), (*infra.ModuleBayProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ModuleBayProfile_To_infra_ModuleBayProfile(a.(*ModuleBayProfile), b.(*infra.ModuleBayProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infra.ModuleBayProfile)(nil), (*ModuleBayProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infra_ModuleBayProfile_To_v1alpha1_ModuleBayProfile(a.(*infra.ModuleBayProfile), b.(*ModuleBayProfile), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ModuleBaySpec)(nil), (*infra.ModuleBaySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ModuleBaySpec_To_infra_ModuleBaySpec(a.(*ModuleBaySpec), b.(*infra.ModuleBaySpec), scope)
 	}); err != nil {
@@ -498,6 +518,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PlatformProfile)(nil), (*infra.PlatformProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlatformProfile_To_infra_PlatformProfile(a.(*PlatformProfile), b.(*infra.PlatformProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infra.PlatformProfile)(nil), (*PlatformProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infra_PlatformProfile_To_v1alpha1_PlatformProfile(a.(*infra.PlatformProfile), b.(*PlatformProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlatformProfileList)(nil), (*infra.PlatformProfileList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlatformProfileList_To_infra_PlatformProfileList(a.(*PlatformProfileList), b.(*infra.PlatformProfileList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infra.PlatformProfileList)(nil), (*PlatformProfileList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infra_PlatformProfileList_To_v1alpha1_PlatformProfileList(a.(*infra.PlatformProfileList), b.(*PlatformProfileList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlatformProfileSpec)(nil), (*infra.PlatformProfileSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlatformProfileSpec_To_infra_PlatformProfileSpec(a.(*PlatformProfileSpec), b.(*infra.PlatformProfileSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infra.PlatformProfileSpec)(nil), (*PlatformProfileSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infra_PlatformProfileSpec_To_v1alpha1_PlatformProfileSpec(a.(*infra.PlatformProfileSpec), b.(*PlatformProfileSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlatformProfileStatus)(nil), (*infra.PlatformProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlatformProfileStatus_To_infra_PlatformProfileStatus(a.(*PlatformProfileStatus), b.(*infra.PlatformProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infra.PlatformProfileStatus)(nil), (*PlatformProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infra_PlatformProfileStatus_To_v1alpha1_PlatformProfileStatus(a.(*infra.PlatformProfileStatus), b.(*PlatformProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Port)(nil), (*infra.Port)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Port_To_infra_Port(a.(*Port), b.(*infra.Port), scope)
 	}); err != nil {
@@ -518,6 +578,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PortProfile)(nil), (*infra.PortProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PortProfile_To_infra_PortProfile(a.(*PortProfile), b.(*infra.PortProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infra.PortProfile)(nil), (*PortProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infra_PortProfile_To_v1alpha1_PortProfile(a.(*infra.PortProfile), b.(*PortProfile), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PortSpec)(nil), (*infra.PortSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PortSpec_To_infra_PortSpec(a.(*PortSpec), b.(*infra.PortSpec), scope)
 	}); err != nil {
@@ -548,6 +618,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RackElevationEntry)(nil), (*infra.RackElevationEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RackElevationEntry_To_infra_RackElevationEntry(a.(*RackElevationEntry), b.(*infra.RackElevationEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infra.RackElevationEntry)(nil), (*RackElevationEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infra_RackElevationEntry_To_v1alpha1_RackElevationEntry(a.(*infra.RackElevationEntry), b.(*RackElevationEntry), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RackList)(nil), (*infra.RackList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RackList_To_infra_RackList(a.(*RackList), b.(*infra.RackList), scope)
 	}); err != nil {
@@ -919,6 +999,30 @@ func Convert_infra_AdaptorStatus_To_v1alpha1_AdaptorStatus(in *infra.AdaptorStat
 	return autoConvert_infra_AdaptorStatus_To_v1alpha1_AdaptorStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_BreakoutProfile_To_infra_BreakoutProfile(in *BreakoutProfile, out *infra.BreakoutProfile, s conversion.Scope) error {
+	out.Adaptor = in.Adaptor
+	out.Endpoints = in.Endpoints
+	out.Speed = (*string)(unsafe.Pointer(in.Speed))
+	return nil
+}
+
+// Convert_v1alpha1_BreakoutProfile_To_infra_BreakoutProfile is an autogenerated conversion function.
+func Convert_v1alpha1_BreakoutProfile_To_infra_BreakoutProfile(in *BreakoutProfile, out *infra.BreakoutProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BreakoutProfile_To_infra_BreakoutProfile(in, out, s)
+}
+
+func autoConvert_infra_BreakoutProfile_To_v1alpha1_BreakoutProfile(in *infra.BreakoutProfile, out *BreakoutProfile, s conversion.Scope) error {
+	out.Adaptor = in.Adaptor
+	out.Endpoints = in.Endpoints
+	out.Speed = (*string)(unsafe.Pointer(in.Speed))
+	return nil
+}
+
+// Convert_infra_BreakoutProfile_To_v1alpha1_BreakoutProfile is an autogenerated conversion function.
+func Convert_infra_BreakoutProfile_To_v1alpha1_BreakoutProfile(in *infra.BreakoutProfile, out *BreakoutProfile, s conversion.Scope) error {
+	return autoConvert_infra_BreakoutProfile_To_v1alpha1_BreakoutProfile(in, out, s)
+}
+
 func autoConvert_v1alpha1_Cluster_To_infra_Cluster(in *Cluster, out *infra.Cluster, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_ClusterSpec_To_infra_ClusterSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1613,6 +1717,30 @@ func Convert_infra_ModuleBayList_To_v1alpha1_ModuleBayList(in *infra.ModuleBayLi
 	return autoConvert_infra_ModuleBayList_To_v1alpha1_ModuleBayList(in, out, s)
 }
 
+func autoConvert_v1alpha1_ModuleBayProfile_To_infra_ModuleBayProfile(in *ModuleBayProfile, out *infra.ModuleBayProfile, s conversion.Scope) error {
+	out.ModuleBay = in.ModuleBay
+	out.Module = (*uint32)(unsafe.Pointer(in.Module))
+	out.Ports = *(*[]infra.PortProfile)(unsafe.Pointer(&in.Ports))
+	return nil
+}
+
+// Convert_v1alpha1_ModuleBayProfile_To_infra_ModuleBayProfile is an autogenerated conversion function.
+func Convert_v1alpha1_ModuleBayProfile_To_infra_ModuleBayProfile(in *ModuleBayProfile, out *infra.ModuleBayProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ModuleBayProfile_To_infra_ModuleBayProfile(in, out, s)
+}
+
+func autoConvert_infra_ModuleBayProfile_To_v1alpha1_ModuleBayProfile(in *infra.ModuleBayProfile, out *ModuleBayProfile, s conversion.Scope) error {
+	out.ModuleBay = in.ModuleBay
+	out.Module = (*uint32)(unsafe.Pointer(in.Module))
+	out.Ports = *(*[]PortProfile)(unsafe.Pointer(&in.Ports))
+	return nil
+}
+
+// Convert_infra_ModuleBayProfile_To_v1alpha1_ModuleBayProfile is an autogenerated conversion function.
+func Convert_infra_ModuleBayProfile_To_v1alpha1_ModuleBayProfile(in *infra.ModuleBayProfile, out *ModuleBayProfile, s conversion.Scope) error {
+	return autoConvert_infra_ModuleBayProfile_To_v1alpha1_ModuleBayProfile(in, out, s)
+}
+
 func autoConvert_v1alpha1_ModuleBaySpec_To_infra_ModuleBaySpec(in *ModuleBaySpec, out *infra.ModuleBaySpec, s conversion.Scope) error {
 	if err := Convert_v1alpha1_PartitionNodeID_To_id_PartitionNodeID(&in.PartitionNodeID, &out.PartitionNodeID, s); err != nil {
 		return err
@@ -2112,6 +2240,7 @@ func autoConvert_v1alpha1_NodeSpec_To_infra_NodeSpec(in *NodeSpec, out *infra.No
 	if err := asv1alpha1.Convert_v1alpha1_UserDefinedLabels_To_common_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
 		return err
 	}
+	out.Units = (*uint32)(unsafe.Pointer(in.Units))
 	return nil
 }
 
@@ -2133,6 +2262,7 @@ func autoConvert_infra_NodeSpec_To_v1alpha1_NodeSpec(in *infra.NodeSpec, out *No
 	if err := asv1alpha1.Convert_common_UserDefinedLabels_To_v1alpha1_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
 		return err
 	}
+	out.Units = (*uint32)(unsafe.Pointer(in.Units))
 	return nil
 }
 
@@ -2289,6 +2419,136 @@ func Convert_infra_PartitionStatus_To_v1alpha1_PartitionStatus(in *infra.Partiti
 	return autoConvert_infra_PartitionStatus_To_v1alpha1_PartitionStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_PlatformProfile_To_infra_PlatformProfile(in *PlatformProfile, out *infra.PlatformProfile, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_PlatformProfileSpec_To_infra_PlatformProfileSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_PlatformProfileStatus_To_infra_PlatformProfileStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PlatformProfile_To_infra_PlatformProfile is an autogenerated conversion function.
+func Convert_v1alpha1_PlatformProfile_To_infra_PlatformProfile(in *PlatformProfile, out *infra.PlatformProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlatformProfile_To_infra_PlatformProfile(in, out, s)
+}
+
+func autoConvert_infra_PlatformProfile_To_v1alpha1_PlatformProfile(in *infra.PlatformProfile, out *PlatformProfile, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_infra_PlatformProfileSpec_To_v1alpha1_PlatformProfileSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_infra_PlatformProfileStatus_To_v1alpha1_PlatformProfileStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_infra_PlatformProfile_To_v1alpha1_PlatformProfile is an autogenerated conversion function.
+func Convert_infra_PlatformProfile_To_v1alpha1_PlatformProfile(in *infra.PlatformProfile, out *PlatformProfile, s conversion.Scope) error {
+	return autoConvert_infra_PlatformProfile_To_v1alpha1_PlatformProfile(in, out, s)
+}
+
+func autoConvert_v1alpha1_PlatformProfileList_To_infra_PlatformProfileList(in *PlatformProfileList, out *infra.PlatformProfileList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]infra.PlatformProfile, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_PlatformProfile_To_infra_PlatformProfile(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PlatformProfileList_To_infra_PlatformProfileList is an autogenerated conversion function.
+func Convert_v1alpha1_PlatformProfileList_To_infra_PlatformProfileList(in *PlatformProfileList, out *infra.PlatformProfileList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlatformProfileList_To_infra_PlatformProfileList(in, out, s)
+}
+
+func autoConvert_infra_PlatformProfileList_To_v1alpha1_PlatformProfileList(in *infra.PlatformProfileList, out *PlatformProfileList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlatformProfile, len(*in))
+		for i := range *in {
+			if err := Convert_infra_PlatformProfile_To_v1alpha1_PlatformProfile(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_infra_PlatformProfileList_To_v1alpha1_PlatformProfileList is an autogenerated conversion function.
+func Convert_infra_PlatformProfileList_To_v1alpha1_PlatformProfileList(in *infra.PlatformProfileList, out *PlatformProfileList, s conversion.Scope) error {
+	return autoConvert_infra_PlatformProfileList_To_v1alpha1_PlatformProfileList(in, out, s)
+}
+
+func autoConvert_v1alpha1_PlatformProfileSpec_To_infra_PlatformProfileSpec(in *PlatformProfileSpec, out *infra.PlatformProfileSpec, s conversion.Scope) error {
+	out.Provider = in.Provider
+	out.PlatformType = in.PlatformType
+	out.Ports = *(*[]infra.PortProfile)(unsafe.Pointer(&in.Ports))
+	out.ModuleBays = *(*[]infra.ModuleBayProfile)(unsafe.Pointer(&in.ModuleBays))
+	if err := asv1alpha1.Convert_v1alpha1_UserDefinedLabels_To_common_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PlatformProfileSpec_To_infra_PlatformProfileSpec is an autogenerated conversion function.
+func Convert_v1alpha1_PlatformProfileSpec_To_infra_PlatformProfileSpec(in *PlatformProfileSpec, out *infra.PlatformProfileSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlatformProfileSpec_To_infra_PlatformProfileSpec(in, out, s)
+}
+
+func autoConvert_infra_PlatformProfileSpec_To_v1alpha1_PlatformProfileSpec(in *infra.PlatformProfileSpec, out *PlatformProfileSpec, s conversion.Scope) error {
+	out.Provider = in.Provider
+	out.PlatformType = in.PlatformType
+	out.Ports = *(*[]PortProfile)(unsafe.Pointer(&in.Ports))
+	out.ModuleBays = *(*[]ModuleBayProfile)(unsafe.Pointer(&in.ModuleBays))
+	if err := asv1alpha1.Convert_common_UserDefinedLabels_To_v1alpha1_UserDefinedLabels(&in.UserDefinedLabels, &out.UserDefinedLabels, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_infra_PlatformProfileSpec_To_v1alpha1_PlatformProfileSpec is an autogenerated conversion function.
+func Convert_infra_PlatformProfileSpec_To_v1alpha1_PlatformProfileSpec(in *infra.PlatformProfileSpec, out *PlatformProfileSpec, s conversion.Scope) error {
+	return autoConvert_infra_PlatformProfileSpec_To_v1alpha1_PlatformProfileSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_PlatformProfileStatus_To_infra_PlatformProfileStatus(in *PlatformProfileStatus, out *infra.PlatformProfileStatus, s conversion.Scope) error {
+	if err := asv1alpha1.Convert_v1alpha1_ConditionedStatus_To_condition_ConditionedStatus(&in.ConditionedStatus, &out.ConditionedStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PlatformProfileStatus_To_infra_PlatformProfileStatus is an autogenerated conversion function.
+func Convert_v1alpha1_PlatformProfileStatus_To_infra_PlatformProfileStatus(in *PlatformProfileStatus, out *infra.PlatformProfileStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlatformProfileStatus_To_infra_PlatformProfileStatus(in, out, s)
+}
+
+func autoConvert_infra_PlatformProfileStatus_To_v1alpha1_PlatformProfileStatus(in *infra.PlatformProfileStatus, out *PlatformProfileStatus, s conversion.Scope) error {
+	if err := asv1alpha1.Convert_condition_ConditionedStatus_To_v1alpha1_ConditionedStatus(&in.ConditionedStatus, &out.ConditionedStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_infra_PlatformProfileStatus_To_v1alpha1_PlatformProfileStatus is an autogenerated conversion function.
+func Convert_infra_PlatformProfileStatus_To_v1alpha1_PlatformProfileStatus(in *infra.PlatformProfileStatus, out *PlatformProfileStatus, s conversion.Scope) error {
+	return autoConvert_infra_PlatformProfileStatus_To_v1alpha1_PlatformProfileStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_Port_To_infra_Port(in *Port, out *infra.Port, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_PortSpec_To_infra_PortSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2363,6 +2623,34 @@ func Convert_infra_PortList_To_v1alpha1_PortList(in *infra.PortList, out *PortLi
 	return autoConvert_infra_PortList_To_v1alpha1_PortList(in, out, s)
 }
 
+func autoConvert_v1alpha1_PortProfile_To_infra_PortProfile(in *PortProfile, out *infra.PortProfile, s conversion.Scope) error {
+	out.Start = (*uint32)(unsafe.Pointer(in.Start))
+	out.Count = in.Count
+	out.Speed = (*string)(unsafe.Pointer(in.Speed))
+	out.VLANTagging = in.VLANTagging
+	out.Breakout = (*infra.BreakoutProfile)(unsafe.Pointer(in.Breakout))
+	return nil
+}
+
+// Convert_v1alpha1_PortProfile_To_infra_PortProfile is an autogenerated conversion function.
+func Convert_v1alpha1_PortProfile_To_infra_PortProfile(in *PortProfile, out *infra.PortProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PortProfile_To_infra_PortProfile(in, out, s)
+}
+
+func autoConvert_infra_PortProfile_To_v1alpha1_PortProfile(in *infra.PortProfile, out *PortProfile, s conversion.Scope) error {
+	out.Start = (*uint32)(unsafe.Pointer(in.Start))
+	out.Count = in.Count
+	out.Speed = (*string)(unsafe.Pointer(in.Speed))
+	out.VLANTagging = in.VLANTagging
+	out.Breakout = (*BreakoutProfile)(unsafe.Pointer(in.Breakout))
+	return nil
+}
+
+// Convert_infra_PortProfile_To_v1alpha1_PortProfile is an autogenerated conversion function.
+func Convert_infra_PortProfile_To_v1alpha1_PortProfile(in *infra.PortProfile, out *PortProfile, s conversion.Scope) error {
+	return autoConvert_infra_PortProfile_To_v1alpha1_PortProfile(in, out, s)
+}
+
 func autoConvert_v1alpha1_PortSpec_To_infra_PortSpec(in *PortSpec, out *infra.PortSpec, s conversion.Scope) error {
 	if err := Convert_v1alpha1_PartitionPortID_To_id_PartitionPortID(&in.PartitionPortID, &out.PartitionPortID, s); err != nil {
 		return err
@@ -2449,6 +2737,30 @@ func Convert_infra_Rack_To_v1alpha1_Rack(in *infra.Rack, out *Rack, s conversion
 	return autoConvert_infra_Rack_To_v1alpha1_Rack(in, out, s)
 }
 
+func autoConvert_v1alpha1_RackElevationEntry_To_infra_RackElevationEntry(in *RackElevationEntry, out *infra.RackElevationEntry, s conversion.Scope) error {
+	out.Position = in.Position
+	out.Units = in.Units
+	out.Node = in.Node
+	return nil
+}
+
+// Convert_v1alpha1_RackElevationEntry_To_infra_RackElevationEntry is an autogenerated conversion function.
+func Convert_v1alpha1_RackElevationEntry_To_infra_RackElevationEntry(in *RackElevationEntry, out *infra.RackElevationEntry, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RackElevationEntry_To_infra_RackElevationEntry(in, out, s)
+}
+
+func autoConvert_infra_RackElevationEntry_To_v1alpha1_RackElevationEntry(in *infra.RackElevationEntry, out *RackElevationEntry, s conversion.Scope) error {
+	out.Position = in.Position
+	out.Units = in.Units
+	out.Node = in.Node
+	return nil
+}
+
+// Convert_infra_RackElevationEntry_To_v1alpha1_RackElevationEntry is an autogenerated conversion function.
+func Convert_infra_RackElevationEntry_To_v1alpha1_RackElevationEntry(in *infra.RackElevationEntry, out *RackElevationEntry, s conversion.Scope) error {
+	return autoConvert_infra_RackElevationEntry_To_v1alpha1_RackElevationEntry(in, out, s)
+}
+
 func autoConvert_v1alpha1_RackList_To_infra_RackList(in *RackList, out *infra.RackList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -2531,6 +2843,9 @@ func autoConvert_v1alpha1_RackStatus_To_infra_RackStatus(in *RackStatus, out *in
 	if err := asv1alpha1.Convert_v1alpha1_ConditionedStatus_To_condition_ConditionedStatus(&in.ConditionedStatus, &out.ConditionedStatus, s); err != nil {
 		return err
 	}
+	out.UsedUnits = (*uint32)(unsafe.Pointer(in.UsedUnits))
+	out.FreeUnits = (*uint32)(unsafe.Pointer(in.FreeUnits))
+	out.Elevation = *(*[]infra.RackElevationEntry)(unsafe.Pointer(&in.Elevation))
 	return nil
 }
 
@@ -2543,6 +2858,9 @@ func autoConvert_infra_RackStatus_To_v1alpha1_RackStatus(in *infra.RackStatus, o
 	if err := asv1alpha1.Convert_condition_ConditionedStatus_To_v1alpha1_ConditionedStatus(&in.ConditionedStatus, &out.ConditionedStatus, s); err != nil {
 		return err
 	}
+	out.UsedUnits = (*uint32)(unsafe.Pointer(in.UsedUnits))
+	out.FreeUnits = (*uint32)(unsafe.Pointer(in.FreeUnits))
+	out.Elevation = *(*[]RackElevationEntry)(unsafe.Pointer(&in.Elevation))
 	return nil
 }
 
@@ -118,6 +118,26 @@ func (in *AdaptorStatus) DeepCopy() *AdaptorStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BreakoutProfile) DeepCopyInto(out *BreakoutProfile) {
+	*out = *in
+	if in.Speed != nil {
+		in, out := &in.Speed, &out.Speed
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BreakoutProfile.
+func (in *BreakoutProfile) DeepCopy() *BreakoutProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(BreakoutProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
@@ -562,6 +582,11 @@ func (in *LinkSetSpec) DeepCopyInto(out *LinkSetSpec) {
 		}
 	}
 	in.UserDefinedLabels.DeepCopyInto(&out.UserDefinedLabels)
+	if in.MaxNodes != nil {
+		in, out := &in.MaxNodes, &out.MaxNodes
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinkSetSpec.
@@ -769,6 +794,33 @@ func (in *ModuleBayList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleBayProfile) DeepCopyInto(out *ModuleBayProfile) {
+	*out = *in
+	if in.Module != nil {
+		in, out := &in.Module, &out.Module
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]PortProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleBayProfile.
+func (in *ModuleBayProfile) DeepCopy() *ModuleBayProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleBayProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModuleBaySpec) DeepCopyInto(out *ModuleBaySpec) {
 	*out = *in
@@ -1135,6 +1187,11 @@ func (in *NodeSpec) DeepCopyInto(out *NodeSpec) {
 		**out = **in
 	}
 	in.UserDefinedLabels.DeepCopyInto(&out.UserDefinedLabels)
+	if in.Units != nil {
+		in, out := &in.Units, &out.Units
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSpec.
@@ -1259,6 +1316,111 @@ func (in *PartitionStatus) DeepCopy() *PartitionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformProfile) DeepCopyInto(out *PlatformProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformProfile.
+func (in *PlatformProfile) DeepCopy() *PlatformProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlatformProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformProfileList) DeepCopyInto(out *PlatformProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlatformProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformProfileList.
+func (in *PlatformProfileList) DeepCopy() *PlatformProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlatformProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformProfileSpec) DeepCopyInto(out *PlatformProfileSpec) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]PortProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ModuleBays != nil {
+		in, out := &in.ModuleBays, &out.ModuleBays
+		*out = make([]ModuleBayProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.UserDefinedLabels.DeepCopyInto(&out.UserDefinedLabels)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformProfileSpec.
+func (in *PlatformProfileSpec) DeepCopy() *PlatformProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformProfileStatus) DeepCopyInto(out *PlatformProfileStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformProfileStatus.
+func (in *PlatformProfileStatus) DeepCopy() *PlatformProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Port) DeepCopyInto(out *Port) {
 	*out = *in
@@ -1318,6 +1480,36 @@ func (in *PortList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortProfile) DeepCopyInto(out *PortProfile) {
+	*out = *in
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Speed != nil {
+		in, out := &in.Speed, &out.Speed
+		*out = new(string)
+		**out = **in
+	}
+	if in.Breakout != nil {
+		in, out := &in.Breakout, &out.Breakout
+		*out = new(BreakoutProfile)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortProfile.
+func (in *PortProfile) DeepCopy() *PortProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(PortProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortSpec) DeepCopyInto(out *PortSpec) {
 	*out = *in
@@ -1378,6 +1570,21 @@ func (in *Rack) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RackElevationEntry) DeepCopyInto(out *RackElevationEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackElevationEntry.
+func (in *RackElevationEntry) DeepCopy() *RackElevationEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(RackElevationEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RackList) DeepCopyInto(out *RackList) {
 	*out = *in
@@ -1436,6 +1643,21 @@ func (in *RackSpec) DeepCopy() *RackSpec {
 func (in *RackStatus) DeepCopyInto(out *RackStatus) {
 	*out = *in
 	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.UsedUnits != nil {
+		in, out := &in.UsedUnits, &out.UsedUnits
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.FreeUnits != nil {
+		in, out := &in.FreeUnits, &out.FreeUnits
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Elevation != nil {
+		in, out := &in.Elevation, &out.Elevation
+		*out = make([]RackElevationEntry, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackStatus.
@@ -19,23 +19,38 @@ package main
 import (
 	"context"
 	"crypto/tls"
+	"errors"
+	"fmt"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/henderiw/apiserver-builder/pkg/builder"
+	"github.com/henderiw/apiserver-builder/pkg/builder/resource"
+	builderrest "github.com/henderiw/apiserver-builder/pkg/builder/rest"
 	"github.com/henderiw/logger/log"
 	_ "github.com/kuidio/kuid/apis/all"
-	"github.com/kuidio/kuid/pkg/backend"
+	"github.com/kuidio/kuid/pkg/archive"
+	"github.com/kuidio/kuid/pkg/audit"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
 	kuidconfig "github.com/kuidio/kuid/pkg/config"
 	"github.com/kuidio/kuid/pkg/generated/openapi"
+	"github.com/kuidio/kuid/pkg/health"
+	"github.com/kuidio/kuid/pkg/leaderelection"
 	"github.com/kuidio/kuid/pkg/reconcilers"
 	_ "github.com/kuidio/kuid/pkg/reconcilers/all"
 	"github.com/kuidio/kuid/pkg/reconcilers/ctrlconfig"
+	"github.com/spf13/pflag"
 	"go.uber.org/zap/zapcore"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	k8sleaderelection "k8s.io/client-go/tools/leaderelection"
 	"k8s.io/component-base/logs"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -68,16 +83,82 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
-	// if no async we dont have to start any, the reconcilers len will determine this
-	groupReconcilers := map[string]*ReconcilerGroup{}
-	ctrlCfg := &ctrlconfig.ControllerConfig{Backends: map[string]backend.Backend{}}
-	kuidConfig, err := kuidconfig.GetKuidConfig()
+	// the kuid flags are parsed ahead of the apiserver flags, since the config determines
+	// which resources the apiserver serves
+	overrides := &kuidconfig.Overrides{}
+	kuidFlags := pflag.NewFlagSet("kuid", pflag.ContinueOnError)
+	kuidFlags.ParseErrorsWhitelist.UnknownFlags = true
+	overrides.AddFlags(kuidFlags)
+	if err := kuidFlags.Parse(os.Args[1:]); err != nil && !errors.Is(err, pflag.ErrHelp) {
+		log.Error("cannot parse kuid flags", "err", err)
+		os.Exit(1)
+	}
+
+	kuidConfig, err := kuidconfig.GetKuidConfig(overrides)
 	if err != nil {
 		log.Error("cannot get kuid config", "err", err)
 		os.Exit(1)
 	}
+	log.Info("kuid config", "storage", kuidConfig.Storage, "storageDir", kuidConfig.StorageDir)
 
-	registryOptions, err := kuidconfig.GetRegistryOptions(ctx, kuidConfig.Storage)
+	// the health server is started before the storage is opened such that the
+	// readiness reports not ready until the storage and index caches are initialized
+	checker := health.NewChecker()
+	auditLog, err := getAuditLog(kuidConfig.Audit)
+	if err != nil {
+		log.Error("cannot get audit log", "err", err)
+		os.Exit(1)
+	}
+	archiver := getArchiver(kuidConfig.Archive)
+	checker.Start(ctx, kuidConfig.HealthProbeBindAddress)
+
+	if kuidConfig.LeaderElection == nil || !kuidConfig.LeaderElection.Enabled {
+		run(ctx, kuidConfig, kuidFlags, checker, auditLog, archiver, nil)
+		return
+	}
+
+	// active/standby: only the leader opens the storage and restores the backend caches
+	elector, err := getElector(kuidConfig.LeaderElection)
+	if err != nil {
+		log.Error("cannot get leader elector", "err", err)
+		os.Exit(1)
+	}
+	checker.SetStandby(true)
+	log.Info("waiting for leadership", "identity", elector.Identity(), "type", kuidConfig.LeaderElection.Type)
+	elector.Run(ctx, k8sleaderelection.LeaderCallbacks{
+		OnStartedLeading: func(ctx context.Context) {
+			log.Info("started leading", "identity", elector.Identity())
+			checker.SetStandby(false)
+			run(ctx, kuidConfig, kuidFlags, checker, auditLog, archiver, elector)
+		},
+		OnStoppedLeading: func() {
+			// the backend caches are only authoritative on the leader,
+			// restart such that this replica comes back as standby
+			log.Info("stopped leading", "identity", elector.Identity())
+			os.Exit(1)
+		},
+		OnNewLeader: func(identity string) {
+			log.Info("leader elected", "leader", identity)
+		},
+	})
+}
+
+// run opens the storage, restores the backend caches and serves the apiserver and
+// the reconcilers. When the audit log is not nil the backend operations are audited and
+// the audit records are served on the audit subresource of the indexes.
+// When the archiver is not nil the indexes can be exported and imported once restored.
+// When the leader is not nil the backends refuse mutating operations once the
+// leadership is lost.
+func run(ctx context.Context, kuidConfig *kuidconfig.KuidConfig, kuidFlags *pflag.FlagSet, checker *health.Checker, auditLog *audit.FileLog, archiver *archive.Archiver, leader bebackend.LeaderChecker) {
+	log := log.FromContext(ctx)
+
+	kuidConfig.RegisterWebhooks()
+
+	// if no async we dont have to start any, the reconcilers len will determine this
+	groupReconcilers := map[string]*ReconcilerGroup{}
+	ctrlCfg := &ctrlconfig.ControllerConfig{Backends: map[string]bebackend.Backend{}}
+
+	registryOptions, err := kuidconfig.GetRegistryOptions(ctx, kuidConfig)
 	if err != nil {
 		log.Error("cannot get kuid storage registry options", "err", err)
 		os.Exit(1)
@@ -98,29 +179,31 @@ func main() {
 		if !kuidGroupConfig.Enabled {
 			continue
 		}
-		// check if group is registered
-		groupConfig, ok := kuidconfig.Groups[group]
-		if !ok {
-			log.Info("group configured in kuidconfig, but not registered", "group", group)
-			continue
+		// the group is registered, this is validated when the config is read
+		groupConfig := kuidconfig.Groups[group]
+		// the backend is used with all storage types, with etcd the backend
+		// storage is attached once the controller client is available
+		var be bebackend.Backend
+		if groupConfig.BackendFn != nil {
+			be = bebackend.NewHookBackend(groupConfig.BackendFn(), group)
+			if auditLog != nil {
+				be = bebackend.NewAuditBackend(be, group, auditLog)
+			}
+			if leader != nil {
+				be = bebackend.NewLeaderBackend(be, leader)
+			}
+			ctrlCfg.Backends[group] = be
+			bebackend.RegisterCapacity(group, be)
 		}
 		// create the storageProvider
 		if kuidConfig.Storage != kuidconfig.StorageType_Etcd {
-			if groupConfig.BackendFn != nil {
-				be := groupConfig.BackendFn()
-				ctrlCfg.Backends[group] = be
-				for _, resource := range groupConfig.Resources {
-					storageProvider := resource.StorageProviderFn(ctx, resource.Internal, be, kuidGroupConfig.Sync, registryOptions)
-					for _, resourceVersion := range resource.ResourceVersions {
-						apiserver.WithResourceAndHandler(resourceVersion, storageProvider)
-					}
+			for _, resource := range groupConfig.Resources {
+				storageProvider := resource.StorageProviderFn(ctx, resource.Internal, be, kuidGroupConfig.Sync, registryOptions)
+				if resource.Index {
+					addIndexSubresources(storageProvider, group, resource.Internal, auditLog, archiver)
 				}
-			} else {
-				for _, resource := range groupConfig.Resources {
-					storageProvider := resource.StorageProviderFn(ctx, resource.Internal, nil, kuidGroupConfig.Sync, registryOptions)
-					for _, resourceVersion := range resource.ResourceVersions {
-						apiserver.WithResourceAndHandler(resourceVersion, storageProvider)
-					}
+				for _, resourceVersion := range resource.ResourceVersions {
+					apiserver.WithResourceAndHandler(resourceVersion, storageProvider)
 				}
 			}
 		}
@@ -137,7 +220,6 @@ func main() {
 				groupReconcilers[group].reconcilers = append(groupReconcilers[group].reconcilers, reconciler)
 			}
 		}
-		// add the backend -> for etcd this needs to be configmaps -> tbd how we handle this
 	}
 
 	if kuidConfig.Storage != kuidconfig.StorageType_Etcd {
@@ -145,6 +227,8 @@ func main() {
 		if err != nil {
 			panic(err)
 		}
+		// the kuid flags were parsed already, they are added such that the apiserver accepts them
+		cmd.Flags().AddFlagSet(kuidFlags)
 		for _, kuidGroupConfig := range kuidConfig.Groups {
 			group := kuidGroupConfig.Group
 			if !kuidGroupConfig.Enabled {
@@ -163,11 +247,41 @@ func main() {
 				}
 			}
 		}
+		// restore the backend caches of the persisted indexes before serving
+		// such that claims do not depend on the indexes being re-applied
+		for _, kuidGroupConfig := range kuidConfig.Groups {
+			group := kuidGroupConfig.Group
+			if !kuidGroupConfig.Enabled {
+				continue
+			}
+			groupConfig, ok := kuidconfig.Groups[group]
+			if !ok {
+				continue
+			}
+			be, ok := ctrlCfg.Backends[group]
+			if !ok {
+				continue
+			}
+			if err := restoreIndexes(ctx, apiserver, group, be, groupConfig, checker); err != nil {
+				log.Error("cannot restore backend indexes", "group", group, "error", err.Error())
+				os.Exit(1)
+			}
+			checker.SetRestored(group)
+			if archiver != nil {
+				if err := addArchiveGroup(ctx, apiserver, group, groupConfig, archiver); err != nil {
+					log.Error("cannot add archive group", "group", group, "error", err.Error())
+					os.Exit(1)
+				}
+			}
+		}
+		checker.SetStorageReady(registryOptions.DB)
 		go func() {
 			if err := cmd.Execute(); err != nil {
 				panic(err)
 			}
 		}()
+	} else {
+		checker.SetStorageReady(nil)
 	}
 
 	log.Info("groupReconcilers", "total", len(groupReconcilers))
@@ -212,6 +326,14 @@ func main() {
 			log.Error("cannot start manager", "err", err)
 			os.Exit(1)
 		}
+		if kuidConfig.Storage == kuidconfig.StorageType_Etcd {
+			// the index reconcilers restore the backend caches from the CRDs when the
+			// indexes are reconciled after startup
+			if err := applyClientToBackends(ctx, kuidConfig, ctrlCfg, mgr); err != nil {
+				log.Error("cannot apply client to backend", "error", err.Error())
+				os.Exit(1)
+			}
+		}
 		for _, reconcilerGroup := range groupReconcilers {
 			for _, reconciler := range reconcilerGroup.reconcilers {
 				_, err := reconciler.SetupWithManager(ctx, mgr, ctrlCfg)
@@ -225,10 +347,18 @@ func main() {
 			log.Error("unable to set up health check", "error", err.Error())
 			os.Exit(1)
 		}
+		if err := mgr.AddHealthzCheck("backend", checker.Healthz); err != nil {
+			log.Error("unable to set up health check", "error", err.Error())
+			os.Exit(1)
+		}
 		if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
 			log.Error("unable to set up ready check", "error", err.Error())
 			os.Exit(1)
 		}
+		if err := mgr.AddReadyzCheck("backend", checker.Readyz); err != nil {
+			log.Error("unable to set up ready check", "error", err.Error())
+			os.Exit(1)
+		}
 
 		log.Info("starting manager")
 		if err := mgr.Start(ctx); err != nil {
@@ -241,3 +371,200 @@ func main() {
 		}
 	}
 }
+
+// restoreIndexes restores the backend cache of the persisted indexes of the group
+// and registers the indexes of the group with the health checker
+func restoreIndexes(ctx context.Context, apiserver *builder.Server, group string, be bebackend.Backend, groupConfig *kuidconfig.GroupConfig, checker *health.Checker) error {
+	for _, resource := range groupConfig.Resources {
+		if !resource.Index {
+			continue
+		}
+		gr := resource.Internal.GetGroupVersionResource().GroupResource()
+		storage, statusStorage, err := getResourceStorage(ctx, apiserver, resource)
+		if err != nil {
+			return err
+		}
+		indexStore, ok := storage.(rest.Lister)
+		if !ok {
+			return fmt.Errorf("%s storage does not implement rest.Lister", gr.String())
+		}
+		if statusStorage == nil {
+			return fmt.Errorf("%s storage has no status subresource", gr.String())
+		}
+		statusStore, ok := statusStorage.(rest.Updater)
+		if !ok {
+			return fmt.Errorf("%s status storage does not implement rest.Updater", gr.String())
+		}
+		checker.AddGroup(group, indexStore)
+		if err := bebackend.RestoreIndexes(ctx, be, indexStore, statusStore); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getResourceStorage returns the storage and the status subresource storage of the resource,
+// the status storage is nil when the resource has no status subresource
+func getResourceStorage(ctx context.Context, apiserver *builder.Server, resource *kuidconfig.ResourceConfig) (rest.Storage, rest.Storage, error) {
+	gr := resource.Internal.GetGroupVersionResource().GroupResource()
+	storageProvider, ok := apiserver.StorageProvider[gr]
+	if !ok {
+		return nil, nil, fmt.Errorf("storage for %s not registered", gr.String())
+	}
+	storage, err := storageProvider.Get(ctx, apiserver.Schemes[0], &restOptionsGetter{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if storageProvider.Provider.StatusSubResourceStorageProviderFn == nil {
+		return storage, nil, nil
+	}
+	statusStorage, err := storageProvider.Provider.StatusSubResourceStorageProviderFn(apiserver.Schemes[0], storage)
+	if err != nil {
+		return nil, nil, err
+	}
+	return storage, statusStorage, nil
+}
+
+// addArchiveGroup adds the index, claim and entry storage of the group to the archiver,
+// groups without an index are not archived
+func addArchiveGroup(ctx context.Context, apiserver *builder.Server, group string, groupConfig *kuidconfig.GroupConfig, archiver *archive.Archiver) error {
+	scheme := apiserver.Schemes[0]
+	archiveStorage := &archive.Storage{Scheme: scheme}
+	for _, resource := range groupConfig.Resources {
+		if !resource.Index && !resource.Claim && !resource.Entry {
+			continue
+		}
+		gr := resource.Internal.GetGroupVersionResource().GroupResource()
+		storage, statusStorage, err := getResourceStorage(ctx, apiserver, resource)
+		if err != nil {
+			return err
+		}
+		store, ok := storage.(archive.Store)
+		if !ok {
+			return fmt.Errorf("%s storage does not implement the archive store", gr.String())
+		}
+		resourceStorage := &archive.ResourceStorage{Store: store}
+		if statusStore, ok := statusStorage.(rest.Updater); ok {
+			resourceStorage.Status = statusStore
+		}
+		// the objects are archived in the external version
+		for _, resourceVersion := range resource.ResourceVersions {
+			gvks, _, err := scheme.ObjectKinds(resourceVersion)
+			if err != nil {
+				return err
+			}
+			for _, gvk := range gvks {
+				if gvk.Version != runtime.APIVersionInternal {
+					resourceStorage.GVK = gvk
+				}
+			}
+		}
+		switch {
+		case resource.Index:
+			archiveStorage.Index = resourceStorage
+		case resource.Claim:
+			archiveStorage.Claim = resourceStorage
+		case resource.Entry:
+			archiveStorage.Entry = resourceStorage
+		}
+	}
+	if archiveStorage.Index == nil {
+		return nil
+	}
+	return archiver.AddGroup(group, archiveStorage)
+}
+
+// applyClientToBackends attaches the CRD storage to the backends of the enabled groups.
+// A direct client is used as the backend reads its own writes when saving and restoring
+// the index, which the cached manager client does not guarantee.
+func applyClientToBackends(ctx context.Context, kuidConfig *kuidconfig.KuidConfig, ctrlCfg *ctrlconfig.ControllerConfig, mgr manager.Manager) error {
+	c, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme(), Mapper: mgr.GetRESTMapper()})
+	if err != nil {
+		return err
+	}
+	for _, kuidGroupConfig := range kuidConfig.Groups {
+		group := kuidGroupConfig.Group
+		if !kuidGroupConfig.Enabled {
+			continue
+		}
+		groupConfig, ok := kuidconfig.Groups[group]
+		if !ok || groupConfig.ApplyClientToBackendFn == nil {
+			continue
+		}
+		be, ok := ctrlCfg.Backends[group]
+		if !ok {
+			continue
+		}
+		if err := groupConfig.ApplyClientToBackendFn(ctx, be, c); err != nil {
+			return fmt.Errorf("group %s: %w", group, err)
+		}
+	}
+	return nil
+}
+
+var _ generic.RESTOptionsGetter = &restOptionsGetter{}
+
+type restOptionsGetter struct{}
+
+func (r *restOptionsGetter) GetRESTOptions(resource schema.GroupResource, example runtime.Object) (generic.RESTOptions, error) {
+	return generic.RESTOptions{}, nil
+}
+
+// getAuditLog returns the audit log when enabled
+func getAuditLog(cfg *kuidconfig.AuditConfig) (*audit.FileLog, error) {
+	if cfg == nil || !cfg.Enabled {
+		return nil, nil
+	}
+	return audit.NewFileLog(audit.Options{
+		Path:       cfg.Path,
+		MaxSizeMB:  cfg.MaxSizeMB,
+		MaxBackups: cfg.MaxBackups,
+		MaxAgeDays: cfg.MaxAgeDays,
+	})
+}
+
+// addIndexSubresources adds the audit and the archive subresources to the index storage
+// when enabled, the subresources are authorized by the apiserver
+func addIndexSubresources(sp *builderrest.StorageProvider, group string, obj resource.InternalObject, auditLog *audit.FileLog, archiver *archive.Archiver) {
+	if sp.ArbitrarySubresourceHandlerProviders == nil {
+		sp.ArbitrarySubresourceHandlerProviders = map[string]builderrest.SubResourceStorageProviderFn{}
+	}
+	if auditLog != nil {
+		sp.ArbitrarySubresourceHandlerProviders[audit.SubResourceName] = func(scheme *runtime.Scheme, store rest.Storage) (rest.Storage, error) {
+			return audit.NewREST(auditLog, group, obj.New), nil
+		}
+	}
+	if archiver != nil {
+		sp.ArbitrarySubresourceHandlerProviders[archive.SubResourceName] = func(scheme *runtime.Scheme, store rest.Storage) (rest.Storage, error) {
+			return archive.NewREST(archiver, group, obj.New), nil
+		}
+	}
+}
+
+// getArchiver returns the archiver when enabled, the indexes are exported and imported
+// on the archive subresource of the indexes
+func getArchiver(cfg *kuidconfig.ArchiveConfig) *archive.Archiver {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+	return archive.NewArchiver()
+}
+
+func getElector(cfg *kuidconfig.LeaderElectionConfig) (leaderelection.Elector, error) {
+	identity := leaderelection.GetIdentity()
+	switch cfg.Type {
+	case kuidconfig.LeaderElectionType_Lease:
+		return leaderelection.NewLeaseElector(ctrl.GetConfigOrDie(), leaderelection.LeaseConfig{
+			Namespace:     cfg.Namespace,
+			Name:          cfg.Name,
+			Identity:      identity,
+			LeaseDuration: 15 * time.Second,
+			RenewDeadline: 10 * time.Second,
+			RetryPeriod:   2 * time.Second,
+		})
+	case kuidconfig.LeaderElectionType_File:
+		return leaderelection.NewFileElector(cfg.LockFile, identity, 2*time.Second), nil
+	default:
+		return nil, fmt.Errorf("unsupported leader election type %s", cfg.Type)
+	}
+}
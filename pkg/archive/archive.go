@@ -0,0 +1,102 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// APIVersion is the version of the archive format
+	APIVersion = "archive.kuid.dev/v1alpha1"
+	Kind       = "IndexArchive"
+)
+
+type Format string
+
+const (
+	Format_YAML Format = "yaml"
+	Format_JSON Format = "json"
+)
+
+// Archive holds the indexes of the backend groups together with their claims
+// and entries. The objects are stored in the external api version.
+type Archive struct {
+	APIVersion string    `json:"apiVersion"`
+	Kind       string    `json:"kind"`
+	Created    time.Time `json:"created"`
+	Indexes    []*Index  `json:"indexes"`
+}
+
+// Index holds a backend index with the claims and entries of the index
+type Index struct {
+	Group   string                       `json:"group"`
+	Index   *unstructured.Unstructured   `json:"index"`
+	Claims  []*unstructured.Unstructured `json:"claims,omitempty"`
+	Entries []*unstructured.Unstructured `json:"entries,omitempty"`
+}
+
+func New() *Archive {
+	return &Archive{
+		APIVersion: APIVersion,
+		Kind:       Kind,
+		Created:    time.Now().UTC(),
+		Indexes:    []*Index{},
+	}
+}
+
+// Encode writes the archive in the format, yaml is used when no format is provided
+func (r *Archive) Encode(w io.Writer, format Format) error {
+	switch format {
+	case Format_JSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	case Format_YAML, "":
+		b, err := yaml.Marshal(r)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return err
+	default:
+		return fmt.Errorf("unsupported archive format %q, supported: %s, %s", format, Format_YAML, Format_JSON)
+	}
+}
+
+// Decode reads an archive in yaml or json
+func Decode(b []byte) (*Archive, error) {
+	archive := &Archive{}
+	if err := yaml.Unmarshal(b, archive); err != nil {
+		return nil, err
+	}
+	if archive.APIVersion != APIVersion || archive.Kind != Kind {
+		return nil, fmt.Errorf("unsupported archive %s %s, expected %s %s", archive.APIVersion, archive.Kind, APIVersion, Kind)
+	}
+	for _, index := range archive.Indexes {
+		if index.Index == nil {
+			return nil, fmt.Errorf("archive of group %s has no index", index.Group)
+		}
+	}
+	return archive, nil
+}
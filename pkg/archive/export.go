@@ -0,0 +1,203 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// ExportOptions select the indexes that are exported, empty fields select all
+type ExportOptions struct {
+	Group     string
+	Namespace string
+	Index     string
+}
+
+// Export returns the archive of the selected indexes with their claims and entries
+func (r *Archiver) Export(ctx context.Context, opts ExportOptions) (*Archive, error) {
+	groups := r.listGroups()
+	if opts.Group != "" {
+		if _, err := r.getGroup(opts.Group); err != nil {
+			return nil, err
+		}
+		groups = []string{opts.Group}
+	}
+	archive := New()
+	for _, group := range groups {
+		storage, err := r.getGroup(group)
+		if err != nil {
+			return nil, err
+		}
+		indexes, err := storage.exportIndexes(ctx, group, opts)
+		if err != nil {
+			return nil, fmt.Errorf("group %s: %w", group, err)
+		}
+		archive.Indexes = append(archive.Indexes, indexes...)
+	}
+	if opts.Index != "" && len(archive.Indexes) == 0 {
+		gr := schema.GroupResource{Group: opts.Group, Resource: "indexes"}
+		if opts.Group != "" {
+			storage, _ := r.getGroup(opts.Group)
+			gr = storage.Index.groupResource()
+		}
+		return nil, apierrors.NewNotFound(gr, opts.Index)
+	}
+	return archive, nil
+}
+
+func (r *Storage) exportIndexes(ctx context.Context, group string, opts ExportOptions) ([]*Index, error) {
+	indexes, err := list(ctx, r.Index.Store, opts.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	archived := []*Index{}
+	for _, index := range indexes {
+		accessor, err := meta.Accessor(index)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Index != "" && accessor.GetName() != opts.Index {
+			continue
+		}
+		if accessor.GetDeletionTimestamp() != nil {
+			continue
+		}
+		indexArchive, err := r.exportIndex(ctx, group, index, accessor)
+		if err != nil {
+			return nil, fmt.Errorf("index %s/%s: %w", accessor.GetNamespace(), accessor.GetName(), err)
+		}
+		archived = append(archived, indexArchive)
+	}
+	return archived, nil
+}
+
+func (r *Storage) exportIndex(ctx context.Context, group string, index runtime.Object, accessor metav1.Object) (*Index, error) {
+	u, err := r.toExternal(index, r.Index)
+	if err != nil {
+		return nil, err
+	}
+	indexArchive := &Index{
+		Group: group,
+		Index: u,
+	}
+	claims, err := listIndexObjects(ctx, r.Claim.Store, accessor.GetNamespace(), accessor.GetName())
+	if err != nil {
+		return nil, err
+	}
+	for _, claim := range claims {
+		u, err := r.toExternal(claim, r.Claim)
+		if err != nil {
+			return nil, err
+		}
+		indexArchive.Claims = append(indexArchive.Claims, u)
+	}
+	entries, err := listIndexObjects(ctx, r.Entry.Store, accessor.GetNamespace(), accessor.GetName())
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		u, err := r.toExternal(entry, r.Entry)
+		if err != nil {
+			return nil, err
+		}
+		indexArchive.Entries = append(indexArchive.Entries, u)
+	}
+	return indexArchive, nil
+}
+
+// toExternal converts the internal object to the archived version, the system
+// populated metadata is removed
+func (r *Storage) toExternal(obj runtime.Object, resourceStorage *ResourceStorage) (*unstructured.Unstructured, error) {
+	external, err := r.Scheme.ConvertToVersion(obj.DeepCopyObject(), resourceStorage.GVK.GroupVersion())
+	if err != nil {
+		return nil, err
+	}
+	uobj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(external)
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{Object: uobj}
+	u.SetGroupVersionKind(resourceStorage.GVK)
+	clearSystemFields(u)
+	return u, nil
+}
+
+func clearSystemFields(u *unstructured.Unstructured) {
+	u.SetResourceVersion("")
+	u.SetUID("")
+	u.SetGeneration(0)
+	u.SetCreationTimestamp(metav1.Time{})
+	u.SetDeletionTimestamp(nil)
+	u.SetManagedFields(nil)
+}
+
+// list returns the objects of the store in the namespace, all namespaces are listed
+// when the namespace is empty
+func list(ctx context.Context, store Store, namespace string) ([]runtime.Object, error) {
+	if namespace != "" {
+		ctx = genericapirequest.WithNamespace(ctx, namespace)
+	}
+	list, err := store.List(ctx, &internalversion.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return meta.ExtractList(list)
+}
+
+// listIndexObjects returns the claims or entries of the index sorted by name
+func listIndexObjects(ctx context.Context, store Store, namespace, index string) ([]runtime.Object, error) {
+	objs, err := list(ctx, store, namespace)
+	if err != nil {
+		return nil, err
+	}
+	indexObjs := []runtime.Object{}
+	names := map[runtime.Object]string{}
+	for _, obj := range objs {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		if accessor.GetNamespace() != namespace || getIndex(obj) != index {
+			continue
+		}
+		names[obj] = accessor.GetName()
+		indexObjs = append(indexObjs, obj)
+	}
+	sort.SliceStable(indexObjs, func(i, j int) bool {
+		return names[indexObjs[i]] < names[indexObjs[j]]
+	})
+	return indexObjs, nil
+}
+
+func getIndex(obj runtime.Object) string {
+	indexObj, ok := obj.(interface{ GetIndex() string })
+	if !ok {
+		return ""
+	}
+	return indexObj.GetIndex()
+}
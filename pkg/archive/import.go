@@ -0,0 +1,448 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/henderiw/logger/log"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+const fieldManager = "archive"
+
+// ImportOptions remap the namespace and the name of the imported indexes, empty fields
+// keep the archived namespace and name. The index name can only be remapped when the
+// archive holds a single index.
+type ImportOptions struct {
+	Namespace string
+	Index     string
+	// DryRun validates the archive and checks the conflicts without importing the indexes
+	DryRun bool
+}
+
+// Import recreates the indexes of the archive with their claims and entries. The claims keep
+// their allocation: the entries and claims are persisted without being allocated and the
+// backend restores the index from them when the index is created.
+// The archive is rejected when any index, claim or entry exists already, when existing
+// claims or entries refer to an imported index or when an allocation hook denies a claim.
+// The claims that are not owned by the index are subject to the claim quotas.
+func (r *Archiver) Import(ctx context.Context, archive *Archive, opts ImportOptions) error {
+	if opts.Index != "" && len(archive.Indexes) > 1 {
+		return fmt.Errorf("the index can only be remapped for a single index, the archive holds %d indexes", len(archive.Indexes))
+	}
+	imports := make([]*indexImport, 0, len(archive.Indexes))
+	seen := map[string]bool{}
+	for _, indexArchive := range archive.Indexes {
+		storage, err := r.getGroup(indexArchive.Group)
+		if err != nil {
+			return err
+		}
+		imp, err := storage.prepareImport(indexArchive, opts)
+		if err != nil {
+			return fmt.Errorf("group %s index %s/%s: %w", indexArchive.Group, indexArchive.Index.GetNamespace(), indexArchive.Index.GetName(), err)
+		}
+		key := fmt.Sprintf("%s/%s", imp.group, imp.nsn.String())
+		if seen[key] {
+			return fmt.Errorf("group %s index %s is archived more than once", imp.group, imp.nsn.String())
+		}
+		seen[key] = true
+		if err := imp.checkConflicts(ctx); err != nil {
+			return err
+		}
+		if err := imp.preClaim(ctx); err != nil {
+			return err
+		}
+		imports = append(imports, imp)
+	}
+	if opts.DryRun {
+		return nil
+	}
+	// the archive is imported as a whole, the indexes imported before a failed index are
+	// removed again such that the archive can be imported once the failure is resolved
+	for i, imp := range imports {
+		if err := imp.run(ctx); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				imports[j].rollback(ctx)
+			}
+			return fmt.Errorf("group %s index %s: %w", imp.group, imp.nsn.String(), err)
+		}
+	}
+	for _, imp := range imports {
+		imp.postClaim(ctx)
+	}
+	return nil
+}
+
+type indexImport struct {
+	storage *Storage
+	group   string
+	nsn     types.NamespacedName
+	index   runtime.Object
+	// indexClaims are the claims owned by the index, e.g. the reserved ranges, they are
+	// created by the backend and not subject to the quotas and hooks
+	indexClaims []runtime.Object
+	claims      []runtime.Object
+	entries     []runtime.Object
+	// created are the persisted entries and claims, indexCreated is set once the index is
+	// created
+	created      []*createdObject
+	indexCreated bool
+}
+
+func (r *Storage) prepareImport(indexArchive *Index, opts ImportOptions) (*indexImport, error) {
+	remap := newRemapper(indexArchive.Index, opts)
+	imp := &indexImport{
+		storage: r,
+		group:   indexArchive.Group,
+		nsn:     types.NamespacedName{Namespace: remap.namespace, Name: remap.index},
+	}
+	var err error
+	u := indexArchive.Index.DeepCopy()
+	remap.remapIndex(u)
+	if imp.index, err = r.toInternal(u, r.Index); err != nil {
+		return nil, err
+	}
+	// the claims are remapped first, the entries refer to the remapped claim names
+	for _, claim := range indexArchive.Claims {
+		u := claim.DeepCopy()
+		indexOwned, err := remap.remapClaim(u, r.Index.GVK.Kind)
+		if err != nil {
+			return nil, err
+		}
+		obj, err := r.toInternal(u, r.Claim)
+		if err != nil {
+			return nil, err
+		}
+		if indexOwned {
+			imp.indexClaims = append(imp.indexClaims, obj)
+			continue
+		}
+		imp.claims = append(imp.claims, obj)
+	}
+	for _, entry := range indexArchive.Entries {
+		u := entry.DeepCopy()
+		if err := remap.remapEntry(u, r.Index.GVK.Kind); err != nil {
+			return nil, err
+		}
+		obj, err := r.toInternal(u, r.Entry)
+		if err != nil {
+			return nil, err
+		}
+		imp.entries = append(imp.entries, obj)
+	}
+	return imp, nil
+}
+
+// toInternal converts the archived object to the internal version
+func (r *Storage) toInternal(u *unstructured.Unstructured, resourceStorage *ResourceStorage) (runtime.Object, error) {
+	if u.GroupVersionKind() != resourceStorage.GVK {
+		return nil, fmt.Errorf("unexpected object %s %s, expected %s", u.GroupVersionKind().String(), u.GetName(), resourceStorage.GVK.String())
+	}
+	clearSystemFields(u)
+	external, err := r.Scheme.New(resourceStorage.GVK)
+	if err != nil {
+		return nil, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), external); err != nil {
+		return nil, fmt.Errorf("invalid %s %s: %w", resourceStorage.GVK.Kind, u.GetName(), err)
+	}
+	return r.Scheme.ConvertToVersion(external, schema.GroupVersion{Group: resourceStorage.GVK.Group, Version: runtime.APIVersionInternal})
+}
+
+// checkConflicts validates the index, claims and entries do not exist and no existing
+// claims or entries refer to the index, since they would be restored in the index
+func (r *indexImport) checkConflicts(ctx context.Context) error {
+	ctx = genericapirequest.WithNamespace(ctx, r.nsn.Namespace)
+	if err := notExists(ctx, r.storage.Index, r.index); err != nil {
+		return err
+	}
+	for _, claim := range append(r.indexClaims, r.claims...) {
+		if err := notExists(ctx, r.storage.Claim, claim); err != nil {
+			return err
+		}
+	}
+	for _, entry := range r.entries {
+		if err := notExists(ctx, r.storage.Entry, entry); err != nil {
+			return err
+		}
+	}
+	for _, resourceStorage := range []*ResourceStorage{r.storage.Claim, r.storage.Entry} {
+		objs, err := listIndexObjects(ctx, resourceStorage.Store, r.nsn.Namespace, r.nsn.Name)
+		if err != nil {
+			return err
+		}
+		if len(objs) != 0 {
+			return apierrors.NewConflict(r.storage.Index.groupResource(), r.nsn.Name,
+				fmt.Errorf("%d existing %s refer to the index", len(objs), resourceStorage.groupResource().Resource))
+		}
+	}
+	return nil
+}
+
+// preClaim invokes the PreClaim hooks of the group for the claims, a denied claim rejects
+// the import
+func (r *indexImport) preClaim(ctx context.Context) error {
+	for _, claim := range r.claims {
+		if err := bebackend.PreClaimHooks(ctx, r.group, claim); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// postClaim notifies the PostClaim hooks of the group with the imported allocations
+func (r *indexImport) postClaim(ctx context.Context) {
+	for _, claim := range r.claims {
+		bebackend.PostClaimHooks(ctx, r.group, claim)
+	}
+}
+
+func notExists(ctx context.Context, resourceStorage *ResourceStorage, obj runtime.Object) error {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	if _, err := resourceStorage.Store.Get(ctx, accessor.GetName(), &metav1.GetOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return apierrors.NewAlreadyExists(resourceStorage.groupResource(), accessor.GetName())
+}
+
+// run persists the entries and claims without allocating them and creates the index last,
+// such that the backend restores the index cache from the persisted entries and claims.
+// The claims owned by the index are persisted first as they count towards the quotas, the
+// other claims are checked against the quotas when they are persisted.
+// The persisted entries and claims are removed when the import fails.
+func (r *indexImport) run(ctx context.Context) (err error) {
+	log := log.FromContext(ctx).With("group", r.group, "index", r.nsn.String())
+	ctx = genericapirequest.WithNamespace(ctx, r.nsn.Namespace)
+	importCtx := bebackend.WithImport(ctx)
+
+	defer func() {
+		if err != nil {
+			r.rollback(ctx)
+		}
+	}()
+
+	for _, entry := range r.entries {
+		if err := r.create(importCtx, r.storage.Entry, entry, true); err != nil {
+			return err
+		}
+	}
+	for _, claim := range r.indexClaims {
+		if err := r.create(importCtx, r.storage.Claim, claim, true); err != nil {
+			return err
+		}
+	}
+	for _, claim := range r.claims {
+		if err := r.create(importCtx, r.storage.Claim, claim, false); err != nil {
+			return err
+		}
+	}
+	// the index is created through the backend, which restores the index cache
+	if _, err := r.storage.Index.Store.Create(ctx, r.index.DeepCopyObject(), nil, &metav1.CreateOptions{
+		FieldManager: fieldManager,
+	}); err != nil {
+		return err
+	}
+	r.indexCreated = true
+	log.Info("index imported", "claims", len(r.indexClaims)+len(r.claims), "entries", len(r.entries))
+	return nil
+}
+
+// create persists the object and records it for the rollback
+func (r *indexImport) create(ctx context.Context, resourceStorage *ResourceStorage, obj runtime.Object, recursion bool) error {
+	c, err := create(ctx, resourceStorage, obj, recursion)
+	if c != nil {
+		r.created = append(r.created, c)
+	}
+	return err
+}
+
+// rollback removes the imported index, claims and entries. The index is deleted through
+// the backend, which removes the index cache; the claims and entries are removed without
+// being released as they were persisted without being allocated.
+func (r *indexImport) rollback(ctx context.Context) {
+	log := log.FromContext(ctx).With("group", r.group, "index", r.nsn.String())
+	ctx = genericapirequest.WithNamespace(ctx, r.nsn.Namespace)
+	importCtx := bebackend.WithImport(ctx)
+
+	if r.indexCreated {
+		if _, _, err := r.storage.Index.Store.Delete(ctx, r.nsn.Name, nil, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			log.Error("cannot remove imported index", "error", err.Error())
+		}
+		r.indexCreated = false
+	}
+	for i := len(r.created) - 1; i >= 0; i-- {
+		if _, _, err := r.created[i].storage.Store.Delete(importCtx, r.created[i].name, nil, &metav1.DeleteOptions{
+			DryRun: []string{"recursion"},
+		}); err != nil && !apierrors.IsNotFound(err) {
+			log.Error("cannot remove imported object", "name", r.created[i].name, "error", err.Error())
+		}
+	}
+	r.created = nil
+}
+
+type createdObject struct {
+	storage *ResourceStorage
+	name    string
+}
+
+// create persists the object, the status is reset on create and restored afterwards.
+// Objects that are not created as recursion are validated by the invokers, e.g. the
+// claim quotas.
+func create(ctx context.Context, resourceStorage *ResourceStorage, obj runtime.Object, recursion bool) (*createdObject, error) {
+	opts := &metav1.CreateOptions{FieldManager: fieldManager}
+	if recursion {
+		opts.DryRun = []string{"recursion"}
+	}
+	newObj, err := resourceStorage.Store.Create(ctx, obj.DeepCopyObject(), nil, opts)
+	if err != nil {
+		return nil, err
+	}
+	newAccessor, err := meta.Accessor(newObj)
+	if err != nil {
+		return nil, err
+	}
+	c := &createdObject{storage: resourceStorage, name: newAccessor.GetName()}
+	if resourceStorage.Status == nil {
+		return c, nil
+	}
+	obj = obj.DeepCopyObject()
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return c, err
+	}
+	accessor.SetResourceVersion(newAccessor.GetResourceVersion())
+	accessor.SetUID(newAccessor.GetUID())
+	accessor.SetCreationTimestamp(newAccessor.GetCreationTimestamp())
+	if _, _, err := resourceStorage.Status.Update(ctx, accessor.GetName(), rest.DefaultUpdatedObjectInfo(obj), nil, nil, false, &metav1.UpdateOptions{
+		FieldManager: fieldManager,
+	}); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// remapper remaps the namespace and the index name of the archived objects.
+// The names of the entries and of the claims owned by the index are derived from the
+// index name and, for ip entries, the namespace; they are renamed accordingly.
+type remapper struct {
+	oldNamespace string
+	namespace    string
+	oldIndex     string
+	index        string
+	// claimNames holds the renamed claims
+	claimNames map[string]string
+}
+
+func newRemapper(index *unstructured.Unstructured, opts ImportOptions) *remapper {
+	r := &remapper{
+		oldNamespace: index.GetNamespace(),
+		namespace:    index.GetNamespace(),
+		oldIndex:     index.GetName(),
+		index:        index.GetName(),
+		claimNames:   map[string]string{},
+	}
+	if opts.Namespace != "" {
+		r.namespace = opts.Namespace
+	}
+	if opts.Index != "" {
+		r.index = opts.Index
+	}
+	return r
+}
+
+func (r *remapper) remapIndex(u *unstructured.Unstructured) {
+	u.SetNamespace(r.namespace)
+	u.SetName(r.index)
+}
+
+// remapClaim remaps the claim and returns true when the claim is owned by the index
+func (r *remapper) remapClaim(u *unstructured.Unstructured, indexKind string) (bool, error) {
+	u.SetNamespace(r.namespace)
+	indexOwned := false
+	for _, ref := range u.GetOwnerReferences() {
+		if ref.Kind == indexKind {
+			name := r.name(u.GetName())
+			r.claimNames[u.GetName()] = name
+			u.SetName(name)
+			indexOwned = true
+			break
+		}
+	}
+	r.remapOwnerReferences(u, indexKind)
+	return indexOwned, r.remapSpecIndex(u)
+}
+
+func (r *remapper) remapEntry(u *unstructured.Unstructured, indexKind string) error {
+	u.SetNamespace(r.namespace)
+	u.SetName(r.name(u.GetName()))
+	r.remapOwnerReferences(u, indexKind)
+	return r.remapSpecIndex(u)
+}
+
+func (r *remapper) remapOwnerReferences(u *unstructured.Unstructured, indexKind string) {
+	refs := u.GetOwnerReferences()
+	for i := range refs {
+		if refs[i].Kind == indexKind && refs[i].Name == r.oldIndex {
+			refs[i].Name = r.index
+			continue
+		}
+		if name, ok := r.claimNames[refs[i].Name]; ok {
+			refs[i].Name = name
+		}
+	}
+	u.SetOwnerReferences(refs)
+}
+
+func (r *remapper) remapSpecIndex(u *unstructured.Unstructured) error {
+	index, found, err := unstructured.NestedString(u.Object, "spec", "index")
+	if err != nil {
+		return err
+	}
+	if !found || index != r.oldIndex {
+		return fmt.Errorf("%s %s does not refer to index %s", u.GetKind(), u.GetName(), r.oldIndex)
+	}
+	return unstructured.SetNestedField(u.Object, r.index, "spec", "index")
+}
+
+// name renames the names prefixed with the namespace and index or with the index
+func (r *remapper) name(name string) string {
+	if prefix := fmt.Sprintf("%s.%s.", r.oldNamespace, r.oldIndex); strings.HasPrefix(name, prefix) {
+		return fmt.Sprintf("%s.%s.%s", r.namespace, r.index, strings.TrimPrefix(name, prefix))
+	}
+	if prefix := r.oldIndex + "."; strings.HasPrefix(name, prefix) {
+		return fmt.Sprintf("%s.%s", r.index, strings.TrimPrefix(name, prefix))
+	}
+	return name
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+const SubResourceName = "archive"
+
+// maxArchiveSize limits the size of an imported archive
+const maxArchiveSize = 256 << 20
+
+var _ rest.Storage = &REST{}
+var _ rest.Connecter = &REST{}
+
+// REST implements the archive subresource of the indexes of a backend group, the export
+// and the import of an index are authorized on the subresource of the index
+//
+// GET .../<indexes>/<name>/archive[?format=yaml|json] exports the index with its claims and entries
+// POST .../<indexes>/<name>/archive[?dryRun=true] imports the archive of a single index of the
+// group as the index of the request path
+type REST struct {
+	archiver *Archiver
+	group    string
+	newFn    func() runtime.Object
+}
+
+func NewREST(archiver *Archiver, group string, newFn func() runtime.Object) *REST {
+	return &REST{
+		archiver: archiver,
+		group:    group,
+		newFn:    newFn,
+	}
+}
+
+// New implements rest.Storage
+func (r *REST) New() runtime.Object {
+	return r.newFn()
+}
+
+// Destroy implements rest.Storage
+func (r *REST) Destroy() {}
+
+// ConnectMethods implements rest.Connecter
+func (r *REST) ConnectMethods() []string {
+	return []string{http.MethodGet, http.MethodPost}
+}
+
+// NewConnectOptions implements rest.Connecter, the query parameters are parsed from the request
+func (r *REST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect implements rest.Connecter
+func (r *REST) Connect(ctx context.Context, name string, _ runtime.Object, responder rest.Responder) (http.Handler, error) {
+	namespace, ok := genericapirequest.NamespaceFrom(ctx)
+	if !ok || namespace == "" {
+		return nil, apierrors.NewBadRequest("namespace is required")
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			r.export(ctx, w, req, namespace, name, responder)
+		case http.MethodPost:
+			r.importIndex(ctx, w, req, namespace, name, responder)
+		default:
+			responder.Error(apierrors.NewMethodNotSupported(schema.GroupResource{Group: r.group, Resource: SubResourceName}, req.Method))
+		}
+	}), nil
+}
+
+func (r *REST) export(ctx context.Context, w http.ResponseWriter, req *http.Request, namespace, name string, responder rest.Responder) {
+	format := Format(req.URL.Query().Get("format"))
+	archive, err := r.archiver.Export(ctx, ExportOptions{
+		Group:     r.group,
+		Namespace: namespace,
+		Index:     name,
+	})
+	if err != nil {
+		responder.Error(toAPIError(err))
+		return
+	}
+	buf := &bytes.Buffer{}
+	if err := archive.Encode(buf, format); err != nil {
+		responder.Error(apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	if format == Format_JSON {
+		w.Header().Set("Content-Type", "application/json")
+	} else {
+		w.Header().Set("Content-Type", "application/yaml")
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+func (r *REST) importIndex(ctx context.Context, w http.ResponseWriter, req *http.Request, namespace, name string, responder rest.Responder) {
+	opts := ImportOptions{
+		Namespace: namespace,
+		Index:     name,
+	}
+	if dryRun := req.URL.Query().Get("dryRun"); dryRun != "" {
+		b, err := strconv.ParseBool(dryRun)
+		if err != nil {
+			responder.Error(apierrors.NewBadRequest(fmt.Sprintf("invalid dryRun %q", dryRun)))
+			return
+		}
+		opts.DryRun = b
+	}
+	b, err := io.ReadAll(io.LimitReader(req.Body, maxArchiveSize))
+	if err != nil {
+		responder.Error(apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	archive, err := Decode(b)
+	if err != nil {
+		responder.Error(apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	if len(archive.Indexes) != 1 {
+		responder.Error(apierrors.NewBadRequest(fmt.Sprintf("the archive must hold a single index, got %d", len(archive.Indexes))))
+		return
+	}
+	if archive.Indexes[0].Group != r.group {
+		responder.Error(apierrors.NewBadRequest(fmt.Sprintf("the archive holds an index of group %s, expected %s", archive.Indexes[0].Group, r.group)))
+		return
+	}
+	if err := r.archiver.Import(ctx, archive, opts); err != nil {
+		responder.Error(toAPIError(err))
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if opts.DryRun {
+		_, _ = fmt.Fprintf(w, "validated index %s/%s\n", namespace, name)
+		return
+	}
+	_, _ = fmt.Fprintf(w, "imported index %s/%s\n", namespace, name)
+}
+
+// toAPIError returns the status of the wrapped api status error with the message of
+// the error, other errors are bad requests
+func toAPIError(err error) error {
+	var status apierrors.APIStatus
+	if errors.As(err, &status) {
+		statusErr := &apierrors.StatusError{ErrStatus: status.Status()}
+		statusErr.ErrStatus.Message = err.Error()
+		return statusErr
+	}
+	return apierrors.NewBadRequest(err.Error())
+}
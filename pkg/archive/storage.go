@@ -0,0 +1,113 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// Store is the storage of a resource used to export and import the objects
+type Store interface {
+	rest.Getter
+	rest.Lister
+	rest.Creater
+	rest.GracefulDeleter
+}
+
+// ResourceStorage is the storage of the index, claim or entry resource of a backend group
+type ResourceStorage struct {
+	// GVK is the external version the objects are archived in
+	GVK   schema.GroupVersionKind
+	Store Store
+	// Status is the status subresource storage, the status of the imported objects is
+	// restored when set
+	Status rest.Updater
+}
+
+func (r *ResourceStorage) groupResource() schema.GroupResource {
+	plural, _ := meta.UnsafeGuessKindToResource(r.GVK)
+	return plural.GroupResource()
+}
+
+// Storage is the storage of a backend group
+type Storage struct {
+	Scheme *runtime.Scheme
+	Index  *ResourceStorage
+	Claim  *ResourceStorage
+	Entry  *ResourceStorage
+}
+
+func (r *Storage) validate() error {
+	if r.Scheme == nil || r.Index == nil || r.Claim == nil || r.Entry == nil {
+		return fmt.Errorf("the scheme and the index, claim and entry storage are required")
+	}
+	return nil
+}
+
+// Archiver exports and imports the indexes of the backend groups from and to the storage
+type Archiver struct {
+	m      sync.RWMutex
+	groups map[string]*Storage
+}
+
+func NewArchiver() *Archiver {
+	return &Archiver{
+		groups: map[string]*Storage{},
+	}
+}
+
+// AddGroup adds the storage of a backend group, the group can be archived once
+// the storage is added
+func (r *Archiver) AddGroup(group string, storage *Storage) error {
+	if err := storage.validate(); err != nil {
+		return fmt.Errorf("group %s: %w", group, err)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.groups[group] = storage
+	return nil
+}
+
+func (r *Archiver) getGroup(group string) (*Storage, error) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	storage, ok := r.groups[group]
+	if !ok {
+		return nil, apierrors.NewServiceUnavailable(fmt.Sprintf("group %s is not available for archiving", group))
+	}
+	return storage, nil
+}
+
+// listGroups returns the groups in alphabetical order
+func (r *Archiver) listGroups() []string {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	groups := make([]string, 0, len(r.groups))
+	for group := range r.groups {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	return groups
+}
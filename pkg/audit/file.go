@@ -0,0 +1,166 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/henderiw/logger/log"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	// DefaultQueryLimit is the number of records a query returns when no limit is provided
+	DefaultQueryLimit = 1000
+	// MaxQueryLimit is the maximum number of records a query returns
+	MaxQueryLimit = 10000
+)
+
+type Options struct {
+	// Path of the audit log file, the rotated files are stored in the same directory
+	Path string
+	// MaxSizeMB is the size of the audit log file before it is rotated
+	MaxSizeMB int
+	// MaxBackups is the number of rotated files that are retained
+	MaxBackups int
+	// MaxAgeDays is the number of days the rotated files are retained
+	MaxAgeDays int
+}
+
+var _ bebackend.Auditor = &FileLog{}
+
+// FileLog is an append-only audit log stored as json lines in a local file
+// with size based rotation and retention
+type FileLog struct {
+	m      sync.Mutex
+	path   string
+	writer *lumberjack.Logger
+}
+
+func NewFileLog(opts Options) (*FileLog, error) {
+	if err := os.MkdirAll(filepath.Dir(opts.Path), 0755); err != nil {
+		return nil, err
+	}
+	return &FileLog{
+		path: opts.Path,
+		writer: &lumberjack.Logger{
+			Filename:   opts.Path,
+			MaxSize:    opts.MaxSizeMB,
+			MaxBackups: opts.MaxBackups,
+			MaxAge:     opts.MaxAgeDays,
+			LocalTime:  false,
+			Compress:   false,
+		},
+	}, nil
+}
+
+// Record appends the record to the audit log, a failing write is logged
+// and does not fail the backend operation
+func (r *FileLog) Record(ctx context.Context, record *bebackend.AuditRecord) {
+	b, err := json.Marshal(record)
+	if err != nil {
+		log.FromContext(ctx).Error("cannot marshal audit record", "error", err.Error())
+		return
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	if _, err := r.writer.Write(append(b, '\n')); err != nil {
+		log.FromContext(ctx).Error("cannot write audit record", "error", err.Error())
+	}
+}
+
+func (r *FileLog) Close() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.writer.Close()
+}
+
+// Query calls fn with the last filter.Limit records that match the filter from the rotated
+// and the current audit log file, oldest first. The default limit applies when no limit is
+// provided. The files are read without blocking the writer, a rotation during the query can
+// skip the records of the rotated file.
+func (r *FileLog) Query(filter *Filter, fn func(record *bebackend.AuditRecord) error) error {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultQueryLimit
+	}
+	// the last matching records are retained in a ring buffer, hence the memory is bound
+	// by the limit and not by the size of the audit log
+	ring := make([]*bebackend.AuditRecord, limit)
+	n := 0
+	for _, file := range r.files() {
+		if err := readFile(file, filter, func(record *bebackend.AuditRecord) {
+			ring[n%limit] = record
+			n++
+		}); err != nil {
+			return err
+		}
+	}
+	start := 0
+	if n > limit {
+		start = n - limit
+	}
+	for i := start; i < n; i++ {
+		if err := fn(ring[i%limit]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// files returns the rotated files, oldest first, followed by the current file
+func (r *FileLog) files() []string {
+	ext := filepath.Ext(r.path)
+	prefix := strings.TrimSuffix(r.path, ext) + "-"
+	backups, _ := filepath.Glob(prefix + "*" + ext)
+	// the rotated files carry a timestamp hence the lexical order is the time order
+	sort.Strings(backups)
+	return append(backups, r.path)
+}
+
+func readFile(path string, filter *Filter, fn func(record *bebackend.AuditRecord)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		record := &bebackend.AuditRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			// a partially written record is skipped
+			continue
+		}
+		if filter.Matches(record) {
+			fn(record)
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+)
+
+func newTestLog(t *testing.T) *FileLog {
+	t.Helper()
+	auditLog, err := NewFileLog(Options{Path: filepath.Join(t.TempDir(), "audit", "audit.log"), MaxSizeMB: 1})
+	if err != nil {
+		t.Fatalf("cannot create audit log: %v", err)
+	}
+	t.Cleanup(func() { _ = auditLog.Close() })
+	return auditLog
+}
+
+func record(auditLog *FileLog, n int, index string) {
+	for i := 0; i < n; i++ {
+		auditLog.Record(context.Background(), &bebackend.AuditRecord{
+			Group:     "as.be.kuid.dev",
+			Operation: bebackend.AuditOperationClaim,
+			Namespace: "default",
+			Index:     index,
+			Name:      fmt.Sprintf("claim%d", i),
+			Result:    "success",
+		})
+	}
+}
+
+func queryNames(t *testing.T, auditLog *FileLog, filter *Filter) []string {
+	t.Helper()
+	names := []string{}
+	if err := auditLog.Query(filter, func(record *bebackend.AuditRecord) error {
+		names = append(names, record.Name)
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return names
+}
+
+func TestQuery(t *testing.T) {
+	auditLog := newTestLog(t)
+	record(auditLog, 5, "a")
+	record(auditLog, 3, "b")
+
+	cases := map[string]struct {
+		filter *Filter
+		want   []string
+	}{
+		"Index": {
+			filter: &Filter{Index: "b"},
+			want:   []string{"claim0", "claim1", "claim2"},
+		},
+		"LastRecords": {
+			filter: &Filter{Index: "a", Limit: 2},
+			want:   []string{"claim3", "claim4"},
+		},
+		"LimitAboveMatches": {
+			filter: &Filter{Index: "b", Limit: 10},
+			want:   []string{"claim0", "claim1", "claim2"},
+		},
+		"NoMatch": {
+			filter: &Filter{Index: "c"},
+			want:   []string{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, queryNames(t, auditLog, tc.filter)); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestQueryDefaultLimit(t *testing.T) {
+	auditLog := newTestLog(t)
+	record(auditLog, DefaultQueryLimit+5, "a")
+
+	names := queryNames(t, auditLog, &Filter{})
+	if len(names) != DefaultQueryLimit {
+		t.Fatalf("want %d records, got %d", DefaultQueryLimit, len(names))
+	}
+	if names[0] != "claim5" {
+		t.Errorf("want the last records starting with claim5, got %s", names[0])
+	}
+}
+
+// TestQueryDoesNotBlockWriter validates a query does not take the writer lock
+func TestQueryDoesNotBlockWriter(t *testing.T) {
+	auditLog := newTestLog(t)
+	record(auditLog, 2, "a")
+
+	auditLog.m.Lock()
+	defer auditLog.m.Unlock()
+	done := make(chan []string)
+	go func() {
+		names := []string{}
+		_ = auditLog.Query(&Filter{}, func(record *bebackend.AuditRecord) error {
+			names = append(names, record.Name)
+			return nil
+		})
+		done <- names
+	}()
+	select {
+	case names := <-done:
+		if len(names) != 2 {
+			t.Errorf("want 2 records, got %d", len(names))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("query is blocked by the writer lock")
+	}
+}
+
+func TestFilterFromQuery(t *testing.T) {
+	cases := map[string]struct {
+		query       string
+		want        *Filter
+		expectedErr bool
+	}{
+		"Empty": {
+			query: "",
+			want:  &Filter{},
+		},
+		"Fields": {
+			query: "name=claim1&operation=claim&user=alice&result=failure&limit=10",
+			want:  &Filter{Name: "claim1", Operation: "claim", User: "alice", Result: "failure", Limit: 10},
+		},
+		"Since": {
+			query: "since=2024-01-02T03:04:05Z",
+			want:  &Filter{Since: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		},
+		"InvalidSince": {
+			query:       "since=yesterday",
+			expectedErr: true,
+		},
+		"NegativeLimit": {
+			query:       "limit=-1",
+			expectedErr: true,
+		},
+		"LimitAboveMax": {
+			query:       fmt.Sprintf("limit=%d", MaxQueryLimit+1),
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			values, err := url.ParseQuery(tc.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			filter, err := FilterFromQuery(values)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, filter); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+)
+
+// Filter selects audit records, empty fields match all records
+type Filter struct {
+	Group     string
+	Namespace string
+	Name      string
+	Index     string
+	Operation string
+	User      string
+	Result    string
+	Since     time.Time
+	// Limit returns the last n matching records, DefaultQueryLimit when not set
+	Limit int
+}
+
+func (r *Filter) Matches(record *bebackend.AuditRecord) bool {
+	if r.Group != "" && r.Group != record.Group {
+		return false
+	}
+	if r.Namespace != "" && r.Namespace != record.Namespace {
+		return false
+	}
+	if r.Name != "" && r.Name != record.Name {
+		return false
+	}
+	if r.Index != "" && r.Index != record.Index {
+		return false
+	}
+	if r.Operation != "" && r.Operation != record.Operation {
+		return false
+	}
+	if r.User != "" && r.User != record.User {
+		return false
+	}
+	if r.Result != "" && r.Result != record.Result {
+		return false
+	}
+	if !r.Since.IsZero() && record.Time.Before(r.Since) {
+		return false
+	}
+	return true
+}
+
+// FilterFromQuery builds a filter from the query parameters: group, namespace, name, index,
+// operation, user, result, since (RFC3339) and limit (at most MaxQueryLimit)
+func FilterFromQuery(values url.Values) (*Filter, error) {
+	filter := &Filter{
+		Group:     values.Get("group"),
+		Namespace: values.Get("namespace"),
+		Name:      values.Get("name"),
+		Index:     values.Get("index"),
+		Operation: values.Get("operation"),
+		User:      values.Get("user"),
+		Result:    values.Get("result"),
+	}
+	if since := values.Get("since"); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since %q: %w", since, err)
+		}
+		filter.Since = t
+	}
+	if limit := values.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 0 || n > MaxQueryLimit {
+			return nil, fmt.Errorf("invalid limit %q, the limit must be between 0 and %d", limit, MaxQueryLimit)
+		}
+		filter.Limit = n
+	}
+	return filter, nil
+}
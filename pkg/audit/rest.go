@@ -0,0 +1,123 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+const SubResourceName = "audit"
+
+var _ rest.Storage = &REST{}
+var _ rest.Connecter = &REST{}
+
+// REST implements the read-only audit subresource of the indexes of a backend group,
+// access to the audit records is authorized on the subresource of the index
+//
+// GET .../<indexes>/<name>/audit[?name=][&operation=][&user=][&result=][&since=<RFC3339>][&limit=]
+//
+// The records of the index are returned in json, oldest first. The records of a deleted
+// index remain available as long as they are retained.
+type REST struct {
+	log   *FileLog
+	group string
+	newFn func() runtime.Object
+}
+
+func NewREST(log *FileLog, group string, newFn func() runtime.Object) *REST {
+	return &REST{
+		log:   log,
+		group: group,
+		newFn: newFn,
+	}
+}
+
+// New implements rest.Storage
+func (r *REST) New() runtime.Object {
+	return r.newFn()
+}
+
+// Destroy implements rest.Storage
+func (r *REST) Destroy() {}
+
+// ConnectMethods implements rest.Connecter
+func (r *REST) ConnectMethods() []string {
+	return []string{http.MethodGet}
+}
+
+// NewConnectOptions implements rest.Connecter, the query parameters are parsed from the request
+func (r *REST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect implements rest.Connecter, the group, namespace and index of the filter are
+// set from the request path
+func (r *REST) Connect(ctx context.Context, name string, _ runtime.Object, responder rest.Responder) (http.Handler, error) {
+	namespace, ok := genericapirequest.NamespaceFrom(ctx)
+	if !ok || namespace == "" {
+		return nil, apierrors.NewBadRequest("namespace is required")
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		filter, err := FilterFromQuery(req.URL.Query())
+		if err != nil {
+			responder.Error(apierrors.NewBadRequest(err.Error()))
+			return
+		}
+		filter.Group = r.group
+		filter.Namespace = namespace
+		filter.Index = name
+
+		// the records are streamed as a json array, the files are read before the first
+		// record is returned hence a read error is reported before the response is written
+		enc := json.NewEncoder(w)
+		written := false
+		if err := r.log.Query(filter, func(record *bebackend.AuditRecord) error {
+			sep := ","
+			if !written {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				sep = "["
+				written = true
+			}
+			if _, err := io.WriteString(w, sep); err != nil {
+				return err
+			}
+			return enc.Encode(record)
+		}); err != nil {
+			if !written {
+				responder.Error(apierrors.NewInternalError(err))
+			}
+			return
+		}
+		if !written {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = io.WriteString(w, "[]\n")
+			return
+		}
+		_, _ = io.WriteString(w, "]\n")
+	}), nil
+}
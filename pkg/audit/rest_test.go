@@ -0,0 +1,117 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kuidio/kuid/apis/backend/as"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+type responder struct {
+	err error
+}
+
+func (r *responder) Object(statusCode int, obj runtime.Object) {}
+
+func (r *responder) Error(err error) { r.err = err }
+
+func TestREST(t *testing.T) {
+	auditLog := newTestLog(t)
+	record(auditLog, 3, "a")
+	record(auditLog, 1, "b")
+	// a record of the same index in another namespace is not returned
+	auditLog.Record(context.Background(), &bebackend.AuditRecord{Group: "as.be.kuid.dev", Namespace: "other", Index: "a", Name: "other"})
+
+	rest := NewREST(auditLog, "as.be.kuid.dev", func() runtime.Object { return &as.ASIndex{} })
+
+	cases := map[string]struct {
+		index      string
+		query      string
+		want       []string
+		badRequest bool
+	}{
+		"Index": {
+			index: "a",
+			want:  []string{"claim0", "claim1", "claim2"},
+		},
+		"Limit": {
+			index: "a",
+			query: "?limit=1",
+			want:  []string{"claim2"},
+		},
+		"IndexCannotBeOverridden": {
+			index: "b",
+			query: "?index=a&namespace=other",
+			want:  []string{"claim0"},
+		},
+		"NoRecords": {
+			index: "c",
+			want:  []string{},
+		},
+		"InvalidLimit": {
+			index:      "a",
+			query:      "?limit=x",
+			badRequest: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := genericapirequest.WithNamespace(context.Background(), "default")
+			rsp := &responder{}
+			handler, err := rest.Connect(ctx, tc.index, nil, rsp)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/audit"+tc.query, nil))
+			if tc.badRequest {
+				if rsp.err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if rsp.err != nil {
+				t.Fatalf("unexpected error: %v", rsp.err)
+			}
+			records := []*bebackend.AuditRecord{}
+			if err := json.Unmarshal(w.Body.Bytes(), &records); err != nil {
+				t.Fatalf("invalid json %q: %v", w.Body.String(), err)
+			}
+			names := []string{}
+			for _, record := range records {
+				names = append(names, record.Name)
+			}
+			if diff := cmp.Diff(tc.want, names); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := rest.Connect(context.Background(), "a", nil, &responder{}); err == nil {
+		t.Errorf("expected an error without namespace")
+	}
+}
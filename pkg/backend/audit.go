@@ -0,0 +1,137 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+const (
+	AuditOperationClaim       = "claim"
+	AuditOperationRelease     = "release"
+	AuditOperationCreateIndex = "createIndex"
+	AuditOperationDeleteIndex = "deleteIndex"
+)
+
+// AuditRecord is the audit trail record of a backend operation
+type AuditRecord struct {
+	Time      time.Time `json:"time"`
+	Group     string    `json:"group"`
+	Operation string    `json:"operation"`
+	// User is the user of the apiserver request, empty for operations of the reconcilers
+	User      string `json:"user,omitempty"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid,omitempty"`
+	Index     string `json:"index"`
+	// Requested holds the spec of the claim or index
+	Requested map[string]any `json:"requested,omitempty"`
+	// Allocated holds the status of the claim without the conditions
+	Allocated map[string]any `json:"allocated,omitempty"`
+	Result    string         `json:"result"`
+	Error     string         `json:"error,omitempty"`
+}
+
+// Auditor records the audit trail of the backend operations
+type Auditor interface {
+	Record(ctx context.Context, record *AuditRecord)
+}
+
+// NewAuditBackend returns a backend that records every claim, release, index create and
+// index delete with its result in the audit trail
+func NewAuditBackend(be Backend, group string, auditor Auditor) Backend {
+	return &auditBackend{
+		Backend: be,
+		group:   group,
+		auditor: auditor,
+	}
+}
+
+type auditBackend struct {
+	Backend
+	group   string
+	auditor Auditor
+}
+
+func (r *auditBackend) CreateIndex(ctx context.Context, obj runtime.Object) error {
+	err := r.Backend.CreateIndex(ctx, obj)
+	r.record(ctx, AuditOperationCreateIndex, obj, err)
+	return err
+}
+
+func (r *auditBackend) DeleteIndex(ctx context.Context, obj runtime.Object) error {
+	err := r.Backend.DeleteIndex(ctx, obj)
+	r.record(ctx, AuditOperationDeleteIndex, obj, err)
+	return err
+}
+
+func (r *auditBackend) Claim(ctx context.Context, obj runtime.Object, recursion bool) error {
+	err := r.Backend.Claim(ctx, obj, recursion)
+	r.record(ctx, AuditOperationClaim, obj, err)
+	return err
+}
+
+func (r *auditBackend) Release(ctx context.Context, obj runtime.Object, recursion bool) error {
+	err := r.Backend.Release(ctx, obj, recursion)
+	r.record(ctx, AuditOperationRelease, obj, err)
+	return err
+}
+
+func (r *auditBackend) record(ctx context.Context, operation string, obj runtime.Object, err error) {
+	record := &AuditRecord{
+		Time:      time.Now().UTC(),
+		Group:     r.group,
+		Operation: operation,
+		Kind:      reflect.TypeOf(obj).Elem().Name(),
+		Result:    MetricResultSuccess,
+	}
+	if user, ok := genericapirequest.UserFrom(ctx); ok {
+		record.User = user.GetName()
+	}
+	if accessor, aerr := meta.Accessor(obj); aerr == nil {
+		record.Namespace = accessor.GetNamespace()
+		record.Name = accessor.GetName()
+		record.UID = string(accessor.GetUID())
+		record.Index = accessor.GetName()
+	}
+	if indexObj, ok := obj.(interface{ GetIndex() string }); ok {
+		record.Index = indexObj.GetIndex()
+	}
+	if u, cerr := runtime.DefaultUnstructuredConverter.ToUnstructured(obj); cerr == nil {
+		if spec, ok := u["spec"].(map[string]any); ok {
+			record.Requested = spec
+		}
+		if status, ok := u["status"].(map[string]any); ok {
+			delete(status, "conditions")
+			if len(status) != 0 {
+				record.Allocated = status
+			}
+		}
+	}
+	if err != nil {
+		record.Result = MetricResultFailure
+		record.Error = err.Error()
+	}
+	r.auditor.Record(ctx, record)
+}
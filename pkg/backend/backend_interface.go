@@ -27,6 +27,9 @@ type Backend interface {
 	AddStorageInterfaces(bestorage any) error
 	// CreateIndex creates a backend index
 	CreateIndex(ctx context.Context, obj runtime.Object) error
+	// RestoreIndex restores the cache of a backend index from the stored entries and claims
+	// when it is not yet initialized, the result is reflected in the index conditions
+	RestoreIndex(ctx context.Context, obj runtime.Object) error
 	// DeleteIndex deletes a backend index
 	DeleteIndex(ctx context.Context, obj runtime.Object) error
 	// Claim claims an entry in the backend index
@@ -35,4 +38,6 @@ type Backend interface {
 	Release(ctx context.Context, obj runtime.Object, recursion bool) error
 	// PrintEntries prints the entries of the cache
 	PrintEntries(ctx context.Context, index string)
+	// IndexCapacity returns the allocated and free capacity of the initialized indexes
+	IndexCapacity(ctx context.Context) []IndexCapacity
 }
@@ -30,6 +30,7 @@ type Cache[T1 any] interface {
 	Get(ctx context.Context, k store.Key) (T1, error)
 	Create(ctx context.Context, k store.Key, i T1)
 	Delete(ctx context.Context, k store.Key)
+	List(ctx context.Context, fn func(k store.Key, i T1))
 }
 
 func NewCache[T1 any]() Cache[T1] {
@@ -50,6 +51,15 @@ func (r *cache[T1]) Delete(ctx context.Context, k store.Key) {
 	_ = r.store.Delete(k)
 }
 
+// List calls fn for every initialized cache
+func (r *cache[T1]) List(ctx context.Context, fn func(k store.Key, i T1)) {
+	r.store.List(func(k store.Key, cacheInstance *cacheInstance[T1]) {
+		if cacheInstance.IsInitialized() {
+			fn(k, cacheInstance.instance)
+		}
+	})
+}
+
 // Get returns the cache; the initialized flag can be used to return a cache even if not initialized
 func (r *cache[T1]) Get(ctx context.Context, k store.Key) (T1, error) {
 	cacheInstance, err := r.store.Get(k)
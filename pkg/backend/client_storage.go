@@ -0,0 +1,150 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/henderiw/logger/log"
+	"github.com/henderiw/store"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IndexedObject is an entry or claim of a backend index
+type IndexedObject interface {
+	client.Object
+	GetIndex() string
+}
+
+// ClientStorage persists the entries and claims of the backend indexes as CRDs.
+// It is shared by the generic and the ipam backend storage using their entry type E
+// and claim type C.
+type ClientStorage[E, C IndexedObject] struct {
+	entryStore *ClientStore
+	claimStore *ClientStore
+}
+
+func NewClientStorage[E, C IndexedObject](entryStore, claimStore *ClientStore) *ClientStorage[E, C] {
+	return &ClientStorage[E, C]{
+		entryStore: entryStore,
+		claimStore: claimStore,
+	}
+}
+
+// ListEntries returns the entries of the index identified by the key,
+// only the namespace of the index is listed
+func (r *ClientStorage[E, C]) ListEntries(ctx context.Context, k store.Key) ([]E, error) {
+	log := log.FromContext(ctx).With("key", k.String())
+	items, err := r.entryStore.List(ctx, client.InNamespace(k.Namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	entryList := make([]E, 0)
+	var errm error
+	for _, obj := range items {
+		entryObj, ok := obj.(E)
+		if !ok {
+			log.Error("unexpected entry object", "obj", reflect.TypeOf(obj).String())
+			errm = errors.Join(errm, fmt.Errorf("unexpected entry object %T", obj))
+			continue
+		}
+		if entryObj.GetIndex() == k.Name {
+			entryList = append(entryList, entryObj)
+		}
+	}
+	return entryList, errm
+}
+
+func (r *ClientStorage[E, C]) CreateEntry(ctx context.Context, obj E) error {
+	return r.entryStore.Create(ctx, obj, client.FieldOwner("backend"))
+}
+
+func (r *ClientStorage[E, C]) UpdateEntry(ctx context.Context, obj, old E) error {
+	return r.entryStore.Update(ctx, obj, old, client.FieldOwner("backend"))
+}
+
+func (r *ClientStorage[E, C]) DeleteEntry(ctx context.Context, obj E) error {
+	return client.IgnoreNotFound(r.entryStore.Delete(ctx, obj))
+}
+
+// ListClaims returns the claims of the index identified by the key keyed by their
+// namespaced name, only the namespace of the index is listed. When ownerKind is not
+// empty only the claims owned by the kind are returned.
+func (r *ClientStorage[E, C]) ListClaims(ctx context.Context, k store.Key, ownerKind string) (map[string]C, error) {
+	log := log.FromContext(ctx).With("key", k.String())
+	log.Debug("list claims from storage")
+	items, err := r.claimStore.List(ctx, client.InNamespace(k.Namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	claimMap := make(map[string]C)
+	var errm error
+	for _, obj := range items {
+		claimObj, ok := obj.(C)
+		if !ok {
+			log.Error("unexpected claim object", "obj", reflect.TypeOf(obj).String())
+			errm = errors.Join(errm, fmt.Errorf("unexpected claim object %T", obj))
+			continue
+		}
+		if claimObj.GetIndex() != k.Name {
+			continue
+		}
+		if ownerKind != "" {
+			for _, ownerref := range claimObj.GetOwnerReferences() {
+				if ownerref.Kind == ownerKind {
+					claimMap[client.ObjectKeyFromObject(claimObj).String()] = claimObj
+				}
+			}
+		} else {
+			claimMap[client.ObjectKeyFromObject(claimObj).String()] = claimObj
+		}
+	}
+	return claimMap, errm
+}
+
+// CreateClaim creates the claim CR, the claim reconciler claims the entry in the backend
+func (r *ClientStorage[E, C]) CreateClaim(ctx context.Context, obj C) error {
+	log := log.FromContext(ctx)
+	if err := r.claimStore.Create(ctx, obj, client.FieldOwner("backend")); err != nil {
+		log.Error("create claim failed", "name", obj.GetName(), "error", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (r *ClientStorage[E, C]) UpdateClaim(ctx context.Context, obj, old C) error {
+	log := log.FromContext(ctx)
+	if err := r.claimStore.Update(ctx, obj, old, client.FieldOwner("backend")); err != nil {
+		log.Error("update claim failed", "name", obj.GetName(), "error", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (r *ClientStorage[E, C]) DeleteClaim(ctx context.Context, obj C) error {
+	log := log.FromContext(ctx)
+	if err := client.IgnoreNotFound(r.claimStore.Delete(ctx, obj)); err != nil {
+		log.Error("cannot delete claim", "error", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ClientStore persists the internal backend objects as CRDs using a controller-runtime client.
+// The internal objects are converted to the versioned kind using the scheme, the scheme
+// must hold the internal and versioned types and their conversions.
+type ClientStore struct {
+	client client.Client
+	scheme *runtime.Scheme
+	gvk    schema.GroupVersionKind
+	// status indicates the status subresource is written by the store
+	status bool
+}
+
+// NewClientStore returns a ClientStore for the versioned kind, when status is true
+// the status subresource is written after a create or update
+func NewClientStore(c client.Client, scheme *runtime.Scheme, gvk schema.GroupVersionKind, status bool) *ClientStore {
+	return &ClientStore{
+		client: c,
+		scheme: scheme,
+		gvk:    gvk,
+		status: status,
+	}
+}
+
+// List returns the objects of the kind as internal objects, by default the objects
+// of all namespaces are returned
+func (r *ClientStore) List(ctx context.Context, opts ...client.ListOption) ([]runtime.Object, error) {
+	obj, err := r.scheme.New(r.gvk.GroupVersion().WithKind(r.gvk.Kind + "List"))
+	if err != nil {
+		return nil, err
+	}
+	list, ok := obj.(client.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("%s list is not a client.ObjectList", r.gvk.Kind)
+	}
+	if err := r.client.List(ctx, list, opts...); err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		intObj, err := r.toInternal(item)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, intObj)
+	}
+	return objs, nil
+}
+
+// Create creates the object, the CRDs use the status subresource hence
+// the status is written separately
+func (r *ClientStore) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOption) error {
+	extObj, err := r.toVersioned(obj)
+	if err != nil {
+		return err
+	}
+	status := extObj.DeepCopyObject().(client.Object)
+	if err := r.client.Create(ctx, extObj, opts...); err != nil {
+		return err
+	}
+	if !r.status {
+		return nil
+	}
+	status.SetResourceVersion(extObj.GetResourceVersion())
+	status.SetUID(extObj.GetUID())
+	return r.client.Status().Update(ctx, status)
+}
+
+// Update updates the object, the resourceVersion and uid are taken
+// from the old object
+func (r *ClientStore) Update(ctx context.Context, obj, old runtime.Object, opts ...client.UpdateOption) error {
+	extObj, err := r.toVersioned(obj)
+	if err != nil {
+		return err
+	}
+	oldAccessor, err := meta.Accessor(old)
+	if err != nil {
+		return err
+	}
+	extObj.SetResourceVersion(oldAccessor.GetResourceVersion())
+	extObj.SetUID(oldAccessor.GetUID())
+	status := extObj.DeepCopyObject().(client.Object)
+	if err := r.client.Update(ctx, extObj, opts...); err != nil {
+		return err
+	}
+	if !r.status {
+		return nil
+	}
+	status.SetResourceVersion(extObj.GetResourceVersion())
+	return r.client.Status().Update(ctx, status)
+}
+
+// Delete deletes the object
+func (r *ClientStore) Delete(ctx context.Context, obj runtime.Object, opts ...client.DeleteOption) error {
+	extObj, err := r.toVersioned(obj)
+	if err != nil {
+		return err
+	}
+	return r.client.Delete(ctx, extObj, opts...)
+}
+
+func (r *ClientStore) toVersioned(obj runtime.Object) (client.Object, error) {
+	extObj, err := r.scheme.New(r.gvk)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.scheme.Convert(obj, extObj, nil); err != nil {
+		return nil, err
+	}
+	cObj, ok := extObj.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a client.Object", r.gvk.Kind)
+	}
+	cObj.GetObjectKind().SetGroupVersionKind(r.gvk)
+	return cObj, nil
+}
+
+func (r *ClientStore) toInternal(obj runtime.Object) (runtime.Object, error) {
+	intObj, err := r.scheme.New(schema.GroupVersionKind{
+		Group:   r.gvk.Group,
+		Version: runtime.APIVersionInternal,
+		Kind:    r.gvk.Kind,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := r.scheme.Convert(obj, intObj, nil); err != nil {
+		return nil, err
+	}
+	return intObj, nil
+}
@@ -68,3 +68,18 @@ func InitClaimContext(ctx context.Context, op string, claim backend.ClaimObject)
 	}
 	return log.IntoContext(ctx, l)
 }
+
+type importKey struct{}
+
+// WithImport marks the context of the storage operations that persist imported claims.
+// The claims are not allocated in the backend, the backend restores them from the stored
+// entries and claims when the index is created.
+func WithImport(ctx context.Context) context.Context {
+	return context.WithValue(ctx, importKey{}, true)
+}
+
+// IsImport returns true when the context is marked by WithImport
+func IsImport(ctx context.Context) bool {
+	imported, _ := ctx.Value(importKey{}).(bool)
+	return imported
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/henderiw/idxtable/pkg/table"
 	"github.com/henderiw/idxtable/pkg/tree"
@@ -133,11 +134,9 @@ func isReserved(parentName, index string) bool {
 	// given we use the ownerreference with index in the kind the parentName and index
 	// match when we check for reserved fields
 	// This means a range cannot be defined using the name of the index
-	return parentName == index
-	/*
-		return parentName == fmt.Sprintf("%s.%s", index, backend.IndexReservedMaxName) ||
-			parentName == fmt.Sprintf("%s.%s", index, backend.IndexReservedMinName)
-	*/
+	// The ranges the index reserves, e.g. the min and max ranges, cannot be claimed from
+	return parentName == index ||
+		strings.HasPrefix(parentName, fmt.Sprintf("%s.%s", index, backend.IndexReservedName))
 }
 
 func (r *applicator) getExistingCLaimSet(ctx context.Context, claim backend.ClaimObject) (map[string]tree.ID, sets.Set[string], error) {
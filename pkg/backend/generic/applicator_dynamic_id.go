@@ -24,6 +24,7 @@ import (
 	"github.com/henderiw/store"
 	"github.com/kform-dev/choreo/apis/condition"
 	"github.com/kuidio/kuid/apis/backend"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
 	"k8s.io/utils/ptr"
 )
 
@@ -132,9 +133,9 @@ func (r *dynamicApplicator) Apply(ctx context.Context, claim backend.ClaimObject
 			return nil
 
 		}
-		e, err := r.cacheInstanceCtx.tree.ClaimFree(claim.GetClaimLabels())
+		e, err := r.cacheInstanceCtx.claimFree(claim)
 		if err != nil {
-			return fmt.Errorf("claimed failed, no claim ID found err: %s", err)
+			return bebackend.NewExhaustedError(fmt.Errorf("claimed failed, no claim ID found err: %s", err))
 		}
 		claimID = ptr.To[uint64](e.ID().ID())
 		claim.SetStatusID(claimID)
@@ -168,7 +169,7 @@ func (r *dynamicApplicator) Apply(ctx context.Context, claim backend.ClaimObject
 	}
 	e, err := table.ClaimFree(claim.GetClaimLabels())
 	if err != nil {
-		return fmt.Errorf("claimed failed, no claim ID found err: %s", err)
+		return bebackend.NewExhaustedError(fmt.Errorf("claimed failed, no claim ID found err: %s", err))
 	}
 	claimID = ptr.To[uint64](e.ID().ID())
 	claim.SetStatusID(claimID)
@@ -33,7 +33,7 @@ type staticApplicator struct {
 
 func (r *staticApplicator) Validate(ctx context.Context, claim backend.ClaimObject) error {
 	//log := log.FromContext(ctx)
-	if claim.GetStaticID() == nil {
+	if r.getStaticID(claim) == nil {
 		return fmt.Errorf("cannot claim a static id without an id")
 	}
 
@@ -96,16 +96,16 @@ func (r *staticApplicator) Apply(ctx context.Context, claim backend.ClaimObject)
 			return fmt.Errorf("selectAddress range does not have corresponding range table: err: %s", err.Error())
 		}
 		if claimID != nil {
-			if err := table.Update(*claim.GetStaticID(), claim.GetClaimLabels()); err != nil {
+			if err := table.Update(*r.getStaticID(claim), claim.GetClaimLabels()); err != nil {
 				return err
 			}
 		} else {
-			if err := table.Claim(*claim.GetStaticID(), claim.GetClaimLabels()); err != nil {
+			if err := table.Claim(*r.getStaticID(claim), claim.GetClaimLabels()); err != nil {
 				return err
 			}
 		}
 	}
-	claim.SetStatusID(claim.GetStaticID())
+	claim.SetStatusID(r.getStaticID(claim))
 	claim.SetConditions(condition.Ready())
 	return nil
 }
@@ -122,7 +122,7 @@ func (r *staticApplicator) validateExists(ctx context.Context, claim backend.Cla
 		return nil, "", err
 	}
 
-	claimID, claimTreeName := reclaimIDFromExisitingEntries(existingEntries, *claim.GetStaticID())
+	claimID, claimTreeName := reclaimIDFromExisitingEntries(existingEntries, *r.getStaticID(claim))
 	// remove the existing entries that don't match the claimed ID
 	// should be none, but just in case
 	if err := r.deleteNonClaimedEntries(ctx, existingEntries, claimID, claimTreeName); err != nil {
@@ -131,6 +131,15 @@ func (r *staticApplicator) validateExists(ctx context.Context, claim backend.Cla
 	return claimID, claimTreeName, nil
 }
 
+// getStaticID returns the static id of the claim, resolved with the type of the index for
+// the claims whose static id depends on the type of the index
+func (r *staticApplicator) getStaticID(claim backend.ClaimObject) *uint64 {
+	if typedClaim, ok := claim.(backend.TypedStaticIDClaimObject); ok {
+		return typedClaim.GetTypedStaticID(r.cacheInstanceCtx.Type())
+	}
+	return claim.GetStaticID()
+}
+
 func (r *staticApplicator) getParentContext(ctx context.Context, claim backend.ClaimObject) (string, error) {
 	log := log.FromContext(ctx).With("name", claim.GetName())
 
@@ -51,6 +51,9 @@ func (r *rangeApplicator) Validate(ctx context.Context, claim backend.ClaimObjec
 		if err := r.validateRangeOverlap(ctx, claim); err != nil {
 			return err
 		}
+		if err := r.validateRangeChildren(ctx, claim); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -78,31 +81,71 @@ func (r *rangeApplicator) validateChange(ctx context.Context, claim backend.Clai
 	return false, nil
 }
 
+// validateRangeOverlap validates the segments of the range do not overlap with the ids
+// claimed by other claims, the entries of the tree are compared as runs of ids
 func (r *rangeApplicator) validateRangeOverlap(_ context.Context, claim backend.ClaimObject) error {
-	arange, err := claim.GetRangeID(r.cacheInstanceCtx.Type())
+	aranges, err := claim.GetRangeIDs(r.cacheInstanceCtx.Type())
 	if err != nil {
 		return err
 	}
+	overlaps := false
+	for _, arange := range aranges {
+		overlaps = overlaps || r.cacheInstanceCtx.tree.overlaps(arange.From().ID(), arange.To().ID())
+	}
+	if !overlaps {
+		return nil
+	}
+	// the entries are only scanned to find the claims the range overlaps with
 	var errm error
-	for _, id := range arange.IDs() {
-		childEntries := r.cacheInstanceCtx.tree.Children(id)
-		if len(childEntries) != 0 {
-			errm = errors.Join(errm, fmt.Errorf("range id %s overlaps with children: %v", id.String(), childEntries))
+	for _, entry := range r.cacheInstanceCtx.tree.GetAll() {
+		if claim.IsOwner(entry.Labels()) {
+			continue
 		}
-		parentEntries := r.cacheInstanceCtx.tree.Parents(id)
-		if len(parentEntries) > 0 {
-			for _, entry := range parentEntries {
-				if !claim.IsOwner(entry.Labels()) {
-					errm = errors.Join(errm, fmt.Errorf("range id %s overlaps with parent: %v", id.String(), parentEntries))
-				}
+		run := newIDRun(entry)
+		for _, arange := range aranges {
+			if run.overlaps(arange.From().ID(), arange.To().ID()) {
+				errm = errors.Join(errm, fmt.Errorf("range %s overlaps with ids %s claimed by %s",
+					arange.String(), backend.RangeEntryID(run.from, run.to), entry.Labels()[backend.KuidClaimNameKey]))
 			}
 		}
 	}
 	return errm
 }
 
+// validateRangeChildren validates the ids claimed from an existing range still fit in the
+// segments of the changed range
+func (r *rangeApplicator) validateRangeChildren(_ context.Context, claim backend.ClaimObject) error {
+	table, err := r.cacheInstanceCtx.ranges.Get(store.ToKey(claim.GetName()))
+	if err != nil {
+		// the range does not exist yet
+		return nil
+	}
+	aranges, err := claim.GetRangeIDs(r.cacheInstanceCtx.Type())
+	if err != nil {
+		return err
+	}
+	idRanges := backend.GetIDRanges(aranges)
+	var errm error
+	for _, entry := range table.GetAll() {
+		if !fitsIDRanges(idRanges, entry.ID().ID()) {
+			errm = errors.Join(errm, fmt.Errorf("id %d claimed by %s does not fit in the range %s",
+				entry.ID().ID(), entry.Labels()[backend.KuidClaimNameKey], *claim.GetRange()))
+		}
+	}
+	return errm
+}
+
+func fitsIDRanges(ranges []backend.IDRange, id uint64) bool {
+	for _, r := range ranges {
+		if id >= r.From && id <= r.To {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *rangeApplicator) Apply(ctx context.Context, claim backend.ClaimObject) error {
-	arange, err := claim.GetRangeID(r.cacheInstanceCtx.Type())
+	aranges, err := claim.GetRangeIDs(r.cacheInstanceCtx.Type())
 	if err != nil {
 		return err
 	}
@@ -137,12 +180,23 @@ func (r *rangeApplicator) Apply(ctx context.Context, claim backend.ClaimObject)
 	}
 
 	k := store.ToKey(claim.GetName())
-	if _, err := r.cacheInstanceCtx.ranges.Get(k); err != nil {
-		//table := table.New(uint32(arange.From().ID()), uint32(arange.To().ID()))
-		table := claim.GetTable(r.cacheInstanceCtx.Type(), arange.From().ID(), arange.To().ID())
+	table := claim.GetTable(r.cacheInstanceCtx.Type(), backend.GetIDRanges(aranges))
+	oldTable, err := r.cacheInstanceCtx.ranges.Get(k)
+	if err != nil {
 		if err := r.cacheInstanceCtx.ranges.Create(k, table); err != nil {
 			return err
 		}
+	} else if !backend.RangeTablesEqual(oldTable, table) {
+		// the segments of the range changed, the ids claimed from the range are
+		// moved to a table with the new segments
+		for _, entry := range oldTable.GetAll() {
+			if err := table.Claim(entry.ID().ID(), entry.Labels()); err != nil {
+				return err
+			}
+		}
+		if err := r.cacheInstanceCtx.ranges.Update(k, table); err != nil {
+			return err
+		}
 	}
 	claim.SetStatusRange(claim.GetRange())
 	claim.SetConditions(condition.Ready())
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/henderiw/logger/log"
 	"github.com/henderiw/store"
@@ -12,6 +13,7 @@ import (
 	"github.com/kuidio/kuid/apis/backend"
 	bebackend "github.com/kuidio/kuid/pkg/backend"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func New(
@@ -59,6 +61,23 @@ func (r *be) PrintEntries(ctx context.Context, index string) {
 	}
 }
 
+// IndexCapacity returns the allocated and free capacity of the initialized indexes
+func (r *be) IndexCapacity(ctx context.Context) []bebackend.IndexCapacity {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	capacities := []bebackend.IndexCapacity{}
+	r.cache.List(ctx, func(k store.Key, cacheCtx *CacheInstanceContext) {
+		allocated, free := cacheCtx.Capacity(k.Name)
+		capacities = append(capacities, bebackend.IndexCapacity{
+			Namespace: k.Namespace,
+			Index:     k.Name,
+			Allocated: allocated,
+			Free:      free,
+		})
+	})
+	return capacities
+}
+
 func (r *be) AddStorageInterfaces(bes any) error {
 	bestorage, ok := bes.(BackendStorage)
 	if !ok {
@@ -79,16 +98,43 @@ func (r *be) CreateIndex(ctx context.Context, obj runtime.Object) error {
 	ctx = bebackend.InitIndexContext(ctx, "create", index)
 	log := log.FromContext(ctx)
 	log.Debug("start")
+
+	if err := r.initIndex(ctx, index); err != nil {
+		return err
+	}
+	log.Debug("update Index claims", "object", obj)
+	return r.updateIndexClaims(ctx, index)
+}
+
+// RestoreIndex restores the cache of a backend index
+func (r *be) RestoreIndex(ctx context.Context, obj runtime.Object) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	index, err := r.indexObjectFn(obj)
+	if err != nil {
+		return err
+	}
+	ctx = bebackend.InitIndexContext(ctx, "restore", index)
+	return r.initIndex(ctx, index)
+}
+
+// initIndex creates the cache of the index and restores it when not initialized
+func (r *be) initIndex(ctx context.Context, index backend.IndexObject) error {
+	log := log.FromContext(ctx)
 	key := index.GetKey()
 
 	log.Debug("start", "isInitialized", r.cache.IsInitialized(ctx, key))
 	// if the Cache is not initialized -> restore the cache
 	// this happens upon initialization or backend restart
-	if _, err := r.cache.Get(ctx, key); err != nil {
+	cacheInstanceCtx, err := r.cache.Get(ctx, key)
+	if err != nil {
 		// if it does not exist create the cache
-		cacheInstanceCtx := NewCacheInstanceContext(index.GetTree(), index.GetType())
+		cacheInstanceCtx = NewCacheInstanceContext(index.GetTree(), index.GetType(), index.GetMax())
 		r.cache.Create(ctx, key, cacheInstanceCtx)
 	}
+	if dynamicRangeIndex, ok := index.(backend.DynamicRangeIndexObject); ok {
+		cacheInstanceCtx.SetDynamicRanges(dynamicRangeIndex.GetDynamicRanges())
+	}
 
 	if !r.cache.IsInitialized(ctx, key) {
 		if err := r.restore(ctx, index); err != nil {
@@ -98,14 +144,12 @@ func (r *be) CreateIndex(ctx context.Context, obj runtime.Object) error {
 		}
 		log.Debug("restored")
 		index.SetConditions(condition.Ready())
-		obj = index
 
 		if err := r.cache.SetInitialized(ctx, key); err != nil {
 			return err
 		}
 	}
-	log.Debug("update Index claims", "object", obj)
-	return r.updateIndexClaims(ctx, index)
+	return nil
 }
 
 // DeleteIndex deletes a backend index
@@ -134,7 +178,7 @@ func (r *be) DeleteIndex(ctx context.Context, obj runtime.Object) error {
 	return nil
 }
 
-func (r *be) Claim(ctx context.Context, obj runtime.Object, recursion bool) error {
+func (r *be) Claim(ctx context.Context, obj runtime.Object, recursion bool) (err error) {
 	if !recursion {
 		r.m.Lock()
 		defer r.m.Unlock()
@@ -143,11 +187,16 @@ func (r *be) Claim(ctx context.Context, obj runtime.Object, recursion bool) erro
 	if err != nil {
 		return err
 	}
+	group := getGroup(claim)
+	metric := bebackend.NewClaimMetric(group, claim.GetNamespace(), claim.GetIndex(), string(claim.GetClaimType()), bebackend.MetricOperationClaim)
+	var reason string
+	defer func() { metric.Observe(reason, err) }()
 
 	ctx = bebackend.InitClaimContext(ctx, "create", claim)
 	log := log.FromContext(ctx)
 	log.Debug("start")
 
+	reason = bebackend.MetricReasonIndexNotReady
 	cacheCtx, err := r.cache.Get(ctx, claim.GetKey())
 	if err != nil {
 		return err
@@ -156,6 +205,12 @@ func (r *be) Claim(ctx context.Context, obj runtime.Object, recursion bool) erro
 		return fmt.Errorf("cache not initialized")
 	}
 
+	reason = bebackend.MetricReasonInvalid
+	if typedClaim, ok := claim.(backend.TypedClaimObject); ok {
+		if err := typedClaim.ValidateIndexType(cacheCtx.Type()); err != nil {
+			return err
+		}
+	}
 	a, err := getApplicator(ctx, cacheCtx, claim)
 	if err != nil {
 		return err
@@ -163,18 +218,25 @@ func (r *be) Claim(ctx context.Context, obj runtime.Object, recursion bool) erro
 	if err := a.Validate(ctx, claim); err != nil {
 		return err
 	}
+	reason = bebackend.MetricReasonApply
 	if err := a.Apply(ctx, claim); err != nil {
 		return err
 	}
+	if notationClaim, ok := claim.(backend.NotationClaimObject); ok {
+		notationClaim.SetStatusNotation(cacheCtx.Type())
+	}
 	// store the resources in the backend
+	reason = bebackend.MetricReasonStorage
+	start := time.Now()
 	if err := r.saveAll(ctx, claim.GetKey()); err != nil {
 		return err
 	}
+	bebackend.ObserveSave(group, start)
 	obj = claim
 	return nil
 }
 
-func (r *be) Release(ctx context.Context, obj runtime.Object, recursion bool) error {
+func (r *be) Release(ctx context.Context, obj runtime.Object, recursion bool) (err error) {
 	if !recursion {
 		r.m.Lock()
 		defer r.m.Unlock()
@@ -183,11 +245,16 @@ func (r *be) Release(ctx context.Context, obj runtime.Object, recursion bool) er
 	if err != nil {
 		return err
 	}
+	group := getGroup(claim)
+	metric := bebackend.NewClaimMetric(group, claim.GetNamespace(), claim.GetIndex(), string(claim.GetClaimType()), bebackend.MetricOperationRelease)
+	var reason string
+	defer func() { metric.Observe(reason, err) }()
 
 	ctx = bebackend.InitClaimContext(ctx, "delete", claim)
 	log := log.FromContext(ctx)
 	log.Debug("start")
 
+	reason = bebackend.MetricReasonIndexNotReady
 	cacheCtx, err := r.cache.Get(ctx, claim.GetKey())
 	if err != nil {
 		return err
@@ -196,15 +263,32 @@ func (r *be) Release(ctx context.Context, obj runtime.Object, recursion bool) er
 		return fmt.Errorf("cache not initialized")
 	}
 
+	reason = bebackend.MetricReasonInvalid
 	a, err := getApplicator(ctx, cacheCtx, claim)
 	if err != nil {
 		return err
 	}
+	reason = bebackend.MetricReasonApply
 	if err := a.Delete(ctx, claim); err != nil {
 		return err
 	}
 
-	return r.saveAll(ctx, claim.GetKey())
+	reason = bebackend.MetricReasonStorage
+	start := time.Now()
+	if err := r.saveAll(ctx, claim.GetKey()); err != nil {
+		return err
+	}
+	bebackend.ObserveSave(group, start)
+	return nil
+}
+
+// getGroup returns the api group of the claim
+func getGroup(claim backend.ClaimObject) string {
+	gv, err := schema.ParseGroupVersion(claim.GetChoreoAPIVersion())
+	if err != nil {
+		return ""
+	}
+	return gv.Group
 }
 
 func getApplicator(_ context.Context, cacheInstanceCtx *CacheInstanceContext, claim backend.ClaimObject) (Applicator, error) {
@@ -11,6 +11,8 @@ import (
 	"github.com/henderiw/store"
 	"github.com/kuidio/kuid/apis/backend"
 	bebackend "github.com/kuidio/kuid/pkg/backend"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
@@ -67,25 +69,17 @@ func (r *be) saveAll(ctx context.Context, k store.Key) error {
 		return err
 	}
 
-
-	apiEntries, err := r.listEntries(ctx, k)
+	entries, err := r.listEntries(ctx, k)
 	if err != nil {
 		return err
 	}
+	apiEntries := make(map[types.NamespacedName]backend.EntryObject, len(entries))
+	for _, apiEntry := range entries {
+		apiEntries[apiEntry.GetNamespacedName()] = apiEntry
+	}
 
 	for _, cacheEntry := range cacheEntries {
-		found := false
-		var oldEntry backend.EntryObject
-		for idx, apiEntry := range apiEntries {
-			if apiEntry.GetNamespacedName() == cacheEntry.GetNamespacedName() {
-				// delete the current entry
-				apiEntries = append(apiEntries[:idx], apiEntries[idx+1:]...)
-				found = true
-				oldEntry = apiEntry
-				break
-			}
-		}
-
+		oldEntry, found := apiEntries[cacheEntry.GetNamespacedName()]
 		if !found {
 			if err := r.bestorage.CreateEntry(ctx, cacheEntry); err != nil {
 				log.Error("saveAll create failed", "name", cacheEntry.GetName(), "error", err.Error())
@@ -93,6 +87,10 @@ func (r *be) saveAll(ctx context.Context, k store.Key) error {
 			}
 			continue
 		}
+		delete(apiEntries, cacheEntry.GetNamespacedName())
+		if !entryChanged(cacheEntry, oldEntry) {
+			continue
+		}
 		if err := r.bestorage.UpdateEntry(ctx, cacheEntry, oldEntry); err != nil {
 			log.Error("saveAll update failed", "name", cacheEntry.GetName(), "error", err.Error())
 			return err
@@ -108,6 +106,22 @@ func (r *be) saveAll(ctx context.Context, k store.Key) error {
 	return nil
 }
 
+// entryChanged returns true when the spec, labels or owners of the entry changed,
+// unchanged entries are not written to the storage
+func entryChanged(newEntry, oldEntry backend.EntryObject) bool {
+	newObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newEntry)
+	if err != nil {
+		return true
+	}
+	oldObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(oldEntry)
+	if err != nil {
+		return true
+	}
+	return !equality.Semantic.DeepEqual(newObj["spec"], oldObj["spec"]) ||
+		!labels.Equals(newEntry.GetLabels(), oldEntry.GetLabels()) ||
+		!equality.Semantic.DeepEqual(newEntry.GetOwnerReferences(), oldEntry.GetOwnerReferences())
+}
+
 // Destroy removes the store db
 func (r *be) destroy(ctx context.Context, k store.Key) error {
 	// no need to delete the index as this is what this fn is supposed to do
@@ -126,10 +140,19 @@ func (r *be) getEntriesFromCache(ctx context.Context, k store.Key) ([]backend.En
 	}
 
 	entries := make([]backend.EntryObject, 0, cacheInstanceCtx.Size())
-	// add the main rib entry
+	// add the main rib entry, the ids of a range are added as contiguous runs
+	// such that a range results in a single entry rather than an entry per prefix
+	runs := []*idRun{}
 	for _, entry := range cacheInstanceCtx.tree.GetAll() {
+		if backend.GetClaimTypeFromString(entry.Labels()[backend.KuidClaimTypeKey]) == backend.ClaimType_Range {
+			runs = append(runs, newIDRun(entry))
+			continue
+		}
 		entries = append(entries, r.entryFromCacheFn(k, "", entry.ID().String(), entry.Labels()))
 	}
+	for _, run := range mergeIDRuns(runs) {
+		entries = append(entries, r.entryFromCacheFn(k, "", backend.RangeEntryID(run.from, run.to), run.labels))
+	}
 	// add all the range entries
 	cacheInstanceCtx.ranges.List(func(key store.Key, t table.Table) {
 		for _, entry := range t.GetAll() {
@@ -17,22 +17,33 @@ limitations under the License.
 package generic
 
 import (
+	"fmt"
+	"math"
+	"math/bits"
+	"strings"
+
 	"github.com/henderiw/idxtable/pkg/table"
+	"github.com/henderiw/idxtable/pkg/tree"
 	"github.com/henderiw/idxtable/pkg/tree/gtree"
 	"github.com/henderiw/store"
 	"github.com/henderiw/store/memory"
+	"github.com/kuidio/kuid/apis/backend"
 )
 
 type CacheInstanceContext struct {
 	idxType string
-	tree    gtree.GTree
+	max     uint64
+	tree    *runTree
 	ranges  store.Storer[table.Table]
+	// dynamicRanges restrict the ids the dynamic claims allocate from the tree
+	dynamicRanges []backend.IDRange
 }
 
-func NewCacheInstanceContext(tree gtree.GTree, idxType string) *CacheInstanceContext {
+func NewCacheInstanceContext(tree gtree.GTree, idxType string, max uint64) *CacheInstanceContext {
 	return &CacheInstanceContext{
 		idxType: idxType, // provides extra context around the
-		tree:    tree,
+		max:     max,
+		tree:    newRunTree(tree),
 		ranges:  memory.NewStore[table.Table](nil),
 	}
 }
@@ -49,3 +60,55 @@ func (r *CacheInstanceContext) Size() int {
 func (r *CacheInstanceContext) Type() string {
 	return r.idxType
 }
+
+// SetDynamicRanges restricts the ids the dynamic claims allocate from the tree, no ranges
+// allocate from the whole tree
+func (r *CacheInstanceContext) SetDynamicRanges(ranges []backend.IDRange) {
+	r.dynamicRanges = ranges
+}
+
+// claimFree claims the first free id of the tree within the dynamic ranges or the whole
+// tree without dynamic ranges. The dynamic ranges of the claim further restrict the ids.
+// The free id is searched in the gaps between the claimed runs of the tree as the free id
+// of the tree only accounts for the children of the first id
+func (r *CacheInstanceContext) claimFree(claim backend.ClaimObject) (tree.Entry, error) {
+	dynamicRanges := r.dynamicRanges
+	if len(dynamicRanges) == 0 {
+		dynamicRanges = []backend.IDRange{{From: 0, To: r.max}}
+	}
+	if dynamicRangeClaim, ok := claim.(backend.DynamicRangeClaimObject); ok {
+		if claimRanges := dynamicRangeClaim.GetDynamicRanges(r.idxType); len(claimRanges) != 0 {
+			dynamicRanges = backend.IntersectIDRanges(dynamicRanges, claimRanges)
+		}
+	}
+	for _, dynamicRange := range dynamicRanges {
+		id, free := r.tree.firstFree(dynamicRange.From, dynamicRange.To)
+		if !free {
+			continue
+		}
+		treeID := claim.GetClaimID(r.idxType, id)
+		if err := r.tree.ClaimID(treeID, claim.GetClaimLabels()); err != nil {
+			return nil, err
+		}
+		return r.tree.Get(treeID)
+	}
+	return nil, fmt.Errorf("no free id available")
+}
+
+// Capacity returns the allocated and free ids of the index, the ids claimed by the
+// ranges the index reserves are excluded. The entries of the tree are scanned as the
+// capacity is only computed when the metrics are collected.
+func (r *CacheInstanceContext) Capacity(index string) (float64, float64) {
+	width := bits.Len64(r.max)
+	total := math.Exp2(float64(width))
+	var allocated, reserved float64
+	for _, entry := range r.tree.GetAll() {
+		size := math.Exp2(float64(width - int(entry.ID().Length())))
+		if strings.HasPrefix(entry.Labels()[backend.KuidClaimNameKey], fmt.Sprintf("%s.%s", index, backend.IndexReservedName)) {
+			reserved += size
+			continue
+		}
+		allocated += size
+	}
+	return allocated, total - reserved - allocated
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generic
+
+import (
+	"context"
+
+	"github.com/henderiw/store"
+	"github.com/kuidio/kuid/apis/backend"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+)
+
+// NewClientBackendstorage returns a BackendStorage that persists the entries and claims as CRDs
+func NewClientBackendstorage(entryStore, claimStore *bebackend.ClientStore) BackendStorage {
+	return &clientbe{
+		ClientStorage: bebackend.NewClientStorage[backend.EntryObject, backend.ClaimObject](entryStore, claimStore),
+	}
+}
+
+type clientbe struct {
+	*bebackend.ClientStorage[backend.EntryObject, backend.ClaimObject]
+}
+
+func (r *clientbe) ListClaims(ctx context.Context, k store.Key, opts ...ListOption) (map[string]backend.ClaimObject, error) {
+	o := &ListOptions{}
+	o.ApplyOptions(opts)
+	return r.ClientStorage.ListClaims(ctx, k, o.OwnerKind)
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generic
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/henderiw/idxtable/pkg/tree"
+	"github.com/henderiw/idxtable/pkg/tree/gtree"
+	"github.com/henderiw/idxtable/pkg/tree/id16"
+	"github.com/henderiw/idxtable/pkg/tree/id32"
+	"github.com/henderiw/idxtable/pkg/tree/id64"
+	"github.com/kuidio/kuid/apis/backend"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// idBitSizes maps the id types of the trees to their bit size, the ids of the tree
+// only expose the length of the prefix
+var idBitSizes = map[reflect.Type]uint8{
+	reflect.TypeOf(id16.NewID(0, 0).Copy()): id16.IDBitSize,
+	reflect.TypeOf(id32.NewID(0, 0).Copy()): id32.IDBitSize,
+	reflect.TypeOf(id64.NewID(0, 0).Copy()): id64.IDBitSize,
+}
+
+// idRun is a contiguous run of ids from-to with the labels of the claim holding them
+type idRun struct {
+	from   uint64
+	to     uint64
+	labels labels.Set
+}
+
+// newIDRun returns the run of ids a tree entry holds, a range is stored in the tree
+// as the prefixes covering the range
+func newIDRun(entry tree.Entry) *idRun {
+	id := entry.ID()
+	run := &idRun{from: id.ID(), to: id.ID(), labels: entry.Labels()}
+	bitSize, ok := idBitSizes[reflect.TypeOf(id.Copy())]
+	if !ok || id.Length() >= bitSize {
+		return run
+	}
+	run.to = run.from | ^uint64(0)>>(64-(bitSize-id.Length()))
+	return run
+}
+
+func (r *idRun) overlaps(from, to uint64) bool {
+	return r.from <= to && from <= r.to
+}
+
+// mergeIDRuns returns the runs sorted by id, where adjacent runs with the same labels,
+// e.g. the prefixes of a range claim, are merged in a single run
+func mergeIDRuns(runs []*idRun) []*idRun {
+	sort.Slice(runs, func(i, j int) bool { return runs[i].from < runs[j].from })
+	merged := make([]*idRun, 0, len(runs))
+	for _, run := range runs {
+		if n := len(merged); n > 0 {
+			last := merged[n-1]
+			if last.to+1 == run.from && labels.Equals(last.labels, run.labels) {
+				last.to = run.to
+				continue
+			}
+		}
+		merged = append(merged, run)
+	}
+	return merged
+}
+
+// runTree keeps the ids claimed in the tree as disjoint runs sorted by id, where adjacent
+// runs are merged regardless of the claim holding them. The runs are updated with every
+// claim and release of the tree, such that a free id is found without scanning the tree.
+type runTree struct {
+	gtree.GTree
+	runs []backend.IDRange
+}
+
+func newRunTree(t gtree.GTree) *runTree {
+	r := &runTree{GTree: t}
+	r.rebuild()
+	return r
+}
+
+func (r *runTree) Clone() gtree.GTree {
+	return &runTree{GTree: r.GTree.Clone(), runs: slices.Clone(r.runs)}
+}
+
+func (r *runTree) ClaimID(id tree.ID, labels labels.Set) error {
+	if err := r.GTree.ClaimID(id, labels); err != nil {
+		return err
+	}
+	run := newIDRun(tree.NewEntry(id, labels))
+	r.add(run.from, run.to)
+	return nil
+}
+
+func (r *runTree) ReleaseID(id tree.ID) error {
+	if err := r.GTree.ReleaseID(id); err != nil {
+		return err
+	}
+	run := newIDRun(tree.NewEntry(id, nil))
+	r.remove(run.from, run.to)
+	// the ids remain claimed by the entries overlapping the released id
+	for _, entry := range append(r.GTree.Parents(id), r.GTree.Children(id)...) {
+		run := newIDRun(entry)
+		r.add(run.from, run.to)
+	}
+	return nil
+}
+
+func (r *runTree) ClaimFree(labels labels.Set) (tree.Entry, error) {
+	defer r.rebuild()
+	return r.GTree.ClaimFree(labels)
+}
+
+func (r *runTree) ClaimRange(s string, labels labels.Set) error {
+	defer r.rebuild()
+	return r.GTree.ClaimRange(s, labels)
+}
+
+func (r *runTree) ReleaseByLabel(selector labels.Selector) error {
+	defer r.rebuild()
+	return r.GTree.ReleaseByLabel(selector)
+}
+
+// rebuild derives the runs from the entries of the tree
+func (r *runTree) rebuild() {
+	r.runs = nil
+	for _, entry := range r.GTree.GetAll() {
+		run := newIDRun(entry)
+		r.add(run.from, run.to)
+	}
+}
+
+// search returns the index of the first run that ends at or after the id
+func (r *runTree) search(id uint64) int {
+	return sort.Search(len(r.runs), func(i int) bool { return r.runs[i].To >= id })
+}
+
+// add marks the ids from-to as claimed
+func (r *runTree) add(from, to uint64) {
+	i := r.search(from)
+	if i > 0 && from > 0 && r.runs[i-1].To == from-1 {
+		// the run ending right before the ids is merged
+		i--
+	}
+	j := i
+	for j < len(r.runs) && (r.runs[j].From <= to || (to != math.MaxUint64 && r.runs[j].From == to+1)) {
+		j++
+	}
+	if j > i {
+		from, to = min(from, r.runs[i].From), max(to, r.runs[j-1].To)
+	}
+	r.runs = slices.Replace(r.runs, i, j, backend.IDRange{From: from, To: to})
+}
+
+// remove marks the ids from-to as free
+func (r *runTree) remove(from, to uint64) {
+	i := r.search(from)
+	j := i
+	parts := []backend.IDRange{}
+	for ; j < len(r.runs) && r.runs[j].From <= to; j++ {
+		if r.runs[j].From < from {
+			parts = append(parts, backend.IDRange{From: r.runs[j].From, To: from - 1})
+		}
+		if r.runs[j].To > to {
+			parts = append(parts, backend.IDRange{From: to + 1, To: r.runs[j].To})
+		}
+	}
+	r.runs = slices.Replace(r.runs, i, j, parts...)
+}
+
+// firstFree returns the first id from-to that is not claimed in the tree
+func (r *runTree) firstFree(from, to uint64) (uint64, bool) {
+	id := from
+	if i := r.search(id); i < len(r.runs) && r.runs[i].From <= id {
+		// the next run starts after a free id as adjacent runs are merged
+		if r.runs[i].To >= to {
+			return 0, false
+		}
+		id = r.runs[i].To + 1
+	}
+	return id, true
+}
+
+// overlaps returns true when an id from-to is claimed in the tree
+func (r *runTree) overlaps(from, to uint64) bool {
+	i := r.search(from)
+	return i < len(r.runs) && r.runs[i].From <= to
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generic
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/henderiw/idxtable/pkg/tree/id32"
+	"github.com/henderiw/idxtable/pkg/tree/tree32"
+	"github.com/kuidio/kuid/apis/backend"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func newTestRunTree(t testing.TB) *runTree {
+	gt, err := tree32.New("test", 32)
+	if err != nil {
+		t.Fatalf("cannot create tree: %v", err)
+	}
+	return newRunTree(gt)
+}
+
+func claimLabels(id uint64) labels.Set {
+	return labels.Set{backend.KuidClaimNameKey: fmt.Sprintf("claim%d", id)}
+}
+
+func TestRunTree(t *testing.T) {
+	r := newTestRunTree(t)
+	for _, id := range []uint64{0, 1, 2, 4} {
+		if err := r.ClaimID(id32.NewID(uint32(id), id32.IDBitSize), claimLabels(id)); err != nil {
+			t.Fatalf("claim %d: unexpected error: %v", id, err)
+		}
+	}
+	if diff := cmp.Diff([]backend.IDRange{{From: 0, To: 2}, {From: 4, To: 4}}, r.runs); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+
+	// a claim in the gap merges the runs
+	if err := r.ClaimID(id32.NewID(3, id32.IDBitSize), claimLabels(3)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]backend.IDRange{{From: 0, To: 4}}, r.runs); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+
+	// a release splits the run
+	if err := r.ReleaseID(id32.NewID(2, id32.IDBitSize)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]backend.IDRange{{From: 0, To: 1}, {From: 3, To: 4}}, r.runs); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+
+	// a prefix claims a run of ids
+	if err := r.ClaimID(id32.NewID(8, 29), claimLabels(8)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]backend.IDRange{{From: 0, To: 1}, {From: 3, To: 4}, {From: 8, To: 15}}, r.runs); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+
+	for name, tc := range map[string]struct {
+		from, to uint64
+		wantID   uint64
+		wantFree bool
+	}{
+		"Gap":           {from: 0, to: 100, wantID: 2, wantFree: true},
+		"AfterRun":      {from: 3, to: 100, wantID: 5, wantFree: true},
+		"Claimed":       {from: 3, to: 4},
+		"PrefixClaimed": {from: 8, to: 15},
+		"AfterPrefix":   {from: 9, to: 100, wantID: 16, wantFree: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			id, free := r.firstFree(tc.from, tc.to)
+			if diff := cmp.Diff(tc.wantFree, free); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantID, id); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+	if !r.overlaps(5, 10) || r.overlaps(5, 7) {
+		t.Errorf("want the ids 5-10 to overlap and the ids 5-7 to be free")
+	}
+}
+
+// TestRunTreeRebuild validates the incrementally updated runs match the runs derived
+// from the entries of the tree
+func TestRunTreeRebuild(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	r := newTestRunTree(t)
+	claimed := map[uint64]bool{}
+	for i := 0; i < 2000; i++ {
+		id := uint64(rnd.Intn(256))
+		treeID := id32.NewID(uint32(id), id32.IDBitSize)
+		if claimed[id] {
+			if err := r.ReleaseID(treeID); err != nil {
+				t.Fatalf("release %d: unexpected error: %v", id, err)
+			}
+			delete(claimed, id)
+			continue
+		}
+		if err := r.ClaimID(treeID, claimLabels(id)); err != nil {
+			t.Fatalf("claim %d: unexpected error: %v", id, err)
+		}
+		claimed[id] = true
+	}
+	if diff := cmp.Diff(newRunTree(r.GTree).runs, r.runs); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+}
+
+// BenchmarkClaimFree claims the first free id of a tree holding n claimed ids, the cost
+// does not depend on the amount of claimed ids
+func BenchmarkClaimFree(b *testing.B) {
+	for _, n := range []int{1000, 10000, 100000} {
+		b.Run(fmt.Sprintf("claimed-%d", n), func(b *testing.B) {
+			r := newTestRunTree(b)
+			for id := 0; id < n; id++ {
+				if err := r.ClaimID(id32.NewID(uint32(id), id32.IDBitSize), claimLabels(uint64(id))); err != nil {
+					b.Fatalf("claim %d: unexpected error: %v", id, err)
+				}
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				id, free := r.firstFree(0, math.MaxUint32)
+				if !free {
+					b.Fatalf("no free id")
+				}
+				treeID := id32.NewID(uint32(id), id32.IDBitSize)
+				if err := r.ClaimID(treeID, claimLabels(id)); err != nil {
+					b.Fatalf("claim %d: unexpected error: %v", id, err)
+				}
+				if err := r.ReleaseID(treeID); err != nil {
+					b.Fatalf("release %d: unexpected error: %v", id, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/henderiw/logger/log"
+	"github.com/kuidio/kuid/apis/backend"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type HookOperation string
+
+const (
+	HookOperation_Claim   HookOperation = "claim"
+	HookOperation_Release HookOperation = "release"
+)
+
+type HookStage string
+
+const (
+	HookStage_Pre  HookStage = "pre"
+	HookStage_Post HookStage = "post"
+)
+
+// HookRequest describes the claim operation that is handed to the hooks
+type HookRequest struct {
+	Group     string            `json:"group"`
+	Operation HookOperation     `json:"operation"`
+	Kind      string            `json:"kind"`
+	Namespace string            `json:"namespace"`
+	Name      string            `json:"name"`
+	Index     string            `json:"index"`
+	ClaimType string            `json:"claimType,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	// Object is the claim, in the post stage the status holds the final allocation
+	Object runtime.Object `json:"object"`
+}
+
+// Hook is invoked around the claim and release operations of a backend
+type Hook interface {
+	// PreClaim is called before a claim is applied to the backend, an error denies the claim
+	// and the error message is returned to the claimer
+	PreClaim(ctx context.Context, req *HookRequest) error
+	// PostClaim is called after a claim or release is applied to the backend
+	PostClaim(ctx context.Context, req *HookRequest)
+}
+
+// HookFuncs adapts plain functions to a Hook, a nil function is a no-op
+type HookFuncs struct {
+	PreClaimFn  func(ctx context.Context, req *HookRequest) error
+	PostClaimFn func(ctx context.Context, req *HookRequest)
+}
+
+func (r HookFuncs) PreClaim(ctx context.Context, req *HookRequest) error {
+	if r.PreClaimFn == nil {
+		return nil
+	}
+	return r.PreClaimFn(ctx, req)
+}
+
+func (r HookFuncs) PostClaim(ctx context.Context, req *HookRequest) {
+	if r.PostClaimFn != nil {
+		r.PostClaimFn(ctx, req)
+	}
+}
+
+type hookRegistration struct {
+	groups map[string]struct{}
+	hook   Hook
+}
+
+var (
+	hookMutex sync.RWMutex
+	hooks     []*hookRegistration
+)
+
+// RegisterHook registers a hook for the given groups, without groups the hook
+// is invoked for all the groups. The hooks are invoked in registration order.
+// The returned function unregisters the hook.
+func RegisterHook(hook Hook, groups ...string) func() {
+	hookMutex.Lock()
+	defer hookMutex.Unlock()
+	reg := &hookRegistration{
+		groups: make(map[string]struct{}, len(groups)),
+		hook:   hook,
+	}
+	for _, group := range groups {
+		reg.groups[group] = struct{}{}
+	}
+	hooks = append(hooks, reg)
+	return func() { unregisterHook(reg) }
+}
+
+func unregisterHook(reg *hookRegistration) {
+	hookMutex.Lock()
+	defer hookMutex.Unlock()
+	for i, r := range hooks {
+		if r == reg {
+			hooks = append(hooks[:i:i], hooks[i+1:]...)
+			return
+		}
+	}
+}
+
+func getHooks(group string) []Hook {
+	hookMutex.RLock()
+	defer hookMutex.RUnlock()
+	groupHooks := []Hook{}
+	for _, reg := range hooks {
+		if len(reg.groups) != 0 {
+			if _, ok := reg.groups[group]; !ok {
+				continue
+			}
+		}
+		groupHooks = append(groupHooks, reg.hook)
+	}
+	return groupHooks
+}
+
+// NewHookBackend returns a backend that invokes the registered hooks of the group
+// around claim and release. Claims applied by the backend itself (recursion) are
+// not handed to the hooks.
+func NewHookBackend(be Backend, group string) Backend {
+	return &hookBackend{
+		Backend: be,
+		group:   group,
+	}
+}
+
+type hookBackend struct {
+	Backend
+	group string
+}
+
+func (r *hookBackend) Claim(ctx context.Context, obj runtime.Object, recursion bool) error {
+	groupHooks := getHooks(r.group)
+	if recursion || len(groupHooks) == 0 {
+		return r.Backend.Claim(ctx, obj, recursion)
+	}
+	req, err := newHookRequest(r.group, obj, HookOperation_Claim)
+	if err != nil {
+		return err
+	}
+	if err := preClaim(ctx, groupHooks, req); err != nil {
+		return err
+	}
+	if err := r.Backend.Claim(ctx, obj, recursion); err != nil {
+		return err
+	}
+	postClaim(ctx, groupHooks, req)
+	return nil
+}
+
+func (r *hookBackend) Release(ctx context.Context, obj runtime.Object, recursion bool) error {
+	if err := r.Backend.Release(ctx, obj, recursion); err != nil {
+		return err
+	}
+	groupHooks := getHooks(r.group)
+	if recursion || len(groupHooks) == 0 {
+		return nil
+	}
+	req, err := newHookRequest(r.group, obj, HookOperation_Release)
+	if err != nil {
+		log.FromContext(ctx).Error("cannot build hook request", "error", err.Error())
+		return nil
+	}
+	postClaim(ctx, groupHooks, req)
+	return nil
+}
+
+// PreClaimHooks invokes the PreClaim hooks of the group for a claim that is not applied
+// through the hook backend, e.g. an imported claim that keeps its allocation
+func PreClaimHooks(ctx context.Context, group string, obj runtime.Object) error {
+	groupHooks := getHooks(group)
+	if len(groupHooks) == 0 {
+		return nil
+	}
+	req, err := newHookRequest(group, obj, HookOperation_Claim)
+	if err != nil {
+		return err
+	}
+	return preClaim(ctx, groupHooks, req)
+}
+
+// PostClaimHooks invokes the PostClaim hooks of the group for a claim that is not applied
+// through the hook backend, e.g. an imported claim that keeps its allocation
+func PostClaimHooks(ctx context.Context, group string, obj runtime.Object) {
+	groupHooks := getHooks(group)
+	if len(groupHooks) == 0 {
+		return
+	}
+	req, err := newHookRequest(group, obj, HookOperation_Claim)
+	if err != nil {
+		log.FromContext(ctx).Error("cannot build hook request", "error", err.Error())
+		return
+	}
+	postClaim(ctx, groupHooks, req)
+}
+
+// preClaim returns a forbidden error when a hook denies the claim
+func preClaim(ctx context.Context, groupHooks []Hook, req *HookRequest) error {
+	for _, hook := range groupHooks {
+		if err := hook.PreClaim(ctx, req); err != nil {
+			metric := NewClaimMetric(req.Group, req.Namespace, req.Index, req.ClaimType, MetricOperationClaim)
+			denyErr := apierrors.NewForbidden(schema.GroupResource{Group: req.Group, Resource: strings.ToLower(req.Kind)}, req.Name, err)
+			metric.Observe(MetricReasonDenied, denyErr)
+			return denyErr
+		}
+	}
+	return nil
+}
+
+func postClaim(ctx context.Context, groupHooks []Hook, req *HookRequest) {
+	for _, hook := range groupHooks {
+		hook.PostClaim(ctx, req)
+	}
+}
+
+func newHookRequest(group string, obj runtime.Object, op HookOperation) (*HookRequest, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	req := &HookRequest{
+		Group:     group,
+		Operation: op,
+		Kind:      reflect.TypeOf(obj).Elem().Name(),
+		Namespace: accessor.GetNamespace(),
+		Name:      accessor.GetName(),
+		Labels:    accessor.GetLabels(),
+		Object:    obj,
+	}
+	if indexObj, ok := obj.(interface{ GetIndex() string }); ok {
+		req.Index = indexObj.GetIndex()
+	}
+	if claimObj, ok := obj.(interface{ GetClaimType() backend.ClaimType }); ok {
+		req.ClaimType = string(claimObj.GetClaimType())
+	}
+	return req, nil
+}
@@ -23,6 +23,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// NewClaimInvoker returns an invoker that allocates and releases the claims in the backend,
+// imported claims are persisted as is
 func NewClaimInvoker(be Backend) options.BackendInvoker {
 	return &claimInvoker{
 		be: be,
@@ -34,6 +36,9 @@ type claimInvoker struct {
 }
 
 func (r *claimInvoker) InvokeCreate(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	if IsImport(ctx) {
+		return obj, nil
+	}
 	if err := r.be.Claim(ctx, obj, recursion); err != nil {
 		return obj, err
 	}
@@ -41,6 +46,9 @@ func (r *claimInvoker) InvokeCreate(ctx context.Context, obj runtime.Object, rec
 }
 
 func (r *claimInvoker) InvokeUpdate(ctx context.Context, obj, old runtime.Object, recursion bool) (runtime.Object, runtime.Object, error) {
+	if IsImport(ctx) {
+		return obj, old, nil
+	}
 	if err := r.be.Claim(ctx, obj, recursion); err != nil {
 		return obj, old, err
 	}
@@ -48,6 +56,9 @@ func (r *claimInvoker) InvokeUpdate(ctx context.Context, obj, old runtime.Object
 }
 
 func (r *claimInvoker) InvokeDelete(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	if IsImport(ctx) {
+		return obj, nil
+	}
 	if err := r.be.Release(ctx, obj, recursion); err != nil {
 		return obj, err
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/henderiw/store"
 	"github.com/kuidio/kuid/apis/backend"
 	"github.com/kuidio/kuid/apis/backend/ipam"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
 )
 
 type dynamicAddressApplicator struct {
@@ -191,7 +192,7 @@ func (r *dynamicAddressApplicator) selectAddress(_ context.Context, claim *ipam.
 			}
 			addr, err := ipTable.FindFree()
 			if err != nil {
-				return nil, err
+				return nil, bebackend.NewExhaustedError(err)
 			}
 			return iputil.NewPrefixInfo(netip.PrefixFrom(addr, int(pi.GetAddressPrefixLength()))), nil
 
@@ -227,7 +228,7 @@ func (r *dynamicAddressApplicator) selectAddress(_ context.Context, claim *ipam.
 		default:
 		}
 	}
-	return nil, fmt.Errorf("no free addresses found in routes: %v", routes)
+	return nil, bebackend.NewExhaustedError(fmt.Errorf("no free addresses found in routes: %v", routes))
 }
 
 func (r *dynamicAddressApplicator) Delete(ctx context.Context, claim *ipam.IPClaim) error {
@@ -24,6 +24,7 @@ import (
 	"github.com/henderiw/iputil"
 	"github.com/henderiw/logger/log"
 	"github.com/kuidio/kuid/apis/backend/ipam"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
 )
 
 type dynamicPrefixApplicator struct {
@@ -124,7 +125,7 @@ func (r *dynamicPrefixApplicator) claimPrefix(ctx context.Context, claim *ipam.I
 			}
 		}
 	}
-	return nil, errors.New("no free prefix found")
+	return nil, bebackend.NewExhaustedError(errors.New("no free prefix found"))
 }
 
 func (r *dynamicPrefixApplicator) Delete(ctx context.Context, claim *ipam.IPClaim) error {
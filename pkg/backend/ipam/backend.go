@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/henderiw/logger/log"
 	"github.com/henderiw/store"
@@ -55,6 +56,23 @@ func (r *be) PrintEntries(ctx context.Context, index string) {
 	}
 }
 
+// IndexCapacity returns the allocated and free addresses of the initialized indexes
+func (r *be) IndexCapacity(ctx context.Context) []bebackend.IndexCapacity {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	capacities := []bebackend.IndexCapacity{}
+	r.cache.List(ctx, func(k store.Key, cacheCtx *CacheInstanceContext) {
+		allocated, free := cacheCtx.Capacity()
+		capacities = append(capacities, bebackend.IndexCapacity{
+			Namespace: k.Namespace,
+			Index:     k.Name,
+			Allocated: allocated,
+			Free:      free,
+		})
+	})
+	return capacities
+}
+
 func (r *be) AddStorageInterfaces(bes any) error {
 	bestorage, ok := bes.(BackendStorage)
 	if !ok {
@@ -75,6 +93,29 @@ func (r *be) CreateIndex(ctx context.Context, obj runtime.Object) error {
 
 	ctx = bebackend.InitIndexContext(ctx, "create", index)
 	log := log.FromContext(ctx)
+
+	if err := r.initIndex(ctx, index); err != nil {
+		return err
+	}
+	log.Debug("update IPIndex claims", "object", index)
+	return r.updateIPIndexClaims(ctx, index)
+}
+
+// RestoreIndex restores the cache of a backend index
+func (r *be) RestoreIndex(ctx context.Context, obj runtime.Object) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	index, ok := obj.(*ipam.IPIndex)
+	if !ok {
+		return errors.New("runtime object is not IPIndex")
+	}
+	ctx = bebackend.InitIndexContext(ctx, "restore", index)
+	return r.initIndex(ctx, index)
+}
+
+// initIndex creates the cache of the index and restores it when not initialized
+func (r *be) initIndex(ctx context.Context, index *ipam.IPIndex) error {
+	log := log.FromContext(ctx)
 	key := index.GetKey()
 
 	log.Debug("create index", "isInitialized", r.cache.IsInitialized(ctx, key))
@@ -93,14 +134,12 @@ func (r *be) CreateIndex(ctx context.Context, obj runtime.Object) error {
 		}
 		log.Debug("restored")
 		index.SetConditions(condition.Ready())
-		obj = index
 
 		if err := r.cache.SetInitialized(ctx, key); err != nil {
 			return err
 		}
 	}
-	log.Debug("update IPIndex claims", "object", index)
-	return r.updateIPIndexClaims(ctx, index)
+	return nil
 }
 
 // DeleteIndex deletes a backend index
@@ -130,7 +169,7 @@ func (r *be) DeleteIndex(ctx context.Context, obj runtime.Object) error {
 	return nil
 }
 
-func (r *be) Claim(ctx context.Context, obj runtime.Object, recursion bool) error {
+func (r *be) Claim(ctx context.Context, obj runtime.Object, recursion bool) (err error) {
 	// index delete/create can call the claim create/delete -> this avoid double locking
 	if !recursion {
 		r.m.Lock()
@@ -140,11 +179,16 @@ func (r *be) Claim(ctx context.Context, obj runtime.Object, recursion bool) erro
 	if !ok {
 		return errors.New("runtime object is not IPClaim")
 	}
+	ipClaimType, _ := claim.GetIPClaimType()
+	metric := bebackend.NewClaimMetric(ipam.SchemeGroupVersion.Group, claim.GetNamespace(), claim.GetIndex(), string(ipClaimType), bebackend.MetricOperationClaim)
+	var reason string
+	defer func() { metric.Observe(reason, err) }()
 
 	ctx = initClaimContext(ctx, "create", claim)
 	log := log.FromContext(ctx)
 	log.Debug("start")
 
+	reason = bebackend.MetricReasonIndexNotReady
 	cacheCtx, err := r.cache.Get(ctx, claim.GetKey())
 	if err != nil {
 		return err
@@ -153,6 +197,7 @@ func (r *be) Claim(ctx context.Context, obj runtime.Object, recursion bool) erro
 		return fmt.Errorf("cache not initialized")
 	}
 
+	reason = bebackend.MetricReasonInvalid
 	a, err := getApplicator(ctx, cacheCtx, claim)
 	if err != nil {
 		return err
@@ -160,19 +205,23 @@ func (r *be) Claim(ctx context.Context, obj runtime.Object, recursion bool) erro
 	if err := a.Validate(ctx, claim); err != nil {
 		return err
 	}
+	reason = bebackend.MetricReasonApply
 	if err := a.Apply(ctx, claim); err != nil {
 		return err
 	}
 	// store the resources in the backend
+	reason = bebackend.MetricReasonStorage
+	start := time.Now()
 	if err := r.saveAll(ctx, claim.GetKey()); err != nil {
 		return err
 	}
+	bebackend.ObserveSave(ipam.SchemeGroupVersion.Group, start)
 	obj = claim
 	return nil
 
 }
 
-func (r *be) Release(ctx context.Context, obj runtime.Object, recursion bool) error {
+func (r *be) Release(ctx context.Context, obj runtime.Object, recursion bool) (err error) {
 	// index delete/create can call the claim create/delete -> this avoid double locking
 	if !recursion {
 		r.m.Lock()
@@ -182,11 +231,16 @@ func (r *be) Release(ctx context.Context, obj runtime.Object, recursion bool) er
 	if !ok {
 		return errors.New("runtime object is not IPClaim")
 	}
+	ipClaimType, _ := claim.GetIPClaimType()
+	metric := bebackend.NewClaimMetric(ipam.SchemeGroupVersion.Group, claim.GetNamespace(), claim.GetIndex(), string(ipClaimType), bebackend.MetricOperationRelease)
+	var reason string
+	defer func() { metric.Observe(reason, err) }()
 
 	ctx = initClaimContext(ctx, "delete", claim)
 	log := log.FromContext(ctx)
 	log.Debug("start")
 
+	reason = bebackend.MetricReasonIndexNotReady
 	cacheCtx, err := r.cache.Get(ctx, claim.GetKey())
 	if err != nil {
 		return err
@@ -195,15 +249,23 @@ func (r *be) Release(ctx context.Context, obj runtime.Object, recursion bool) er
 		return fmt.Errorf("cache not initialized")
 	}
 
+	reason = bebackend.MetricReasonInvalid
 	a, err := getApplicator(ctx, cacheCtx, claim)
 	if err != nil {
 		return err
 	}
+	reason = bebackend.MetricReasonApply
 	if err := a.Delete(ctx, claim); err != nil {
 		return err
 	}
 
-	return r.saveAll(ctx, claim.GetKey())
+	reason = bebackend.MetricReasonStorage
+	start := time.Now()
+	if err := r.saveAll(ctx, claim.GetKey()); err != nil {
+		return err
+	}
+	bebackend.ObserveSave(ipam.SchemeGroupVersion.Group, start)
+	return nil
 }
 
 func getApplicator(_ context.Context, cacheInstanceCtx *CacheInstanceContext, claim *ipam.IPClaim) (Applicator, error) {
@@ -17,10 +17,15 @@ limitations under the License.
 package ipam
 
 import (
+	"math"
+	"net/netip"
+	"sort"
+
 	"github.com/hansthienpondt/nipam/pkg/table"
 	"github.com/henderiw/idxtable/pkg/iptable"
 	"github.com/henderiw/store"
 	"github.com/henderiw/store/memory"
+	"go4.org/netipx"
 )
 
 type CacheInstanceContext struct {
@@ -43,3 +48,49 @@ func (r *CacheInstanceContext) Size() int {
 	})
 	return size
 }
+
+// Capacity returns the allocated and free addresses of the index. The prefixes
+// without a parent define the capacity of the index, the prefixes nested in them
+// are allocated.
+func (r *CacheInstanceContext) Capacity() (float64, float64) {
+	routes := r.rib.GetTable()
+	// sorted by address and prefix length a parent prefix precedes its children and
+	// the last root is the only root that can contain the next prefix
+	sort.SliceStable(routes, func(i, j int) bool {
+		a, b := routes[i].Prefix(), routes[j].Prefix()
+		if c := a.Addr().Compare(b.Addr()); c != 0 {
+			return c < 0
+		}
+		return a.Bits() < b.Bits()
+	})
+	var rootBldr, allocatedBldr netipx.IPSetBuilder
+	var root netip.Prefix
+	for _, route := range routes {
+		prefix := route.Prefix()
+		if root.IsValid() && root.Overlaps(prefix) && root.Bits() <= prefix.Bits() {
+			allocatedBldr.AddPrefix(prefix)
+			continue
+		}
+		rootBldr.AddPrefix(prefix)
+		root = prefix
+	}
+	if !root.IsValid() {
+		return 0, 0
+	}
+	roots, _ := rootBldr.IPSet()
+	allocatedSet, _ := allocatedBldr.IPSet()
+	total := addresses(roots)
+	allocated := addresses(allocatedSet)
+	return allocated, total - allocated
+}
+
+func addresses(s *netipx.IPSet) float64 {
+	var size float64
+	if s == nil {
+		return size
+	}
+	for _, prefix := range s.Prefixes() {
+		size += math.Exp2(float64(prefix.Addr().BitLen() - prefix.Bits()))
+	}
+	return size
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/hansthienpondt/nipam/pkg/table"
+)
+
+func TestCapacity(t *testing.T) {
+	cases := map[string]struct {
+		prefixes      []string
+		wantAllocated float64
+		wantFree      float64
+	}{
+		"Empty": {},
+		"Root": {
+			prefixes: []string{"10.0.0.0/24"},
+			wantFree: 256,
+		},
+		"Nested": {
+			prefixes:      []string{"10.0.0.0/24", "10.0.0.0/26", "10.0.0.0/28", "10.0.0.128/32"},
+			wantAllocated: 65,
+			wantFree:      191,
+		},
+		"MultipleRoots": {
+			prefixes:      []string{"10.0.0.0/30", "10.0.0.1/32", "10.0.1.0/30", "192.168.0.0/31"},
+			wantAllocated: 1,
+			wantFree:      9,
+		},
+		"DualStack": {
+			prefixes:      []string{"10.0.0.0/30", "2001:db8::/126", "2001:db8::1/128"},
+			wantAllocated: 1,
+			wantFree:      7,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewCacheInstanceContext()
+			for _, p := range tc.prefixes {
+				if err := r.rib.Add(table.NewRoute(netip.MustParsePrefix(p), map[string]string{}, map[string]any{})); err != nil {
+					t.Fatalf("cannot add prefix %s, err: %v", p, err)
+				}
+			}
+			allocated, free := r.Capacity()
+			if allocated != tc.wantAllocated || free != tc.wantFree {
+				t.Errorf("want allocated %v free %v, got allocated %v free %v", tc.wantAllocated, tc.wantFree, allocated, free)
+			}
+		})
+	}
+}
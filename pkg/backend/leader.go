@@ -0,0 +1,79 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// LeaderChecker reports if this replica holds the leadership
+type LeaderChecker interface {
+	IsLeader() bool
+}
+
+// NewLeaderBackend returns a backend that refuses the mutating operations when this
+// replica is not the leader, such that a replica that lost the leadership does not
+// hand out allocations that conflict with the new leader
+func NewLeaderBackend(be Backend, leader LeaderChecker) Backend {
+	return &leaderBackend{
+		Backend: be,
+		leader:  leader,
+	}
+}
+
+type leaderBackend struct {
+	Backend
+	leader LeaderChecker
+}
+
+func (r *leaderBackend) checkLeader() error {
+	if !r.leader.IsLeader() {
+		return apierrors.NewServiceUnavailable("kuid server is not the leader, retry against the leader")
+	}
+	return nil
+}
+
+func (r *leaderBackend) CreateIndex(ctx context.Context, obj runtime.Object) error {
+	if err := r.checkLeader(); err != nil {
+		return err
+	}
+	return r.Backend.CreateIndex(ctx, obj)
+}
+
+func (r *leaderBackend) DeleteIndex(ctx context.Context, obj runtime.Object) error {
+	if err := r.checkLeader(); err != nil {
+		return err
+	}
+	return r.Backend.DeleteIndex(ctx, obj)
+}
+
+func (r *leaderBackend) Claim(ctx context.Context, obj runtime.Object, recursion bool) error {
+	if err := r.checkLeader(); err != nil {
+		return err
+	}
+	return r.Backend.Claim(ctx, obj, recursion)
+}
+
+func (r *leaderBackend) Release(ctx context.Context, obj runtime.Object, recursion bool) error {
+	if err := r.checkLeader(); err != nil {
+		return err
+	}
+	return r.Backend.Release(ctx, obj, recursion)
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	MetricOperationClaim   = "claim"
+	MetricOperationRelease = "release"
+	MetricOperationSave    = "save"
+
+	MetricResultSuccess = "success"
+	MetricResultFailure = "failure"
+
+	// failure reasons of a claim or release
+	MetricReasonIndexNotReady = "index_not_ready"
+	MetricReasonInvalid       = "invalid"
+	MetricReasonExhausted     = "exhausted"
+	MetricReasonApply         = "apply"
+	MetricReasonStorage       = "storage"
+	MetricReasonDenied        = "denied"
+)
+
+var (
+	claimsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "kuid",
+			Subsystem: "backend",
+			Name:      "claims_total",
+			Help:      "Total number of claims and releases processed by the backend",
+		},
+		[]string{"group", "namespace", "index", "claim_type", "operation", "result", "reason"},
+	)
+	exhaustedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "kuid",
+			Subsystem: "backend",
+			Name:      "exhausted_total",
+			Help:      "Total number of claims that failed because the index has no free ids, addresses or prefixes",
+		},
+		[]string{"group", "namespace", "index", "claim_type"},
+	)
+	operationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "kuid",
+			Subsystem: "backend",
+			Name:      "operation_duration_seconds",
+			Help:      "Latency of the backend claim, release and save operations",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 15),
+		},
+		[]string{"group", "operation"},
+	)
+	indexAllocatedDesc = prometheus.NewDesc(
+		"kuid_backend_index_allocated",
+		"Number of allocated ids or addresses in the index, excluding the reserved ranges",
+		[]string{"group", "namespace", "index"}, nil,
+	)
+	indexFreeDesc = prometheus.NewDesc(
+		"kuid_backend_index_free",
+		"Number of free ids or addresses in the index",
+		[]string{"group", "namespace", "index"}, nil,
+	)
+	indexCapacity = &capacityCollector{backends: map[string]Backend{}}
+)
+
+func init() {
+	metrics.Registry.MustRegister(claimsTotal, exhaustedTotal, operationDuration, indexCapacity)
+}
+
+// ExhaustedError indicates the index has no free ids, addresses or prefixes left for the claim
+type ExhaustedError struct {
+	err error
+}
+
+func NewExhaustedError(err error) error {
+	return &ExhaustedError{err: err}
+}
+
+func (r *ExhaustedError) Error() string { return r.err.Error() }
+
+func (r *ExhaustedError) Unwrap() error { return r.err }
+
+func IsExhausted(err error) bool {
+	var exhaustedErr *ExhaustedError
+	return errors.As(err, &exhaustedErr)
+}
+
+// ClaimMetric records the result and latency of a claim or release
+type ClaimMetric struct {
+	Group     string
+	Namespace string
+	Index     string
+	ClaimType string
+	Operation string
+	start     time.Time
+}
+
+func NewClaimMetric(group, namespace, index, claimType, operation string) *ClaimMetric {
+	return &ClaimMetric{
+		Group:     group,
+		Namespace: namespace,
+		Index:     index,
+		ClaimType: claimType,
+		Operation: operation,
+		start:     time.Now(),
+	}
+}
+
+// Observe records the result of the operation, reason is the stage at which the operation failed
+func (r *ClaimMetric) Observe(reason string, err error) {
+	operationDuration.WithLabelValues(r.Group, r.Operation).Observe(time.Since(r.start).Seconds())
+	if err == nil {
+		claimsTotal.WithLabelValues(r.Group, r.Namespace, r.Index, r.ClaimType, r.Operation, MetricResultSuccess, "").Inc()
+		return
+	}
+	if IsExhausted(err) {
+		reason = MetricReasonExhausted
+		exhaustedTotal.WithLabelValues(r.Group, r.Namespace, r.Index, r.ClaimType).Inc()
+	}
+	claimsTotal.WithLabelValues(r.Group, r.Namespace, r.Index, r.ClaimType, r.Operation, MetricResultFailure, reason).Inc()
+}
+
+// ObserveSave records the latency of storing the entries of an index
+func ObserveSave(group string, start time.Time) {
+	operationDuration.WithLabelValues(group, MetricOperationSave).Observe(time.Since(start).Seconds())
+}
+
+// IndexCapacity defines the allocated and free capacity of an index
+type IndexCapacity struct {
+	Namespace string
+	Index     string
+	Allocated float64
+	Free      float64
+}
+
+// RegisterCapacity adds the backend of the group to the collector that reports the
+// capacity of the indexes at scrape time
+func RegisterCapacity(group string, be Backend) {
+	indexCapacity.m.Lock()
+	defer indexCapacity.m.Unlock()
+	indexCapacity.backends[group] = be
+}
+
+type capacityCollector struct {
+	m        sync.RWMutex
+	backends map[string]Backend
+}
+
+func (r *capacityCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- indexAllocatedDesc
+	ch <- indexFreeDesc
+}
+
+func (r *capacityCollector) Collect(ch chan<- prometheus.Metric) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	for group, be := range r.backends {
+		for _, capacity := range be.IndexCapacity(context.Background()) {
+			ch <- prometheus.MustNewConstMetric(indexAllocatedDesc, prometheus.GaugeValue, capacity.Allocated, group, capacity.Namespace, capacity.Index)
+			ch <- prometheus.MustNewConstMetric(indexFreeDesc, prometheus.GaugeValue, capacity.Free, group, capacity.Namespace, capacity.Index)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+
+	"github.com/henderiw/logger/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// RestoreIndexes restores the backend cache of all the persisted indexes in the index store,
+// such that claims can be served without the indexes being re-applied after a restart.
+// The outcome of the restore is written to the index status using the status store.
+// A failing index restore does not stop the restore of the other indexes.
+func RestoreIndexes(ctx context.Context, be Backend, indexStore rest.Lister, statusStore rest.Updater) error {
+	log := log.FromContext(ctx)
+
+	list, err := indexStore.List(ctx, &internalversion.ListOptions{})
+	if err != nil {
+		return err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		obj := item.DeepCopyObject()
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+		if accessor.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if err := be.RestoreIndex(ctx, obj); err != nil {
+			log.Error("cannot restore index", "namespace", accessor.GetNamespace(), "name", accessor.GetName(), "error", err.Error())
+		}
+		if err := updateStatus(ctx, statusStore, obj, accessor); err != nil {
+			log.Error("cannot update index status", "namespace", accessor.GetNamespace(), "name", accessor.GetName(), "error", err.Error())
+		}
+	}
+	log.Info("restore indexes finished", "total", len(items))
+	return nil
+}
+
+func updateStatus(ctx context.Context, statusStore rest.Updater, obj runtime.Object, accessor metav1.Object) error {
+	ctx = genericapirequest.WithNamespace(ctx, accessor.GetNamespace())
+	_, _, err := statusStore.Update(ctx, accessor.GetName(), rest.DefaultUpdatedObjectInfo(obj), nil, nil, false, &metav1.UpdateOptions{
+		FieldManager: "backend",
+	})
+	return err
+}
@@ -0,0 +1,431 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testas
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/henderiw/apiserver-builder/pkg/builder"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/as"
+	asbev1alpha1 "github.com/kuidio/kuid/apis/backend/as/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/quota"
+	quotaregister "github.com/kuidio/kuid/apis/backend/quota/register"
+	quotabev1alpha1 "github.com/kuidio/kuid/apis/backend/quota/v1alpha1"
+	"github.com/kuidio/kuid/pkg/archive"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	claimquota "github.com/kuidio/kuid/pkg/quota"
+	"github.com/kuidio/kuid/pkg/registry/options"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/ptr"
+)
+
+func getArchiveStorage(ctx context.Context, apiserver *builder.Server) (*archive.Storage, error) {
+	storage := &archive.Storage{Scheme: apiserver.Schemes[0]}
+	for resource, kind := range map[string]string{
+		as.ASIndexPlural: as.ASIndexKind,
+		as.ASClaimPlural: as.ASClaimKind,
+		as.ASEntryPlural: as.ASEntryKind,
+	} {
+		gr := schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: resource}
+		store, err := getStorage(ctx, apiserver, gr)
+		if err != nil {
+			return nil, err
+		}
+		statusStore, err := apiserver.StorageProvider[gr].Provider.StatusSubResourceStorageProviderFn(apiserver.Schemes[0], store)
+		if err != nil {
+			return nil, err
+		}
+		resourceStorage := &archive.ResourceStorage{
+			GVK:    asbev1alpha1.SchemeGroupVersion.WithKind(kind),
+			Store:  store,
+			Status: statusStore.(rest.Updater),
+		}
+		switch kind {
+		case as.ASIndexKind:
+			storage.Index = resourceStorage
+		case as.ASClaimKind:
+			storage.Claim = resourceStorage
+		case as.ASEntryKind:
+			storage.Entry = resourceStorage
+		}
+	}
+	return storage, nil
+}
+
+// exportIndex creates index a with a pool and dynamic claims with a hole in the allocations
+// and returns the decoded archive of the index with the allocated ids of the claims
+func exportIndex(t *testing.T) (*archive.Archive, map[string]uint64) {
+	return exportIndexes(t, namespace)
+}
+
+// exportIndexes creates index a in every namespace with a pool and dynamic claims with a
+// hole in the allocations and returns the decoded archive of the indexes with the
+// allocated ids of the claims, which are the same in every namespace
+func exportIndexes(t *testing.T, namespaces ...string) (*archive.Archive, map[string]uint64) {
+	ctx := context.Background()
+	group := as.SchemeGroupVersion.Group
+
+	apiserver := apiServer()
+	_, err := initBackend(ctx, apiserver)
+	assert.NoError(t, err)
+	indexStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: group, Resource: as.ASIndexPlural})
+	assert.NoError(t, err)
+	claimStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: group, Resource: as.ASClaimPlural})
+	assert.NoError(t, err)
+
+	allocated := map[string]uint64{}
+	for _, ns := range namespaces {
+		index, err := getIndex("a", "")
+		assert.NoError(t, err)
+		index.SetNamespace(ns)
+		ctx := genericapirequest.WithNamespace(ctx, ns)
+		_, err = indexStorage.Create(ctx, index, nil, &metav1.CreateOptions{FieldManager: "backend"})
+		assert.NoError(t, err)
+		pool, err := testCtx{name: "pool", tRange: "100-199"}.getRangeClaim("a", "")
+		assert.NoError(t, err)
+		pool.SetNamespace(ns)
+		_, err = claimStorage.Create(ctx, pool, nil, &metav1.CreateOptions{FieldManager: "test"})
+		assert.NoError(t, err)
+		for i := 0; i < 5; i++ {
+			claim, err := testCtx{name: fmt.Sprintf("claim%d", i), selector: poolSelector()}.getDynamicClaim("a", "")
+			assert.NoError(t, err)
+			claim.SetNamespace(ns)
+			_, err = claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+			assert.NoError(t, err)
+		}
+		// the hole would be allocated to claim2 when the claims are allocated again
+		_, _, err = claimStorage.Delete(ctx, "claim1", nil, &metav1.DeleteOptions{})
+		assert.NoError(t, err)
+		for _, name := range []string{"claim0", "claim2", "claim3", "claim4"} {
+			obj, err := claimStorage.Get(ctx, name, &metav1.GetOptions{})
+			assert.NoError(t, err)
+			claim := obj.(*as.ASClaim)
+			if assert.NotNil(t, claim.Status.ID) {
+				allocated[name] = uint64(*claim.Status.ID)
+			}
+		}
+	}
+
+	storage, err := getArchiveStorage(ctx, apiserver)
+	assert.NoError(t, err)
+	archiver := archive.NewArchiver()
+	assert.NoError(t, archiver.AddGroup(group, storage))
+	exportNamespace := ""
+	if len(namespaces) == 1 {
+		exportNamespace = namespaces[0]
+	}
+	exported, err := archiver.Export(ctx, archive.ExportOptions{Group: group, Namespace: exportNamespace, Index: "a"})
+	assert.NoError(t, err)
+	buf := &bytes.Buffer{}
+	assert.NoError(t, exported.Encode(buf, archive.Format_YAML))
+	decoded, err := archive.Decode(buf.Bytes())
+	assert.NoError(t, err)
+	return decoded, allocated
+}
+
+func poolSelector() *metav1.LabelSelector {
+	return &metav1.LabelSelector{MatchLabels: map[string]string{backend.KuidClaimNameKey: "pool"}}
+}
+
+// importServer returns an archiver for a new backend, the claim quotas are enforced
+// when withQuotas is set
+func importServer(t *testing.T, withQuotas bool) (*builder.Server, *archive.Archiver) {
+	ctx := context.Background()
+	apiserver := apiServer()
+	if withQuotas {
+		storageProvider := quotaregister.NewStorageProvider(ctx, &quota.ClaimQuota{}, nil, true, &options.Options{
+			Type: options.StorageType_Memory,
+		})
+		apiserver.WithResourceAndHandler(&quota.ClaimQuota{}, storageProvider)
+		apiserver.WithResourceAndHandler(&quotabev1alpha1.ClaimQuota{}, storageProvider)
+	}
+	_, err := initBackend(ctx, apiserver)
+	assert.NoError(t, err)
+	if withQuotas {
+		assert.NoError(t, quotaregister.ApplyStorageToQuota(ctx, nil, apiserver))
+		t.Cleanup(func() { claimquota.AddQuotaStore(nil) })
+	}
+	storage, err := getArchiveStorage(ctx, apiserver)
+	assert.NoError(t, err)
+	archiver := archive.NewArchiver()
+	assert.NoError(t, archiver.AddGroup(as.SchemeGroupVersion.Group, storage))
+	return apiserver, archiver
+}
+
+// TestArchive exports an index with holes in the allocations and imports it in a new
+// backend under another namespace and index, the claims keep their allocation
+func TestArchive(t *testing.T) {
+	ctx := context.Background()
+	group := as.SchemeGroupVersion.Group
+
+	decoded, allocated := exportIndex(t)
+	if assert.Len(t, decoded.Indexes, 1) {
+		// the claims of the reserved ASs are exported with the index
+		assert.Len(t, decoded.Indexes[0].Claims, 5+len(as.ASReservedRanges))
+		assert.NotEmpty(t, decoded.Indexes[0].Entries)
+	}
+
+	// import in a new backend
+	importApiserver, importer := importServer(t, false)
+	assert.NoError(t, importer.Import(context.Background(), decoded, archive.ImportOptions{Namespace: "other", Index: "b"}))
+
+	importClaimStorage, err := getStorage(ctx, importApiserver, schema.GroupResource{Group: group, Resource: as.ASClaimPlural})
+	assert.NoError(t, err)
+	importCtx := genericapirequest.WithNamespace(context.Background(), "other")
+	for name, id := range allocated {
+		obj, err := importClaimStorage.Get(importCtx, name, &metav1.GetOptions{})
+		if !assert.NoError(t, err) {
+			continue
+		}
+		claim := obj.(*as.ASClaim)
+		assert.Equal(t, "b", claim.Spec.Index)
+		if assert.NotNil(t, claim.Status.ID, name) {
+			assert.Equal(t, id, uint64(*claim.Status.ID), name)
+		}
+	}
+	// a new claim gets the hole and does not conflict with the imported claims
+	claim, err := testCtx{name: "claim5", selector: poolSelector()}.getDynamicClaim("b", "")
+	assert.NoError(t, err)
+	claim.SetNamespace("other")
+	obj, err := importClaimStorage.Create(importCtx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+	if assert.NoError(t, err) {
+		newClaim := obj.(*as.ASClaim)
+		if assert.NotNil(t, newClaim.Status.ID) {
+			for name, id := range allocated {
+				assert.NotEqual(t, id, uint64(*newClaim.Status.ID), name)
+			}
+		}
+	}
+
+	// importing the index again conflicts with the imported index
+	err = importer.Import(context.Background(), decoded, archive.ImportOptions{Namespace: "other", Index: "b"})
+	assert.True(t, apierrors.IsAlreadyExists(err), "expected already exists, got %v", err)
+}
+
+// TestArchiveImportHooks validates the allocation hooks apply to the imported claims
+func TestArchiveImportHooks(t *testing.T) {
+	decoded, _ := exportIndex(t)
+
+	var m sync.Mutex
+	notified := []string{}
+	t.Cleanup(bebackend.RegisterHook(bebackend.HookFuncs{
+		PreClaimFn: func(ctx context.Context, req *bebackend.HookRequest) error {
+			if req.Namespace == "denied" && req.Name == "claim3" {
+				return fmt.Errorf("claim3 is not approved")
+			}
+			return nil
+		},
+		PostClaimFn: func(ctx context.Context, req *bebackend.HookRequest) {
+			m.Lock()
+			defer m.Unlock()
+			notified = append(notified, req.Name)
+		},
+	}, as.SchemeGroupVersion.Group))
+
+	apiserver, importer := importServer(t, false)
+	err := importer.Import(context.Background(), decoded, archive.ImportOptions{Namespace: "denied", Index: "b"})
+	assert.True(t, apierrors.IsForbidden(err), "expected forbidden, got %v", err)
+	assert.Empty(t, listClaims(t, apiserver, "denied"))
+
+	assert.NoError(t, importer.Import(context.Background(), decoded, archive.ImportOptions{Namespace: "other", Index: "b"}))
+	m.Lock()
+	defer m.Unlock()
+	// the claims owned by the index are not handed to the hooks
+	assert.ElementsMatch(t, []string{"pool", "claim0", "claim2", "claim3", "claim4"}, notified)
+}
+
+// TestArchiveImportQuota validates the claim quotas apply to the imported claims, the
+// claims owned by the index count towards the quota
+func TestArchiveImportQuota(t *testing.T) {
+	decoded, _ := exportIndex(t)
+	userClaims := int64(5)
+	reservedClaims := int64(len(as.ASReservedRanges))
+
+	cases := map[string]struct {
+		claims      int64
+		expectedErr bool
+	}{
+		"AtLimit": {
+			claims: reservedClaims + userClaims,
+		},
+		"AboveLimit": {
+			claims:      reservedClaims + userClaims - 1,
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apiserver, importer := importServer(t, true)
+			quotaStorage, err := getStorage(context.Background(), apiserver, quota.Resource(quota.ClaimQuotaPlural))
+			assert.NoError(t, err)
+			ctx := genericapirequest.WithNamespace(context.Background(), "other")
+			_, err = quotaStorage.Create(ctx, &quota.ClaimQuota{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "as"},
+				Spec:       quota.ClaimQuotaSpec{Group: as.SchemeGroupVersion.Group, Claims: ptr.To(tc.claims)},
+			}, nil, &metav1.CreateOptions{FieldManager: "test"})
+			assert.NoError(t, err)
+
+			err = importer.Import(context.Background(), decoded, archive.ImportOptions{Namespace: "other", Index: "b"})
+			if tc.expectedErr {
+				assert.True(t, apierrors.IsForbidden(err), "expected forbidden, got %v", err)
+				// the imported objects are removed again
+				assert.Empty(t, listClaims(t, apiserver, "other"))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, listClaims(t, apiserver, "other"), int(reservedClaims+userClaims))
+		})
+	}
+}
+
+// TestArchiveImportAtomic validates an archive with multiple indexes is imported as a
+// whole, the indexes imported before a failed index are removed again
+func TestArchiveImportAtomic(t *testing.T) {
+	decoded, _ := exportIndexes(t, "first", "second")
+	if !assert.Len(t, decoded.Indexes, 2) {
+		return
+	}
+	// the index of the second namespace is imported last
+	sort.Slice(decoded.Indexes, func(i, j int) bool {
+		return decoded.Indexes[i].Index.GetNamespace() < decoded.Indexes[j].Index.GetNamespace()
+	})
+
+	apiserver, importer := importServer(t, true)
+	quotaStorage, err := getStorage(context.Background(), apiserver, quota.Resource(quota.ClaimQuotaPlural))
+	assert.NoError(t, err)
+	ctx := genericapirequest.WithNamespace(context.Background(), "second")
+	_, err = quotaStorage.Create(ctx, &quota.ClaimQuota{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "second", Name: "as"},
+		Spec:       quota.ClaimQuotaSpec{Group: as.SchemeGroupVersion.Group, Claims: ptr.To[int64](1)},
+	}, nil, &metav1.CreateOptions{FieldManager: "test"})
+	assert.NoError(t, err)
+
+	err = importer.Import(context.Background(), decoded, archive.ImportOptions{})
+	assert.True(t, apierrors.IsForbidden(err), "expected forbidden, got %v", err)
+	// the index, claims and entries of the first namespace are removed again
+	for _, ns := range []string{"first", "second"} {
+		assert.Empty(t, listClaims(t, apiserver, ns), "namespace %s claims", ns)
+		assert.Empty(t, listObjects(t, apiserver, as.ASEntryPlural, ns), "namespace %s entries", ns)
+		assert.Empty(t, listObjects(t, apiserver, as.ASIndexPlural, ns), "namespace %s indexes", ns)
+	}
+
+	// the archive is imported once the failure is resolved
+	_, _, err = quotaStorage.Delete(ctx, "as", nil, &metav1.DeleteOptions{})
+	assert.NoError(t, err)
+	assert.NoError(t, importer.Import(context.Background(), decoded, archive.ImportOptions{}))
+	for _, ns := range []string{"first", "second"} {
+		assert.Len(t, listObjects(t, apiserver, as.ASIndexPlural, ns), 1, "namespace %s indexes", ns)
+	}
+}
+
+func listObjects(t *testing.T, apiserver *builder.Server, resource, ns string) []runtime.Object {
+	storage, err := getStorage(context.Background(), apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: resource})
+	assert.NoError(t, err)
+	ctx := genericapirequest.WithNamespace(context.Background(), ns)
+	list, err := storage.List(ctx, &internalversion.ListOptions{})
+	assert.NoError(t, err)
+	items, err := meta.ExtractList(list)
+	assert.NoError(t, err)
+	return items
+}
+
+func listClaims(t *testing.T, apiserver *builder.Server, ns string) []runtime.Object {
+	return listObjects(t, apiserver, as.ASClaimPlural, ns)
+}
+
+type responder struct {
+	err error
+}
+
+func (r *responder) Object(statusCode int, obj runtime.Object) {}
+
+func (r *responder) Error(err error) { r.err = err }
+
+// TestArchiveREST exports and imports an index on the archive subresource
+func TestArchiveREST(t *testing.T) {
+	group := as.SchemeGroupVersion.Group
+	decoded, _ := exportIndex(t)
+	body := &bytes.Buffer{}
+	assert.NoError(t, decoded.Encode(body, archive.Format_JSON))
+
+	apiserver, importer := importServer(t, false)
+	rest := archive.NewREST(importer, group, func() runtime.Object { return &as.ASIndex{} })
+	serve := func(method, name, query string, b []byte) (*httptest.ResponseRecorder, error) {
+		rsp := &responder{}
+		ctx := genericapirequest.WithNamespace(context.Background(), "other")
+		handler, err := rest.Connect(ctx, name, nil, rsp)
+		if err != nil {
+			return nil, err
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, "/archive"+query, bytes.NewReader(b)))
+		return w, rsp.err
+	}
+
+	// a dry run does not import the index
+	_, err := serve(http.MethodPost, "b", "?dryRun=true", body.Bytes())
+	assert.NoError(t, err)
+	assert.Empty(t, listClaims(t, apiserver, "other"))
+
+	_, err = serve(http.MethodPost, "b", "", body.Bytes())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, listClaims(t, apiserver, "other"))
+	_, err = serve(http.MethodPost, "b", "", body.Bytes())
+	assert.True(t, apierrors.IsAlreadyExists(err), "expected already exists, got %v", err)
+
+	// the index of the path is exported
+	w, err := serve(http.MethodGet, "b", "?format=json", nil)
+	if assert.NoError(t, err) {
+		exported, err := archive.Decode(w.Body.Bytes())
+		if assert.NoError(t, err) && assert.Len(t, exported.Indexes, 1) {
+			assert.Equal(t, "other", exported.Indexes[0].Index.GetNamespace())
+			assert.Equal(t, "b", exported.Indexes[0].Index.GetName())
+		}
+	}
+	_, err = serve(http.MethodGet, "c", "", nil)
+	assert.True(t, apierrors.IsNotFound(err), "expected not found, got %v", err)
+
+	// an archive of another group or with multiple indexes is rejected
+	other := *decoded
+	other.Indexes = []*archive.Index{{Group: "vlan.be.kuid.dev", Index: decoded.Indexes[0].Index}}
+	otherBody := &bytes.Buffer{}
+	assert.NoError(t, other.Encode(otherBody, archive.Format_JSON))
+	_, err = serve(http.MethodPost, "c", "", otherBody.Bytes())
+	assert.True(t, apierrors.IsBadRequest(err), "expected bad request, got %v", err)
+	other.Indexes = append(decoded.Indexes, decoded.Indexes...)
+	otherBody.Reset()
+	assert.NoError(t, other.Encode(otherBody, archive.Format_JSON))
+	_, err = serve(http.MethodPost, "c", "", otherBody.Bytes())
+	assert.True(t, apierrors.IsBadRequest(err), "expected bad request, got %v", err)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testas
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend/as"
+	"github.com/kuidio/kuid/pkg/audit"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+func TestAudit(t *testing.T) {
+	ctx := context.Background()
+
+	auditLog, err := audit.NewFileLog(audit.Options{Path: filepath.Join(t.TempDir(), "audit", "audit.log"), MaxSizeMB: 1})
+	assert.NoError(t, err)
+	defer auditLog.Close()
+
+	apiserver := apiServer()
+	_, err = initWrappedBackend(ctx, apiserver, func(be bebackend.Backend) bebackend.Backend {
+		return bebackend.NewAuditBackend(be, as.SchemeGroupVersion.Group, auditLog)
+	})
+	assert.NoError(t, err)
+	indexStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASIndexPlural})
+	assert.NoError(t, err)
+	claimStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASClaimPlural})
+	assert.NoError(t, err)
+
+	index, err := getIndex("audit", "")
+	assert.NoError(t, err)
+	// no reserved claims in the audit trail
+	index.Spec.AllowReserved = true
+	ctx = genericapirequest.WithNamespace(ctx, index.GetNamespace())
+	ctx = genericapirequest.WithUser(ctx, &user.DefaultInfo{Name: "alice"})
+	_, err = indexStorage.Create(ctx, index, nil, &metav1.CreateOptions{FieldManager: "backend"})
+	assert.NoError(t, err)
+
+	for _, tc := range []testCtx{
+		{name: "claim1", id: 100},
+		{name: "claim2", id: 100}, // conflicts with claim1
+	} {
+		claim, err := tc.getStaticClaim("audit", "")
+		assert.NoError(t, err)
+		_, _ = claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+	}
+	_, _, err = claimStorage.Delete(ctx, "claim1", nil, &metav1.DeleteOptions{})
+	assert.NoError(t, err)
+
+	records, err := queryAudit(auditLog, &audit.Filter{Index: "audit"})
+	assert.NoError(t, err)
+	operations := []string{}
+	for _, record := range records {
+		assert.Equal(t, "alice", record.User)
+		operations = append(operations, record.Operation+"/"+record.Name+"/"+record.Result)
+	}
+	assert.Equal(t, []string{
+		"createIndex/audit/success",
+		"claim/claim1/success",
+		"claim/claim2/failure",
+		"release/claim1/success",
+	}, operations)
+
+	records, err = queryAudit(auditLog, &audit.Filter{Name: "claim1", Operation: bebackend.AuditOperationClaim})
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, float64(100), records[0].Requested["id"])
+		assert.Equal(t, float64(100), records[0].Allocated["id"])
+	}
+}
+
+func queryAudit(auditLog *audit.FileLog, filter *audit.Filter) ([]*bebackend.AuditRecord, error) {
+	records := []*bebackend.AuditRecord{}
+	err := auditLog.Query(filter, func(record *bebackend.AuditRecord) error {
+		records = append(records, record)
+		return nil
+	})
+	return records, err
+}
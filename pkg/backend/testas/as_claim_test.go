@@ -42,7 +42,7 @@ func Test(t *testing.T) {
 		"Mix": {
 			index: "a",
 			ctxs: []testCtx{
-				{claimType: dynamicClaim, name: "claim1", expectedError: false, expectedID: ptr.To[uint64](0)},
+				{claimType: dynamicClaim, name: "claim1", expectedError: false, expectedID: ptr.To[uint64](1)}, // 0 is reserved
 				{claimType: staticClaim, name: "claim2", id: 100, expectedError: false},
 				{claimType: staticClaim, name: "claim3", id: 4000, expectedError: false},
 				{claimType: rangeClaim, name: "claim4", tRange: "10-19", expectedError: false},
@@ -0,0 +1,158 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testas
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend/as"
+	"github.com/kuidio/kuid/apis/backend/as/register"
+	asbev1alpha1 "github.com/kuidio/kuid/apis/backend/as/v1alpha1"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	genericbe "github.com/kuidio/kuid/pkg/backend/generic"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+)
+
+const otherNamespace = "other"
+
+func clientScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NoError(t, as.AddToScheme(scheme))
+	assert.NoError(t, asbev1alpha1.AddToScheme(scheme))
+	return scheme
+}
+
+// TestClientStorage validates the CRD storage of the entries and claims using a fake client
+func TestClientStorage(t *testing.T) {
+	scheme := clientScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&asbev1alpha1.ASEntry{}, &asbev1alpha1.ASClaim{}, &asbev1alpha1.ASIndex{}).
+		Build()
+	testClientStorage(t, c)
+}
+
+// TestClientStorageEnvtest validates the CRD storage of the entries and claims against
+// a real apiserver, the test requires the envtest binaries (KUBEBUILDER_ASSETS)
+func TestClientStorageEnvtest(t *testing.T) {
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS not set, skipping envtest")
+	}
+	env := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "..", "crds")},
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := env.Start()
+	if err != nil {
+		t.Fatalf("cannot start envtest, err: %v", err)
+	}
+	t.Cleanup(func() { _ = env.Stop() })
+
+	c, err := client.New(cfg, client.Options{Scheme: clientScheme(t)})
+	if err != nil {
+		t.Fatalf("cannot create client, err: %v", err)
+	}
+	testClientStorage(t, c)
+}
+
+// testClientStorage allocates the same id in an index with the same name in 2 namespaces
+// and validates the entries and claims of one namespace do not affect the other
+func testClientStorage(t *testing.T, c client.Client) {
+	ctx := context.Background()
+	for _, ns := range []string{namespace, otherNamespace} {
+		err := c.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+		assert.NoError(t, client.IgnoreAlreadyExists(err))
+	}
+
+	be := register.NewBackend()
+	if !assert.NoError(t, register.ApplyClientToBackend(ctx, be, c)) {
+		return
+	}
+	for _, ns := range []string{otherNamespace, namespace} {
+		index := as.BuildASIndex(metav1.ObjectMeta{Namespace: ns, Name: "a"}, nil, nil)
+		assert.NoError(t, be.CreateIndex(ctx, index))
+		claim := getClientClaim(t, ns, "claim10", 10)
+		createClientClaim(t, ctx, c, claim)
+		assert.NoError(t, be.Claim(ctx, claim, false))
+	}
+
+	// the entries of the index in the other namespace are not removed when
+	// the index with the same name is saved
+	for _, ns := range []string{otherNamespace, namespace} {
+		entries := &asbev1alpha1.ASEntryList{}
+		assert.NoError(t, c.List(ctx, entries, client.InNamespace(ns)))
+		assert.NotEmpty(t, entries.Items, "namespace %s has no entries", ns)
+	}
+
+	scheme := clientScheme(t)
+	storage := genericbe.NewClientBackendstorage(
+		bebackend.NewClientStore(c, scheme, asbev1alpha1.SchemeGroupVersion.WithKind(as.ASEntryKind), true),
+		bebackend.NewClientStore(c, scheme, asbev1alpha1.SchemeGroupVersion.WithKind(as.ASClaimKind), false),
+	)
+	key := as.BuildASIndex(metav1.ObjectMeta{Namespace: namespace, Name: "a"}, nil, nil).GetKey()
+	claims, err := storage.ListClaims(ctx, key)
+	assert.NoError(t, err)
+	assert.Contains(t, claims, namespace+"/claim10")
+	for _, claim := range claims {
+		assert.Equal(t, namespace, claim.GetNamespace())
+	}
+	entries, err := storage.ListEntries(ctx, key)
+	assert.NoError(t, err)
+	for _, entry := range entries {
+		assert.Equal(t, namespace, entry.GetNamespace())
+	}
+
+	// a restored backend only restores the claims of the namespace of the index
+	restored := register.NewBackend()
+	if !assert.NoError(t, register.ApplyClientToBackend(ctx, restored, c)) {
+		return
+	}
+	index := as.BuildASIndex(metav1.ObjectMeta{Namespace: namespace, Name: "a"}, nil, nil)
+	assert.NoError(t, restored.RestoreIndex(ctx, index))
+	assert.Error(t, restored.Claim(ctx, getClientClaim(t, namespace, "conflict", 10), false))
+	assert.NoError(t, restored.Claim(ctx, getClientClaim(t, namespace, "claim11", 11), false))
+}
+
+func getClientClaim(t *testing.T, ns, name string, id uint32) *as.ASClaim {
+	claim, err := testCtx{name: name, id: uint64(id)}.getStaticClaim("a", "")
+	assert.NoError(t, err)
+	claim.SetNamespace(ns)
+	claim.SetUID(uuid.NewUUID())
+	return claim.(*as.ASClaim)
+}
+
+// createClientClaim creates the versioned claim, in CRD mode the claims are created
+// by the user and the backend only persists the entries
+func createClientClaim(t *testing.T, ctx context.Context, c client.Client, claim *as.ASClaim) {
+	extClaim := &asbev1alpha1.ASClaim{}
+	assert.NoError(t, asbev1alpha1.Convert_as_ASClaim_To_v1alpha1_ASClaim(claim, extClaim, nil))
+	extClaim.SetUID("")
+	extClaim.SetResourceVersion("")
+	assert.NoError(t, c.Create(ctx, extClaim))
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testas
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/henderiw/apiserver-builder/pkg/builder"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/as"
+	"github.com/kuidio/kuid/apis/backend/as/register"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	genericbe "github.com/kuidio/kuid/pkg/backend/generic"
+	"github.com/kuidio/kuid/pkg/leaderelection"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	k8sleaderelection "k8s.io/client-go/tools/leaderelection"
+)
+
+func initLeaderBackend(ctx context.Context, apiserver *builder.Server, leader bebackend.LeaderChecker) (bebackend.Backend, error) {
+	return initWrappedBackend(ctx, apiserver, func(be bebackend.Backend) bebackend.Backend {
+		return bebackend.NewLeaderBackend(be, leader)
+	})
+}
+
+// campaign runs the elector and returns a channel that is closed when the leadership is acquired
+func campaign(ctx context.Context, elector leaderelection.Elector) <-chan struct{} {
+	leading := make(chan struct{})
+	go elector.Run(ctx, k8sleaderelection.LeaderCallbacks{
+		OnStartedLeading: func(ctx context.Context) { close(leading) },
+		OnStoppedLeading: func() {},
+	})
+	return leading
+}
+
+// TestFailover kills the leader after a fixed number of claims and validates the
+// standby takes over with a restored cache that does not hand out conflicting ids
+func TestFailover(t *testing.T) {
+	lockFile := filepath.Join(t.TempDir(), "kuid-server.lock")
+	electorA := leaderelection.NewFileElector(lockFile, "a", 10*time.Millisecond)
+	electorB := leaderelection.NewFileElector(lockFile, "b", 10*time.Millisecond)
+
+	ctxA, killA := context.WithCancel(context.Background())
+	defer killA()
+	ctxB, cancelB := context.WithCancel(context.Background())
+	defer cancelB()
+
+	select {
+	case <-campaign(ctxA, electorA):
+	case <-time.After(5 * time.Second):
+		t.Fatal("replica a did not acquire the leadership")
+	}
+	leadingB := campaign(ctxB, electorB)
+	assert.False(t, electorB.IsLeader())
+
+	ctx := context.Background()
+	apiserver := apiServer()
+	if _, err := initLeaderBackend(ctx, apiserver, electorA); err != nil {
+		t.Fatalf("cannot get backend, err: %v", err)
+	}
+	indexStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASIndexPlural})
+	if err != nil {
+		t.Fatalf("cannot get index storage, err: %v", err)
+	}
+	claimStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASClaimPlural})
+	if err != nil {
+		t.Fatalf("cannot get claim storage, err: %v", err)
+	}
+	entryStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASEntryPlural})
+	if err != nil {
+		t.Fatalf("cannot get entry storage, err: %v", err)
+	}
+
+	index, err := getIndex("a", "")
+	assert.NoError(t, err)
+	ctx = genericapirequest.WithNamespace(ctx, index.GetNamespace())
+	_, err = indexStorage.Create(ctx, index, nil, &metav1.CreateOptions{FieldManager: "backend"})
+	assert.NoError(t, err)
+	pool, err := testCtx{name: "pool", tRange: "100-199"}.getRangeClaim("a", "")
+	assert.NoError(t, err)
+	_, err = claimStorage.Create(ctx, pool, nil, &metav1.CreateOptions{FieldManager: "test"})
+	assert.NoError(t, err)
+	poolSelector := &metav1.LabelSelector{MatchLabels: map[string]string{backend.KuidClaimNameKey: "pool"}}
+
+	// allocate a fixed number of claims on the leader
+	allocated := map[uint64]string{}
+	for i := 0; i < 10; i++ {
+		claim, err := testCtx{name: fmt.Sprintf("a-claim%d", i), selector: poolSelector}.getDynamicClaim("a", "")
+		assert.NoError(t, err)
+		newClaim, err := claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		allocated[*newClaim.(backend.ClaimObject).GetStatusID()] = claim.GetName()
+	}
+	assert.Len(t, allocated, 10)
+
+	// kill the leader, the standby takes over once the lock is released
+	killA()
+	select {
+	case <-leadingB:
+	case <-time.After(5 * time.Second):
+		t.Fatal("replica b did not take over the leadership")
+	}
+	assert.False(t, electorA.IsLeader())
+
+	// claims on a replica that lost the leadership are refused
+	claim, err := testCtx{name: "a-claim-refused", selector: poolSelector}.getDynamicClaim("a", "")
+	assert.NoError(t, err)
+	_, err = claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+	assert.True(t, apierrors.IsServiceUnavailable(err), "claims on a replica that lost the leadership must be refused, got: %v", err)
+
+	// the new leader restores its cache from the shared storage
+	beB := bebackend.NewLeaderBackend(register.NewBackend(), electorB)
+	assert.NoError(t, beB.AddStorageInterfaces(genericbe.NewKuidBackendstorage(entryStorage, claimStorage)))
+	storedIndex, err := indexStorage.Get(ctx, index.GetName(), &metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NoError(t, beB.RestoreIndex(ctx, storedIndex))
+
+	for i := 0; i < 10; i++ {
+		claim, err := testCtx{name: fmt.Sprintf("b-claim%d", i), selector: poolSelector}.getDynamicClaim("a", "")
+		assert.NoError(t, err)
+		claim.SetUID(uuid.NewUUID())
+		assert.NoError(t, beB.Claim(ctx, claim, false))
+		id := claim.GetStatusID()
+		if !assert.NotNil(t, id) {
+			return
+		}
+		owner, ok := allocated[*id]
+		assert.False(t, ok, "id %d claimed by %s was handed out again to %s", *id, owner, claim.GetName())
+		allocated[*id] = claim.GetName()
+	}
+}
+
+// TestFailoverMidAllocation kills the leader while claims are in flight and validates every
+// claim either finished on the old leader or is retried on the new leader, without
+// duplicate ids nor orphaned entries once the standby restored the index
+func TestFailoverMidAllocation(t *testing.T) {
+	lockFile := filepath.Join(t.TempDir(), "kuid-server.lock")
+	electorA := leaderelection.NewFileElector(lockFile, "a", 10*time.Millisecond)
+	electorB := leaderelection.NewFileElector(lockFile, "b", 10*time.Millisecond)
+
+	ctxA, killA := context.WithCancel(context.Background())
+	defer killA()
+	ctxB, cancelB := context.WithCancel(context.Background())
+	defer cancelB()
+
+	select {
+	case <-campaign(ctxA, electorA):
+	case <-time.After(5 * time.Second):
+		t.Fatal("replica a did not acquire the leadership")
+	}
+	leadingB := campaign(ctxB, electorB)
+
+	ctx := context.Background()
+	apiserver := apiServer()
+	if _, err := initLeaderBackend(ctx, apiserver, electorA); err != nil {
+		t.Fatalf("cannot get backend, err: %v", err)
+	}
+	indexStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASIndexPlural})
+	if err != nil {
+		t.Fatalf("cannot get index storage, err: %v", err)
+	}
+	claimStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASClaimPlural})
+	if err != nil {
+		t.Fatalf("cannot get claim storage, err: %v", err)
+	}
+	entryStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASEntryPlural})
+	if err != nil {
+		t.Fatalf("cannot get entry storage, err: %v", err)
+	}
+
+	index, err := getIndex("a", "")
+	assert.NoError(t, err)
+	ctx = genericapirequest.WithNamespace(ctx, index.GetNamespace())
+	_, err = indexStorage.Create(ctx, index, nil, &metav1.CreateOptions{FieldManager: "backend"})
+	assert.NoError(t, err)
+	pool, err := testCtx{name: "pool", tRange: "100-1099"}.getRangeClaim("a", "")
+	assert.NoError(t, err)
+	_, err = claimStorage.Create(ctx, pool, nil, &metav1.CreateOptions{FieldManager: "test"})
+	assert.NoError(t, err)
+	poolSelector := &metav1.LabelSelector{MatchLabels: map[string]string{backend.KuidClaimNameKey: "pool"}}
+
+	// the workers allocate on the leader until the leader refuses a claim, the leader is
+	// killed while the workers are issuing claims
+	const workers = 4
+	var m sync.Mutex
+	finished := map[string]uint64{} // claims finished on the old leader
+	refused := []string{}           // claims to retry on the new leader
+	var wg sync.WaitGroup
+	allocating := make(chan struct{})
+	var allocatingOnce sync.Once
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; ; i++ {
+				claim, err := testCtx{name: fmt.Sprintf("w%d-claim%d", w, i), selector: poolSelector}.getDynamicClaim("a", "")
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				newClaim, err := claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+				m.Lock()
+				if err != nil {
+					assert.True(t, apierrors.IsServiceUnavailable(err), "claim %s: claims on a replica that lost the leadership must be refused, got: %v", claim.GetName(), err)
+					refused = append(refused, claim.GetName())
+					m.Unlock()
+					return
+				}
+				finished[claim.GetName()] = *newClaim.(backend.ClaimObject).GetStatusID()
+				if len(finished) == 2*workers {
+					allocatingOnce.Do(func() { close(allocating) })
+				}
+				m.Unlock()
+			}
+		}(w)
+	}
+
+	select {
+	case <-allocating:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the workers did not allocate on the leader")
+	}
+	killA()
+	wg.Wait()
+	select {
+	case <-leadingB:
+	case <-time.After(5 * time.Second):
+		t.Fatal("replica b did not take over the leadership")
+	}
+	assert.False(t, electorA.IsLeader())
+	assert.Len(t, refused, workers, "every worker stops at its first refused claim")
+
+	// the new leader restores its cache from the shared storage and the refused claims
+	// are retried on the new leader
+	beB := bebackend.NewLeaderBackend(register.NewBackend(), electorB)
+	assert.NoError(t, beB.AddStorageInterfaces(genericbe.NewKuidBackendstorage(entryStorage, claimStorage)))
+	storedIndex, err := indexStorage.Get(ctx, index.GetName(), &metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NoError(t, beB.RestoreIndex(ctx, storedIndex))
+
+	retried := map[string]uint64{}
+	for _, name := range refused {
+		claim, err := testCtx{name: name, selector: poolSelector}.getDynamicClaim("a", "")
+		assert.NoError(t, err)
+		claim.SetUID(uuid.NewUUID())
+		if !assert.NoError(t, beB.Claim(ctx, claim, false), "claim %s", name) {
+			continue
+		}
+		if assert.NotNil(t, claim.GetStatusID(), "claim %s", name) {
+			retried[name] = *claim.GetStatusID()
+		}
+	}
+
+	// every claim finished on the old leader or was retried on the new leader, no id is
+	// handed out twice
+	allocated := map[uint64]string{}
+	for _, claims := range []map[string]uint64{finished, retried} {
+		for name, id := range claims {
+			owner, ok := allocated[id]
+			assert.False(t, ok, "id %d claimed by %s was handed out again to %s", id, owner, name)
+			allocated[id] = name
+		}
+	}
+	for _, name := range refused {
+		_, ok := finished[name]
+		assert.False(t, ok, "refused claim %s is finished on the old leader", name)
+		assert.Contains(t, retried, name)
+	}
+
+	// every dynamic entry belongs to a finished or retried claim and holds its id
+	entries := map[string]uint64{}
+	for _, entry := range listEntries(t, ctx, entryStorage, fields.Everything()) {
+		if entry.Spec.ClaimType != backend.ClaimType_DynamicID {
+			continue
+		}
+		if !assert.Len(t, entry.GetOwnerReferences(), 1, "entry %s", entry.GetName()) {
+			continue
+		}
+		name := entry.GetOwnerReferences()[0].Name
+		_, ok := entries[name]
+		assert.False(t, ok, "claim %s has multiple entries", name)
+		treeID, _, _ := strings.Cut(entry.Spec.ID, "/")
+		id, err := strconv.ParseUint(treeID, 10, 64)
+		assert.NoError(t, err)
+		entries[name] = id
+	}
+	assert.Len(t, entries, len(allocated), "orphaned or missing entries")
+	for id, name := range allocated {
+		assert.Equal(t, id, entries[name], "entry of claim %s", name)
+	}
+}
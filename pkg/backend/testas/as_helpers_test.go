@@ -68,6 +68,11 @@ func apiServer() *builder.Server {
 }
 
 func initBackend(ctx context.Context, apiserver *builder.Server) (bebackend.Backend, error) {
+	return initWrappedBackend(ctx, apiserver, func(be bebackend.Backend) bebackend.Backend { return be })
+}
+
+// initWrappedBackend initializes the backend wrapped by the wrap function, e.g. for leader election or hooks
+func initWrappedBackend(ctx context.Context, apiserver *builder.Server, wrap func(bebackend.Backend) bebackend.Backend) (bebackend.Backend, error) {
 	groupConfig := config.GroupConfig{
 		BackendFn:               register.NewBackend,
 		ApplyStorageToBackendFn: register.ApplyStorageToBackend,
@@ -78,7 +83,7 @@ func initBackend(ctx context.Context, apiserver *builder.Server) (bebackend.Back
 		},
 	}
 
-	be := groupConfig.BackendFn()
+	be := wrap(groupConfig.BackendFn())
 	for _, resource := range groupConfig.Resources {
 		storageProvider := resource.StorageProviderFn(ctx, resource.Internal, be, true, &options.Options{
 			Type: options.StorageType_Memory,
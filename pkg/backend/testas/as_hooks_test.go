@@ -0,0 +1,141 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend/as"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+const hookIndex = "hooks"
+
+func TestHooks(t *testing.T) {
+	ctx := context.Background()
+
+	// in-process policy: ids below 100 are reserved for the infra namespace
+	t.Cleanup(bebackend.RegisterHook(bebackend.HookFuncs{
+		PreClaimFn: func(ctx context.Context, req *bebackend.HookRequest) error {
+			claim, ok := req.Object.(*as.ASClaim)
+			if !ok || req.Index != hookIndex {
+				return nil
+			}
+			if claim.Spec.ID != nil && *claim.Spec.ID < 100 && req.Namespace != "infra" {
+				return fmt.Errorf("ids below 100 are reserved for namespace infra")
+			}
+			return nil
+		},
+	}, as.SchemeGroupVersion.Group))
+
+	// webhook policy: claims require the approved label, the allocations are notified
+	var m sync.Mutex
+	notified := map[string]*uint32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		review := struct {
+			Stage   bebackend.HookStage `json:"stage"`
+			Request struct {
+				Operation bebackend.HookOperation `json:"operation"`
+				Name      string                  `json:"name"`
+				Index     string                  `json:"index"`
+				Labels    map[string]string       `json:"labels"`
+				Object    *as.ASClaim             `json:"object"`
+			} `json:"request"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		rsp := &bebackend.HookReview{Stage: review.Stage, Response: &bebackend.HookResponse{Allowed: true}}
+		if review.Request.Index == hookIndex {
+			switch review.Stage {
+			case bebackend.HookStage_Pre:
+				if review.Request.Labels["approved"] != "true" {
+					rsp.Response = &bebackend.HookResponse{Allowed: false, Message: "claim is not approved"}
+				}
+			case bebackend.HookStage_Post:
+				m.Lock()
+				notified[string(review.Request.Operation)+"/"+review.Request.Name] = review.Request.Object.Status.ID
+				m.Unlock()
+			}
+		}
+		_ = json.NewEncoder(w).Encode(rsp)
+	}))
+	defer server.Close()
+	t.Cleanup(bebackend.RegisterHook(bebackend.NewWebhook(bebackend.WebhookOptions{Name: "approval", URL: server.URL}), as.SchemeGroupVersion.Group))
+
+	apiserver := apiServer()
+	_, err := initWrappedBackend(ctx, apiserver, func(be bebackend.Backend) bebackend.Backend {
+		return bebackend.NewHookBackend(be, as.SchemeGroupVersion.Group)
+	})
+	assert.NoError(t, err)
+	indexStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASIndexPlural})
+	assert.NoError(t, err)
+	claimStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASClaimPlural})
+	assert.NoError(t, err)
+
+	index, err := getIndex(hookIndex, "")
+	assert.NoError(t, err)
+	ctx = genericapirequest.WithNamespace(ctx, index.GetNamespace())
+	_, err = indexStorage.Create(ctx, index, nil, &metav1.CreateOptions{FieldManager: "backend"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		ctx       testCtx
+		approved  bool
+		forbidden bool
+	}{
+		{ctx: testCtx{name: "reserved", id: 50}, approved: true, forbidden: true},
+		{ctx: testCtx{name: "unapproved", id: 150}, forbidden: true},
+		{ctx: testCtx{name: "approved", id: 150}, approved: true},
+	}
+	for _, tc := range tests {
+		claim, err := tc.ctx.getStaticClaim(hookIndex, "")
+		assert.NoError(t, err)
+		if tc.approved {
+			claim.SetLabels(map[string]string{"approved": "true"})
+		}
+		_, err = claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+		if tc.forbidden {
+			assert.True(t, apierrors.IsForbidden(err), "claim %s: expected forbidden, got %v", tc.ctx.name, err)
+			continue
+		}
+		assert.NoError(t, err)
+	}
+
+	_, _, err = claimStorage.Delete(ctx, "approved", nil, &metav1.DeleteOptions{})
+	assert.NoError(t, err)
+
+	m.Lock()
+	defer m.Unlock()
+	assert.Len(t, notified, 2)
+	if id := notified["claim/approved"]; assert.NotNil(t, id) {
+		assert.Equal(t, uint32(150), *id)
+	}
+	assert.Contains(t, notified, "release/approved")
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testas
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend/as"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsIndex = "metrics"
+
+// indexGatherer gathers the metrics of the index from the registry, the metrics of the
+// indexes of the other tests are dropped
+func indexGatherer(index string) prometheus.Gatherer {
+	return prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		mfs, err := metrics.Registry.Gather()
+		if err != nil {
+			return nil, err
+		}
+		indexMfs := make([]*dto.MetricFamily, 0, len(mfs))
+		for _, mf := range mfs {
+			indexMetrics := make([]*dto.Metric, 0, len(mf.GetMetric()))
+			for _, m := range mf.GetMetric() {
+				for _, label := range m.GetLabel() {
+					if label.GetName() == "index" && label.GetValue() == index {
+						indexMetrics = append(indexMetrics, m)
+						break
+					}
+				}
+			}
+			if len(indexMetrics) != 0 {
+				mf.Metric = indexMetrics
+				indexMfs = append(indexMfs, mf)
+			}
+		}
+		return indexMfs, nil
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	ctx := context.Background()
+	apiserver := apiServer()
+	be, err := initBackend(ctx, apiserver)
+	if !assert.NoError(t, err) {
+		return
+	}
+	bebackend.RegisterCapacity(as.SchemeGroupVersion.Group, be)
+
+	indexStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASIndexPlural})
+	if !assert.NoError(t, err) {
+		return
+	}
+	claimStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASClaimPlural})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// the index holds the ids 10 and 11
+	index := as.BuildASIndex(
+		metav1.ObjectMeta{Namespace: namespace, Name: metricsIndex},
+		&as.ASIndexSpec{MinID: ptr.To[uint32](10), MaxID: ptr.To[uint32](11)},
+		nil,
+	)
+	ctx = genericapirequest.WithNamespace(ctx, index.GetNamespace())
+	_, err = indexStorage.Create(ctx, index, nil, &metav1.CreateOptions{FieldManager: "backend"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, tc := range []struct {
+		ctx           testCtx
+		expectedError bool
+	}{
+		{ctx: testCtx{name: "claim1"}},
+		{ctx: testCtx{name: "claim2"}},
+		{ctx: testCtx{name: "claim3"}, expectedError: true}, // the index is exhausted
+	} {
+		claim, err := tc.ctx.getDynamicClaim(metricsIndex, "")
+		if !assert.NoError(t, err) {
+			return
+		}
+		_, err = claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+		if tc.expectedError {
+			assert.Error(t, err, "claim %s", tc.ctx.name)
+			continue
+		}
+		assert.NoError(t, err, "claim %s", tc.ctx.name)
+	}
+	_, _, err = claimStorage.Delete(ctx, "claim1", nil, &metav1.DeleteOptions{})
+	assert.NoError(t, err)
+
+	// the index claims the ranges outside the min and max id as reserved ranges
+	expected := `
+# HELP kuid_backend_claims_total Total number of claims and releases processed by the backend
+# TYPE kuid_backend_claims_total counter
+kuid_backend_claims_total{claim_type="dynamicID",group="as.be.kuid.dev",index="metrics",namespace="dummy",operation="claim",reason="",result="success"} 2
+kuid_backend_claims_total{claim_type="dynamicID",group="as.be.kuid.dev",index="metrics",namespace="dummy",operation="claim",reason="exhausted",result="failure"} 1
+kuid_backend_claims_total{claim_type="dynamicID",group="as.be.kuid.dev",index="metrics",namespace="dummy",operation="release",reason="",result="success"} 1
+kuid_backend_claims_total{claim_type="range",group="as.be.kuid.dev",index="metrics",namespace="dummy",operation="claim",reason="",result="success"} 2
+# HELP kuid_backend_exhausted_total Total number of claims that failed because the index has no free ids, addresses or prefixes
+# TYPE kuid_backend_exhausted_total counter
+kuid_backend_exhausted_total{claim_type="dynamicID",group="as.be.kuid.dev",index="metrics",namespace="dummy"} 1
+# HELP kuid_backend_index_allocated Number of allocated ids or addresses in the index, excluding the reserved ranges
+# TYPE kuid_backend_index_allocated gauge
+kuid_backend_index_allocated{group="as.be.kuid.dev",index="metrics",namespace="dummy"} 1
+# HELP kuid_backend_index_free Number of free ids or addresses in the index
+# TYPE kuid_backend_index_free gauge
+kuid_backend_index_free{group="as.be.kuid.dev",index="metrics",namespace="dummy"} 1
+`
+	assert.NoError(t, testutil.GatherAndCompare(indexGatherer(metricsIndex), strings.NewReader(expected),
+		"kuid_backend_claims_total",
+		"kuid_backend_exhausted_total",
+		"kuid_backend_index_allocated",
+		"kuid_backend_index_free",
+	))
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend/as"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/ptr"
+)
+
+// TestASNotation validates the asdot notation of the claims, the reserved ASs and the
+// restriction of the dynamic claims to the private ASs
+func TestASNotation(t *testing.T) {
+	tests := map[string]struct {
+		allowReserved bool
+		privateOnly   bool
+		spec          as.ASClaimSpec
+		expectedError bool
+		expectedID    *uint32
+		expectedRange *string
+		expectedASDot string
+	}{
+		"ASDot": {
+			spec:          as.ASClaimSpec{ASN: ptr.To("2.10")},
+			expectedID:    ptr.To[uint32](131082),
+			expectedASDot: "2.10",
+		},
+		"ASPlain": {
+			spec:          as.ASClaimSpec{ASN: ptr.To("4259840100")},
+			expectedID:    ptr.To[uint32](4259840100),
+			expectedASDot: "65000.100",
+		},
+		"RangeASDot": {
+			spec:          as.ASClaimSpec{Range: ptr.To("1.100-1.200")},
+			expectedRange: ptr.To("65636-65736"),
+			expectedASDot: "1.100-1.200",
+		},
+		"Reserved": {
+			spec:          as.ASClaimSpec{ID: ptr.To[uint32](23456)},
+			expectedError: true,
+		},
+		"ReservedRange": {
+			spec:          as.ASClaimSpec{Range: ptr.To("64000-64500")},
+			expectedError: true,
+		},
+		"AllowReserved": {
+			allowReserved: true,
+			spec:          as.ASClaimSpec{ID: ptr.To[uint32](23456)},
+			expectedID:    ptr.To[uint32](23456),
+			expectedASDot: "23456",
+		},
+		"Dynamic": {
+			expectedID:    ptr.To[uint32](1),
+			expectedASDot: "1",
+		},
+		"DynamicPrivateOnly": {
+			privateOnly:   true,
+			expectedID:    ptr.To[uint32](64512),
+			expectedASDot: "64512",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			apiserver := apiServer()
+			if _, err := initBackend(ctx, apiserver); err != nil {
+				t.Fatalf("cannot get backend, err: %v", err)
+			}
+			indexStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASIndexPlural})
+			if err != nil {
+				t.Fatalf("cannot get index storage, err: %v", err)
+			}
+			claimStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASClaimPlural})
+			if err != nil {
+				t.Fatalf("cannot get claim storage, err: %v", err)
+			}
+
+			index, err := getIndex("a", "")
+			assert.NoError(t, err)
+			index.Spec.AllowReserved = tc.allowReserved
+			index.Spec.PrivateOnly = tc.privateOnly
+			ctx = genericapirequest.WithNamespace(ctx, index.GetNamespace())
+			_, err = indexStorage.Create(ctx, index, nil, &metav1.CreateOptions{FieldManager: "backend"})
+			assert.NoError(t, err)
+
+			spec := tc.spec
+			spec.Index = "a"
+			claim := as.BuildASClaim(metav1.ObjectMeta{Namespace: namespace, Name: "claim"}, &spec, nil)
+			if errs := claim.ValidateSyntax(""); len(errs) != 0 {
+				t.Fatalf("invalid syntax %v", errs)
+			}
+			obj, err := claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			asClaim := obj.(*as.ASClaim)
+			assert.Equal(t, tc.expectedID, asClaim.Status.ID)
+			assert.Equal(t, tc.expectedRange, asClaim.Status.Range)
+			if assert.NotNil(t, asClaim.Status.ASDot) {
+				assert.Equal(t, tc.expectedASDot, *asClaim.Status.ASDot)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testas
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/as"
+	"github.com/kuidio/kuid/apis/backend/as/register"
+	genericbe "github.com/kuidio/kuid/pkg/backend/generic"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/ptr"
+)
+
+// TestRangeEntries validates a large range is stored as a single entry, the ids are
+// claimed from the range and restored without expanding the range
+func TestRangeEntries(t *testing.T) {
+	ctx := context.Background()
+	apiserver := apiServer()
+	if _, err := initBackend(ctx, apiserver); err != nil {
+		t.Fatalf("cannot get backend, err: %v", err)
+	}
+	indexStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASIndexPlural})
+	if err != nil {
+		t.Fatalf("cannot get index storage, err: %v", err)
+	}
+	claimStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASClaimPlural})
+	if err != nil {
+		t.Fatalf("cannot get claim storage, err: %v", err)
+	}
+	entryStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASEntryPlural})
+	if err != nil {
+		t.Fatalf("cannot get entry storage, err: %v", err)
+	}
+
+	index, err := getIndex("a", "")
+	assert.NoError(t, err)
+	// the range covers reserved ASs
+	index.Spec.AllowReserved = true
+	ctx = genericapirequest.WithNamespace(ctx, index.GetNamespace())
+	_, err = indexStorage.Create(ctx, index, nil, &metav1.CreateOptions{FieldManager: "backend"})
+	assert.NoError(t, err)
+
+	pool, err := testCtx{name: "pool", tRange: "100-4000000"}.getRangeClaim("a", "")
+	assert.NoError(t, err)
+	_, err = claimStorage.Create(ctx, pool, nil, &metav1.CreateOptions{FieldManager: "test"})
+	assert.NoError(t, err)
+
+	overlap, err := testCtx{name: "overlap", tRange: "3000000-5000000"}.getRangeClaim("a", "")
+	assert.NoError(t, err)
+	_, err = claimStorage.Create(ctx, overlap, nil, &metav1.CreateOptions{FieldManager: "test"})
+	assert.Error(t, err, "a range overlapping with another range must fail")
+
+	poolSelector := &metav1.LabelSelector{MatchLabels: map[string]string{backend.KuidClaimNameKey: "pool"}}
+	for i := 0; i < 3; i++ {
+		claim, err := testCtx{name: fmt.Sprintf("claim%d", i), selector: poolSelector}.getDynamicClaim("a", "")
+		assert.NoError(t, err)
+		newClaim, err := claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(100+i), *newClaim.(backend.ClaimObject).GetStatusID())
+	}
+	_, _, err = claimStorage.Delete(ctx, "claim1", nil, &metav1.DeleteOptions{})
+	assert.NoError(t, err)
+
+	entries := listEntries(t, ctx, entryStorage, fields.Everything())
+	assert.Len(t, entries, 3)
+	pools := listEntries(t, ctx, entryStorage, fields.OneTermEqualSelector("spec.id", "123456"))
+	if assert.Len(t, pools, 1) {
+		assert.Equal(t, "100-4000000", pools[0].Spec.ID)
+		assert.Equal(t, uint64(3999901), pools[0].Spec.Count)
+		assert.Equal(t, backend.ClaimType_Range, pools[0].Spec.ClaimType)
+	}
+
+	// a new backend restores the cache from the range entry and the remaining claims
+	be := register.NewBackend()
+	assert.NoError(t, be.AddStorageInterfaces(genericbe.NewKuidBackendstorage(entryStorage, claimStorage)))
+	storedIndex, err := indexStorage.Get(ctx, index.GetName(), &metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NoError(t, be.RestoreIndex(ctx, storedIndex))
+
+	for _, expectedID := range []uint64{101, 103} {
+		claim, err := testCtx{name: fmt.Sprintf("restored%d", expectedID), selector: poolSelector}.getDynamicClaim("a", "")
+		assert.NoError(t, err)
+		claim.SetUID(uuid.NewUUID())
+		assert.NoError(t, be.Claim(ctx, claim, false))
+		if assert.NotNil(t, claim.GetStatusID()) {
+			assert.Equal(t, expectedID, *claim.GetStatusID())
+		}
+	}
+}
+
+func listEntries(t *testing.T, ctx context.Context, entryStorage interface {
+	List(ctx context.Context, options *internalversion.ListOptions) (runtime.Object, error)
+}, selector fields.Selector) []as.ASEntry {
+	t.Helper()
+	list, err := entryStorage.List(ctx, &internalversion.ListOptions{FieldSelector: selector})
+	if err != nil {
+		t.Fatalf("cannot list entries, err: %v", err)
+	}
+	return list.(*as.ASEntryList).Items
+}
+
+// TestRangeSegments validates the dynamic claims of a range with multiple segments are
+// allocated across the segments and the overlap is validated for every segment
+func TestRangeSegments(t *testing.T) {
+	ctx := context.Background()
+	apiserver := apiServer()
+	if _, err := initBackend(ctx, apiserver); err != nil {
+		t.Fatalf("cannot get backend, err: %v", err)
+	}
+	indexStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASIndexPlural})
+	if err != nil {
+		t.Fatalf("cannot get index storage, err: %v", err)
+	}
+	claimStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASClaimPlural})
+	if err != nil {
+		t.Fatalf("cannot get claim storage, err: %v", err)
+	}
+	entryStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASEntryPlural})
+	if err != nil {
+		t.Fatalf("cannot get entry storage, err: %v", err)
+	}
+
+	index, err := getIndex("a", "")
+	assert.NoError(t, err)
+	ctx = genericapirequest.WithNamespace(ctx, index.GetNamespace())
+	_, err = indexStorage.Create(ctx, index, nil, &metav1.CreateOptions{FieldManager: "backend"})
+	assert.NoError(t, err)
+
+	_, err = testCtx{name: "invalid", tRange: "100-200,150-250"}.getRangeClaim("a", "")
+	assert.Error(t, err, "a range with overlapping segments must fail")
+
+	pool, err := testCtx{name: "pool", tRange: "300-301,100-101"}.getRangeClaim("a", "")
+	assert.NoError(t, err)
+	_, err = claimStorage.Create(ctx, pool, nil, &metav1.CreateOptions{FieldManager: "test"})
+	assert.NoError(t, err)
+	// every segment is stored as an entry
+	for _, id := range []string{"100-101", "300-301"} {
+		assert.Len(t, listEntries(t, ctx, entryStorage, fields.OneTermEqualSelector("spec.id", id)), 1)
+	}
+
+	overlap, err := testCtx{name: "overlap", tRange: "200-210,301-310"}.getRangeClaim("a", "")
+	assert.NoError(t, err)
+	_, err = claimStorage.Create(ctx, overlap, nil, &metav1.CreateOptions{FieldManager: "test"})
+	assert.Error(t, err, "a range overlapping with a segment of another range must fail")
+
+	poolSelector := &metav1.LabelSelector{MatchLabels: map[string]string{backend.KuidClaimNameKey: "pool"}}
+	for i, expectedID := range []uint64{100, 101, 300, 301} {
+		claim, err := testCtx{name: fmt.Sprintf("claim%d", i), selector: poolSelector}.getDynamicClaim("a", "")
+		assert.NoError(t, err)
+		newClaim, err := claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, expectedID, *newClaim.(backend.ClaimObject).GetStatusID())
+		}
+	}
+	claim, err := testCtx{name: "exhausted", selector: poolSelector}.getDynamicClaim("a", "")
+	assert.NoError(t, err)
+	_, err = claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+	assert.Error(t, err, "all the segments of the range are claimed")
+
+	// a segment that no longer covers the claimed ids cannot be removed
+	obj, err := claimStorage.Get(ctx, "pool", &metav1.GetOptions{})
+	assert.NoError(t, err)
+	update := obj.(*as.ASClaim)
+	update.Spec.Range = ptr.To("100-101")
+	_, _, err = claimStorage.Update(ctx, update.GetName(), rest.DefaultUpdatedObjectInfo(update), nil, nil, false, &metav1.UpdateOptions{FieldManager: "test"})
+	assert.Error(t, err, "the ids claimed from the removed segment do not fit in the range")
+
+	// a segment added to the range is used for new claims
+	obj, err = claimStorage.Get(ctx, "pool", &metav1.GetOptions{})
+	assert.NoError(t, err)
+	update = obj.(*as.ASClaim)
+	update.Spec.Range = ptr.To("100-101,300-301,500-500")
+	_, _, err = claimStorage.Update(ctx, update.GetName(), rest.DefaultUpdatedObjectInfo(update), nil, nil, false, &metav1.UpdateOptions{FieldManager: "test"})
+	assert.NoError(t, err)
+	newClaim, err := claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint64(500), *newClaim.(backend.ClaimObject).GetStatusID())
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testas
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/as"
+	"github.com/kuidio/kuid/apis/backend/as/register"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	genericbe "github.com/kuidio/kuid/pkg/backend/generic"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// TestRestore validates a backend restored from the persisted indexes, claims and entries
+// does not hand out ids that were claimed before the restart
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+	apiserver := apiServer()
+	if _, err := initBackend(ctx, apiserver); err != nil {
+		t.Fatalf("cannot get backend, err: %v", err)
+	}
+	indexStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASIndexPlural})
+	if err != nil {
+		t.Fatalf("cannot get index storage, err: %v", err)
+	}
+	claimStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASClaimPlural})
+	if err != nil {
+		t.Fatalf("cannot get claim storage, err: %v", err)
+	}
+	entryStorage, err := getStorage(ctx, apiserver, schema.GroupResource{Group: as.SchemeGroupVersion.Group, Resource: as.ASEntryPlural})
+	if err != nil {
+		t.Fatalf("cannot get entry storage, err: %v", err)
+	}
+
+	index, err := getIndex("a", "")
+	assert.NoError(t, err)
+	ctx = genericapirequest.WithNamespace(ctx, index.GetNamespace())
+	_, err = indexStorage.Create(ctx, index, nil, &metav1.CreateOptions{FieldManager: "backend"})
+	assert.NoError(t, err)
+
+	allocated := map[uint64]string{}
+	static, err := testCtx{name: "static", id: 10}.getStaticClaim("a", "")
+	assert.NoError(t, err)
+	_, err = claimStorage.Create(ctx, static, nil, &metav1.CreateOptions{FieldManager: "test"})
+	assert.NoError(t, err)
+	allocated[10] = "static"
+	for i := 0; i < 3; i++ {
+		claim, err := testCtx{name: fmt.Sprintf("dynamic%d", i)}.getDynamicClaim("a", "")
+		assert.NoError(t, err)
+		newClaim, err := claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		allocated[*newClaim.(backend.ClaimObject).GetStatusID()] = claim.GetName()
+	}
+
+	// a new backend, e.g. after a restart, restores its cache from the storage
+	be := register.NewBackend()
+	assert.NoError(t, be.AddStorageInterfaces(genericbe.NewKuidBackendstorage(entryStorage, claimStorage)))
+	assert.NoError(t, bebackend.RestoreIndexes(ctx, be, indexStorage, indexStorage))
+
+	conflict, err := testCtx{name: "conflict", id: 10}.getStaticClaim("a", "")
+	assert.NoError(t, err)
+	conflict.SetUID(uuid.NewUUID())
+	assert.Error(t, be.Claim(ctx, conflict, false), "static id 10 is claimed before the restore")
+
+	for i := 0; i < 3; i++ {
+		claim, err := testCtx{name: fmt.Sprintf("restored%d", i)}.getDynamicClaim("a", "")
+		assert.NoError(t, err)
+		claim.SetUID(uuid.NewUUID())
+		if !assert.NoError(t, be.Claim(ctx, claim, false)) {
+			return
+		}
+		id := claim.GetStatusID()
+		owner, ok := allocated[*id]
+		assert.False(t, ok, "id %d claimed by %s was handed out again to %s", *id, owner, claim.GetName())
+		allocated[*id] = claim.GetName()
+	}
+}
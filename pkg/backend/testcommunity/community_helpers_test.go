@@ -0,0 +1,100 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testcommunity
+
+import (
+	"context"
+
+	"github.com/henderiw/apiserver-builder/pkg/builder/resource"
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/community"
+	"github.com/kuidio/kuid/apis/backend/community/register"
+	communitybev1alpha1 "github.com/kuidio/kuid/apis/backend/community/v1alpha1"
+	"github.com/kuidio/kuid/apis/common"
+	"github.com/kuidio/kuid/pkg/backend/testutil"
+	"github.com/kuidio/kuid/pkg/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+type testCtx struct {
+	name              string
+	claimType         backend.ClaimType
+	id                uint32
+	community         string
+	tRange            string
+	selector          *metav1.LabelSelector
+	expectedError     bool
+	expectedID        *uint32
+	expectedCommunity *string
+}
+
+// alias
+const (
+	namespace    = testutil.Namespace
+	staticClaim  = backend.ClaimType_StaticID
+	dynamicClaim = backend.ClaimType_DynamicID
+	rangeClaim   = backend.ClaimType_Range
+)
+
+var group = testutil.Group{
+	Config: config.GroupConfig{
+		BackendFn:               register.NewBackend,
+		ApplyStorageToBackendFn: register.ApplyStorageToBackend,
+		Resources: []*config.ResourceConfig{
+			{StorageProviderFn: register.NewIndexStorageProvider, Internal: &community.CommunityIndex{}, ResourceVersions: []resource.Object{&community.CommunityIndex{}, &communitybev1alpha1.CommunityIndex{}}},
+			{StorageProviderFn: register.NewClaimStorageProvider, Internal: &community.CommunityClaim{}, ResourceVersions: []resource.Object{&community.CommunityClaim{}, &communitybev1alpha1.CommunityClaim{}}},
+			{StorageProviderFn: register.NewStorageProvider, Internal: &community.CommunityEntry{}, ResourceVersions: []resource.Object{&community.CommunityEntry{}, &communitybev1alpha1.CommunityEntry{}}},
+		},
+	},
+	IndexResource: community.Resource(community.CommunityIndexPlural),
+	ClaimResource: community.Resource(community.CommunityClaimPlural),
+}
+
+// initIndex initializes the backend and creates the index, it returns the claim storage
+func initIndex(ctx context.Context, index *community.CommunityIndex) (context.Context, *registry.Store, error) {
+	return testutil.InitIndex(ctx, group, index)
+}
+
+func getIndex(index string, spec *community.CommunityIndexSpec) *community.CommunityIndex {
+	return community.BuildCommunityIndex(
+		metav1.ObjectMeta{Namespace: namespace, Name: index},
+		spec,
+		nil,
+	)
+}
+
+func (r testCtx) getClaim(index string) (*community.CommunityClaim, error) {
+	spec := &community.CommunityClaimSpec{
+		Index: index,
+		ClaimLabels: common.ClaimLabels{
+			Selector: r.selector,
+		},
+	}
+	switch r.claimType {
+	case staticClaim:
+		if r.community != "" {
+			spec.Community = ptr.To[string](r.community)
+		} else {
+			spec.ID = ptr.To[uint32](r.id)
+		}
+	case rangeClaim:
+		spec.Range = ptr.To[string](r.tRange)
+	}
+	return testutil.Claim[*community.CommunityClaim](community.BuildCommunityClaim(metav1.ObjectMeta{Namespace: namespace, Name: r.name}, spec, nil))
+}
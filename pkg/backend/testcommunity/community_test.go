@@ -0,0 +1,182 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testcommunity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/community"
+	"github.com/kuidio/kuid/pkg/backend/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestCommunity(t *testing.T) {
+	tests := map[string]struct {
+		index string
+		spec  *community.CommunityIndexSpec
+		ctxs  []testCtx
+	}{
+		"Standard": {
+			index: "a",
+			spec:  &community.CommunityIndexSpec{Type: string(community.CommunityType_Standard), GlobalAdministrator: "65000"},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", expectedID: ptr.To[uint32](0), expectedCommunity: ptr.To("65000:0")},
+				{claimType: staticClaim, name: "claim2", id: 100, expectedCommunity: ptr.To("65000:100")},
+				{claimType: staticClaim, name: "claim3", community: "65000:200", expectedID: ptr.To[uint32](200), expectedCommunity: ptr.To("65000:200")},
+				{claimType: staticClaim, name: "claim4", community: "65000:100", expectedError: true},   // claimed by claim2
+				{claimType: staticClaim, name: "claim4", community: "65001:300", expectedError: true},   // another global administrator
+				{claimType: staticClaim, name: "claim4", community: "65000:1:300", expectedError: true}, // a large community
+				{claimType: staticClaim, name: "claim4", id: 65536, expectedError: true},                // exceeds the 16bit value
+				{claimType: rangeClaim, name: "claim5", tRange: "65535-65536", expectedError: true},     // exceeds the 16bit value
+				{claimType: rangeClaim, name: "claim5", tRange: "1000-1099", expectedCommunity: ptr.To("65000:1000-65000:1099")},
+				{claimType: dynamicClaim, name: "claim6", selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{backend.KuidClaimNameKey: "claim5"},
+				}, expectedID: ptr.To[uint32](1000), expectedCommunity: ptr.To("65000:1000")}, // a dynamic claim from the range
+			},
+		},
+		"WellKnown": {
+			index: "a",
+			spec:  &community.CommunityIndexSpec{Type: string(community.CommunityType_Standard), GlobalAdministrator: "65000"},
+			ctxs: []testCtx{
+				{claimType: staticClaim, name: "claim1", community: "NO_EXPORT", expectedError: true},
+				{claimType: staticClaim, name: "claim1", community: "no-advertise", expectedError: true},
+				{claimType: staticClaim, name: "claim1", community: "65535:65281", expectedError: true}, // NO_EXPORT
+				{claimType: staticClaim, name: "claim1", community: "65535:0", expectedError: true},     // GRACEFUL_SHUTDOWN
+				{claimType: staticClaim, name: "claim1", community: "65535:666", expectedError: true},   // BLACKHOLE
+				{claimType: staticClaim, name: "claim1", community: "65535:100", expectedError: true},   // the reserved global administrator
+			},
+		},
+		"Large": {
+			index: "a",
+			spec: &community.CommunityIndexSpec{Type: string(community.CommunityType_Large), GlobalAdministrator: "65000.100",
+				LocalDataPart1: ptr.To[uint32](10)}, // asdot notation
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", expectedID: ptr.To[uint32](0), expectedCommunity: ptr.To("4259840100:10:0")},
+				{claimType: staticClaim, name: "claim2", id: 70000, expectedCommunity: ptr.To("4259840100:10:70000")}, // a 32bit local data part 2
+				{claimType: staticClaim, name: "claim3", community: "4259840100:10:5", expectedID: ptr.To[uint32](5), expectedCommunity: ptr.To("4259840100:10:5")},
+				{claimType: staticClaim, name: "claim4", community: "65000.100:10:6", expectedID: ptr.To[uint32](6), expectedCommunity: ptr.To("4259840100:10:6")}, // the canonical form
+				{claimType: staticClaim, name: "claim5", community: "65000.100:10:5", expectedError: true},                                                         // claimed by claim3
+				{claimType: staticClaim, name: "claim5", community: "4259840100:11:7", expectedError: true},                                                        // another local data part 1
+				{claimType: staticClaim, name: "claim5", community: "4259840101:10:7", expectedError: true},                                                        // another global administrator
+				{claimType: staticClaim, name: "claim5", community: "65000:7", expectedError: true},                                                                // a standard community
+				{claimType: rangeClaim, name: "claim6", tRange: "100000-100099", expectedCommunity: ptr.To("4259840100:10:100000-4259840100:10:100099")},
+			},
+		},
+		"MinMax": {
+			index: "a",
+			spec: &community.CommunityIndexSpec{Type: string(community.CommunityType_Standard), GlobalAdministrator: "65000",
+				MinID: ptr.To[uint32](10), MaxID: ptr.To[uint32](11)},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", expectedID: ptr.To[uint32](10), expectedCommunity: ptr.To("65000:10")},
+				{claimType: dynamicClaim, name: "claim2", expectedID: ptr.To[uint32](11), expectedCommunity: ptr.To("65000:11")},
+				{claimType: dynamicClaim, name: "claim3", expectedError: true}, // the index is exhausted
+				{claimType: staticClaim, name: "claim4", community: "65000:9", expectedError: true},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx, claimStorage, err := initIndex(context.Background(), getIndex(tc.index, tc.spec))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			for _, v := range tc.ctxs {
+				var newClaim *community.CommunityClaim
+				claim, err := v.getClaim(tc.index)
+				if err == nil {
+					newClaim, err = testutil.Apply(ctx, claimStorage, claim)
+				}
+				if v.expectedError {
+					assert.Error(t, err, "claim %s", v.name)
+					continue
+				}
+				if !assert.NoError(t, err, "claim %s", v.name) {
+					continue
+				}
+
+				assert.Equal(t, v.expectedCommunity, newClaim.Status.Community, "claim %s community", v.name)
+				switch v.claimType {
+				case staticClaim, dynamicClaim:
+					expectedID := ptr.To[uint32](v.id)
+					if v.expectedID != nil {
+						expectedID = v.expectedID
+					}
+					assert.Equal(t, expectedID, newClaim.Status.ID, "claim %s id", v.name)
+				case rangeClaim:
+					assert.Equal(t, ptr.To[string](v.tRange), newClaim.Status.Range, "claim %s range", v.name)
+				}
+			}
+		})
+	}
+}
+
+func TestCommunityIndexGlobalAdministrator(t *testing.T) {
+	tests := map[string]struct {
+		spec          *community.CommunityIndexSpec
+		expectedError bool
+	}{
+		"StandardWellKnown": {
+			spec:          &community.CommunityIndexSpec{Type: string(community.CommunityType_Standard), GlobalAdministrator: "65535"},
+			expectedError: true, // holds the well-known communities
+		},
+		"StandardZero": {
+			spec:          &community.CommunityIndexSpec{Type: string(community.CommunityType_Standard), GlobalAdministrator: "0"},
+			expectedError: true,
+		},
+		"StandardNot2byteAS": {
+			spec:          &community.CommunityIndexSpec{Type: string(community.CommunityType_Standard), GlobalAdministrator: "70000"},
+			expectedError: true,
+		},
+		"StandardLocalDataPart1": {
+			spec: &community.CommunityIndexSpec{Type: string(community.CommunityType_Standard), GlobalAdministrator: "65000",
+				LocalDataPart1: ptr.To[uint32](1)},
+			expectedError: true,
+		},
+		"LargeReserved": {
+			spec: &community.CommunityIndexSpec{Type: string(community.CommunityType_Large), GlobalAdministrator: "4294967295",
+				LocalDataPart1: ptr.To[uint32](1)},
+			expectedError: true,
+		},
+		"LargeNoLocalDataPart1": {
+			spec:          &community.CommunityIndexSpec{Type: string(community.CommunityType_Large), GlobalAdministrator: "65000"},
+			expectedError: true,
+		},
+		"Large4byteAS": {
+			spec: &community.CommunityIndexSpec{Type: string(community.CommunityType_Large), GlobalAdministrator: "4259840100",
+				LocalDataPart1: ptr.To[uint32](1)},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, _, err := initIndex(context.Background(), getIndex("a", tc.spec))
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testesi
+
+import (
+	"context"
+
+	"github.com/henderiw/apiserver-builder/pkg/builder/resource"
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/esi"
+	"github.com/kuidio/kuid/apis/backend/esi/register"
+	esibev1alpha1 "github.com/kuidio/kuid/apis/backend/esi/v1alpha1"
+	"github.com/kuidio/kuid/apis/common"
+	"github.com/kuidio/kuid/pkg/backend/testutil"
+	"github.com/kuidio/kuid/pkg/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+type testCtx struct {
+	name          string
+	claimType     backend.ClaimType
+	id            uint32
+	esi           string
+	tRange        string
+	selector      *metav1.LabelSelector
+	expectedError bool
+	expectedID    *uint32
+	expectedESI   *string
+}
+
+// alias
+const (
+	namespace    = testutil.Namespace
+	staticClaim  = backend.ClaimType_StaticID
+	dynamicClaim = backend.ClaimType_DynamicID
+	rangeClaim   = backend.ClaimType_Range
+)
+
+var group = testutil.Group{
+	Config: config.GroupConfig{
+		BackendFn:               register.NewBackend,
+		ApplyStorageToBackendFn: register.ApplyStorageToBackend,
+		Resources: []*config.ResourceConfig{
+			{StorageProviderFn: register.NewIndexStorageProvider, Internal: &esi.ESIIndex{}, ResourceVersions: []resource.Object{&esi.ESIIndex{}, &esibev1alpha1.ESIIndex{}}},
+			{StorageProviderFn: register.NewClaimStorageProvider, Internal: &esi.ESIClaim{}, ResourceVersions: []resource.Object{&esi.ESIClaim{}, &esibev1alpha1.ESIClaim{}}},
+			{StorageProviderFn: register.NewStorageProvider, Internal: &esi.ESIEntry{}, ResourceVersions: []resource.Object{&esi.ESIEntry{}, &esibev1alpha1.ESIEntry{}}},
+		},
+	},
+	IndexResource: esi.Resource(esi.ESIIndexPlural),
+	ClaimResource: esi.Resource(esi.ESIClaimPlural),
+}
+
+// initIndex initializes the backend and creates the index, it returns the claim storage
+func initIndex(ctx context.Context, index *esi.ESIIndex) (context.Context, *registry.Store, error) {
+	return testutil.InitIndex(ctx, group, index)
+}
+
+func getIndex(index string, spec *esi.ESIIndexSpec) *esi.ESIIndex {
+	return esi.BuildESIIndex(
+		metav1.ObjectMeta{Namespace: namespace, Name: index},
+		spec,
+		nil,
+	)
+}
+
+func (r testCtx) getClaim(index string) (*esi.ESIClaim, error) {
+	spec := &esi.ESIClaimSpec{
+		Index: index,
+		ClaimLabels: common.ClaimLabels{
+			Selector: r.selector,
+		},
+	}
+	switch r.claimType {
+	case staticClaim:
+		if r.esi != "" {
+			spec.ESI = ptr.To[string](r.esi)
+		} else {
+			spec.ID = ptr.To[uint32](r.id)
+		}
+	case rangeClaim:
+		spec.Range = ptr.To[string](r.tRange)
+	}
+	return testutil.Claim[*esi.ESIClaim](esi.BuildESIClaim(metav1.ObjectMeta{Namespace: namespace, Name: r.name}, spec, nil))
+}
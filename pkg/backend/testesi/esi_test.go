@@ -0,0 +1,187 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testesi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/esi"
+	"github.com/kuidio/kuid/pkg/backend/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestESI(t *testing.T) {
+	tests := map[string]struct {
+		index string
+		spec  *esi.ESIIndexSpec
+		ctxs  []testCtx
+	}{
+		"Arbitrary": {
+			index: "a",
+			spec:  &esi.ESIIndexSpec{Type: string(esi.ESIType_Arbitrary)},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", expectedID: ptr.To[uint32](1), expectedESI: ptr.To("00:00:00:00:00:00:00:00:00:01")}, // the zero esi is reserved
+				{claimType: staticClaim, name: "claim2", id: 0, expectedError: true},                                                           // the zero esi
+				{claimType: staticClaim, name: "claim2", esi: esi.ESIZero, expectedError: true},                                                // the zero esi
+				{claimType: staticClaim, name: "claim2", esi: "00:00:00:00:00:00:00:00:01:00", expectedID: ptr.To[uint32](256), expectedESI: ptr.To("00:00:00:00:00:00:00:00:01:00")},
+				{claimType: staticClaim, name: "claim3", id: 256, expectedError: true},                              // claimed by claim2
+				{claimType: staticClaim, name: "claim3", esi: "00:00:11:00:00:00:00:00:00:01", expectedError: true}, // another prefix
+				{claimType: staticClaim, name: "claim3", esi: "02:00:00:00:00:00:00:00:00:01", expectedError: true}, // type 2 esis are not allocated
+				{claimType: staticClaim, name: "claim3", esi: "06:00:00:00:00:00:00:00:00:01", expectedError: true}, // unknown type
+				{claimType: rangeClaim, name: "claim4", tRange: "100-199", expectedESI: ptr.To("00:00:00:00:00:00:00:00:00:64-00:00:00:00:00:00:00:00:00:c7")},
+				{claimType: dynamicClaim, name: "claim5", selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{backend.KuidClaimNameKey: "claim4"},
+				}, expectedID: ptr.To[uint32](100), expectedESI: ptr.To("00:00:00:00:00:00:00:00:00:64")}, // a dynamic claim from the range
+			},
+		},
+		"ArbitraryPrefix": {
+			index: "a",
+			spec:  &esi.ESIIndexSpec{Type: string(esi.ESIType_Arbitrary), Prefix: ptr.To("00:11:22:33:44")},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", expectedID: ptr.To[uint32](0), expectedESI: ptr.To("00:00:11:22:33:44:00:00:00:00")}, // only the zero esi is reserved
+				{claimType: staticClaim, name: "claim2", esi: "00:00:11:22:33:44:00:00:00:01", expectedID: ptr.To[uint32](1), expectedESI: ptr.To("00:00:11:22:33:44:00:00:00:01")},
+				{claimType: staticClaim, name: "claim3", esi: "00:00:11:22:33:45:00:00:00:02", expectedError: true}, // another prefix
+			},
+		},
+		"LACP": {
+			index: "a",
+			spec:  &esi.ESIIndexSpec{Type: string(esi.ESIType_LACP), SystemMAC: ptr.To("00:11:22:33:44:55")},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", expectedID: ptr.To[uint32](0), expectedESI: ptr.To("01:00:11:22:33:44:55:00:00:00")},
+				{claimType: staticClaim, name: "claim2", id: 10, expectedESI: ptr.To("01:00:11:22:33:44:55:00:0a:00")},
+				{claimType: staticClaim, name: "claim3", esi: "01:00:11:22:33:44:55:00:0b:00", expectedID: ptr.To[uint32](11), expectedESI: ptr.To("01:00:11:22:33:44:55:00:0b:00")},
+				{claimType: staticClaim, name: "claim4", esi: "01:00:11:22:33:44:55:00:0c:01", expectedError: true}, // the octet following the port key is not 0
+				{claimType: staticClaim, name: "claim4", esi: "01:00:11:22:33:44:66:00:0c:00", expectedError: true}, // another system mac
+				{claimType: staticClaim, name: "claim4", esi: "03:00:11:22:33:44:55:00:00:0c", expectedError: true}, // another type
+				{claimType: staticClaim, name: "claim4", id: 65536, expectedError: true},                            // exceeds the 16bit port key
+				{claimType: rangeClaim, name: "claim5", tRange: "100-101", expectedESI: ptr.To("01:00:11:22:33:44:55:00:64:00-01:00:11:22:33:44:55:00:65:00")},
+			},
+		},
+		"MAC": {
+			index: "a",
+			spec:  &esi.ESIIndexSpec{Type: string(esi.ESIType_MAC), SystemMAC: ptr.To("00:11:22:33:44:55")},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", expectedID: ptr.To[uint32](0), expectedESI: ptr.To("03:00:11:22:33:44:55:00:00:00")},
+				{claimType: staticClaim, name: "claim2", id: 10, expectedESI: ptr.To("03:00:11:22:33:44:55:00:00:0a")},
+				{claimType: staticClaim, name: "claim3", esi: "03:00:11:22:33:44:55:01:00:00", expectedID: ptr.To[uint32](65536), expectedESI: ptr.To("03:00:11:22:33:44:55:01:00:00")},
+				{claimType: staticClaim, name: "claim4", id: 16777216, expectedError: true}, // exceeds the 24bit local discriminator
+				{claimType: rangeClaim, name: "claim5", tRange: "16777215-16777216", expectedError: true},
+				{claimType: staticClaim, name: "claim4", esi: "01:00:11:22:33:44:55:00:0c:00", expectedError: true}, // another type
+			},
+		},
+		"MinMax": {
+			index: "a",
+			spec:  &esi.ESIIndexSpec{Type: string(esi.ESIType_Arbitrary), MinID: ptr.To[uint32](10), MaxID: ptr.To[uint32](11)},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", expectedID: ptr.To[uint32](10), expectedESI: ptr.To("00:00:00:00:00:00:00:00:00:0a")},
+				{claimType: dynamicClaim, name: "claim2", expectedID: ptr.To[uint32](11), expectedESI: ptr.To("00:00:00:00:00:00:00:00:00:0b")},
+				{claimType: dynamicClaim, name: "claim3", expectedError: true}, // the index is exhausted
+				{claimType: staticClaim, name: "claim4", id: 9, expectedError: true},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx, claimStorage, err := initIndex(context.Background(), getIndex(tc.index, tc.spec))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			for _, v := range tc.ctxs {
+				var newClaim *esi.ESIClaim
+				claim, err := v.getClaim(tc.index)
+				if err == nil {
+					newClaim, err = testutil.Apply(ctx, claimStorage, claim)
+				}
+				if v.expectedError {
+					assert.Error(t, err, "claim %s", v.name)
+					continue
+				}
+				if !assert.NoError(t, err, "claim %s", v.name) {
+					continue
+				}
+
+				assert.Equal(t, v.expectedESI, newClaim.Status.ESI, "claim %s esi", v.name)
+				switch v.claimType {
+				case staticClaim, dynamicClaim:
+					expectedID := ptr.To[uint32](v.id)
+					if v.expectedID != nil {
+						expectedID = v.expectedID
+					}
+					assert.Equal(t, expectedID, newClaim.Status.ID, "claim %s id", v.name)
+				case rangeClaim:
+					assert.Equal(t, ptr.To[string](v.tRange), newClaim.Status.Range, "claim %s range", v.name)
+				}
+			}
+		})
+	}
+}
+
+func TestESIReservedClaim(t *testing.T) {
+	tests := map[string]struct {
+		spec     *esi.ESIIndexSpec
+		reserved bool
+	}{
+		"Zero": {
+			spec:     &esi.ESIIndexSpec{Type: string(esi.ESIType_Arbitrary)},
+			reserved: true,
+		},
+		"Prefix": {
+			spec:     &esi.ESIIndexSpec{Type: string(esi.ESIType_Arbitrary), Prefix: ptr.To("00:11:22:33:44")},
+			reserved: false,
+		},
+		"MinID": {
+			spec:     &esi.ESIIndexSpec{Type: string(esi.ESIType_Arbitrary), MinID: ptr.To[uint32](10)},
+			reserved: false,
+		},
+		"LACP": {
+			spec:     &esi.ESIIndexSpec{Type: string(esi.ESIType_LACP), SystemMAC: ptr.To("00:11:22:33:44:55")},
+			reserved: false,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx, claimStorage, err := initIndex(context.Background(), getIndex("a", tc.spec))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			obj, err := claimStorage.Get(ctx, "a.rangereserved-zero", &metav1.GetOptions{})
+			if !tc.reserved {
+				assert.Error(t, err)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			claim, ok := obj.(*esi.ESIClaim)
+			if !assert.True(t, ok) {
+				return
+			}
+			assert.Equal(t, ptr.To("0-0"), claim.Status.Range)
+			assert.Equal(t, ptr.To("00:00:00:00:00:00:00:00:00:00-00:00:00:00:00:00:00:00:00:00"), claim.Status.ESI)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testlabel
+
+import (
+	"context"
+
+	"github.com/henderiw/apiserver-builder/pkg/builder/resource"
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/label"
+	"github.com/kuidio/kuid/apis/backend/label/register"
+	labelbev1alpha1 "github.com/kuidio/kuid/apis/backend/label/v1alpha1"
+	"github.com/kuidio/kuid/apis/common"
+	"github.com/kuidio/kuid/pkg/backend/testutil"
+	"github.com/kuidio/kuid/pkg/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+type testCtx struct {
+	name             string
+	claimType        backend.ClaimType
+	id               uint32
+	sidIndex         *uint32
+	prefixSID        bool
+	tRange           string
+	selector         *metav1.LabelSelector
+	expectedError    bool
+	expectedID       *uint32
+	expectedSIDIndex *uint32
+}
+
+// alias
+const (
+	namespace    = testutil.Namespace
+	staticClaim  = backend.ClaimType_StaticID
+	dynamicClaim = backend.ClaimType_DynamicID
+	rangeClaim   = backend.ClaimType_Range
+)
+
+var group = testutil.Group{
+	Config: config.GroupConfig{
+		BackendFn:               register.NewBackend,
+		ApplyStorageToBackendFn: register.ApplyStorageToBackend,
+		Resources: []*config.ResourceConfig{
+			{StorageProviderFn: register.NewIndexStorageProvider, Internal: &label.LabelIndex{}, ResourceVersions: []resource.Object{&label.LabelIndex{}, &labelbev1alpha1.LabelIndex{}}},
+			{StorageProviderFn: register.NewClaimStorageProvider, Internal: &label.LabelClaim{}, ResourceVersions: []resource.Object{&label.LabelClaim{}, &labelbev1alpha1.LabelClaim{}}},
+			{StorageProviderFn: register.NewStorageProvider, Internal: &label.LabelEntry{}, ResourceVersions: []resource.Object{&label.LabelEntry{}, &labelbev1alpha1.LabelEntry{}}},
+		},
+	},
+	IndexResource: label.Resource(label.LabelIndexPlural),
+	ClaimResource: label.Resource(label.LabelClaimPlural),
+}
+
+// initIndex initializes the backend and creates the indexes, it returns the claim storage
+func initIndex(ctx context.Context, indexes ...*label.LabelIndex) (context.Context, *registry.Store, error) {
+	objs := make([]testutil.Object, 0, len(indexes))
+	for _, index := range indexes {
+		objs = append(objs, index)
+	}
+	return testutil.InitIndex(ctx, group, objs...)
+}
+
+func getIndex(index string, spec *label.LabelIndexSpec) *label.LabelIndex {
+	return label.BuildLabelIndex(
+		metav1.ObjectMeta{Namespace: namespace, Name: index},
+		spec,
+		nil,
+	)
+}
+
+func (r testCtx) getClaim(index string) (*label.LabelClaim, error) {
+	spec := &label.LabelClaimSpec{
+		Index: index,
+		ClaimLabels: common.ClaimLabels{
+			Selector: r.selector,
+		},
+	}
+	spec.PrefixSID = r.prefixSID
+	switch r.claimType {
+	case staticClaim:
+		if r.sidIndex != nil {
+			spec.SIDIndex = r.sidIndex
+		} else {
+			spec.ID = ptr.To[uint32](r.id)
+		}
+	case rangeClaim:
+		spec.Range = ptr.To[string](r.tRange)
+	}
+	return testutil.Claim[*label.LabelClaim](label.BuildLabelClaim(metav1.ObjectMeta{Namespace: namespace, Name: r.name}, spec, nil))
+}
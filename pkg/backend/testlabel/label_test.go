@@ -0,0 +1,284 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testlabel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/label"
+	"github.com/kuidio/kuid/pkg/backend/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestLabel(t *testing.T) {
+	tests := map[string]struct {
+		index string
+		spec  *label.LabelIndexSpec
+		ctxs  []testCtx
+	}{
+		"Reserved": {
+			index: "a",
+			spec:  &label.LabelIndexSpec{},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", expectedID: ptr.To[uint32](16)}, // the labels 0-15 are reserved
+				{claimType: staticClaim, name: "claim2", id: 0, expectedError: true},      // reserved
+				{claimType: staticClaim, name: "claim2", id: 15, expectedError: true},     // reserved
+				{claimType: staticClaim, name: "claim2", id: 16, expectedError: true},     // claimed by claim1
+				{claimType: staticClaim, name: "claim2", id: 17},
+				{claimType: rangeClaim, name: "claim3", tRange: "10-20", expectedError: true}, // overlaps with the reserved labels
+				{claimType: rangeClaim, name: "claim3", tRange: "100-199"},
+				{claimType: dynamicClaim, name: "claim4", selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{backend.KuidClaimNameKey: "claim3"},
+				}, expectedID: ptr.To[uint32](100)}, // a dynamic claim from the range
+			},
+		},
+		"ReservedMinID": {
+			index: "a",
+			spec:  &label.LabelIndexSpec{MinID: ptr.To[uint32](10)},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", expectedID: ptr.To[uint32](16)}, // the labels 10-15 are reserved
+				{claimType: staticClaim, name: "claim2", id: 12, expectedError: true},
+			},
+		},
+		"PrefixSID": {
+			index: "a",
+			spec:  &label.LabelIndexSpec{SRGB: ptr.To("16000-23999"), SRLB: ptr.To("15000-15999")},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", prefixSID: true, expectedID: ptr.To[uint32](16000), expectedSIDIndex: ptr.To[uint32](0)},
+				{claimType: staticClaim, name: "claim2", sidIndex: ptr.To[uint32](10), expectedID: ptr.To[uint32](16010), expectedSIDIndex: ptr.To[uint32](10)},
+				{claimType: dynamicClaim, name: "claim3", prefixSID: true, expectedID: ptr.To[uint32](16001), expectedSIDIndex: ptr.To[uint32](1)},
+				{claimType: staticClaim, name: "claim4", sidIndex: ptr.To[uint32](10), expectedError: true},   // claimed by claim2
+				{claimType: staticClaim, name: "claim4", sidIndex: ptr.To[uint32](8000), expectedError: true}, // exceeds the srgb
+				{claimType: dynamicClaim, name: "claim4", expectedID: ptr.To[uint32](16)},                     // a label outside the srgb
+				{claimType: dynamicClaim, name: "claim5", selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{backend.KuidClaimNameKey: "a.srlb"},
+				}, expectedID: ptr.To[uint32](15000)}, // an adjacency SID from the srlb
+				{claimType: dynamicClaim, name: "claim6", selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{backend.KuidClaimNameKey: "a.srlb"},
+				}, expectedID: ptr.To[uint32](15001)},
+			},
+		},
+		"PrefixSIDExhausted": {
+			index: "a",
+			spec:  &label.LabelIndexSpec{SRGB: ptr.To("16000-16001")},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", prefixSID: true, expectedID: ptr.To[uint32](16000), expectedSIDIndex: ptr.To[uint32](0)},
+				{claimType: dynamicClaim, name: "claim2", prefixSID: true, expectedID: ptr.To[uint32](16001), expectedSIDIndex: ptr.To[uint32](1)},
+				{claimType: dynamicClaim, name: "claim3", prefixSID: true, expectedError: true}, // the srgb is exhausted
+			},
+		},
+		"PrefixSIDNoSRGB": {
+			index: "a",
+			spec:  &label.LabelIndexSpec{SRLB: ptr.To("15000-15999")},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", prefixSID: true, expectedError: true},
+				{claimType: staticClaim, name: "claim1", sidIndex: ptr.To[uint32](1), expectedError: true},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx, claimStorage, err := initIndex(context.Background(), getIndex(tc.index, tc.spec))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			for _, v := range tc.ctxs {
+				var newClaim *label.LabelClaim
+				claim, err := v.getClaim(tc.index)
+				if err == nil {
+					newClaim, err = testutil.Apply(ctx, claimStorage, claim)
+				}
+				if v.expectedError {
+					assert.Error(t, err, "claim %s", v.name)
+					continue
+				}
+				if !assert.NoError(t, err, "claim %s", v.name) {
+					continue
+				}
+
+				assert.Equal(t, v.expectedSIDIndex, newClaim.Status.SIDIndex, "claim %s sid index", v.name)
+				switch v.claimType {
+				case staticClaim, dynamicClaim:
+					expectedID := ptr.To[uint32](v.id)
+					if v.expectedID != nil {
+						expectedID = v.expectedID
+					}
+					assert.Equal(t, expectedID, newClaim.Status.ID, "claim %s id", v.name)
+				case rangeClaim:
+					assert.Equal(t, ptr.To[string](v.tRange), newClaim.Status.Range, "claim %s range", v.name)
+				}
+			}
+		})
+	}
+}
+
+func TestLabelIndexClaims(t *testing.T) {
+	tests := map[string]struct {
+		spec           *label.LabelIndexSpec
+		expectedClaims map[string]*string
+	}{
+		"Reserved": {
+			spec: &label.LabelIndexSpec{},
+			expectedClaims: map[string]*string{
+				"a.rangereserved-special": ptr.To("0-15"),
+				"a.srgb":                  nil,
+				"a.srlb":                  nil,
+			},
+		},
+		"ReservedMinID": {
+			spec: &label.LabelIndexSpec{MinID: ptr.To[uint32](10)},
+			expectedClaims: map[string]*string{
+				"a.rangereserved-special": ptr.To("10-15"), // clipped to the min ID
+			},
+		},
+		"ReservedOutsideMinID": {
+			spec: &label.LabelIndexSpec{MinID: ptr.To[uint32](100)},
+			expectedClaims: map[string]*string{
+				"a.rangereserved-special": nil,
+			},
+		},
+		"Blocks": {
+			spec: &label.LabelIndexSpec{SRGB: ptr.To("16000-23999"), SRLB: ptr.To("15000-15999")},
+			expectedClaims: map[string]*string{
+				"a.rangereserved-special": ptr.To("0-15"),
+				"a.srgb":                  ptr.To("16000-23999"),
+				"a.srlb":                  ptr.To("15000-15999"),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx, claimStorage, err := initIndex(context.Background(), getIndex("a", tc.spec))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			for claimName, expectedRange := range tc.expectedClaims {
+				obj, err := claimStorage.Get(ctx, claimName, &metav1.GetOptions{})
+				if expectedRange == nil {
+					assert.Error(t, err, "claim %s", claimName)
+					continue
+				}
+				if !assert.NoError(t, err, "claim %s", claimName) {
+					continue
+				}
+				claim, ok := obj.(*label.LabelClaim)
+				if !assert.True(t, ok) {
+					continue
+				}
+				assert.Equal(t, expectedRange, claim.Status.Range, "claim %s range", claimName)
+			}
+		})
+	}
+}
+
+func TestLabelIndexBlocks(t *testing.T) {
+	tests := map[string]struct {
+		spec          *label.LabelIndexSpec
+		expectedError bool
+	}{
+		"SRGBReserved": {
+			spec:          &label.LabelIndexSpec{SRGB: ptr.To("0-7999")}, // overlaps with the reserved labels
+			expectedError: true,
+		},
+		"SRGBExceedsMax": {
+			spec:          &label.LabelIndexSpec{SRGB: ptr.To("1048000-1048576")},
+			expectedError: true,
+		},
+		"SRLBOverlapsSRGB": {
+			spec:          &label.LabelIndexSpec{SRGB: ptr.To("16000-23999"), SRLB: ptr.To("15000-16000")},
+			expectedError: true,
+		},
+		"DomainWithoutSRGB": {
+			spec:          &label.LabelIndexSpec{Domain: ptr.To("isis")},
+			expectedError: true,
+		},
+		"SRGBClaimName": {
+			spec:          &label.LabelIndexSpec{Claims: []label.LabelIndexClaim{{Name: "srgb", ID: ptr.To[uint32](100)}}},
+			expectedError: true, // the name is reserved for the srgb block
+		},
+		"Domain": {
+			spec: &label.LabelIndexSpec{SRGB: ptr.To("16000-23999"), SRLB: ptr.To("15000-15999"), Domain: ptr.To("isis")},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, _, err := initIndex(context.Background(), getIndex("a", tc.spec))
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLabelDomain(t *testing.T) {
+	tests := map[string]struct {
+		indexes       []*label.LabelIndex
+		expectedError bool
+	}{
+		"SameSRGB": {
+			indexes: []*label.LabelIndex{
+				getIndex("node1", &label.LabelIndexSpec{SRGB: ptr.To("16000-23999"), Domain: ptr.To("isis")}),
+				getIndex("node2", &label.LabelIndexSpec{SRGB: ptr.To("16000-23999"), Domain: ptr.To("isis")}),
+			},
+		},
+		"DifferentSRGB": {
+			indexes: []*label.LabelIndex{
+				getIndex("node1", &label.LabelIndexSpec{SRGB: ptr.To("16000-23999"), Domain: ptr.To("isis")}),
+				getIndex("node2", &label.LabelIndexSpec{SRGB: ptr.To("20000-27999"), Domain: ptr.To("isis")}),
+			},
+			expectedError: true,
+		},
+		"DifferentDomain": {
+			indexes: []*label.LabelIndex{
+				getIndex("node1", &label.LabelIndexSpec{SRGB: ptr.To("16000-23999"), Domain: ptr.To("isis")}),
+				getIndex("node2", &label.LabelIndexSpec{SRGB: ptr.To("20000-27999"), Domain: ptr.To("ospf")}),
+			},
+		},
+		"NoDomain": {
+			indexes: []*label.LabelIndex{
+				getIndex("node1", &label.LabelIndexSpec{SRGB: ptr.To("16000-23999"), Domain: ptr.To("isis")}),
+				getIndex("node2", &label.LabelIndexSpec{SRGB: ptr.To("20000-27999")}),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, _, err := initIndex(context.Background(), tc.indexes...)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
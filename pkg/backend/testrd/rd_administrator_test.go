@@ -0,0 +1,117 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testrd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend/as"
+	"github.com/kuidio/kuid/apis/backend/ipam"
+	"github.com/kuidio/kuid/apis/backend/rd"
+	"github.com/kuidio/kuid/pkg/backend/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func getASClaim(id uint32) []runtime.Object {
+	return []runtime.Object{
+		as.BuildASIndex(metav1.ObjectMeta{Namespace: namespace, Name: "as"}, &as.ASIndexSpec{}, nil),
+		as.BuildASClaim(metav1.ObjectMeta{Namespace: namespace, Name: "asclaim"}, &as.ASClaimSpec{Index: "as", ID: ptr.To[uint32](id)}, nil),
+	}
+}
+
+func getIPClaim(address string) []runtime.Object {
+	return []runtime.Object{
+		ipam.BuildIPIndex(metav1.ObjectMeta{Namespace: namespace, Name: "default"}, &ipam.IPIndexSpec{
+			Prefixes: []ipam.Prefix{{Prefix: "10.0.0.0/24"}},
+		}, nil),
+		ipam.BuildIPClaim(metav1.ObjectMeta{Namespace: namespace, Name: "router-id"}, &ipam.IPClaimSpec{Index: "default", Address: ptr.To(address)}, nil),
+	}
+}
+
+func TestRDAdministratorFrom(t *testing.T) {
+	tests := map[string]struct {
+		spec          *rd.RDIndexSpec
+		objs          []runtime.Object
+		expectedError bool
+		expectedRD    *string
+	}{
+		"ASClaim": {
+			spec: &rd.RDIndexSpec{Type: string(rd.RDType_2byteAS),
+				AdministratorFrom: &rd.RDAdministratorSource{ASClaim: ptr.To("asclaim")}},
+			objs:       getASClaim(65001),
+			expectedRD: ptr.To("65001:10"),
+		},
+		"ASClaim4byteAS": {
+			spec: &rd.RDIndexSpec{Type: string(rd.RDType_4byteAS),
+				AdministratorFrom: &rd.RDAdministratorSource{ASClaim: ptr.To("asclaim")}},
+			objs:       getASClaim(4259840100),
+			expectedRD: ptr.To("4259840100:10"),
+		},
+		"ASClaimNot2byteAS": {
+			spec: &rd.RDIndexSpec{Type: string(rd.RDType_2byteAS),
+				AdministratorFrom: &rd.RDAdministratorSource{ASClaim: ptr.To("asclaim")}},
+			objs:          getASClaim(4259840100),
+			expectedError: true, // the claimed AS is not a 2 byte AS
+		},
+		"IPClaim": {
+			spec: &rd.RDIndexSpec{Type: string(rd.RDType_IPv4Address),
+				AdministratorFrom: &rd.RDAdministratorSource{IPClaim: ptr.To("router-id")}},
+			objs:       getIPClaim("10.0.0.1/32"),
+			expectedRD: ptr.To("10.0.0.1:10"),
+		},
+		"IPClaimOnASType": {
+			spec: &rd.RDIndexSpec{Type: string(rd.RDType_2byteAS),
+				AdministratorFrom: &rd.RDAdministratorSource{IPClaim: ptr.To("router-id")}},
+			objs:          getIPClaim("10.0.0.1/32"),
+			expectedError: true,
+		},
+		"MissingClaim": {
+			spec: &rd.RDIndexSpec{Type: string(rd.RDType_2byteAS),
+				AdministratorFrom: &rd.RDAdministratorSource{ASClaim: ptr.To("asclaim")}},
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx, claimStorage, err := initIndex(context.Background(), getIndex("a", tc.spec), tc.objs...)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			// the claims derive their rd from the administrator of the claim
+			claim, err := testCtx{claimType: staticClaim, name: "claim1", id: 10}.getClaim("a")
+			if !assert.NoError(t, err) {
+				return
+			}
+			newClaim, err := testutil.Apply(ctx, claimStorage, claim)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tc.expectedRD, newClaim.Status.RD)
+		})
+	}
+}
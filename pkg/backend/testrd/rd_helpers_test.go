@@ -0,0 +1,174 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testrd
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/henderiw/apiserver-builder/pkg/builder"
+	"github.com/henderiw/apiserver-builder/pkg/builder/resource"
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/as"
+	asregister "github.com/kuidio/kuid/apis/backend/as/register"
+	asbev1alpha1 "github.com/kuidio/kuid/apis/backend/as/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/ipam"
+	ipamregister "github.com/kuidio/kuid/apis/backend/ipam/register"
+	ipambev1alpha1 "github.com/kuidio/kuid/apis/backend/ipam/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/rd"
+	"github.com/kuidio/kuid/apis/backend/rd/register"
+	rdbev1alpha1 "github.com/kuidio/kuid/apis/backend/rd/v1alpha1"
+	"github.com/kuidio/kuid/apis/common"
+	"github.com/kuidio/kuid/pkg/backend/testutil"
+	"github.com/kuidio/kuid/pkg/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/ptr"
+)
+
+type testCtx struct {
+	name               string
+	claimType          backend.ClaimType
+	id                 uint32
+	rd                 string
+	tRange             string
+	importRouteTargets []string
+	exportRouteTargets []string
+	selector           *metav1.LabelSelector
+	expectedError      bool
+	expectedID         *uint32
+	expectedRD         *string
+	expectedImportRTs  []string
+	expectedExportRTs  []string
+}
+
+// alias
+const (
+	namespace    = testutil.Namespace
+	staticClaim  = backend.ClaimType_StaticID
+	dynamicClaim = backend.ClaimType_DynamicID
+	rangeClaim   = backend.ClaimType_Range
+)
+
+var group = testutil.Group{
+	Config: config.GroupConfig{
+		BackendFn:               register.NewBackend,
+		ApplyStorageToBackendFn: register.ApplyStorageToBackend,
+		Resources: []*config.ResourceConfig{
+			{StorageProviderFn: register.NewIndexStorageProvider, Internal: &rd.RDIndex{}, ResourceVersions: []resource.Object{&rd.RDIndex{}, &rdbev1alpha1.RDIndex{}}},
+			{StorageProviderFn: register.NewClaimStorageProvider, Internal: &rd.RDClaim{}, ResourceVersions: []resource.Object{&rd.RDClaim{}, &rdbev1alpha1.RDClaim{}}},
+			{StorageProviderFn: register.NewStorageProvider, Internal: &rd.RDEntry{}, ResourceVersions: []resource.Object{&rd.RDEntry{}, &rdbev1alpha1.RDEntry{}}},
+		},
+	},
+	IndexResource: rd.Resource(rd.RDIndexPlural),
+	ClaimResource: rd.Resource(rd.RDClaimPlural),
+}
+
+// the administrator of an rd index can be derived from the claims of the as and ipam backends
+var administratorGroupConfigs = []config.GroupConfig{
+	{
+		BackendFn:               asregister.NewBackend,
+		ApplyStorageToBackendFn: asregister.ApplyStorageToBackend,
+		Resources: []*config.ResourceConfig{
+			{StorageProviderFn: asregister.NewIndexStorageProvider, Internal: &as.ASIndex{}, ResourceVersions: []resource.Object{&as.ASIndex{}, &asbev1alpha1.ASIndex{}}},
+			{StorageProviderFn: asregister.NewClaimStorageProvider, Internal: &as.ASClaim{}, ResourceVersions: []resource.Object{&as.ASClaim{}, &asbev1alpha1.ASClaim{}}},
+			{StorageProviderFn: asregister.NewStorageProvider, Internal: &as.ASEntry{}, ResourceVersions: []resource.Object{&as.ASEntry{}, &asbev1alpha1.ASEntry{}}},
+		},
+	},
+	{
+		BackendFn:               ipamregister.NewBackend,
+		ApplyStorageToBackendFn: ipamregister.ApplyStorageToBackend,
+		Resources: []*config.ResourceConfig{
+			{StorageProviderFn: ipamregister.NewIndexStorageProvider, Internal: &ipam.IPIndex{}, ResourceVersions: []resource.Object{&ipam.IPIndex{}, &ipambev1alpha1.IPIndex{}}},
+			{StorageProviderFn: ipamregister.NewClaimStorageProvider, Internal: &ipam.IPClaim{}, ResourceVersions: []resource.Object{&ipam.IPClaim{}, &ipambev1alpha1.IPClaim{}}},
+			{StorageProviderFn: ipamregister.NewStorageProvider, Internal: &ipam.IPEntry{}, ResourceVersions: []resource.Object{&ipam.IPEntry{}, &ipambev1alpha1.IPEntry{}}},
+		},
+	},
+}
+
+// initIndex initializes the rd backend together with the as and ipam backends and creates
+// the as and ipam objects before the index, it returns the claim storage
+func initIndex(ctx context.Context, index *rd.RDIndex, objs ...runtime.Object) (context.Context, *registry.Store, error) {
+	apiserver, _, err := testutil.InitBackends(ctx, append([]config.GroupConfig{group.Config}, administratorGroupConfigs...)...)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = genericapirequest.WithNamespace(ctx, index.GetNamespace())
+	for _, obj := range objs {
+		if err := create(ctx, apiserver, obj); err != nil {
+			return ctx, nil, err
+		}
+	}
+	return testutil.CreateIndexes(ctx, apiserver, group, index)
+}
+
+// create creates the as or ipam object in its storage
+func create(ctx context.Context, apiserver *builder.Server, obj runtime.Object) error {
+	var gr schema.GroupResource
+	switch obj.(type) {
+	case *as.ASIndex:
+		gr = as.Resource(as.ASIndexPlural)
+	case *as.ASClaim:
+		gr = as.Resource(as.ASClaimPlural)
+	case *ipam.IPIndex:
+		gr = ipam.Resource(ipam.IPIndexPlural)
+	case *ipam.IPClaim:
+		gr = ipam.Resource(ipam.IPClaimPlural)
+	default:
+		return fmt.Errorf("unexpected object %v", reflect.TypeOf(obj).Name())
+	}
+	storage, err := testutil.GetStorage(ctx, apiserver, gr)
+	if err != nil {
+		return err
+	}
+	_, err = storage.Create(ctx, obj, nil, &metav1.CreateOptions{FieldManager: "test"})
+	return err
+}
+
+func getIndex(index string, spec *rd.RDIndexSpec) *rd.RDIndex {
+	return rd.BuildRDIndex(
+		metav1.ObjectMeta{Namespace: namespace, Name: index},
+		spec,
+		nil,
+	)
+}
+
+func (r testCtx) getClaim(index string) (*rd.RDClaim, error) {
+	spec := &rd.RDClaimSpec{
+		Index:              index,
+		ImportRouteTargets: r.importRouteTargets,
+		ExportRouteTargets: r.exportRouteTargets,
+		ClaimLabels: common.ClaimLabels{
+			Selector: r.selector,
+		},
+	}
+	switch r.claimType {
+	case staticClaim:
+		if r.rd != "" {
+			spec.RD = ptr.To[string](r.rd)
+		} else {
+			spec.ID = ptr.To[uint32](r.id)
+		}
+	case rangeClaim:
+		spec.Range = ptr.To[string](r.tRange)
+	}
+	return testutil.Claim[*rd.RDClaim](rd.BuildRDClaim(metav1.ObjectMeta{Namespace: namespace, Name: r.name}, spec, nil))
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testrd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/rd"
+	"github.com/kuidio/kuid/pkg/backend/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestRD(t *testing.T) {
+	tests := map[string]struct {
+		index string
+		spec  *rd.RDIndexSpec
+		ctxs  []testCtx
+	}{
+		"2byteAS": {
+			index: "a",
+			spec:  &rd.RDIndexSpec{Type: string(rd.RDType_2byteAS), Administrator: ptr.To("65000")},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", expectedID: ptr.To[uint32](0), expectedRD: ptr.To("65000:0"),
+					expectedImportRTs: []string{"target:65000:0"}, expectedExportRTs: []string{"target:65000:0"}},
+				{claimType: staticClaim, name: "claim2", id: 100, expectedRD: ptr.To("65000:100"),
+					importRouteTargets: []string{"65001:1", "target:65000:100", "65000.100:5"},
+					exportRouteTargets: []string{"target:10.0.0.1:1"},
+					expectedImportRTs:  []string{"target:65000:100", "target:65001:1", "target:4259840100:5"}, // the own route target is not duplicated
+					expectedExportRTs:  []string{"target:65000:100", "target:10.0.0.1:1"}},
+				{claimType: staticClaim, name: "claim3", rd: "65000:200", expectedID: ptr.To[uint32](200), expectedRD: ptr.To("65000:200"),
+					expectedImportRTs: []string{"target:65000:200"}, expectedExportRTs: []string{"target:65000:200"}},
+				{claimType: staticClaim, name: "claim4", id: 70000, expectedRD: ptr.To("65000:70000"), // a 32bit assigned number
+					expectedImportRTs: []string{"target:65000:70000"}, expectedExportRTs: []string{"target:65000:70000"}},
+				{claimType: staticClaim, name: "claim5", id: 100, expectedError: true},         // claimed by claim2
+				{claimType: staticClaim, name: "claim5", rd: "65001:300", expectedError: true}, // another administrator
+				{claimType: staticClaim, name: "claim5", rd: "65000", expectedError: true},     // no assigned number
+				{claimType: rangeClaim, name: "claim6", tRange: "1000-1099", expectedRD: ptr.To("65000:1000-65000:1099")},
+				{claimType: dynamicClaim, name: "claim7", selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{backend.KuidClaimNameKey: "claim6"},
+				}, expectedID: ptr.To[uint32](1000), expectedRD: ptr.To("65000:1000"),
+					expectedImportRTs: []string{"target:65000:1000"}, expectedExportRTs: []string{"target:65000:1000"}}, // a dynamic claim from the range
+			},
+		},
+		"IPv4Address": {
+			index: "a",
+			spec:  &rd.RDIndexSpec{Type: string(rd.RDType_IPv4Address), Administrator: ptr.To("10.0.0.1")},
+			ctxs: []testCtx{
+				{claimType: staticClaim, name: "claim1", id: 10, expectedRD: ptr.To("10.0.0.1:10"),
+					expectedImportRTs: []string{"target:10.0.0.1:10"}, expectedExportRTs: []string{"target:10.0.0.1:10"}},
+				{claimType: staticClaim, name: "claim2", rd: "10.0.0.1:11", expectedID: ptr.To[uint32](11), expectedRD: ptr.To("10.0.0.1:11"),
+					expectedImportRTs: []string{"target:10.0.0.1:11"}, expectedExportRTs: []string{"target:10.0.0.1:11"}},
+				{claimType: staticClaim, name: "claim3", rd: "10.0.0.2:12", expectedError: true}, // another administrator
+				{claimType: staticClaim, name: "claim3", id: 65536, expectedError: true},         // exceeds the 16bit assigned number
+				{claimType: rangeClaim, name: "claim4", tRange: "65535-65536", expectedError: true},
+			},
+		},
+		"4byteAS": {
+			index: "a",
+			spec:  &rd.RDIndexSpec{Type: string(rd.RDType_4byteAS), Administrator: ptr.To("65000.100")}, // asdot notation
+			ctxs: []testCtx{
+				{claimType: staticClaim, name: "claim1", id: 10, expectedRD: ptr.To("4259840100:10"),
+					expectedImportRTs: []string{"target:4259840100:10"}, expectedExportRTs: []string{"target:4259840100:10"}},
+				{claimType: staticClaim, name: "claim2", rd: "65000.100:11", expectedID: ptr.To[uint32](11), expectedRD: ptr.To("4259840100:11"),
+					expectedImportRTs: []string{"target:4259840100:11"}, expectedExportRTs: []string{"target:4259840100:11"}},
+				{claimType: staticClaim, name: "claim3", rd: "4259840100:11", expectedError: true}, // claimed by claim2
+				{claimType: staticClaim, name: "claim3", id: 65536, expectedError: true},           // exceeds the 16bit assigned number
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx, claimStorage, err := initIndex(context.Background(), getIndex(tc.index, tc.spec))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			for _, v := range tc.ctxs {
+				var newClaim *rd.RDClaim
+				claim, err := v.getClaim(tc.index)
+				if err == nil {
+					newClaim, err = testutil.Apply(ctx, claimStorage, claim)
+				}
+				if v.expectedError {
+					assert.Error(t, err, "claim %s", v.name)
+					continue
+				}
+				if !assert.NoError(t, err, "claim %s", v.name) {
+					continue
+				}
+
+				assert.Equal(t, v.expectedRD, newClaim.Status.RD, "claim %s rd", v.name)
+				assert.Equal(t, v.expectedImportRTs, newClaim.Status.ImportRouteTargets, "claim %s import route targets", v.name)
+				assert.Equal(t, v.expectedExportRTs, newClaim.Status.ExportRouteTargets, "claim %s export route targets", v.name)
+				switch v.claimType {
+				case staticClaim, dynamicClaim:
+					expectedID := ptr.To[uint32](v.id)
+					if v.expectedID != nil {
+						expectedID = v.expectedID
+					}
+					assert.Equal(t, expectedID, newClaim.Status.ID, "claim %s id", v.name)
+				case rangeClaim:
+					assert.Equal(t, ptr.To[string](v.tRange), newClaim.Status.Range, "claim %s range", v.name)
+				}
+			}
+		})
+	}
+}
+
+func TestRDIndexAdministrator(t *testing.T) {
+	tests := map[string]struct {
+		spec          *rd.RDIndexSpec
+		expectedError bool
+	}{
+		"2byteAS": {
+			spec:          &rd.RDIndexSpec{Type: string(rd.RDType_2byteAS), Administrator: ptr.To("70000")},
+			expectedError: true, // not a 2 byte AS
+		},
+		"IPv4Address": {
+			spec:          &rd.RDIndexSpec{Type: string(rd.RDType_IPv4Address), Administrator: ptr.To("2001:db8::1")},
+			expectedError: true,
+		},
+		"4byteAS": {
+			spec: &rd.RDIndexSpec{Type: string(rd.RDType_4byteAS), Administrator: ptr.To("70000")},
+		},
+		"NoAdministrator": {
+			spec:          &rd.RDIndexSpec{Type: string(rd.RDType_2byteAS)},
+			expectedError: true,
+		},
+		"AdministratorAndFrom": {
+			spec: &rd.RDIndexSpec{Type: string(rd.RDType_2byteAS), Administrator: ptr.To("65000"),
+				AdministratorFrom: &rd.RDAdministratorSource{ASClaim: ptr.To("asclaim")}},
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, _, err := initIndex(context.Background(), getIndex("a", tc.spec))
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
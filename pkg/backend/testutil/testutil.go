@@ -0,0 +1,185 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testutil provides the apiserver, backend and storage setup that is shared by
+// the backend group tests
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/henderiw/apiserver-builder/pkg/builder"
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	genericbe "github.com/kuidio/kuid/pkg/backend/generic"
+	"github.com/kuidio/kuid/pkg/config"
+	"github.com/kuidio/kuid/pkg/generated/openapi"
+	"github.com/kuidio/kuid/pkg/registry/options"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// Namespace is the namespace of the test objects
+const Namespace = "dummy"
+
+// Object is an index or claim whose syntax is validated before it is created
+type Object interface {
+	runtime.Object
+	GetName() string
+	GetNamespace() string
+	ValidateSyntax(s string) field.ErrorList
+}
+
+// Group defines the backend group under test
+type Group struct {
+	Config config.GroupConfig
+	// IndexResource is the resource of the index of the group
+	IndexResource schema.GroupResource
+	// ClaimResource is the resource of the claim of the group
+	ClaimResource schema.GroupResource
+}
+
+func apiServer() *builder.Server {
+	return builder.NewAPIServer().
+		WithServerName("kuid-api-server").
+		WithOpenAPIDefinitions("Config", "v1alpha1", openapi.GetOpenAPIDefinitions).
+		WithoutEtcd()
+}
+
+// InitBackends builds an apiserver with in memory storage that serves the resources of
+// the groups, the storage is applied to the backends of the groups
+func InitBackends(ctx context.Context, groupConfigs ...config.GroupConfig) (*builder.Server, []bebackend.Backend, error) {
+	apiserver := apiServer()
+	bes := make([]bebackend.Backend, 0, len(groupConfigs))
+	for _, groupConfig := range groupConfigs {
+		be := groupConfig.BackendFn()
+		for _, resource := range groupConfig.Resources {
+			storageProvider := resource.StorageProviderFn(ctx, resource.Internal, be, true, &options.Options{
+				Type: options.StorageType_Memory,
+			})
+			for _, resourceVersion := range resource.ResourceVersions {
+				apiserver.WithResourceAndHandler(resourceVersion, storageProvider)
+			}
+		}
+		bes = append(bes, be)
+	}
+
+	if _, err := apiserver.Build(ctx); err != nil {
+		return nil, nil, err
+	}
+	for i, groupConfig := range groupConfigs {
+		if err := groupConfig.ApplyStorageToBackendFn(ctx, bes[i], apiserver); err != nil {
+			return nil, nil, err
+		}
+	}
+	return apiserver, bes, nil
+}
+
+// GetStorage returns the registry store of the resource
+func GetStorage(ctx context.Context, apiServer *builder.Server, gr schema.GroupResource) (*registry.Store, error) {
+	storageProvider := apiServer.StorageProvider[gr]
+	storage, err := storageProvider.Get(ctx, apiServer.Schemes[0], &Getter{})
+	if err != nil {
+		return nil, err
+	}
+	registryStore, ok := storage.(*registry.Store)
+	if !ok {
+		return nil, fmt.Errorf("index store is not a *registry.Store, got: %v", reflect.TypeOf(storage).Name())
+	}
+	return registryStore, nil
+}
+
+var _ generic.RESTOptionsGetter = &Getter{}
+
+// Getter returns empty rest options, the storage of the tests is in memory
+type Getter struct{}
+
+func (r *Getter) GetRESTOptions(resource schema.GroupResource, example runtime.Object) (generic.RESTOptions, error) {
+	return generic.RESTOptions{}, nil
+}
+
+// InitIndex initializes the backend of the group and creates the indexes, it returns the
+// claim storage
+func InitIndex(ctx context.Context, group Group, indexes ...Object) (context.Context, *registry.Store, error) {
+	apiserver, _, err := InitBackends(ctx, group.Config)
+	if err != nil {
+		return ctx, nil, err
+	}
+	return CreateIndexes(ctx, apiserver, group, indexes...)
+}
+
+// CreateIndexes creates the indexes of the group in the initialized apiserver, it returns
+// the claim storage
+func CreateIndexes(ctx context.Context, apiserver *builder.Server, group Group, indexes ...Object) (context.Context, *registry.Store, error) {
+	indexStorage, err := GetStorage(ctx, apiserver, group.IndexResource)
+	if err != nil {
+		return ctx, nil, err
+	}
+	claimStorage, err := GetStorage(ctx, apiserver, group.ClaimResource)
+	if err != nil {
+		return ctx, nil, err
+	}
+	for _, index := range indexes {
+		if fieldErrs := index.ValidateSyntax(""); len(fieldErrs) != 0 {
+			return ctx, nil, fmt.Errorf("syntax errors %v", fieldErrs)
+		}
+		ctx = genericapirequest.WithNamespace(ctx, index.GetNamespace())
+		if _, err := indexStorage.Create(ctx, index, nil, &metav1.CreateOptions{FieldManager: "backend"}); err != nil {
+			return ctx, nil, err
+		}
+	}
+	return ctx, claimStorage, nil
+}
+
+// Claim returns the claim built by a group as its type and validates its syntax
+func Claim[T Object](obj runtime.Object) (T, error) {
+	claim, ok := obj.(T)
+	if !ok {
+		return claim, fmt.Errorf("claim is not a %T, got: %v", claim, reflect.TypeOf(obj).Name())
+	}
+	if fieldErrs := claim.ValidateSyntax(""); len(fieldErrs) != 0 {
+		return claim, fmt.Errorf("invalid syntax %v", fieldErrs)
+	}
+	return claim, nil
+}
+
+// Apply creates the claim or updates it when it exists
+func Apply[T Object](ctx context.Context, claimStorage *registry.Store, claim T) (T, error) {
+	var obj runtime.Object
+	var err error
+	if _, getErr := claimStorage.Get(ctx, claim.GetName(), &metav1.GetOptions{}); getErr != nil {
+		obj, err = claimStorage.Create(ctx, claim, nil, &metav1.CreateOptions{FieldManager: "test"})
+	} else {
+		obj, _, err = claimStorage.Update(ctx, claim.GetName(), rest.DefaultUpdatedObjectInfo(claim, genericbe.ClaimTransformer), nil, nil, false, &metav1.UpdateOptions{
+			FieldManager: "backend",
+		})
+	}
+	if err != nil {
+		return claim, err
+	}
+	newClaim, ok := obj.(T)
+	if !ok {
+		return newClaim, fmt.Errorf("expecting %T, got: %v", newClaim, reflect.TypeOf(obj).Name())
+	}
+	return newClaim, nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testvlan
+
+import (
+	"context"
+
+	"github.com/henderiw/apiserver-builder/pkg/builder/resource"
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/vlan"
+	"github.com/kuidio/kuid/apis/backend/vlan/register"
+	vlanbev1alpha1 "github.com/kuidio/kuid/apis/backend/vlan/v1alpha1"
+	"github.com/kuidio/kuid/apis/common"
+	"github.com/kuidio/kuid/pkg/backend/testutil"
+	"github.com/kuidio/kuid/pkg/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+type testCtx struct {
+	name            string
+	claimType       backend.ClaimType
+	id              uint32
+	outerID         *uint32
+	tRange          string
+	selector        *metav1.LabelSelector
+	expectedError   bool
+	expectedID      *uint32
+	expectedOuterID *uint32
+	expectedRange   *string
+}
+
+// alias
+const (
+	namespace    = testutil.Namespace
+	staticClaim  = backend.ClaimType_StaticID
+	dynamicClaim = backend.ClaimType_DynamicID
+	rangeClaim   = backend.ClaimType_Range
+)
+
+var group = testutil.Group{
+	Config: config.GroupConfig{
+		BackendFn:               register.NewBackend,
+		ApplyStorageToBackendFn: register.ApplyStorageToBackend,
+		Resources: []*config.ResourceConfig{
+			{StorageProviderFn: register.NewIndexStorageProvider, Internal: &vlan.VLANIndex{}, ResourceVersions: []resource.Object{&vlan.VLANIndex{}, &vlanbev1alpha1.VLANIndex{}}},
+			{StorageProviderFn: register.NewClaimStorageProvider, Internal: &vlan.VLANClaim{}, ResourceVersions: []resource.Object{&vlan.VLANClaim{}, &vlanbev1alpha1.VLANClaim{}}},
+			{StorageProviderFn: register.NewStorageProvider, Internal: &vlan.VLANEntry{}, ResourceVersions: []resource.Object{&vlan.VLANEntry{}, &vlanbev1alpha1.VLANEntry{}}},
+		},
+	},
+	IndexResource: vlan.Resource(vlan.VLANIndexPlural),
+	ClaimResource: vlan.Resource(vlan.VLANClaimPlural),
+}
+
+// initIndex initializes the backend and creates the index, it returns the claim storage
+func initIndex(ctx context.Context, index *vlan.VLANIndex) (context.Context, *registry.Store, error) {
+	return testutil.InitIndex(ctx, group, index)
+}
+
+func getIndex(index string, spec *vlan.VLANIndexSpec) *vlan.VLANIndex {
+	return vlan.BuildVLANIndex(
+		metav1.ObjectMeta{Namespace: namespace, Name: index},
+		spec,
+		nil,
+	)
+}
+
+func (r testCtx) getClaim(index string) (*vlan.VLANClaim, error) {
+	spec := &vlan.VLANClaimSpec{
+		Index:   index,
+		OuterID: r.outerID,
+		ClaimLabels: common.ClaimLabels{
+			Selector: r.selector,
+		},
+	}
+	switch r.claimType {
+	case staticClaim:
+		spec.ID = ptr.To[uint32](r.id)
+	case rangeClaim:
+		spec.Range = ptr.To[string](r.tRange)
+	}
+	return testutil.Claim[*vlan.VLANClaim](vlan.BuildVLANClaim(metav1.ObjectMeta{Namespace: namespace, Name: r.name}, spec, nil))
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testvlan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/vlan"
+	"github.com/kuidio/kuid/pkg/backend/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestQinQ(t *testing.T) {
+	tests := map[string]struct {
+		index string
+		spec  *vlan.VLANIndexSpec
+		ctxs  []testCtx
+	}{
+		"Static": {
+			index: "a",
+			spec:  &vlan.VLANIndexSpec{QinQ: true},
+			ctxs: []testCtx{
+				{claimType: staticClaim, name: "claim1", outerID: ptr.To[uint32](10), id: 100, expectedOuterID: ptr.To[uint32](10)},
+				{claimType: staticClaim, name: "claim2", outerID: ptr.To[uint32](11), id: 100, expectedOuterID: ptr.To[uint32](11)}, // the inner tags are scoped per outer tag
+				{claimType: staticClaim, name: "claim3", outerID: ptr.To[uint32](10), id: 100, expectedError: true},                 // claimed by claim1
+				{claimType: staticClaim, name: "claim1", outerID: ptr.To[uint32](10), id: 101, expectedOuterID: ptr.To[uint32](10)}, // reclaim
+				{claimType: staticClaim, name: "claim3", outerID: ptr.To[uint32](10), id: 100, expectedOuterID: ptr.To[uint32](10)}, // released by claim1
+				{claimType: staticClaim, name: "claim4", id: 100, expectedError: true},                                              // no outer tag
+			},
+		},
+		"Dynamic": {
+			index: "a",
+			spec:  &vlan.VLANIndexSpec{QinQ: true},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", expectedID: ptr.To[uint32](1), expectedOuterID: ptr.To[uint32](1)},
+				{claimType: dynamicClaim, name: "claim2", expectedID: ptr.To[uint32](2), expectedOuterID: ptr.To[uint32](1)},
+				{claimType: dynamicClaim, name: "claim3", outerID: ptr.To[uint32](20), expectedID: ptr.To[uint32](1), expectedOuterID: ptr.To[uint32](20)},
+				{claimType: staticClaim, name: "claim4", outerID: ptr.To[uint32](20), id: 2, expectedOuterID: ptr.To[uint32](20)},
+				{claimType: dynamicClaim, name: "claim5", outerID: ptr.To[uint32](20), expectedID: ptr.To[uint32](3), expectedOuterID: ptr.To[uint32](20)},
+				{claimType: dynamicClaim, name: "claim3", outerID: ptr.To[uint32](20), expectedID: ptr.To[uint32](1), expectedOuterID: ptr.To[uint32](20)}, // update
+			},
+		},
+		"Range": {
+			index: "a",
+			spec:  &vlan.VLANIndexSpec{QinQ: true},
+			ctxs: []testCtx{
+				{claimType: rangeClaim, name: "claim1", outerID: ptr.To[uint32](30), tRange: "100-199", expectedOuterID: ptr.To[uint32](30)},
+				{claimType: staticClaim, name: "claim2", outerID: ptr.To[uint32](30), id: 150, expectedOuterID: ptr.To[uint32](30)}, // a static id from the range
+				{claimType: dynamicClaim, name: "claim3", selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{backend.KuidClaimNameKey: "claim1"},
+				}, expectedID: ptr.To[uint32](100), expectedOuterID: ptr.To[uint32](30)}, // a dynamic claim from the range
+				{claimType: rangeClaim, name: "claim4", tRange: "1-2"}, // the outer tags 1-2 with all their inner tags
+				{claimType: dynamicClaim, name: "claim5", expectedID: ptr.To[uint32](1), expectedOuterID: ptr.To[uint32](3)},
+				{claimType: dynamicClaim, name: "claim6", selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{backend.KuidClaimNameKey: "claim4"},
+				}, expectedID: ptr.To[uint32](1), expectedOuterID: ptr.To[uint32](1)}, // the protocol vlans are excluded as inner tag
+				{claimType: rangeClaim, name: "claim7", outerID: ptr.To[uint32](30), tRange: "150-250", expectedError: true}, // overlap with claim1
+			},
+		},
+		"Reserved": {
+			index: "a",
+			spec: &vlan.VLANIndexSpec{
+				QinQ:                 true,
+				ReserveProtocolVLANs: true,
+				ReserveDefaultVLAN:   true,
+				ReservedRanges:       []string{"1002-1005"},
+			},
+			ctxs: []testCtx{
+				{claimType: dynamicClaim, name: "claim1", expectedID: ptr.To[uint32](2), expectedOuterID: ptr.To[uint32](2)}, // outer 1 is reserved, inner 1 is excluded
+				{claimType: dynamicClaim, name: "claim2", outerID: ptr.To[uint32](1003), expectedError: true},                // a reserved outer tag
+				{claimType: staticClaim, name: "claim3", outerID: ptr.To[uint32](1), id: 10, expectedError: true},            // a reserved outer tag
+				{claimType: staticClaim, name: "claim4", outerID: ptr.To[uint32](10), id: 1003, expectedOuterID: ptr.To[uint32](10)},
+				{claimType: dynamicClaim, name: "claim5", outerID: ptr.To[uint32](10), expectedID: ptr.To[uint32](2), expectedOuterID: ptr.To[uint32](10)},
+				{claimType: rangeClaim, name: "claim6", tRange: "1000-1002", expectedError: true}, // overlap with the reserved outer tags
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx, claimStorage, err := initIndex(context.Background(), getIndex(tc.index, tc.spec))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			for _, v := range tc.ctxs {
+				claim, err := v.getClaim(tc.index)
+				if !assert.NoError(t, err) {
+					return
+				}
+				newClaim, err := testutil.Apply(ctx, claimStorage, claim)
+				if v.expectedError {
+					assert.Error(t, err, "claim %s", v.name)
+					continue
+				}
+				if !assert.NoError(t, err, "claim %s", v.name) {
+					continue
+				}
+
+				assert.Equal(t, v.expectedOuterID, newClaim.Status.OuterID, "claim %s outer id", v.name)
+				switch v.claimType {
+				case staticClaim, dynamicClaim:
+					expectedID := ptr.To[uint32](v.id)
+					if v.expectedID != nil {
+						expectedID = v.expectedID
+					}
+					assert.Equal(t, expectedID, newClaim.Status.ID, "claim %s id", v.name)
+				case rangeClaim:
+					expectedRange := ptr.To[string](v.tRange)
+					if v.expectedRange != nil {
+						expectedRange = v.expectedRange
+					}
+					assert.Equal(t, expectedRange, newClaim.Status.Range, "claim %s range", v.name)
+				}
+			}
+		})
+	}
+}
+
+func TestQinQReservedClaims(t *testing.T) {
+	index := getIndex("a", &vlan.VLANIndexSpec{
+		QinQ:                 true,
+		ReserveProtocolVLANs: true,
+		ReserveDefaultVLAN:   true,
+		ReservedRanges:       []string{"1002-1005"},
+	})
+	ctx, claimStorage, err := initIndex(context.Background(), index)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	want := map[string]string{
+		"a.rangereserved-protocol": "0-0,4095-4095",
+		"a.rangereserved-default":  "1-1",
+		"a.rangereserved-vendor":   "1002-1005",
+	}
+	for name, reservedRange := range want {
+		obj, err := claimStorage.Get(ctx, name, &metav1.GetOptions{})
+		if !assert.NoError(t, err, "reserved claim %s", name) {
+			continue
+		}
+		claim, ok := obj.(*vlan.VLANClaim)
+		if !assert.True(t, ok) {
+			continue
+		}
+		assert.Equal(t, ptr.To[string](reservedRange), claim.Status.Range, "reserved claim %s", name)
+	}
+}
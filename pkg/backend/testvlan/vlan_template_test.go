@@ -0,0 +1,89 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testvlan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/vlan"
+	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
+	"github.com/kuidio/kuid/pkg/backend/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestTemplateIndex validates a claim without index claims from the index of the index template
+func TestTemplateIndex(t *testing.T) {
+	ctx, claimStorage, err := initIndex(context.Background(), getIndex(vlan.GetTemplateIndexName("access", "leaf1"), &vlan.VLANIndexSpec{}))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tests := map[string]struct {
+		meta          metav1.ObjectMeta
+		expectedIndex string
+		expectedError bool
+	}{
+		"OwnerLabel": {
+			meta: metav1.ObjectMeta{Namespace: namespace, Name: "claim1", Labels: map[string]string{
+				backend.KuidIndexTemplateKey:  "access",
+				backend.KuidIndexOwnerNameKey: "leaf1",
+			}},
+			expectedIndex: "leaf1.access",
+		},
+		"OwnerReference": {
+			meta: metav1.ObjectMeta{Namespace: namespace, Name: "claim2",
+				Labels: map[string]string{backend.KuidIndexTemplateKey: "access"},
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: infrav1alpha1.SchemeGroupVersion.Identifier(), Kind: infrav1alpha1.NodeKind, Name: "leaf1", UID: "uid-leaf1"},
+				},
+			},
+			expectedIndex: "leaf1.access",
+		},
+		"NoOwner": {
+			meta: metav1.ObjectMeta{Namespace: namespace, Name: "claim3", Labels: map[string]string{
+				backend.KuidIndexTemplateKey: "access",
+			}},
+			expectedError: true,
+		},
+		"UnknownIndex": {
+			meta: metav1.ObjectMeta{Namespace: namespace, Name: "claim4", Labels: map[string]string{
+				backend.KuidIndexTemplateKey:  "access",
+				backend.KuidIndexOwnerNameKey: "leaf2",
+			}},
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			claim := vlan.BuildVLANClaim(tc.meta, &vlan.VLANClaimSpec{}, nil).(*vlan.VLANClaim)
+			newClaim, err := testutil.Apply(ctx, claimStorage, claim)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tc.expectedIndex, newClaim.GetIndex())
+			assert.NotNil(t, newClaim.Status.ID)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/henderiw/logger/log"
+)
+
+type WebhookFailurePolicy string
+
+const (
+	// WebhookFailurePolicy_Fail denies the claim when the pre claim webhook cannot be reached
+	// or returns no valid response
+	WebhookFailurePolicy_Fail WebhookFailurePolicy = "Fail"
+	// WebhookFailurePolicy_Ignore allows the claim when the pre claim webhook cannot be reached
+	// or returns no valid response
+	WebhookFailurePolicy_Ignore WebhookFailurePolicy = "Ignore"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// HookReview is the body of the webhook request and response. The request is sent
+// for the pre and post stage, the response is only evaluated for the pre stage.
+type HookReview struct {
+	Stage    HookStage     `json:"stage"`
+	Request  *HookRequest  `json:"request,omitempty"`
+	Response *HookResponse `json:"response,omitempty"`
+}
+
+// HookResponse allows or denies the claim, the message is returned to the claimer
+// when the claim is denied
+type HookResponse struct {
+	Allowed bool   `json:"allowed"`
+	Message string `json:"message,omitempty"`
+}
+
+type WebhookOptions struct {
+	Name string
+	URL  string
+	// Stages the webhook is called for, when empty the webhook is called for all stages
+	Stages        []HookStage
+	Timeout       time.Duration
+	FailurePolicy WebhookFailurePolicy
+}
+
+// NewWebhook returns a Hook that posts a HookReview to the webhook url
+func NewWebhook(opts WebhookOptions) Hook {
+	if opts.Timeout == 0 {
+		opts.Timeout = defaultWebhookTimeout
+	}
+	if opts.FailurePolicy == "" {
+		opts.FailurePolicy = WebhookFailurePolicy_Fail
+	}
+	stages := map[HookStage]struct{}{}
+	for _, stage := range opts.Stages {
+		stages[stage] = struct{}{}
+	}
+	return &webhook{
+		opts:   opts,
+		stages: stages,
+		client: &http.Client{Timeout: opts.Timeout},
+	}
+}
+
+type webhook struct {
+	opts   WebhookOptions
+	stages map[HookStage]struct{}
+	client *http.Client
+}
+
+func (r *webhook) hasStage(stage HookStage) bool {
+	if len(r.stages) == 0 {
+		return true
+	}
+	_, ok := r.stages[stage]
+	return ok
+}
+
+func (r *webhook) PreClaim(ctx context.Context, req *HookRequest) error {
+	if !r.hasStage(HookStage_Pre) {
+		return nil
+	}
+	log := log.FromContext(ctx).With("webhook", r.opts.Name)
+	rsp, err := r.call(ctx, &HookReview{Stage: HookStage_Pre, Request: req})
+	if err == nil && rsp == nil {
+		// a review without a response cannot allow the claim, the failure policy applies
+		err = fmt.Errorf("no response")
+	}
+	if err != nil {
+		if r.opts.FailurePolicy == WebhookFailurePolicy_Ignore {
+			log.Error("webhook failed, ignored", "error", err.Error())
+			return nil
+		}
+		return fmt.Errorf("webhook %s failed: %w", r.opts.Name, err)
+	}
+	if !rsp.Allowed {
+		msg := rsp.Message
+		if msg == "" {
+			msg = "denied"
+		}
+		return fmt.Errorf("webhook %s: %s", r.opts.Name, msg)
+	}
+	return nil
+}
+
+func (r *webhook) PostClaim(ctx context.Context, req *HookRequest) {
+	if !r.hasStage(HookStage_Post) {
+		return
+	}
+	if _, err := r.call(ctx, &HookReview{Stage: HookStage_Post, Request: req}); err != nil {
+		log.FromContext(ctx).Error("webhook notification failed", "webhook", r.opts.Name, "error", err.Error())
+	}
+}
+
+func (r *webhook) call(ctx context.Context, review *HookReview) (*HookResponse, error) {
+	b, err := json.Marshal(review)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, r.opts.URL, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpRsp, err := r.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpRsp.Body.Close()
+	body, err := io.ReadAll(httpRsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpRsp.StatusCode < 200 || httpRsp.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected status %d: %s", httpRsp.StatusCode, string(body))
+	}
+	if len(body) == 0 {
+		return nil, nil
+	}
+	rspReview := &HookReview{}
+	if err := json.Unmarshal(body, rspReview); err != nil {
+		return nil, fmt.Errorf("cannot decode webhook response: %w", err)
+	}
+	return rspReview.Response, nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookPreClaim(t *testing.T) {
+	tests := map[string]struct {
+		status        int
+		body          string
+		failurePolicy WebhookFailurePolicy
+		expectedError bool
+	}{
+		"Allowed": {
+			status: http.StatusOK,
+			body:   `{"stage":"pre","response":{"allowed":true}}`,
+		},
+		"Denied": {
+			status:        http.StatusOK,
+			body:          `{"stage":"pre","response":{"allowed":false,"message":"not approved"}}`,
+			failurePolicy: WebhookFailurePolicy_Ignore,
+			expectedError: true, // a denial is not a failure
+		},
+		"StatusFail": {
+			status:        http.StatusInternalServerError,
+			failurePolicy: WebhookFailurePolicy_Fail,
+			expectedError: true,
+		},
+		"StatusIgnore": {
+			status:        http.StatusInternalServerError,
+			failurePolicy: WebhookFailurePolicy_Ignore,
+		},
+		"EmptyFail": {
+			status:        http.StatusOK,
+			failurePolicy: WebhookFailurePolicy_Fail,
+			expectedError: true,
+		},
+		"EmptyIgnore": {
+			status:        http.StatusOK,
+			failurePolicy: WebhookFailurePolicy_Ignore,
+		},
+		"NoResponseFail": {
+			status:        http.StatusOK,
+			body:          `{"stage":"pre"}`,
+			expectedError: true, // the default policy fails
+		},
+		"NoResponseIgnore": {
+			status:        http.StatusOK,
+			body:          `{"stage":"pre"}`,
+			failurePolicy: WebhookFailurePolicy_Ignore,
+		},
+		"UndecodableFail": {
+			status:        http.StatusOK,
+			body:          `not a review`,
+			failurePolicy: WebhookFailurePolicy_Fail,
+			expectedError: true,
+		},
+		"UndecodableIgnore": {
+			status:        http.StatusOK,
+			body:          `not a review`,
+			failurePolicy: WebhookFailurePolicy_Ignore,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			hook := NewWebhook(WebhookOptions{Name: "test", URL: server.URL, FailurePolicy: tc.failurePolicy})
+			err := hook.PreClaim(context.Background(), &HookRequest{Operation: HookOperation_Claim, Name: "claim1"})
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
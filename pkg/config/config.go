@@ -17,18 +17,24 @@ limitations under the License.
 package config
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/henderiw/apiserver-store/pkg/db/badgerdb"
 	"github.com/henderiw/logger/log"
 	"github.com/kuidio/kuid/pkg/registry/options"
 )
 
-var (
-	configDir      = "/config"
-	configFilePath = "/etc/kuid/config.json"
+const (
+	DefaultConfigFile = "/etc/kuid/config.json"
+	DefaultStorageDir = "/config"
 )
 
 type StorageType string
@@ -42,6 +48,67 @@ const (
 	StorageType_PostGres StorageType = "postgres"
 )
 
+const (
+	DefaultHealthProbeBindAddress = ":8081"
+)
+
+type LeaderElectionType string
+
+const (
+	LeaderElectionType_Lease LeaderElectionType = "lease"
+	LeaderElectionType_File  LeaderElectionType = "file"
+)
+
+const (
+	DefaultAuditFile       = "audit/audit.log"
+	DefaultAuditMaxSizeMB  = 100
+	DefaultAuditMaxBackups = 10
+	DefaultAuditMaxAgeDays = 90
+)
+
+// AuditConfig defines the audit trail of the claims, releases and index changes.
+// The records are appended as json lines to a local file that is rotated by size,
+// the rotated files are retained by count and age.
+type AuditConfig struct {
+	Enabled bool `json:"enabled"`
+	// Path of the audit log file, defaults to audit/audit.log in the storage dir
+	Path string `json:"path,omitempty"`
+	// MaxSizeMB is the size of the audit log file before it is rotated
+	MaxSizeMB int `json:"maxSizeMB,omitempty"`
+	// MaxBackups is the number of rotated files that are retained
+	MaxBackups int `json:"maxBackups,omitempty"`
+	// MaxAgeDays is the number of days the rotated files are retained
+	MaxAgeDays int `json:"maxAgeDays,omitempty"`
+}
+
+// ArchiveConfig enables the export and import of the backend indexes with their claims
+// and entries on the health server, e.g. for migration and backup
+type ArchiveConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+const (
+	DefaultLeaderElectionName      = "kuid-server"
+	DefaultLeaderElectionNamespace = "kuid-system"
+	DefaultLeaderElectionLockFile  = "/tmp/kuid-server.lock"
+)
+
+// LeaderElectionConfig defines the active/standby high availability of the kuid server
+// When enabled only the leader opens the storage, restores the backend caches and serves
+// requests; the other replicas stay on standby until they acquire the leadership
+type LeaderElectionConfig struct {
+	Enabled bool `json:"enabled"`
+	// Type defines the lock used for the election: lease (default) or file
+	Type LeaderElectionType `json:"type,omitempty"`
+	// Name of the lease
+	Name string `json:"name,omitempty"`
+	// Namespace of the lease, defaults to the namespace of the pod
+	Namespace string `json:"namespace,omitempty"`
+	// LockFile is the path of the lock file used by the file type, the file needs to be
+	// on a filesystem shared by the replicas
+	LockFile string `json:"lockFile,omitempty"`
+}
+
 type KuidGroupConfig struct {
 	Group   string `json:"group"`
 	Enabled bool   `json:"enabled"`
@@ -49,27 +116,138 @@ type KuidGroupConfig struct {
 }
 
 type KuidConfig struct {
-	Storage StorageType        `json:"storage"`
-	Groups  []*KuidGroupConfig `json:"groups"`
+	Storage StorageType `json:"storage"`
+	// StorageDir is the directory of the badgerdb storage, defaults to /config
+	StorageDir string             `json:"storageDir,omitempty"`
+	Groups     []*KuidGroupConfig `json:"groups"`
+	// HealthProbeBindAddress is the address the readiness and health endpoints are served on
+	HealthProbeBindAddress string `json:"healthProbeBindAddress,omitempty"`
+	// LeaderElection enables active/standby high availability
+	LeaderElection *LeaderElectionConfig `json:"leaderElection,omitempty"`
+	// Webhooks are called around the claims and releases of the backends
+	Webhooks []*WebhookConfig `json:"webhooks,omitempty"`
+	// Audit enables the audit trail of the backend operations
+	Audit *AuditConfig `json:"audit,omitempty"`
+	// Archive enables the export and import of the backend indexes
+	Archive *ArchiveConfig `json:"archive,omitempty"`
+}
+
+// GetKuidConfig reads the config file, applies the overrides from the environment
+// and the command line flags and validates the result
+func GetKuidConfig(overrides *Overrides) (*KuidConfig, error) {
+	path, explicit := overrides.getConfigFile()
+	cfg, err := readConfig(path, explicit)
+	if err != nil {
+		return nil, err
+	}
+	if err := overrides.apply(cfg); err != nil {
+		return nil, err
+	}
+	cfg.setDefaults()
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid kuid config: %w", err)
+	}
+	return cfg, nil
 }
 
-func GetKuidConfig() (*KuidConfig, error) {
-	if !isFile(configFilePath) {
+// readConfig reads the config file, the default config is returned when the file
+// does not exist unless the path was explicitly provided
+func readConfig(path string, explicit bool) (*KuidConfig, error) {
+	if !isFile(path) {
+		if explicit {
+			return nil, fmt.Errorf("kuid config file %s not found", path)
+		}
 		return getDefaultConfig(), nil
 	}
-	b, err := os.ReadFile(configFilePath)
+	b, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 	cfg := &KuidConfig{}
-	if err := json.Unmarshal([]byte(b), cfg); err != nil {
-		return nil, err
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("cannot parse kuid config file %s: %w", path, err)
 	}
-	// TODO need to add some validation
-	// sync with etcd is not possible
 	return cfg, nil
 }
 
+func (r *KuidConfig) setDefaults() {
+	if r.StorageDir == "" {
+		r.StorageDir = DefaultStorageDir
+	}
+	if r.HealthProbeBindAddress == "" {
+		r.HealthProbeBindAddress = DefaultHealthProbeBindAddress
+	}
+	r.LeaderElection.setDefaults()
+	r.Audit.setDefaults(r.StorageDir)
+}
+
+// Validate validates the config, unknown groups, sync groups with etcd storage,
+// unsupported storage types, invalid webhooks and audit settings and archiving with
+// etcd storage are rejected
+func (r *KuidConfig) Validate() error {
+	var errs error
+	switch r.Storage {
+	case StorageType_Memory, StorageType_Badgerdb, StorageType_Etcd:
+	case "":
+		errs = errors.Join(errs, fmt.Errorf("storage is required, supported: %s", strings.Join(supportedStorageTypes(), ", ")))
+	default:
+		errs = errors.Join(errs, fmt.Errorf("storage %q is not supported, supported: %s", r.Storage, strings.Join(supportedStorageTypes(), ", ")))
+	}
+
+	groups := map[string]struct{}{}
+	for _, group := range r.Groups {
+		if group == nil {
+			continue
+		}
+		if _, ok := Groups[group.Group]; !ok {
+			errs = errors.Join(errs, fmt.Errorf("unknown group %q, supported: %s", group.Group, strings.Join(registeredGroups(), ", ")))
+			continue
+		}
+		if _, ok := groups[group.Group]; ok {
+			errs = errors.Join(errs, fmt.Errorf("group %q is configured more than once", group.Group))
+		}
+		groups[group.Group] = struct{}{}
+		if group.Enabled && group.Sync && r.Storage == StorageType_Etcd {
+			errs = errors.Join(errs, fmt.Errorf("group %q: sync is not supported with etcd storage", group.Group))
+		}
+	}
+
+	if r.LeaderElection != nil && r.LeaderElection.Enabled {
+		switch r.LeaderElection.Type {
+		case LeaderElectionType_Lease, LeaderElectionType_File:
+		default:
+			errs = errors.Join(errs, fmt.Errorf("leader election type %q is not supported, supported: %s, %s", r.LeaderElection.Type, LeaderElectionType_Lease, LeaderElectionType_File))
+		}
+	}
+	if err := r.validateWebhooks(); err != nil {
+		errs = errors.Join(errs, err)
+	}
+	if r.Audit != nil && r.Audit.Enabled {
+		if r.Audit.MaxSizeMB < 0 || r.Audit.MaxBackups < 0 || r.Audit.MaxAgeDays < 0 {
+			errs = errors.Join(errs, fmt.Errorf("audit maxSizeMB, maxBackups and maxAgeDays must not be negative"))
+		}
+	}
+	if r.Archive != nil && r.Archive.Enabled && r.Storage == StorageType_Etcd {
+		errs = errors.Join(errs, fmt.Errorf("archive is not supported with etcd storage"))
+	}
+	return errs
+}
+
+func supportedStorageTypes() []string {
+	return []string{string(StorageType_Memory), string(StorageType_Badgerdb), string(StorageType_Etcd)}
+}
+
+func registeredGroups() []string {
+	groups := make([]string, 0, len(Groups))
+	for group := range Groups {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	return groups
+}
+
 func getDefaultConfig() *KuidConfig {
 	return &KuidConfig{
 		Storage: StorageType_Badgerdb,
@@ -80,32 +258,78 @@ func getDefaultConfig() *KuidConfig {
 			{Group: "ipam.be.kuid.dev", Enabled: true, Sync: true},
 			{Group: "genid.be.kuid.dev", Enabled: true, Sync: true},
 			{Group: "extcomm.be.kuid.dev", Enabled: true, Sync: true},
+			{Group: "esi.be.kuid.dev", Enabled: true, Sync: true},
+			{Group: "rd.be.kuid.dev", Enabled: true, Sync: true},
+			{Group: "label.be.kuid.dev", Enabled: true, Sync: true},
+			{Group: "community.be.kuid.dev", Enabled: true, Sync: true},
+			{Group: "quota.be.kuid.dev", Enabled: true, Sync: true},
 		},
 	}
 }
 
-func GetRegistryOptions(ctx context.Context, typ StorageType) (*options.Options, error) {
+func (r *AuditConfig) setDefaults(storageDir string) {
+	if r == nil {
+		return
+	}
+	if r.Path == "" {
+		r.Path = filepath.Join(storageDir, DefaultAuditFile)
+	}
+	if r.MaxSizeMB == 0 {
+		r.MaxSizeMB = DefaultAuditMaxSizeMB
+	}
+	if r.MaxBackups == 0 {
+		r.MaxBackups = DefaultAuditMaxBackups
+	}
+	if r.MaxAgeDays == 0 {
+		r.MaxAgeDays = DefaultAuditMaxAgeDays
+	}
+}
+
+func (r *LeaderElectionConfig) setDefaults() {
+	if r == nil {
+		return
+	}
+	if r.Type == "" {
+		r.Type = LeaderElectionType_Lease
+	}
+	if r.Name == "" {
+		r.Name = DefaultLeaderElectionName
+	}
+	if r.Namespace == "" {
+		r.Namespace = os.Getenv("POD_NAMESPACE")
+	}
+	if r.Namespace == "" {
+		r.Namespace = DefaultLeaderElectionNamespace
+	}
+	if r.LockFile == "" {
+		r.LockFile = DefaultLeaderElectionLockFile
+	}
+}
+
+func GetRegistryOptions(ctx context.Context, cfg *KuidConfig) (*options.Options, error) {
 	log := log.FromContext(ctx)
-	switch typ {
+	switch cfg.Storage {
 	case StorageType_Badgerdb:
-		db, err := badgerdb.OpenDB(ctx, configDir)
+		db, err := badgerdb.OpenDB(ctx, cfg.StorageDir)
 		if err != nil {
-			log.Error("cannot open db", "err", err.Error())
+			log.Error("cannot open db", "dir", cfg.StorageDir, "err", err.Error())
 			return nil, err
 		}
 
 		return &options.Options{
-			Prefix: configDir,
+			Prefix: cfg.StorageDir,
 			Type:   options.StorageType_KV,
 			DB:     db,
 		}, nil
 	case StorageType_Etcd:
 		return nil, nil
-	default:
+	case StorageType_Memory:
 		return &options.Options{
-			Prefix: configDir,
+			Prefix: cfg.StorageDir,
 			Type:   options.StorageType_Memory,
 		}, nil
+	default:
+		return nil, fmt.Errorf("storage %q is not supported", cfg.Storage)
 	}
 }
 
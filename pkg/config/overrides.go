@@ -0,0 +1,136 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	EnvConfigFile = "KUID_CONFIG_FILE"
+	EnvStorage    = "KUID_STORAGE"
+	EnvStorageDir = "KUID_STORAGE_DIR"
+	// EnvGroupPrefix is the prefix of the env variables with the settings of a group,
+	// the suffix is the first label of the group in upper case, e.g. ENABLE_BE_IPAM=sync
+	EnvGroupPrefix = "ENABLE_BE_"
+)
+
+// Overrides defines the overrides of the config file through the command line flags,
+// the flags take precedence over the env variables which take precedence over the file
+type Overrides struct {
+	ConfigFile string
+	Storage    string
+	StorageDir string
+	// Groups defines the settings of a group as <group>=<settings>
+	Groups []string
+}
+
+func (r *Overrides) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&r.ConfigFile, "kuid-config", "", fmt.Sprintf("path of the kuid config file, default %s (env %s)", DefaultConfigFile, EnvConfigFile))
+	fs.StringVar(&r.Storage, "kuid-storage", "", fmt.Sprintf("storage type, one of %s (env %s)", strings.Join(supportedStorageTypes(), ", "), EnvStorage))
+	fs.StringVar(&r.StorageDir, "kuid-storage-dir", "", fmt.Sprintf("directory of the badgerdb storage, default %s (env %s)", DefaultStorageDir, EnvStorageDir))
+	fs.StringArrayVar(&r.Groups, "kuid-group", nil, fmt.Sprintf("settings of a group as <group>=<settings>, settings is a comma separated list of true, false, sync or async (env %s<NAME>)", EnvGroupPrefix))
+}
+
+func (r *Overrides) getConfigFile() (string, bool) {
+	if r != nil && r.ConfigFile != "" {
+		return r.ConfigFile, true
+	}
+	if path := os.Getenv(EnvConfigFile); path != "" {
+		return path, true
+	}
+	return DefaultConfigFile, false
+}
+
+// apply applies the env variables and the flags to the config
+func (r *Overrides) apply(cfg *KuidConfig) error {
+	if storage := os.Getenv(EnvStorage); storage != "" {
+		cfg.Storage = StorageType(storage)
+	}
+	if dir := os.Getenv(EnvStorageDir); dir != "" {
+		cfg.StorageDir = dir
+	}
+	for _, group := range registeredGroups() {
+		name := GroupEnvName(group)
+		if settings, ok := os.LookupEnv(name); ok {
+			if err := cfg.setGroup(group, settings); err != nil {
+				return fmt.Errorf("env %s: %w", name, err)
+			}
+		}
+	}
+	if r == nil {
+		return nil
+	}
+	if r.Storage != "" {
+		cfg.Storage = StorageType(r.Storage)
+	}
+	if r.StorageDir != "" {
+		cfg.StorageDir = r.StorageDir
+	}
+	for _, groupSettings := range r.Groups {
+		group, settings, ok := strings.Cut(groupSettings, "=")
+		if !ok {
+			return fmt.Errorf("flag kuid-group %q: expected <group>=<settings>", groupSettings)
+		}
+		if err := cfg.setGroup(group, settings); err != nil {
+			return fmt.Errorf("flag kuid-group %q: %w", groupSettings, err)
+		}
+	}
+	return nil
+}
+
+// GroupEnvName returns the name of the env variable with the settings of the group
+func GroupEnvName(group string) string {
+	name, _, _ := strings.Cut(group, ".")
+	return EnvGroupPrefix + strings.ToUpper(name)
+}
+
+// setGroup applies the settings to the group, the group is added when not configured.
+// Like a group in the config file an added group is async unless the settings ask for sync.
+func (r *KuidConfig) setGroup(group, settings string) error {
+	var groupConfig *KuidGroupConfig
+	for _, g := range r.Groups {
+		if g != nil && g.Group == group {
+			groupConfig = g
+		}
+	}
+	if groupConfig == nil {
+		groupConfig = &KuidGroupConfig{Group: group}
+		r.Groups = append(r.Groups, groupConfig)
+	}
+	for _, setting := range strings.Split(settings, ",") {
+		switch strings.ToLower(strings.TrimSpace(setting)) {
+		case "true":
+			groupConfig.Enabled = true
+		case "false":
+			groupConfig.Enabled = false
+		case "sync":
+			groupConfig.Enabled = true
+			groupConfig.Sync = true
+		case "async":
+			groupConfig.Enabled = true
+			groupConfig.Sync = false
+		default:
+			return fmt.Errorf("unknown group setting %q, supported: true, false, sync, async", setting)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testGroup = "test.be.kuid.dev"
+
+func init() {
+	Register(testGroup, nil, nil, nil, nil, nil)
+}
+
+func TestGroupEnvName(t *testing.T) {
+	if got := GroupEnvName("ipam.be.kuid.dev"); got != "ENABLE_BE_IPAM" {
+		t.Errorf("want ENABLE_BE_IPAM, got %s", got)
+	}
+}
+
+func TestSetGroup(t *testing.T) {
+	cases := map[string]struct {
+		configured  *KuidGroupConfig
+		settings    string
+		want        *KuidGroupConfig
+		expectedErr bool
+	}{
+		"AddEnabled": {
+			settings: "true",
+			want:     &KuidGroupConfig{Group: testGroup, Enabled: true, Sync: false},
+		},
+		"AddSync": {
+			settings: "sync",
+			want:     &KuidGroupConfig{Group: testGroup, Enabled: true, Sync: true},
+		},
+		"AddAsync": {
+			settings: "async",
+			want:     &KuidGroupConfig{Group: testGroup, Enabled: true, Sync: false},
+		},
+		"AddDisabled": {
+			settings: "false",
+			want:     &KuidGroupConfig{Group: testGroup, Enabled: false, Sync: false},
+		},
+		"KeepConfiguredSync": {
+			configured: &KuidGroupConfig{Group: testGroup, Enabled: false, Sync: true},
+			settings:   "true",
+			want:       &KuidGroupConfig{Group: testGroup, Enabled: true, Sync: true},
+		},
+		"OverrideConfiguredSync": {
+			configured: &KuidGroupConfig{Group: testGroup, Enabled: true, Sync: true},
+			settings:   "async",
+			want:       &KuidGroupConfig{Group: testGroup, Enabled: true, Sync: false},
+		},
+		"MultipleSettings": {
+			settings: "sync, false",
+			want:     &KuidGroupConfig{Group: testGroup, Enabled: false, Sync: true},
+		},
+		"Unknown": {
+			settings:    "maybe",
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := &KuidConfig{}
+			if tc.configured != nil {
+				cfg.Groups = append(cfg.Groups, tc.configured)
+			}
+			err := cfg.setGroup(testGroup, tc.settings)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff([]*KuidGroupConfig{tc.want}, cfg.Groups); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetKuidConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"storage": "memory", "groups": []}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(EnvStorageDir, "/env")
+	t.Setenv(GroupEnvName(testGroup), "true")
+
+	cfg, err := GetKuidConfig(&Overrides{ConfigFile: path, Storage: string(StorageType_Badgerdb)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Storage != StorageType_Badgerdb {
+		t.Errorf("want the flag to override the storage, got %s", cfg.Storage)
+	}
+	if cfg.StorageDir != "/env" {
+		t.Errorf("want the env to override the storage dir, got %s", cfg.StorageDir)
+	}
+	if diff := cmp.Diff([]*KuidGroupConfig{{Group: testGroup, Enabled: true}}, cfg.Groups); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+
+	if _, err := GetKuidConfig(&Overrides{ConfigFile: filepath.Join(t.TempDir(), "missing.json")}); err == nil {
+		t.Errorf("expected an error for a missing explicit config file")
+	}
+	if _, err := GetKuidConfig(&Overrides{ConfigFile: path, Storage: "unknown"}); err == nil {
+		t.Errorf("expected an error for an unsupported storage")
+	}
+}
@@ -25,6 +25,7 @@ import (
 	bebackend "github.com/kuidio/kuid/pkg/backend"
 	"github.com/kuidio/kuid/pkg/registry/options"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var Groups = map[string]*GroupConfig{}
@@ -35,10 +36,14 @@ type StorageProviderFn func(ctx context.Context, obj resource.InternalObject, be
 
 type ApplyStorageToBackendFn func(ctx context.Context, be bebackend.Backend, apiServer *builder.Server) error
 
+// ApplyClientToBackendFn attaches the CRD storage to the backend, used with etcd storage
+type ApplyClientToBackendFn func(ctx context.Context, be bebackend.Backend, c client.Client) error
+
 type GroupConfig struct {
 	AddToScheme             func(s *runtime.Scheme) error
 	BackendFn               BackendFn
 	ApplyStorageToBackendFn ApplyStorageToBackendFn
+	ApplyClientToBackendFn  ApplyClientToBackendFn
 	Resources               []*ResourceConfig
 }
 
@@ -46,13 +51,21 @@ type ResourceConfig struct {
 	StorageProviderFn StorageProviderFn
 	Internal          resource.InternalObject
 	ResourceVersions  []resource.Object
+	// Index indicates the resource is a backend index, the backend cache of the
+	// persisted indexes is restored at startup
+	Index bool
+	// Claim and Entry indicate the resource holds the claims or the entries of a backend
+	// index, they are archived with the index
+	Claim bool
+	Entry bool
 }
 
-func Register(groupName string, addToScheme func(s *runtime.Scheme) error, befn BackendFn, applybefn ApplyStorageToBackendFn, resources []*ResourceConfig) {
+func Register(groupName string, addToScheme func(s *runtime.Scheme) error, befn BackendFn, applybefn ApplyStorageToBackendFn, applyclientfn ApplyClientToBackendFn, resources []*ResourceConfig) {
 	Groups[groupName] = &GroupConfig{
 		AddToScheme:             addToScheme,
 		BackendFn:               befn,
 		ApplyStorageToBackendFn: applybefn,
+		ApplyClientToBackendFn:  applyclientfn,
 		Resources:               resources,
 	}
 }
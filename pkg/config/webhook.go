@@ -0,0 +1,103 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+)
+
+// WebhookConfig defines an allocation hook webhook. The webhook is called before a claim
+// is applied (pre) and can deny the claim, and after a claim or release is applied (post)
+// to receive the final allocation.
+type WebhookConfig struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Groups the webhook applies to, when empty the webhook applies to all groups
+	Groups []string `json:"groups,omitempty"`
+	// Stages the webhook is called for: pre and/or post, when empty both
+	Stages []bebackend.HookStage `json:"stages,omitempty"`
+	// TimeoutSeconds of the webhook call, defaults to 10s
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+	// FailurePolicy defines if a claim is denied (Fail, default) or allowed (Ignore)
+	// when the pre webhook cannot be reached
+	FailurePolicy bebackend.WebhookFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// RegisterWebhooks registers the configured webhooks as backend hooks
+func (r *KuidConfig) RegisterWebhooks() {
+	for _, webhook := range r.Webhooks {
+		bebackend.RegisterHook(bebackend.NewWebhook(webhook.getOptions()), webhook.Groups...)
+	}
+}
+
+func (r *WebhookConfig) getOptions() bebackend.WebhookOptions {
+	return bebackend.WebhookOptions{
+		Name:          r.Name,
+		URL:           r.URL,
+		Stages:        r.Stages,
+		Timeout:       time.Duration(r.TimeoutSeconds) * time.Second,
+		FailurePolicy: r.FailurePolicy,
+	}
+}
+
+func (r *KuidConfig) validateWebhooks() error {
+	var errs error
+	names := map[string]struct{}{}
+	for i, webhook := range r.Webhooks {
+		if webhook == nil {
+			errs = errors.Join(errs, fmt.Errorf("webhook %d: empty webhook entry", i))
+			continue
+		}
+		if webhook.Name == "" {
+			errs = errors.Join(errs, fmt.Errorf("webhook name is required"))
+		}
+		if _, ok := names[webhook.Name]; ok {
+			errs = errors.Join(errs, fmt.Errorf("webhook %q is configured more than once", webhook.Name))
+		}
+		names[webhook.Name] = struct{}{}
+		u, err := url.Parse(webhook.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = errors.Join(errs, fmt.Errorf("webhook %q: url %q must be an absolute http or https url", webhook.Name, webhook.URL))
+		}
+		for _, group := range webhook.Groups {
+			if _, ok := Groups[group]; !ok {
+				errs = errors.Join(errs, fmt.Errorf("webhook %q: unknown group %q", webhook.Name, group))
+			}
+		}
+		for _, stage := range webhook.Stages {
+			switch stage {
+			case bebackend.HookStage_Pre, bebackend.HookStage_Post:
+			default:
+				errs = errors.Join(errs, fmt.Errorf("webhook %q: stage %q is not supported, supported: %s, %s", webhook.Name, stage, bebackend.HookStage_Pre, bebackend.HookStage_Post))
+			}
+		}
+		switch webhook.FailurePolicy {
+		case "", bebackend.WebhookFailurePolicy_Fail, bebackend.WebhookFailurePolicy_Ignore:
+		default:
+			errs = errors.Join(errs, fmt.Errorf("webhook %q: failurePolicy %q is not supported, supported: %s, %s", webhook.Name, webhook.FailurePolicy, bebackend.WebhookFailurePolicy_Fail, bebackend.WebhookFailurePolicy_Ignore))
+		}
+		if webhook.TimeoutSeconds < 0 {
+			errs = errors.Join(errs, fmt.Errorf("webhook %q: timeoutSeconds must not be negative", webhook.Name))
+		}
+	}
+	return errs
+}
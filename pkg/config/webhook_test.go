@@ -0,0 +1,76 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+)
+
+func TestValidateWebhooks(t *testing.T) {
+	cases := map[string]struct {
+		webhooks    []*WebhookConfig
+		expectedErr bool
+	}{
+		"Valid": {
+			webhooks: []*WebhookConfig{
+				{Name: "a", URL: "https://a.example.com/hook", Groups: []string{testGroup}, Stages: []bebackend.HookStage{bebackend.HookStage_Pre}},
+			},
+		},
+		"NilEntry": {
+			webhooks:    []*WebhookConfig{nil},
+			expectedErr: true,
+		},
+		"Duplicate": {
+			webhooks: []*WebhookConfig{
+				{Name: "a", URL: "https://a.example.com/hook"},
+				{Name: "a", URL: "https://b.example.com/hook"},
+			},
+			expectedErr: true,
+		},
+		"RelativeURL": {
+			webhooks:    []*WebhookConfig{{Name: "a", URL: "/hook"}},
+			expectedErr: true,
+		},
+		"UnknownGroup": {
+			webhooks:    []*WebhookConfig{{Name: "a", URL: "https://a.example.com/hook", Groups: []string{"unknown.be.kuid.dev"}}},
+			expectedErr: true,
+		},
+		"UnknownStage": {
+			webhooks:    []*WebhookConfig{{Name: "a", URL: "https://a.example.com/hook", Stages: []bebackend.HookStage{"mid"}}},
+			expectedErr: true,
+		},
+		"NegativeTimeout": {
+			webhooks:    []*WebhookConfig{{Name: "a", URL: "https://a.example.com/hook", TimeoutSeconds: -1}},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := &KuidConfig{Webhooks: tc.webhooks}
+			err := cfg.validateWebhooks()
+			if tc.expectedErr && err == nil {
+				t.Errorf("expected an error")
+			}
+			if !tc.expectedErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elevation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kuidio/kuid/apis/infra"
+	"github.com/kuidio/kuid/pkg/registry/options"
+	"github.com/kuidio/kuid/pkg/topology"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// NewNodeInvoker returns an invoker that rejects nodes referencing an unknown rack,
+// positioned beyond the rack height or overlapping with other nodes in the rack
+func NewNodeInvoker(storage *topology.Storage) options.BackendInvoker {
+	return &nodeInvoker{
+		storage: storage,
+	}
+}
+
+type nodeInvoker struct {
+	storage *topology.Storage
+}
+
+func (r *nodeInvoker) InvokeCreate(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	return obj, r.check(ctx, obj)
+}
+
+func (r *nodeInvoker) InvokeUpdate(ctx context.Context, obj, old runtime.Object, recursion bool) (runtime.Object, runtime.Object, error) {
+	return obj, old, r.check(ctx, obj)
+}
+
+func (r *nodeInvoker) InvokeDelete(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	return obj, nil
+}
+
+func (r *nodeInvoker) check(ctx context.Context, obj runtime.Object) error {
+	node, ok := obj.(*infra.Node)
+	if !ok {
+		return fmt.Errorf("unexpected object, want %s, got %T", infra.NodeKind, obj)
+	}
+	if node.Spec.Rack == nil || *node.Spec.Rack == "" {
+		return nil
+	}
+	rack, err := r.getRack(ctx, *node.Spec.Rack)
+	if err != nil {
+		return err
+	}
+	if rack == nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("node %s references unknown rack %s", node.Name, *node.Spec.Rack))
+	}
+	slot, err := node.GetRackSlot()
+	if err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+	if slot == nil {
+		return nil
+	}
+	height, err := rack.GetHeightUnits()
+	if err != nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("rack %s, err: %s", rack.Name, err.Error()))
+	}
+	others, err := getRackSlots(ctx, r.storage, rack.Name)
+	if err != nil {
+		return err
+	}
+	if err := infra.CheckRackSlot(height, *slot, others); err != nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("rack %s, err: %s", rack.Name, err.Error()))
+	}
+	return nil
+}
+
+func (r *nodeInvoker) getRack(ctx context.Context, name string) (*infra.Rack, error) {
+	racks, err := topology.List[*infra.Rack](ctx, r.storage, infra.RackPlural)
+	if err != nil {
+		return nil, err
+	}
+	for _, rack := range racks {
+		if rack.Name == name {
+			return rack, nil
+		}
+	}
+	return nil, nil
+}
+
+// NewRackInvoker returns an invoker that rejects a rack height change when
+// nodes in the rack no longer fit
+func NewRackInvoker(storage *topology.Storage) options.BackendInvoker {
+	return &rackInvoker{
+		storage: storage,
+	}
+}
+
+type rackInvoker struct {
+	storage *topology.Storage
+}
+
+func (r *rackInvoker) InvokeCreate(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	return obj, nil
+}
+
+func (r *rackInvoker) InvokeUpdate(ctx context.Context, obj, old runtime.Object, recursion bool) (runtime.Object, runtime.Object, error) {
+	rack, ok := obj.(*infra.Rack)
+	if !ok {
+		return obj, old, fmt.Errorf("unexpected object, want %s, got %T", infra.RackKind, obj)
+	}
+	height, err := rack.GetHeightUnits()
+	if err != nil || height == 0 {
+		return obj, old, err
+	}
+	slots, err := getRackSlots(ctx, r.storage, rack.Name)
+	if err != nil {
+		return obj, old, err
+	}
+	for _, slot := range slots {
+		if slot.Top() > height {
+			return obj, old, apierrors.NewBadRequest(fmt.Sprintf("rack %s height %dU is smaller than the U%d occupied by node %s",
+				rack.Name, height, slot.Top(), slot.Node))
+		}
+	}
+	return obj, old, nil
+}
+
+func (r *rackInvoker) InvokeDelete(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	return obj, nil
+}
+
+// getRackSlots returns the slots of the positioned nodes in the rack
+func getRackSlots(ctx context.Context, storage *topology.Storage, rack string) ([]infra.RackSlot, error) {
+	nodes, err := topology.List[*infra.Node](ctx, storage, infra.NodePlural)
+	if err != nil {
+		return nil, err
+	}
+	slots := []infra.RackSlot{}
+	for _, node := range nodes {
+		if node.Spec.Rack == nil || *node.Spec.Rack != rack {
+			continue
+		}
+		slot, err := node.GetRackSlot()
+		if err != nil || slot == nil {
+			continue
+		}
+		slots = append(slots, *slot)
+	}
+	return slots, nil
+}
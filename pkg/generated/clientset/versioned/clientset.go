@@ -22,9 +22,14 @@ import (
 	"net/http"
 
 	asv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/as/v1alpha1"
+	communityv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/community/v1alpha1"
+	esiv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/esi/v1alpha1"
 	extcommv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/extcomm/v1alpha1"
 	infrav1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/infra/v1alpha1"
 	ipamv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/ipam/v1alpha1"
+	labelv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/label/v1alpha1"
+	quotav1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/quota/v1alpha1"
+	rdv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/rd/v1alpha1"
 	vlanv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/vlan/v1alpha1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
@@ -34,20 +39,30 @@ import (
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	AsV1alpha1() asv1alpha1.AsV1alpha1Interface
+	CommunityV1alpha1() communityv1alpha1.CommunityV1alpha1Interface
+	EsiV1alpha1() esiv1alpha1.EsiV1alpha1Interface
 	ExtcommV1alpha1() extcommv1alpha1.ExtcommV1alpha1Interface
 	InfraV1alpha1() infrav1alpha1.InfraV1alpha1Interface
 	IpamV1alpha1() ipamv1alpha1.IpamV1alpha1Interface
+	LabelV1alpha1() labelv1alpha1.LabelV1alpha1Interface
+	QuotaV1alpha1() quotav1alpha1.QuotaV1alpha1Interface
+	RdV1alpha1() rdv1alpha1.RdV1alpha1Interface
 	VlanV1alpha1() vlanv1alpha1.VlanV1alpha1Interface
 }
 
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
-	asV1alpha1      *asv1alpha1.AsV1alpha1Client
-	extcommV1alpha1 *extcommv1alpha1.ExtcommV1alpha1Client
-	infraV1alpha1   *infrav1alpha1.InfraV1alpha1Client
-	ipamV1alpha1    *ipamv1alpha1.IpamV1alpha1Client
-	vlanV1alpha1    *vlanv1alpha1.VlanV1alpha1Client
+	asV1alpha1        *asv1alpha1.AsV1alpha1Client
+	communityV1alpha1 *communityv1alpha1.CommunityV1alpha1Client
+	esiV1alpha1       *esiv1alpha1.EsiV1alpha1Client
+	extcommV1alpha1   *extcommv1alpha1.ExtcommV1alpha1Client
+	infraV1alpha1     *infrav1alpha1.InfraV1alpha1Client
+	ipamV1alpha1      *ipamv1alpha1.IpamV1alpha1Client
+	labelV1alpha1     *labelv1alpha1.LabelV1alpha1Client
+	quotaV1alpha1     *quotav1alpha1.QuotaV1alpha1Client
+	rdV1alpha1        *rdv1alpha1.RdV1alpha1Client
+	vlanV1alpha1      *vlanv1alpha1.VlanV1alpha1Client
 }
 
 // AsV1alpha1 retrieves the AsV1alpha1Client
@@ -55,6 +70,16 @@ func (c *Clientset) AsV1alpha1() asv1alpha1.AsV1alpha1Interface {
 	return c.asV1alpha1
 }
 
+// CommunityV1alpha1 retrieves the CommunityV1alpha1Client
+func (c *Clientset) CommunityV1alpha1() communityv1alpha1.CommunityV1alpha1Interface {
+	return c.communityV1alpha1
+}
+
+// EsiV1alpha1 retrieves the EsiV1alpha1Client
+func (c *Clientset) EsiV1alpha1() esiv1alpha1.EsiV1alpha1Interface {
+	return c.esiV1alpha1
+}
+
 // ExtcommV1alpha1 retrieves the ExtcommV1alpha1Client
 func (c *Clientset) ExtcommV1alpha1() extcommv1alpha1.ExtcommV1alpha1Interface {
 	return c.extcommV1alpha1
@@ -70,6 +95,21 @@ func (c *Clientset) IpamV1alpha1() ipamv1alpha1.IpamV1alpha1Interface {
 	return c.ipamV1alpha1
 }
 
+// LabelV1alpha1 retrieves the LabelV1alpha1Client
+func (c *Clientset) LabelV1alpha1() labelv1alpha1.LabelV1alpha1Interface {
+	return c.labelV1alpha1
+}
+
+// QuotaV1alpha1 retrieves the QuotaV1alpha1Client
+func (c *Clientset) QuotaV1alpha1() quotav1alpha1.QuotaV1alpha1Interface {
+	return c.quotaV1alpha1
+}
+
+// RdV1alpha1 retrieves the RdV1alpha1Client
+func (c *Clientset) RdV1alpha1() rdv1alpha1.RdV1alpha1Interface {
+	return c.rdV1alpha1
+}
+
 // VlanV1alpha1 retrieves the VlanV1alpha1Client
 func (c *Clientset) VlanV1alpha1() vlanv1alpha1.VlanV1alpha1Interface {
 	return c.vlanV1alpha1
@@ -123,6 +163,14 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.communityV1alpha1, err = communityv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	cs.esiV1alpha1, err = esiv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.extcommV1alpha1, err = extcommv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -135,6 +183,18 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.labelV1alpha1, err = labelv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	cs.quotaV1alpha1, err = quotav1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	cs.rdV1alpha1, err = rdv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.vlanV1alpha1, err = vlanv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -161,9 +221,14 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.asV1alpha1 = asv1alpha1.New(c)
+	cs.communityV1alpha1 = communityv1alpha1.New(c)
+	cs.esiV1alpha1 = esiv1alpha1.New(c)
 	cs.extcommV1alpha1 = extcommv1alpha1.New(c)
 	cs.infraV1alpha1 = infrav1alpha1.New(c)
 	cs.ipamV1alpha1 = ipamv1alpha1.New(c)
+	cs.labelV1alpha1 = labelv1alpha1.New(c)
+	cs.quotaV1alpha1 = quotav1alpha1.New(c)
+	cs.rdV1alpha1 = rdv1alpha1.New(c)
 	cs.vlanV1alpha1 = vlanv1alpha1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
@@ -21,12 +21,22 @@ import (
 	clientset "github.com/kuidio/kuid/pkg/generated/clientset/versioned"
 	asv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/as/v1alpha1"
 	fakeasv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/as/v1alpha1/fake"
+	communityv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/community/v1alpha1"
+	fakecommunityv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/community/v1alpha1/fake"
+	esiv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/esi/v1alpha1"
+	fakeesiv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/esi/v1alpha1/fake"
 	extcommv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/extcomm/v1alpha1"
 	fakeextcommv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/extcomm/v1alpha1/fake"
 	infrav1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/infra/v1alpha1"
 	fakeinfrav1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/infra/v1alpha1/fake"
 	ipamv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/ipam/v1alpha1"
 	fakeipamv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/ipam/v1alpha1/fake"
+	labelv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/label/v1alpha1"
+	fakelabelv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/label/v1alpha1/fake"
+	quotav1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/quota/v1alpha1"
+	fakequotav1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/quota/v1alpha1/fake"
+	rdv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/rd/v1alpha1"
+	fakerdv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/rd/v1alpha1/fake"
 	vlanv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/vlan/v1alpha1"
 	fakevlanv1alpha1 "github.com/kuidio/kuid/pkg/generated/clientset/versioned/typed/vlan/v1alpha1/fake"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -95,6 +105,16 @@ func (c *Clientset) AsV1alpha1() asv1alpha1.AsV1alpha1Interface {
 	return &fakeasv1alpha1.FakeAsV1alpha1{Fake: &c.Fake}
 }
 
+// CommunityV1alpha1 retrieves the CommunityV1alpha1Client
+func (c *Clientset) CommunityV1alpha1() communityv1alpha1.CommunityV1alpha1Interface {
+	return &fakecommunityv1alpha1.FakeCommunityV1alpha1{Fake: &c.Fake}
+}
+
+// EsiV1alpha1 retrieves the EsiV1alpha1Client
+func (c *Clientset) EsiV1alpha1() esiv1alpha1.EsiV1alpha1Interface {
+	return &fakeesiv1alpha1.FakeEsiV1alpha1{Fake: &c.Fake}
+}
+
 // ExtcommV1alpha1 retrieves the ExtcommV1alpha1Client
 func (c *Clientset) ExtcommV1alpha1() extcommv1alpha1.ExtcommV1alpha1Interface {
 	return &fakeextcommv1alpha1.FakeExtcommV1alpha1{Fake: &c.Fake}
@@ -110,6 +130,21 @@ func (c *Clientset) IpamV1alpha1() ipamv1alpha1.IpamV1alpha1Interface {
 	return &fakeipamv1alpha1.FakeIpamV1alpha1{Fake: &c.Fake}
 }
 
+// LabelV1alpha1 retrieves the LabelV1alpha1Client
+func (c *Clientset) LabelV1alpha1() labelv1alpha1.LabelV1alpha1Interface {
+	return &fakelabelv1alpha1.FakeLabelV1alpha1{Fake: &c.Fake}
+}
+
+// QuotaV1alpha1 retrieves the QuotaV1alpha1Client
+func (c *Clientset) QuotaV1alpha1() quotav1alpha1.QuotaV1alpha1Interface {
+	return &fakequotav1alpha1.FakeQuotaV1alpha1{Fake: &c.Fake}
+}
+
+// RdV1alpha1 retrieves the RdV1alpha1Client
+func (c *Clientset) RdV1alpha1() rdv1alpha1.RdV1alpha1Interface {
+	return &fakerdv1alpha1.FakeRdV1alpha1{Fake: &c.Fake}
+}
+
 // VlanV1alpha1 retrieves the VlanV1alpha1Client
 func (c *Clientset) VlanV1alpha1() vlanv1alpha1.VlanV1alpha1Interface {
 	return &fakevlanv1alpha1.FakeVlanV1alpha1{Fake: &c.Fake}
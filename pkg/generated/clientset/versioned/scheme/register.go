@@ -19,8 +19,13 @@ package scheme
 
 import (
 	asv1alpha1 "github.com/kuidio/kuid/apis/backend/as/v1alpha1"
+	communityv1alpha1 "github.com/kuidio/kuid/apis/backend/community/v1alpha1"
+	esiv1alpha1 "github.com/kuidio/kuid/apis/backend/esi/v1alpha1"
 	extcommv1alpha1 "github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1"
 	ipamv1alpha1 "github.com/kuidio/kuid/apis/backend/ipam/v1alpha1"
+	labelv1alpha1 "github.com/kuidio/kuid/apis/backend/label/v1alpha1"
+	quotav1alpha1 "github.com/kuidio/kuid/apis/backend/quota/v1alpha1"
+	rdv1alpha1 "github.com/kuidio/kuid/apis/backend/rd/v1alpha1"
 	vlanv1alpha1 "github.com/kuidio/kuid/apis/backend/vlan/v1alpha1"
 	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,9 +40,14 @@ var Codecs = serializer.NewCodecFactory(Scheme)
 var ParameterCodec = runtime.NewParameterCodec(Scheme)
 var localSchemeBuilder = runtime.SchemeBuilder{
 	asv1alpha1.AddToScheme,
+	communityv1alpha1.AddToScheme,
+	esiv1alpha1.AddToScheme,
 	extcommv1alpha1.AddToScheme,
 	infrav1alpha1.AddToScheme,
 	ipamv1alpha1.AddToScheme,
+	labelv1alpha1.AddToScheme,
+	quotav1alpha1.AddToScheme,
+	rdv1alpha1.AddToScheme,
 	vlanv1alpha1.AddToScheme,
 }
 
@@ -75,6 +75,10 @@ func (c *FakeInfraV1alpha1) Partitions(namespace string) v1alpha1.PartitionInter
 	return &FakePartitions{c, namespace}
 }
 
+func (c *FakeInfraV1alpha1) PlatformProfiles(namespace string) v1alpha1.PlatformProfileInterface {
+	return &FakePlatformProfiles{c, namespace}
+}
+
 func (c *FakeInfraV1alpha1) Ports(namespace string) v1alpha1.PortInterface {
 	return &FakePorts{c, namespace}
 }
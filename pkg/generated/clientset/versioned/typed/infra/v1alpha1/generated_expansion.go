@@ -41,6 +41,8 @@ type NodeSetExpansion interface{}
 
 type PartitionExpansion interface{}
 
+type PlatformProfileExpansion interface{}
+
 type PortExpansion interface{}
 
 type RackExpansion interface{}
@@ -39,6 +39,7 @@ type InfraV1alpha1Interface interface {
 	NodeItemsGetter
 	NodeSetsGetter
 	PartitionsGetter
+	PlatformProfilesGetter
 	PortsGetter
 	RacksGetter
 	RegionsGetter
@@ -98,6 +99,10 @@ func (c *InfraV1alpha1Client) Partitions(namespace string) PartitionInterface {
 	return newPartitions(c, namespace)
 }
 
+func (c *InfraV1alpha1Client) PlatformProfiles(namespace string) PlatformProfileInterface {
+	return newPlatformProfiles(c, namespace)
+}
+
 func (c *InfraV1alpha1Client) Ports(namespace string) PortInterface {
 	return newPorts(c, namespace)
 }
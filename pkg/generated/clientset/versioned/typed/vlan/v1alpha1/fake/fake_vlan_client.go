@@ -39,6 +39,10 @@ func (c *FakeVlanV1alpha1) VLANIndexes(namespace string) v1alpha1.VLANIndexInter
 	return &FakeVLANIndexes{c, namespace}
 }
 
+func (c *FakeVlanV1alpha1) VLANIndexTemplates(namespace string) v1alpha1.VLANIndexTemplateInterface {
+	return &FakeVLANIndexTemplates{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeVlanV1alpha1) RESTClient() rest.Interface {
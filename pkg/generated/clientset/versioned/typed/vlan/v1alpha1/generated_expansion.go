@@ -22,3 +22,5 @@ type VLANClaimExpansion interface{}
 type VLANEntryExpansion interface{}
 
 type VLANIndexExpansion interface{}
+
+type VLANIndexTemplateExpansion interface{}
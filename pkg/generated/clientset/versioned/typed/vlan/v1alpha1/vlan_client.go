@@ -30,6 +30,7 @@ type VlanV1alpha1Interface interface {
 	VLANClaimsGetter
 	VLANEntriesGetter
 	VLANIndexesGetter
+	VLANIndexTemplatesGetter
 }
 
 // VlanV1alpha1Client is used to interact with features provided by the vlan.be.kuid.dev group.
@@ -49,6 +50,10 @@ func (c *VlanV1alpha1Client) VLANIndexes(namespace string) VLANIndexInterface {
 	return newVLANIndexes(c, namespace)
 }
 
+func (c *VlanV1alpha1Client) VLANIndexTemplates(namespace string) VLANIndexTemplateInterface {
+	return newVLANIndexTemplates(c, namespace)
+}
+
 // NewForConfig creates a new VlanV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -24,10 +24,15 @@ import (
 
 	versioned "github.com/kuidio/kuid/pkg/generated/clientset/versioned"
 	as "github.com/kuidio/kuid/pkg/generated/informers/externalversions/as"
+	community "github.com/kuidio/kuid/pkg/generated/informers/externalversions/community"
+	esi "github.com/kuidio/kuid/pkg/generated/informers/externalversions/esi"
 	extcomm "github.com/kuidio/kuid/pkg/generated/informers/externalversions/extcomm"
 	infra "github.com/kuidio/kuid/pkg/generated/informers/externalversions/infra"
 	internalinterfaces "github.com/kuidio/kuid/pkg/generated/informers/externalversions/internalinterfaces"
 	ipam "github.com/kuidio/kuid/pkg/generated/informers/externalversions/ipam"
+	label "github.com/kuidio/kuid/pkg/generated/informers/externalversions/label"
+	quota "github.com/kuidio/kuid/pkg/generated/informers/externalversions/quota"
+	rd "github.com/kuidio/kuid/pkg/generated/informers/externalversions/rd"
 	vlan "github.com/kuidio/kuid/pkg/generated/informers/externalversions/vlan"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -258,9 +263,14 @@ type SharedInformerFactory interface {
 	InformerFor(obj runtime.Object, newFunc internalinterfaces.NewInformerFunc) cache.SharedIndexInformer
 
 	As() as.Interface
+	Community() community.Interface
+	Esi() esi.Interface
 	Extcomm() extcomm.Interface
 	Infra() infra.Interface
 	Ipam() ipam.Interface
+	Label() label.Interface
+	Quota() quota.Interface
+	Rd() rd.Interface
 	Vlan() vlan.Interface
 }
 
@@ -268,6 +278,14 @@ func (f *sharedInformerFactory) As() as.Interface {
 	return as.New(f, f.namespace, f.tweakListOptions)
 }
 
+func (f *sharedInformerFactory) Community() community.Interface {
+	return community.New(f, f.namespace, f.tweakListOptions)
+}
+
+func (f *sharedInformerFactory) Esi() esi.Interface {
+	return esi.New(f, f.namespace, f.tweakListOptions)
+}
+
 func (f *sharedInformerFactory) Extcomm() extcomm.Interface {
 	return extcomm.New(f, f.namespace, f.tweakListOptions)
 }
@@ -280,6 +298,18 @@ func (f *sharedInformerFactory) Ipam() ipam.Interface {
 	return ipam.New(f, f.namespace, f.tweakListOptions)
 }
 
+func (f *sharedInformerFactory) Label() label.Interface {
+	return label.New(f, f.namespace, f.tweakListOptions)
+}
+
+func (f *sharedInformerFactory) Quota() quota.Interface {
+	return quota.New(f, f.namespace, f.tweakListOptions)
+}
+
+func (f *sharedInformerFactory) Rd() rd.Interface {
+	return rd.New(f, f.namespace, f.tweakListOptions)
+}
+
 func (f *sharedInformerFactory) Vlan() vlan.Interface {
 	return vlan.New(f, f.namespace, f.tweakListOptions)
 }
@@ -21,8 +21,13 @@ import (
 	"fmt"
 
 	v1alpha1 "github.com/kuidio/kuid/apis/backend/as/v1alpha1"
+	communityv1alpha1 "github.com/kuidio/kuid/apis/backend/community/v1alpha1"
+	esiv1alpha1 "github.com/kuidio/kuid/apis/backend/esi/v1alpha1"
 	extcommv1alpha1 "github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1"
 	ipamv1alpha1 "github.com/kuidio/kuid/apis/backend/ipam/v1alpha1"
+	labelv1alpha1 "github.com/kuidio/kuid/apis/backend/label/v1alpha1"
+	quotav1alpha1 "github.com/kuidio/kuid/apis/backend/quota/v1alpha1"
+	rdv1alpha1 "github.com/kuidio/kuid/apis/backend/rd/v1alpha1"
 	vlanv1alpha1 "github.com/kuidio/kuid/apis/backend/vlan/v1alpha1"
 	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -63,6 +68,22 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v1alpha1.SchemeGroupVersion.WithResource("asindexes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.As().V1alpha1().ASIndexes().Informer()}, nil
 
+		// Group=community.be.kuid.dev, Version=v1alpha1
+	case communityv1alpha1.SchemeGroupVersion.WithResource("communityclaims"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Community().V1alpha1().CommunityClaims().Informer()}, nil
+	case communityv1alpha1.SchemeGroupVersion.WithResource("communityentries"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Community().V1alpha1().CommunityEntries().Informer()}, nil
+	case communityv1alpha1.SchemeGroupVersion.WithResource("communityindexes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Community().V1alpha1().CommunityIndexes().Informer()}, nil
+
+		// Group=esi.be.kuid.dev, Version=v1alpha1
+	case esiv1alpha1.SchemeGroupVersion.WithResource("esiclaims"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Esi().V1alpha1().ESIClaims().Informer()}, nil
+	case esiv1alpha1.SchemeGroupVersion.WithResource("esientries"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Esi().V1alpha1().ESIEntries().Informer()}, nil
+	case esiv1alpha1.SchemeGroupVersion.WithResource("esiindexes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Esi().V1alpha1().ESIIndexes().Informer()}, nil
+
 		// Group=extcomm.be.kuid.dev, Version=v1alpha1
 	case extcommv1alpha1.SchemeGroupVersion.WithResource("extcommclaims"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Extcomm().V1alpha1().EXTCOMMClaims().Informer()}, nil
@@ -96,6 +117,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Infra().V1alpha1().NodeSets().Informer()}, nil
 	case infrav1alpha1.SchemeGroupVersion.WithResource("partitions"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Infra().V1alpha1().Partitions().Informer()}, nil
+	case infrav1alpha1.SchemeGroupVersion.WithResource("platformprofiles"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Infra().V1alpha1().PlatformProfiles().Informer()}, nil
 	case infrav1alpha1.SchemeGroupVersion.WithResource("ports"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Infra().V1alpha1().Ports().Informer()}, nil
 	case infrav1alpha1.SchemeGroupVersion.WithResource("racks"):
@@ -113,6 +136,26 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case ipamv1alpha1.SchemeGroupVersion.WithResource("ipindexes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ipam().V1alpha1().IPIndexes().Informer()}, nil
 
+		// Group=label.be.kuid.dev, Version=v1alpha1
+	case labelv1alpha1.SchemeGroupVersion.WithResource("labelclaims"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Label().V1alpha1().LabelClaims().Informer()}, nil
+	case labelv1alpha1.SchemeGroupVersion.WithResource("labelentries"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Label().V1alpha1().LabelEntries().Informer()}, nil
+	case labelv1alpha1.SchemeGroupVersion.WithResource("labelindexes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Label().V1alpha1().LabelIndexes().Informer()}, nil
+
+		// Group=quota.be.kuid.dev, Version=v1alpha1
+	case quotav1alpha1.SchemeGroupVersion.WithResource("claimquotas"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Quota().V1alpha1().ClaimQuotas().Informer()}, nil
+
+		// Group=rd.be.kuid.dev, Version=v1alpha1
+	case rdv1alpha1.SchemeGroupVersion.WithResource("rdclaims"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Rd().V1alpha1().RDClaims().Informer()}, nil
+	case rdv1alpha1.SchemeGroupVersion.WithResource("rdentries"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Rd().V1alpha1().RDEntries().Informer()}, nil
+	case rdv1alpha1.SchemeGroupVersion.WithResource("rdindexes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Rd().V1alpha1().RDIndexes().Informer()}, nil
+
 		// Group=vlan.be.kuid.dev, Version=v1alpha1
 	case vlanv1alpha1.SchemeGroupVersion.WithResource("vlanclaims"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Vlan().V1alpha1().VLANClaims().Informer()}, nil
@@ -120,6 +163,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Vlan().V1alpha1().VLANEntries().Informer()}, nil
 	case vlanv1alpha1.SchemeGroupVersion.WithResource("vlanindexes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Vlan().V1alpha1().VLANIndexes().Informer()}, nil
+	case vlanv1alpha1.SchemeGroupVersion.WithResource("vlanindextemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Vlan().V1alpha1().VLANIndexTemplates().Informer()}, nil
 
 	}
 
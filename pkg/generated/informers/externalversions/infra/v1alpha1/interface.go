@@ -47,6 +47,8 @@ type Interface interface {
 	NodeSets() NodeSetInformer
 	// Partitions returns a PartitionInformer.
 	Partitions() PartitionInformer
+	// PlatformProfiles returns a PlatformProfileInformer.
+	PlatformProfiles() PlatformProfileInformer
 	// Ports returns a PortInformer.
 	Ports() PortInformer
 	// Racks returns a RackInformer.
@@ -128,6 +130,11 @@ func (v *version) Partitions() PartitionInformer {
 	return &partitionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// PlatformProfiles returns a PlatformProfileInformer.
+func (v *version) PlatformProfiles() PlatformProfileInformer {
+	return &platformProfileInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Ports returns a PortInformer.
 func (v *version) Ports() PortInformer {
 	return &portInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
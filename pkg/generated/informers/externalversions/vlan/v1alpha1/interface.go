@@ -29,6 +29,8 @@ type Interface interface {
 	VLANEntries() VLANEntryInformer
 	// VLANIndexes returns a VLANIndexInformer.
 	VLANIndexes() VLANIndexInformer
+	// VLANIndexTemplates returns a VLANIndexTemplateInformer.
+	VLANIndexTemplates() VLANIndexTemplateInformer
 }
 
 type version struct {
@@ -56,3 +58,8 @@ func (v *version) VLANEntries() VLANEntryInformer {
 func (v *version) VLANIndexes() VLANIndexInformer {
 	return &vLANIndexInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// VLANIndexTemplates returns a VLANIndexTemplateInformer.
+func (v *version) VLANIndexTemplates() VLANIndexTemplateInformer {
+	return &vLANIndexTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
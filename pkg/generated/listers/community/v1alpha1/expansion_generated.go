@@ -0,0 +1,42 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// CommunityClaimListerExpansion allows custom methods to be added to
+// CommunityClaimLister.
+type CommunityClaimListerExpansion interface{}
+
+// CommunityClaimNamespaceListerExpansion allows custom methods to be added to
+// CommunityClaimNamespaceLister.
+type CommunityClaimNamespaceListerExpansion interface{}
+
+// CommunityEntryListerExpansion allows custom methods to be added to
+// CommunityEntryLister.
+type CommunityEntryListerExpansion interface{}
+
+// CommunityEntryNamespaceListerExpansion allows custom methods to be added to
+// CommunityEntryNamespaceLister.
+type CommunityEntryNamespaceListerExpansion interface{}
+
+// CommunityIndexListerExpansion allows custom methods to be added to
+// CommunityIndexLister.
+type CommunityIndexListerExpansion interface{}
+
+// CommunityIndexNamespaceListerExpansion allows custom methods to be added to
+// CommunityIndexNamespaceLister.
+type CommunityIndexNamespaceListerExpansion interface{}
@@ -113,6 +113,14 @@ type PartitionListerExpansion interface{}
 // PartitionNamespaceLister.
 type PartitionNamespaceListerExpansion interface{}
 
+// PlatformProfileListerExpansion allows custom methods to be added to
+// PlatformProfileLister.
+type PlatformProfileListerExpansion interface{}
+
+// PlatformProfileNamespaceListerExpansion allows custom methods to be added to
+// PlatformProfileNamespaceLister.
+type PlatformProfileNamespaceListerExpansion interface{}
+
 // PortListerExpansion allows custom methods to be added to
 // PortLister.
 type PortListerExpansion interface{}
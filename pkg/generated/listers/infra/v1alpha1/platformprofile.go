@@ -0,0 +1,69 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// PlatformProfileLister helps list PlatformProfiles.
+// All objects returned here must be treated as read-only.
+type PlatformProfileLister interface {
+	// List lists all PlatformProfiles in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.PlatformProfile, err error)
+	// PlatformProfiles returns an object that can list and get PlatformProfiles.
+	PlatformProfiles(namespace string) PlatformProfileNamespaceLister
+	PlatformProfileListerExpansion
+}
+
+// platformProfileLister implements the PlatformProfileLister interface.
+type platformProfileLister struct {
+	listers.ResourceIndexer[*v1alpha1.PlatformProfile]
+}
+
+// NewPlatformProfileLister returns a new PlatformProfileLister.
+func NewPlatformProfileLister(indexer cache.Indexer) PlatformProfileLister {
+	return &platformProfileLister{listers.New[*v1alpha1.PlatformProfile](indexer, v1alpha1.Resource("platformprofile"))}
+}
+
+// PlatformProfiles returns an object that can list and get PlatformProfiles.
+func (s *platformProfileLister) PlatformProfiles(namespace string) PlatformProfileNamespaceLister {
+	return platformProfileNamespaceLister{listers.NewNamespaced[*v1alpha1.PlatformProfile](s.ResourceIndexer, namespace)}
+}
+
+// PlatformProfileNamespaceLister helps list and get PlatformProfiles.
+// All objects returned here must be treated as read-only.
+type PlatformProfileNamespaceLister interface {
+	// List lists all PlatformProfiles in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.PlatformProfile, err error)
+	// Get retrieves the PlatformProfile from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.PlatformProfile, error)
+	PlatformProfileNamespaceListerExpansion
+}
+
+// platformProfileNamespaceLister implements the PlatformProfileNamespaceLister
+// interface.
+type platformProfileNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1.PlatformProfile]
+}
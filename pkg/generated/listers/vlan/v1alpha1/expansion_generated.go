@@ -40,3 +40,11 @@ type VLANIndexListerExpansion interface{}
 // VLANIndexNamespaceListerExpansion allows custom methods to be added to
 // VLANIndexNamespaceLister.
 type VLANIndexNamespaceListerExpansion interface{}
+
+// VLANIndexTemplateListerExpansion allows custom methods to be added to
+// VLANIndexTemplateLister.
+type VLANIndexTemplateListerExpansion interface{}
+
+// VLANIndexTemplateNamespaceListerExpansion allows custom methods to be added to
+// VLANIndexTemplateNamespaceLister.
+type VLANIndexTemplateNamespaceListerExpansion interface{}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kuidio/kuid/apis/backend/vlan/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// VLANIndexTemplateLister helps list VLANIndexTemplates.
+// All objects returned here must be treated as read-only.
+type VLANIndexTemplateLister interface {
+	// List lists all VLANIndexTemplates in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.VLANIndexTemplate, err error)
+	// VLANIndexTemplates returns an object that can list and get VLANIndexTemplates.
+	VLANIndexTemplates(namespace string) VLANIndexTemplateNamespaceLister
+	VLANIndexTemplateListerExpansion
+}
+
+// vLANIndexTemplateLister implements the VLANIndexTemplateLister interface.
+type vLANIndexTemplateLister struct {
+	listers.ResourceIndexer[*v1alpha1.VLANIndexTemplate]
+}
+
+// NewVLANIndexTemplateLister returns a new VLANIndexTemplateLister.
+func NewVLANIndexTemplateLister(indexer cache.Indexer) VLANIndexTemplateLister {
+	return &vLANIndexTemplateLister{listers.New[*v1alpha1.VLANIndexTemplate](indexer, v1alpha1.Resource("vlanindextemplate"))}
+}
+
+// VLANIndexTemplates returns an object that can list and get VLANIndexTemplates.
+func (s *vLANIndexTemplateLister) VLANIndexTemplates(namespace string) VLANIndexTemplateNamespaceLister {
+	return vLANIndexTemplateNamespaceLister{listers.NewNamespaced[*v1alpha1.VLANIndexTemplate](s.ResourceIndexer, namespace)}
+}
+
+// VLANIndexTemplateNamespaceLister helps list and get VLANIndexTemplates.
+// All objects returned here must be treated as read-only.
+type VLANIndexTemplateNamespaceLister interface {
+	// List lists all VLANIndexTemplates in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.VLANIndexTemplate, err error)
+	// Get retrieves the VLANIndexTemplate from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.VLANIndexTemplate, error)
+	VLANIndexTemplateNamespaceListerExpansion
+}
+
+// vLANIndexTemplateNamespaceLister implements the VLANIndexTemplateNamespaceLister
+// interface.
+type vLANIndexTemplateNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1.VLANIndexTemplate]
+}
@@ -77,6 +77,32 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kuidio/kuid/apis/backend/as/v1alpha1.ASIndexList":                                       schema_apis_backend_as_v1alpha1_ASIndexList(ref),
 		"github.com/kuidio/kuid/apis/backend/as/v1alpha1.ASIndexSpec":                                       schema_apis_backend_as_v1alpha1_ASIndexSpec(ref),
 		"github.com/kuidio/kuid/apis/backend/as/v1alpha1.ASIndexStatus":                                     schema_apis_backend_as_v1alpha1_ASIndexStatus(ref),
+		"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityClaim":                             schema_apis_backend_community_v1alpha1_CommunityClaim(ref),
+		"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityClaimList":                         schema_apis_backend_community_v1alpha1_CommunityClaimList(ref),
+		"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityClaimSpec":                         schema_apis_backend_community_v1alpha1_CommunityClaimSpec(ref),
+		"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityClaimStatus":                       schema_apis_backend_community_v1alpha1_CommunityClaimStatus(ref),
+		"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityEntry":                             schema_apis_backend_community_v1alpha1_CommunityEntry(ref),
+		"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityEntryList":                         schema_apis_backend_community_v1alpha1_CommunityEntryList(ref),
+		"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityEntrySpec":                         schema_apis_backend_community_v1alpha1_CommunityEntrySpec(ref),
+		"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityEntryStatus":                       schema_apis_backend_community_v1alpha1_CommunityEntryStatus(ref),
+		"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityIndex":                             schema_apis_backend_community_v1alpha1_CommunityIndex(ref),
+		"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityIndexClaim":                        schema_apis_backend_community_v1alpha1_CommunityIndexClaim(ref),
+		"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityIndexList":                         schema_apis_backend_community_v1alpha1_CommunityIndexList(ref),
+		"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityIndexSpec":                         schema_apis_backend_community_v1alpha1_CommunityIndexSpec(ref),
+		"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityIndexStatus":                       schema_apis_backend_community_v1alpha1_CommunityIndexStatus(ref),
+		"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIClaim":                                         schema_apis_backend_esi_v1alpha1_ESIClaim(ref),
+		"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIClaimList":                                     schema_apis_backend_esi_v1alpha1_ESIClaimList(ref),
+		"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIClaimSpec":                                     schema_apis_backend_esi_v1alpha1_ESIClaimSpec(ref),
+		"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIClaimStatus":                                   schema_apis_backend_esi_v1alpha1_ESIClaimStatus(ref),
+		"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIEntry":                                         schema_apis_backend_esi_v1alpha1_ESIEntry(ref),
+		"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIEntryList":                                     schema_apis_backend_esi_v1alpha1_ESIEntryList(ref),
+		"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIEntrySpec":                                     schema_apis_backend_esi_v1alpha1_ESIEntrySpec(ref),
+		"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIEntryStatus":                                   schema_apis_backend_esi_v1alpha1_ESIEntryStatus(ref),
+		"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIIndex":                                         schema_apis_backend_esi_v1alpha1_ESIIndex(ref),
+		"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIIndexClaim":                                    schema_apis_backend_esi_v1alpha1_ESIIndexClaim(ref),
+		"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIIndexList":                                     schema_apis_backend_esi_v1alpha1_ESIIndexList(ref),
+		"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIIndexSpec":                                     schema_apis_backend_esi_v1alpha1_ESIIndexSpec(ref),
+		"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIIndexStatus":                                   schema_apis_backend_esi_v1alpha1_ESIIndexStatus(ref),
 		"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaim":                                 schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaim(ref),
 		"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaimList":                             schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimList(ref),
 		"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaimSpec":                             schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimSpec(ref),
@@ -116,6 +142,38 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndexSpec":                                     schema_apis_backend_ipam_v1alpha1_IPIndexSpec(ref),
 		"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndexStatus":                                   schema_apis_backend_ipam_v1alpha1_IPIndexStatus(ref),
 		"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.Prefix":                                          schema_apis_backend_ipam_v1alpha1_Prefix(ref),
+		"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelClaim":                                     schema_apis_backend_label_v1alpha1_LabelClaim(ref),
+		"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelClaimList":                                 schema_apis_backend_label_v1alpha1_LabelClaimList(ref),
+		"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelClaimSpec":                                 schema_apis_backend_label_v1alpha1_LabelClaimSpec(ref),
+		"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelClaimStatus":                               schema_apis_backend_label_v1alpha1_LabelClaimStatus(ref),
+		"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelEntry":                                     schema_apis_backend_label_v1alpha1_LabelEntry(ref),
+		"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelEntryList":                                 schema_apis_backend_label_v1alpha1_LabelEntryList(ref),
+		"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelEntrySpec":                                 schema_apis_backend_label_v1alpha1_LabelEntrySpec(ref),
+		"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelEntryStatus":                               schema_apis_backend_label_v1alpha1_LabelEntryStatus(ref),
+		"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelIndex":                                     schema_apis_backend_label_v1alpha1_LabelIndex(ref),
+		"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelIndexClaim":                                schema_apis_backend_label_v1alpha1_LabelIndexClaim(ref),
+		"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelIndexList":                                 schema_apis_backend_label_v1alpha1_LabelIndexList(ref),
+		"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelIndexSpec":                                 schema_apis_backend_label_v1alpha1_LabelIndexSpec(ref),
+		"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelIndexStatus":                               schema_apis_backend_label_v1alpha1_LabelIndexStatus(ref),
+		"github.com/kuidio/kuid/apis/backend/quota/v1alpha1.ClaimQuota":                                     schema_apis_backend_quota_v1alpha1_ClaimQuota(ref),
+		"github.com/kuidio/kuid/apis/backend/quota/v1alpha1.ClaimQuotaList":                                 schema_apis_backend_quota_v1alpha1_ClaimQuotaList(ref),
+		"github.com/kuidio/kuid/apis/backend/quota/v1alpha1.ClaimQuotaSpec":                                 schema_apis_backend_quota_v1alpha1_ClaimQuotaSpec(ref),
+		"github.com/kuidio/kuid/apis/backend/quota/v1alpha1.ClaimQuotaStatus":                               schema_apis_backend_quota_v1alpha1_ClaimQuotaStatus(ref),
+		"github.com/kuidio/kuid/apis/backend/quota/v1alpha1.ClaimQuotaUsage":                                schema_apis_backend_quota_v1alpha1_ClaimQuotaUsage(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDAdministratorSource":                             schema_apis_backend_rd_v1alpha1_RDAdministratorSource(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDClaim":                                           schema_apis_backend_rd_v1alpha1_RDClaim(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDClaimList":                                       schema_apis_backend_rd_v1alpha1_RDClaimList(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDClaimSpec":                                       schema_apis_backend_rd_v1alpha1_RDClaimSpec(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDClaimStatus":                                     schema_apis_backend_rd_v1alpha1_RDClaimStatus(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDEntry":                                           schema_apis_backend_rd_v1alpha1_RDEntry(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDEntryList":                                       schema_apis_backend_rd_v1alpha1_RDEntryList(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDEntrySpec":                                       schema_apis_backend_rd_v1alpha1_RDEntrySpec(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDEntryStatus":                                     schema_apis_backend_rd_v1alpha1_RDEntryStatus(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDIndex":                                           schema_apis_backend_rd_v1alpha1_RDIndex(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDIndexClaim":                                      schema_apis_backend_rd_v1alpha1_RDIndexClaim(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDIndexList":                                       schema_apis_backend_rd_v1alpha1_RDIndexList(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDIndexSpec":                                       schema_apis_backend_rd_v1alpha1_RDIndexSpec(ref),
+		"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDIndexStatus":                                     schema_apis_backend_rd_v1alpha1_RDIndexStatus(ref),
 		"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANClaim":                                       schema_apis_backend_vlan_v1alpha1_VLANClaim(ref),
 		"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANClaimList":                                   schema_apis_backend_vlan_v1alpha1_VLANClaimList(ref),
 		"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANClaimSpec":                                   schema_apis_backend_vlan_v1alpha1_VLANClaimSpec(ref),
@@ -129,6 +187,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexList":                                   schema_apis_backend_vlan_v1alpha1_VLANIndexList(ref),
 		"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexSpec":                                   schema_apis_backend_vlan_v1alpha1_VLANIndexSpec(ref),
 		"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexStatus":                                 schema_apis_backend_vlan_v1alpha1_VLANIndexStatus(ref),
+		"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexTemplate":                               schema_apis_backend_vlan_v1alpha1_VLANIndexTemplate(ref),
+		"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexTemplateList":                           schema_apis_backend_vlan_v1alpha1_VLANIndexTemplateList(ref),
+		"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexTemplateSpec":                           schema_apis_backend_vlan_v1alpha1_VLANIndexTemplateSpec(ref),
+		"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexTemplateStatus":                         schema_apis_backend_vlan_v1alpha1_VLANIndexTemplateStatus(ref),
 		"github.com/kuidio/kuid/apis/common/v1alpha1.ClaimLabels":                                           schema_kuid_apis_common_v1alpha1_ClaimLabels(ref),
 		"github.com/kuidio/kuid/apis/common/v1alpha1.UserDefinedLabels":                                     schema_kuid_apis_common_v1alpha1_UserDefinedLabels(ref),
 		"github.com/kuidio/kuid/apis/id/v1alpha1.ClusterID":                                                 schema_kuid_apis_id_v1alpha1_ClusterID(ref),
@@ -147,6 +209,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.AdaptorList":                                            schema_kuid_apis_infra_v1alpha1_AdaptorList(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.AdaptorSpec":                                            schema_kuid_apis_infra_v1alpha1_AdaptorSpec(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.AdaptorStatus":                                          schema_kuid_apis_infra_v1alpha1_AdaptorStatus(ref),
+		"github.com/kuidio/kuid/apis/infra/v1alpha1.BreakoutProfile":                                        schema_kuid_apis_infra_v1alpha1_BreakoutProfile(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.Cluster":                                                schema_kuid_apis_infra_v1alpha1_Cluster(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.ClusterList":                                            schema_kuid_apis_infra_v1alpha1_ClusterList(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.ClusterSpec":                                            schema_kuid_apis_infra_v1alpha1_ClusterSpec(ref),
@@ -171,6 +234,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.Module":                                                 schema_kuid_apis_infra_v1alpha1_Module(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.ModuleBay":                                              schema_kuid_apis_infra_v1alpha1_ModuleBay(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.ModuleBayList":                                          schema_kuid_apis_infra_v1alpha1_ModuleBayList(ref),
+		"github.com/kuidio/kuid/apis/infra/v1alpha1.ModuleBayProfile":                                       schema_kuid_apis_infra_v1alpha1_ModuleBayProfile(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.ModuleBaySpec":                                          schema_kuid_apis_infra_v1alpha1_ModuleBaySpec(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.ModuleBayStatus":                                        schema_kuid_apis_infra_v1alpha1_ModuleBayStatus(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.ModuleList":                                             schema_kuid_apis_infra_v1alpha1_ModuleList(ref),
@@ -192,11 +256,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.PartitionList":                                          schema_kuid_apis_infra_v1alpha1_PartitionList(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.PartitionSpec":                                          schema_kuid_apis_infra_v1alpha1_PartitionSpec(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.PartitionStatus":                                        schema_kuid_apis_infra_v1alpha1_PartitionStatus(ref),
+		"github.com/kuidio/kuid/apis/infra/v1alpha1.PlatformProfile":                                        schema_kuid_apis_infra_v1alpha1_PlatformProfile(ref),
+		"github.com/kuidio/kuid/apis/infra/v1alpha1.PlatformProfileList":                                    schema_kuid_apis_infra_v1alpha1_PlatformProfileList(ref),
+		"github.com/kuidio/kuid/apis/infra/v1alpha1.PlatformProfileSpec":                                    schema_kuid_apis_infra_v1alpha1_PlatformProfileSpec(ref),
+		"github.com/kuidio/kuid/apis/infra/v1alpha1.PlatformProfileStatus":                                  schema_kuid_apis_infra_v1alpha1_PlatformProfileStatus(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.Port":                                                   schema_kuid_apis_infra_v1alpha1_Port(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.PortList":                                               schema_kuid_apis_infra_v1alpha1_PortList(ref),
+		"github.com/kuidio/kuid/apis/infra/v1alpha1.PortProfile":                                            schema_kuid_apis_infra_v1alpha1_PortProfile(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.PortSpec":                                               schema_kuid_apis_infra_v1alpha1_PortSpec(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.PortStatus":                                             schema_kuid_apis_infra_v1alpha1_PortStatus(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.Rack":                                                   schema_kuid_apis_infra_v1alpha1_Rack(ref),
+		"github.com/kuidio/kuid/apis/infra/v1alpha1.RackElevationEntry":                                     schema_kuid_apis_infra_v1alpha1_RackElevationEntry(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.RackList":                                               schema_kuid_apis_infra_v1alpha1_RackList(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.RackSpec":                                               schema_kuid_apis_infra_v1alpha1_RackSpec(ref),
 		"github.com/kuidio/kuid/apis/infra/v1alpha1.RackStatus":                                             schema_kuid_apis_infra_v1alpha1_RackStatus(ref),
@@ -1462,7 +1532,7 @@ func schema_apis_backend_as_v1alpha1_ASClaimSpec(ref common.ReferenceCallback) c
 					},
 					"range": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Range defines the AS range for the AS claim The following notation is used: start-end <start-ASID>-<end-ASID> the ASs in the range must be consecutive",
+							Description: "Range defines the AS range for the AS claim The following notation is used: start-end <start-ASID>-<end-ASID> the ASs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399 The ASs can be provided in asplain (4259840100) or asdot (65000.100) notation",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -1489,6 +1559,13 @@ func schema_apis_backend_as_v1alpha1_ASClaimSpec(ref common.ReferenceCallback) c
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"asn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ASN defines the AS for the AS claim in asplain (4259840100) or asdot (65000.100) notation, as an alternative for the id",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"index"},
 			},
@@ -1540,6 +1617,13 @@ func schema_apis_backend_as_v1alpha1_ASClaimStatus(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"asdot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ASDot defines the claimed AS or AS range in asdot notation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1703,6 +1787,13 @@ func schema_apis_backend_as_v1alpha1_ASEntrySpec(ref common.ReferenceCallback) c
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count defines the number of ids the entry holds when the entry is a contiguous run of ids claimed by a range",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"index", "indexEntry"},
 			},
@@ -1811,7 +1902,7 @@ func schema_apis_backend_as_v1alpha1_ASIndexClaim(ref common.ReferenceCallback)
 					},
 					"range": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive",
+							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399 The ASs can be provided in asplain or asdot notation",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -1832,6 +1923,13 @@ func schema_apis_backend_as_v1alpha1_ASIndexClaim(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"asn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ASN defines the AS for the AS claim in asplain or asdot notation, as an alternative for the id",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -1939,6 +2037,20 @@ func schema_apis_backend_as_v1alpha1_ASIndexSpec(ref common.ReferenceCallback) c
 							},
 						},
 					},
+					"allowReserved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowReserved allows claims on the reserved and documentation ASs (0, 23456, 64496-64511, 65535, 65536-65551 and 4294967295), by default the index reserves them",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"privateOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrivateOnly restricts the dynamic claims without a selector to the private ASs (64512-65534 and 4200000000-4294967294)",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1990,11 +2102,11 @@ func schema_apis_backend_as_v1alpha1_ASIndexStatus(ref common.ReferenceCallback)
 	}
 }
 
-func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_community_v1alpha1_CommunityClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EXTCOMMClaim is the Schema for the EXTCOMMClaim API",
+				Description: "CommunityClaim is the Schema for the CommunityClaim API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2020,28 +2132,28 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaim(ref common.ReferenceCallb
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaimSpec"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityClaimSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaimStatus"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityClaimStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaimSpec", "github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaimStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityClaimSpec", "github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityClaimStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_community_v1alpha1_CommunityClaimList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EXTCOMMClaimList contains a list of EXTCOMMClaims",
+				Description: "CommunityClaimList contains a list of CommunityClaims",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2071,7 +2183,7 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimList(ref common.ReferenceC
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaim"),
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityClaim"),
 									},
 								},
 							},
@@ -2082,20 +2194,20 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimList(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaim", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityClaim", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_community_v1alpha1_CommunityClaimSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EXTCOMMClaimSpec defines the dEXTCOMMred state of EXTCOMMClaim",
+				Description: "CommunityClaimSpec defines the desired state of CommunityClaim",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"index": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EXTCOMMIndex defines the EXTCOMM index for the EXTCOMM Claim",
+							Description: "Index defines the index for the Community Claim",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -2103,14 +2215,14 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimSpec(ref common.ReferenceC
 					},
 					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EXTCOMMID defines the EXTCOMM for the EXTCOMM claim",
+							Description: "ID defines the value of the community, the second local data part for large communities",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 					"range": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Range defines the EXTCOMM range for the EXTCOMM claim The following notation is used: start-end <start-EXTCOMMID>-<end-EXTCOMMID> the EXTCOMMs in the range must be consecutive",
+							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2137,6 +2249,13 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimSpec(ref common.ReferenceC
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"community": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Community defines the community in the textual form as an alternative for the id, <global administrator>:<value> for standard communities, e.g. 65000:100, and <global administrator>:<local data part 1>:<local data part 2> for large communities. The community must be within the global administrator of the index",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"index"},
 			},
@@ -2146,11 +2265,11 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimSpec(ref common.ReferenceC
 	}
 }
 
-func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_community_v1alpha1_CommunityClaimStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EXTCOMMClaimStatus defines the observed state of EXTCOMMClaim",
+				Description: "CommunityClaimStatus defines the observed state of CommunityClaim",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
@@ -2169,14 +2288,14 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimStatus(ref common.Referenc
 					},
 					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EXTCOMMID defines the EXTCOMM for the EXTCOMM claim",
+							Description: "ID defines the ID of the Community claim",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 					"range": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EXTCOMMRange defines the EXTCOMM range for the EXTCOMM claim",
+							Description: "Range defines the ID range of the Community claim",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2188,6 +2307,13 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimStatus(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"community": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Community defines the claimed community or community range in the canonical textual form",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2196,11 +2322,11 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimStatus(ref common.Referenc
 	}
 }
 
-func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_community_v1alpha1_CommunityEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EXTCOMMEntry is the Schema for the EXTCOMMentry API",
+				Description: "CommunityEntry is the Schema for the ASentry API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2226,28 +2352,28 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntry(ref common.ReferenceCallb
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMEntrySpec"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityEntrySpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMEntryStatus"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityEntryStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMEntrySpec", "github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMEntryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityEntrySpec", "github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityEntryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_community_v1alpha1_CommunityEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EXTCOMMEntryList contains a list of EXTCOMMEntries",
+				Description: "CommunityEntryList contains a list of ASEntries",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2277,7 +2403,7 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntryList(ref common.ReferenceC
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMEntry"),
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityEntry"),
 									},
 								},
 							},
@@ -2288,20 +2414,20 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntryList(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMEntry", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityEntry", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_community_v1alpha1_CommunityEntrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EXTCOMMEntrySpec defines the dEXTCOMMred state of EXTCOMMEntry",
+				Description: "CommunityEntrySpec defines the desired state of CommunityEntry",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"index": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EXTCOMMIndex defines the EXTCOMM index for the EXTCOMM Claim",
+							Description: "Index defines the index for the resource",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -2317,14 +2443,14 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntrySpec(ref common.ReferenceC
 					},
 					"claimType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClaimType defines the claimType of the EXTCOMM Entry",
+							Description: "ClaimType defines the claimType of the resource",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID defines the id of the EXTCOMM entry in the tree",
+							Description: "ID defines the id of the resource in the tree",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2351,16 +2477,15 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntrySpec(ref common.ReferenceC
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"claim": {
+					"count": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Claim defines the name of the claim that is the origin of this  entry",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Count defines the number of ids the entry holds when the entry is a contiguous run of ids claimed by a range",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"index", "indexEntry", "claim"},
+				Required: []string{"index", "indexEntry"},
 			},
 		},
 		Dependencies: []string{
@@ -2368,11 +2493,11 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntrySpec(ref common.ReferenceC
 	}
 }
 
-func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_community_v1alpha1_CommunityEntryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EXTCOMMEntryStatus defines the observed state of EXTCOMMEntry",
+				Description: "CommunityEntryStatus defines the observed state of CommunityEntry",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
@@ -2397,11 +2522,11 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntryStatus(ref common.Referenc
 	}
 }
 
-func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndex(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_community_v1alpha1_CommunityIndex(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EXTCOMMIndex is the Schema for the EXTCOMMIndex API",
+				Description: "CommunityIndex is the Schema for the CommunityIndex API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2427,24 +2552,24 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndex(ref common.ReferenceCallb
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndexSpec"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityIndexSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndexStatus"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityIndexStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndexSpec", "github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndexStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityIndexSpec", "github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityIndexStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_community_v1alpha1_CommunityIndexClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -2460,14 +2585,14 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexClaim(ref common.Reference
 					},
 					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EXTCOMMID defines the EXTCOMM for the EXTCOMM claim",
+							Description: "ID defines the value of the community, the second local data part for large communities",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 					"range": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive",
+							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2488,6 +2613,13 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexClaim(ref common.Reference
 							},
 						},
 					},
+					"community": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Community defines the community in the textual form as an alternative for the id",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -2495,11 +2627,11 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexClaim(ref common.Reference
 	}
 }
 
-func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_community_v1alpha1_CommunityIndexList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EXTCOMMIndexList contains a list of EXTCOMMIndexs",
+				Description: "CommunityIndexList contains a list of CommunityIndex",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2529,7 +2661,7 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexList(ref common.ReferenceC
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndex"),
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityIndex"),
 									},
 								},
 							},
@@ -2540,27 +2672,27 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexList(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndex", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityIndex", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_community_v1alpha1_CommunityIndexSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EXTCOMMIndexSpec defines the dEXTCOMMred state of EXTCOMMIndex",
+				Description: "CommunityIndexSpec defines the desired state of CommunityIndex",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"minID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MinID defines the min EXTCOMM ID the index supports",
+							Description: "MinID defines the min ID the index supports",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 					"maxID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxID defines the max EXTCOMM ID the index supports",
+							Description: "MaxID defines the max ID the index supports",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
@@ -2581,76 +2713,69 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexSpec(ref common.ReferenceC
 							},
 						},
 					},
-					"transitive": {
+					"claims": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Transitive defines the transative nature of the extended community",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Claims define the embedded claims in the Index",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityIndexClaim"),
+									},
+								},
+							},
 						},
 					},
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type defines the type of the extended community 2byteAS, 4byteAS, ipv4Address, opaque",
+							Description: "Type defines the type of the communities of the index standard: RFC1997 communities, a 2 byte AS global administrator followed by a 16bit value large: RFC8092 large communities, a 4 byte AS global administrator followed by 2 32bit local data parts",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"subType": {
+					"globalAdministrator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubType defines the subTyoe of the extended community routeTarget, routeOrigin;",
+							Description: "GlobalAdministrator defines the AS the communities of the index are allocated in, asplain or asdot for the large type. The reserved ASs of the well-known communities cannot be used",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"globalID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "GlobalID is interpreted dependeing on the type AS in case of 2byteAS, 4byteAS IPV4 addrress irrelevant for the opaque type",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"claims": {
+					"localDataPart1": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Claims define the embedded claims in the Index",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndexClaim"),
-									},
-								},
-							},
+							Description: "LocalDataPart1 defines the first local data part of the large communities of the index, the claims allocate the second local data part. Required for the large type",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"type", "subType"},
+				Required: []string{"type", "globalAdministrator"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndexClaim"},
+			"github.com/kuidio/kuid/apis/backend/community/v1alpha1.CommunityIndexClaim"},
 	}
 }
 
-func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_community_v1alpha1_CommunityIndexStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EXTCOMMIndexStatus defines the observed state of EXTCOMMIndex",
+				Description: "CommunityIndexStatus defines the observed state of CommunityIndex",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"minID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MinID defines the min EXTCOMM ID the index supports",
+							Description: "MinID defines the min ID the index supports",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 					"maxID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxID defines the max EXTCOMM ID the index supports",
+							Description: "MaxID defines the max ID the index supports",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
@@ -2677,11 +2802,11 @@ func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexStatus(ref common.Referenc
 	}
 }
 
-func schema_apis_backend_genid_v1alpha1_GENIDClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_esi_v1alpha1_ESIClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GENIDClaim is the Schema for the GENIDClaim API",
+				Description: "ESIClaim is the Schema for the ESIClaim API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2707,28 +2832,28 @@ func schema_apis_backend_genid_v1alpha1_GENIDClaim(ref common.ReferenceCallback)
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDClaimSpec"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIClaimSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDClaimStatus"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIClaimStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDClaimSpec", "github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDClaimStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIClaimSpec", "github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIClaimStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_apis_backend_genid_v1alpha1_GENIDClaimList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_esi_v1alpha1_ESIClaimList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GENIDClaimList contains a list of GENIDClaims",
+				Description: "ESIClaimList contains a list of ESIClaims",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2758,7 +2883,7 @@ func schema_apis_backend_genid_v1alpha1_GENIDClaimList(ref common.ReferenceCallb
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDClaim"),
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIClaim"),
 									},
 								},
 							},
@@ -2769,20 +2894,20 @@ func schema_apis_backend_genid_v1alpha1_GENIDClaimList(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDClaim", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIClaim", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_apis_backend_genid_v1alpha1_GENIDClaimSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_esi_v1alpha1_ESIClaimSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GENIDClaimSpec defines the desired state of GENIDClaim",
+				Description: "ESIClaimSpec defines the desired state of ESIClaim",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"index": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Index defines the index for the resource",
+							Description: "Index defines the index for the ESI Claim",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -2790,14 +2915,14 @@ func schema_apis_backend_genid_v1alpha1_GENIDClaimSpec(ref common.ReferenceCallb
 					},
 					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID defines the id of the resource",
+							Description: "ID defines the ID of the ESI, the LACP port key for the lacp type and the local discriminator for the mac type",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 					"range": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Range defines the VLAN range of the resource The following notation is used: start-end <start-VLANID>-<end-VLANID> the VLANs in the range must be consecutive",
+							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2824,6 +2949,13 @@ func schema_apis_backend_genid_v1alpha1_GENIDClaimSpec(ref common.ReferenceCallb
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"esi": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ESI defines the 10 octet ESI in colon-hex notation (00:11:22:33:44:55:00:00:00:01), as an alternative for the id. The ESI must match the type and the prefix or system MAC of the index",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"index"},
 			},
@@ -2833,11 +2965,11 @@ func schema_apis_backend_genid_v1alpha1_GENIDClaimSpec(ref common.ReferenceCallb
 	}
 }
 
-func schema_apis_backend_genid_v1alpha1_GENIDClaimStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_esi_v1alpha1_ESIClaimStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GENIDClaimStatus defines the observed state of GENIDClaim",
+				Description: "ESIClaimStatus defines the observed state of ESIClaim",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
@@ -2856,14 +2988,14 @@ func schema_apis_backend_genid_v1alpha1_GENIDClaimStatus(ref common.ReferenceCal
 					},
 					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID defines the ID assigned to the resource",
+							Description: "ID defines the ID of the ESI claim",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 					"range": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Range defines the range assigned to the resource",
+							Description: "Range defines the ID range of the ESI claim",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2875,6 +3007,13 @@ func schema_apis_backend_genid_v1alpha1_GENIDClaimStatus(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"esi": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ESI defines the claimed ESI or ESI range in colon-hex notation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2883,11 +3022,11 @@ func schema_apis_backend_genid_v1alpha1_GENIDClaimStatus(ref common.ReferenceCal
 	}
 }
 
-func schema_apis_backend_genid_v1alpha1_GENIDEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_esi_v1alpha1_ESIEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GENIDEntry is the Schema for the GENIDEntry API",
+				Description: "ESIEntry is the Schema for the ASentry API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2913,28 +3052,28 @@ func schema_apis_backend_genid_v1alpha1_GENIDEntry(ref common.ReferenceCallback)
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDEntrySpec"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIEntrySpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDEntryStatus"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIEntryStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDEntrySpec", "github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDEntryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIEntrySpec", "github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIEntryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_apis_backend_genid_v1alpha1_GENIDEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_esi_v1alpha1_ESIEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GENIDEntryList contains a list of VLANEntries",
+				Description: "ESIEntryList contains a list of ASEntries",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2964,7 +3103,7 @@ func schema_apis_backend_genid_v1alpha1_GENIDEntryList(ref common.ReferenceCallb
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDEntry"),
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIEntry"),
 									},
 								},
 							},
@@ -2975,15 +3114,15 @@ func schema_apis_backend_genid_v1alpha1_GENIDEntryList(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDEntry", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIEntry", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_apis_backend_genid_v1alpha1_GENIDEntrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_esi_v1alpha1_ESIEntrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GENIDEntrySpec defines the desired state of GENIDEntry",
+				Description: "ESIEntrySpec defines the desired state of ESIEntry",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"index": {
@@ -3038,6 +3177,13 @@ func schema_apis_backend_genid_v1alpha1_GENIDEntrySpec(ref common.ReferenceCallb
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count defines the number of ids the entry holds when the entry is a contiguous run of ids claimed by a range",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"index", "indexEntry"},
 			},
@@ -3047,11 +3193,11 @@ func schema_apis_backend_genid_v1alpha1_GENIDEntrySpec(ref common.ReferenceCallb
 	}
 }
 
-func schema_apis_backend_genid_v1alpha1_GENIDEntryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_esi_v1alpha1_ESIEntryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GENIDEntryStatus defines the observed state of GENIDEntry",
+				Description: "ESIEntryStatus defines the observed state of ESIEntry",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
@@ -3076,11 +3222,11 @@ func schema_apis_backend_genid_v1alpha1_GENIDEntryStatus(ref common.ReferenceCal
 	}
 }
 
-func schema_apis_backend_genid_v1alpha1_GENIDIndex(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_esi_v1alpha1_ESIIndex(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GENIDIndex is the Schema for the GENIDIndex API",
+				Description: "ESIIndex is the Schema for the ESIIndex API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3106,24 +3252,24 @@ func schema_apis_backend_genid_v1alpha1_GENIDIndex(ref common.ReferenceCallback)
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndexSpec"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIIndexSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndexStatus"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIIndexStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndexSpec", "github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndexStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIIndexSpec", "github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIIndexStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_apis_backend_genid_v1alpha1_GENIDIndexClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_esi_v1alpha1_ESIIndexClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -3139,14 +3285,14 @@ func schema_apis_backend_genid_v1alpha1_GENIDIndexClaim(ref common.ReferenceCall
 					},
 					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID defines the id of the resource",
+							Description: "ID defines the ID of the ESI, the LACP port key for the lacp type and the local discriminator for the mac type",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 					"range": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive",
+							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -3167,6 +3313,13 @@ func schema_apis_backend_genid_v1alpha1_GENIDIndexClaim(ref common.ReferenceCall
 							},
 						},
 					},
+					"esi": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ESI defines the 10 octet ESI in colon-hex notation, as an alternative for the id. The ESI must match the type and the prefix or system MAC of the index",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -3174,11 +3327,11 @@ func schema_apis_backend_genid_v1alpha1_GENIDIndexClaim(ref common.ReferenceCall
 	}
 }
 
-func schema_apis_backend_genid_v1alpha1_GENIDIndexList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_esi_v1alpha1_ESIIndexList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GENIDIndexList contains a list of GENIDIndexs",
+				Description: "ESIIndexList contains a list of ESIIndex",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3208,7 +3361,7 @@ func schema_apis_backend_genid_v1alpha1_GENIDIndexList(ref common.ReferenceCallb
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndex"),
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIIndex"),
 									},
 								},
 							},
@@ -3219,15 +3372,15 @@ func schema_apis_backend_genid_v1alpha1_GENIDIndexList(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndex", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIIndex", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_apis_backend_genid_v1alpha1_GENIDIndexSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_esi_v1alpha1_ESIIndexSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GENIDIndexSpec defines the desired state of GENIDIndex",
+				Description: "ESIIndexSpec defines the desired state of ESIIndex",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"minID": {
@@ -3260,13 +3413,6 @@ func schema_apis_backend_genid_v1alpha1_GENIDIndexSpec(ref common.ReferenceCallb
 							},
 						},
 					},
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type defines the type of the GENID 16bit, 32bit, 48bit, 64bit",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"claims": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Claims define the embedded claims in the Index",
@@ -3275,25 +3421,48 @@ func schema_apis_backend_genid_v1alpha1_GENIDIndexSpec(ref common.ReferenceCallb
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndexClaim"),
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIIndexClaim"),
 									},
 								},
 							},
 						},
 					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type defines the type of the ESIs of the index arbitrary: type 0 ESIs, the prefix followed by a 32bit ID lacp: type 1 ESIs, the system MAC followed by the 16bit LACP port key as ID mac: type 3 ESIs, the system MAC followed by the 24bit local discriminator as ID",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix defines the 5 octets following the type octet of the arbitrary ESIs in colon-hex notation, e.g. 00:11:22:33:44. Defaults to 00:00:00:00:00 and only applies to the arbitrary type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"systemMAC": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SystemMAC defines the system MAC address the ESIs are derived from in colon-hex notation, the CE LACP system MAC for the lacp type or the system MAC for the mac type. Required for the lacp and mac type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndexClaim"},
+			"github.com/kuidio/kuid/apis/backend/esi/v1alpha1.ESIIndexClaim"},
 	}
 }
 
-func schema_apis_backend_genid_v1alpha1_GENIDIndexStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_esi_v1alpha1_ESIIndexStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GENIDIndexStatus defines the observed state of GENIDIndex",
+				Description: "ESIIndexStatus defines the observed state of ESIIndex",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"minID": {
@@ -3332,11 +3501,11 @@ func schema_apis_backend_genid_v1alpha1_GENIDIndexStatus(ref common.ReferenceCal
 	}
 }
 
-func schema_apis_backend_ipam_v1alpha1_IPClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPClaim is the Schema for the ipclaim API",
+				Description: "EXTCOMMClaim is the Schema for the EXTCOMMClaim API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3362,28 +3531,28 @@ func schema_apis_backend_ipam_v1alpha1_IPClaim(ref common.ReferenceCallback) com
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPClaimSpec"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaimSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPClaimStatus"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaimStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPClaimSpec", "github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPClaimStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaimSpec", "github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaimStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_apis_backend_ipam_v1alpha1_IPClaimList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPClaimList contains a list of IPClaims",
+				Description: "EXTCOMMClaimList contains a list of EXTCOMMClaims",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3413,7 +3582,7 @@ func schema_apis_backend_ipam_v1alpha1_IPClaimList(ref common.ReferenceCallback)
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPClaim"),
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaim"),
 									},
 								},
 							},
@@ -3424,88 +3593,39 @@ func schema_apis_backend_ipam_v1alpha1_IPClaimList(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPClaim", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMClaim", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_apis_backend_ipam_v1alpha1_IPClaimSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPClaimSpec defines the desired state of IPClaim",
+				Description: "EXTCOMMClaimSpec defines the dEXTCOMMred state of EXTCOMMClaim",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"index": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Index defines the index for the IP Entry",
+							Description: "EXTCOMMIndex defines the EXTCOMM index for the EXTCOMM Claim",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"prefixType": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PrefixType network indicates a special type of prefix for which network and broadcast addresses are claimed in the ipam, used for physical, virtual nics devices If no prefixes type is defined the internally this is defaulted to other",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"prefix": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Prefix defines the prefix for the IP claim",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"address": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Address defines the address for the IP claim",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"range": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Range defines the range for the IP claim",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"defaultGateway": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DefaultGateway defines if the address acts as a default gateway",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"createPrefix": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CreatePrefix defines if this prefix must be created. Only used for dynamic prefixes e.g. non /32 ipv4 and non /128 ipv6 prefixes",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"prefixLength": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PrefixLength defines the prefix length for the IP Claim, Must be set when CreatePrefic is set If not present we use assume /32 for ipv4 and /128 for ipv6",
+							Description: "EXTCOMMID defines the EXTCOMM for the EXTCOMM claim",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
-					"addressFamily": {
+					"range": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AddressFamily defines the address family for the IP claim",
+							Description: "Range defines the EXTCOMM range for the EXTCOMM claim The following notation is used: start-end <start-EXTCOMMID>-<end-EXTCOMMID> the EXTCOMMs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"idx": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Index defines the index of the IP Claim, used to get a deterministic IP from a prefix If not present we claim a random prefix from a prefix",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
 					"labels": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Labels as user defined labels",
@@ -3537,11 +3657,11 @@ func schema_apis_backend_ipam_v1alpha1_IPClaimSpec(ref common.ReferenceCallback)
 	}
 }
 
-func schema_apis_backend_ipam_v1alpha1_IPClaimStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_extcomm_v1alpha1_EXTCOMMClaimStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPClaimStatus defines the observed state of IPClaim",
+				Description: "EXTCOMMClaimStatus defines the observed state of EXTCOMMClaim",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
@@ -3558,30 +3678,16 @@ func schema_apis_backend_ipam_v1alpha1_IPClaimStatus(ref common.ReferenceCallbac
 							},
 						},
 					},
-					"range": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Range defines the range, claimed through the IPAM backend",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"address": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Address defines the address, claimed through the IPAM backend",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"prefix": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Prefix defines the prefix, claimed through the IPAM backend",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "EXTCOMMID defines the EXTCOMM for the EXTCOMM claim",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"defaultGateway": {
+					"range": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DefaultGateway defines the default gateway IP for the claimed prefix DefaultGateway is only relevant for prefix kind = network",
+							Description: "EXTCOMMRange defines the EXTCOMM range for the EXTCOMM claim",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -3601,11 +3707,11 @@ func schema_apis_backend_ipam_v1alpha1_IPClaimStatus(ref common.ReferenceCallbac
 	}
 }
 
-func schema_apis_backend_ipam_v1alpha1_IPEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPEntry is the Schema for the ipentry API",
+				Description: "EXTCOMMEntry is the Schema for the EXTCOMMentry API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3631,28 +3737,28 @@ func schema_apis_backend_ipam_v1alpha1_IPEntry(ref common.ReferenceCallback) com
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPEntrySpec"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMEntrySpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPEntryStatus"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMEntryStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPEntrySpec", "github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPEntryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMEntrySpec", "github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMEntryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_apis_backend_ipam_v1alpha1_IPEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPEntryList contains a list of IPEntries",
+				Description: "EXTCOMMEntryList contains a list of EXTCOMMEntries",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3682,7 +3788,7 @@ func schema_apis_backend_ipam_v1alpha1_IPEntryList(ref common.ReferenceCallback)
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPEntry"),
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMEntry"),
 									},
 								},
 							},
@@ -3693,20 +3799,20 @@ func schema_apis_backend_ipam_v1alpha1_IPEntryList(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPEntry", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMEntry", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_apis_backend_ipam_v1alpha1_IPEntrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPEntrySpec defines the desired state of IPEntry",
+				Description: "EXTCOMMEntrySpec defines the dEXTCOMMred state of EXTCOMMEntry",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"index": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Index defines the index for the IP Entry",
+							Description: "EXTCOMMIndex defines the EXTCOMM index for the EXTCOMM Claim",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -3720,38 +3826,16 @@ func schema_apis_backend_ipam_v1alpha1_IPEntrySpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
-					"prefixType": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PrefixType network indicates a special type of prefix for which network and broadcast addresses are claimed in the ipam, used for physical, virtual nics devices If no prefixes type is defined the internally this is defaulted to other",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"claimType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClaimType defines the claimType of the IP Entry",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"prefix": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Prefix defines the prefix for the IP entry; which can be an expanded prefix from the prefix, range or address",
-							Default:     "",
+							Description: "ClaimType defines the claimType of the EXTCOMM Entry",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"defaultGateway": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DefaultGateway defines if the address acts as a default gateway",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"addressFamily": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AddressFamily defines the address family for the IP claim",
+							Description: "ID defines the id of the EXTCOMM entry in the tree",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -3772,18 +3856,41 @@ func schema_apis_backend_ipam_v1alpha1_IPEntrySpec(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector defines the selector criterias",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"claim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Claim defines the name of the claim that is the origin of this  entry",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count defines the number of ids the entry holds when the entry is a contiguous run of ids claimed by a range",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
-				Required: []string{"index", "indexEntry", "prefix"},
+				Required: []string{"index", "indexEntry", "claim"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_apis_backend_ipam_v1alpha1_IPEntryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_extcomm_v1alpha1_EXTCOMMEntryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPEntryStatus defines the observed state of IPEntry",
+				Description: "EXTCOMMEntryStatus defines the observed state of EXTCOMMEntry",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
@@ -3808,11 +3915,11 @@ func schema_apis_backend_ipam_v1alpha1_IPEntryStatus(ref common.ReferenceCallbac
 	}
 }
 
-func schema_apis_backend_ipam_v1alpha1_IPIndex(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndex(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPIndex is the Schema for the IPIndex API",
+				Description: "EXTCOMMIndex is the Schema for the EXTCOMMIndex API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3838,28 +3945,79 @@ func schema_apis_backend_ipam_v1alpha1_IPIndex(ref common.ReferenceCallback) com
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndexSpec"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndexSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndexStatus"),
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndexStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndexSpec", "github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndexStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndexSpec", "github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndexStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_apis_backend_ipam_v1alpha1_IPIndexList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPIndexList contains a list of IPIndexs",
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Claim",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EXTCOMMID defines the EXTCOMM for the EXTCOMM claim",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EXTCOMMIndexList contains a list of EXTCOMMIndexs",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3889,7 +4047,7 @@ func schema_apis_backend_ipam_v1alpha1_IPIndexList(ref common.ReferenceCallback)
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndex"),
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndex"),
 									},
 								},
 							},
@@ -3900,44 +4058,2939 @@ func schema_apis_backend_ipam_v1alpha1_IPIndexList(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndex", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndex", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_apis_backend_ipam_v1alpha1_IPIndexSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPIndexSpec defines the desired state of IPIndex",
+				Description: "EXTCOMMIndexSpec defines the dEXTCOMMred state of EXTCOMMIndex",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"prefixes": {
+					"minID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Prefixes define the prefixes for the index",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "MinID defines the min EXTCOMM ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxID defines the max EXTCOMM ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"transitive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transitive defines the transative nature of the extended community",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type defines the type of the extended community 2byteAS, 4byteAS, ipv4Address, opaque",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubType defines the subTyoe of the extended community routeTarget, routeOrigin;",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"globalID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GlobalID is interpreted dependeing on the type AS in case of 2byteAS, 4byteAS IPV4 addrress irrelevant for the opaque type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"claims": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Claims define the embedded claims in the Index",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndexClaim"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"type", "subType"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1.EXTCOMMIndexClaim"},
+	}
+}
+
+func schema_apis_backend_extcomm_v1alpha1_EXTCOMMIndexStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EXTCOMMIndexStatus defines the observed state of EXTCOMMIndex",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinID defines the min EXTCOMM ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxID defines the max EXTCOMM ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+	}
+}
+
+func schema_apis_backend_genid_v1alpha1_GENIDClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GENIDClaim is the Schema for the GENIDClaim API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDClaimSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDClaimStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDClaimSpec", "github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDClaimStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_genid_v1alpha1_GENIDClaimList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GENIDClaimList contains a list of GENIDClaims",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDClaim"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDClaim", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_genid_v1alpha1_GENIDClaimSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GENIDClaimSpec defines the desired state of GENIDClaim",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"index": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Index defines the index for the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID defines the id of the resource",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range defines the VLAN range of the resource The following notation is used: start-end <start-VLANID>-<end-VLANID> the VLANs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399 For the mac type the IDs can be provided in colon-hex notation, e.g. 02:00:5e:00:00:00-02:00:5e:00:00:ff",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector defines the selector criterias",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"mac": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MAC defines the MAC address of the resource in colon-hex notation, e.g. 02:00:5e:00:01:01, as an alternative for the id. Only applies to the mac type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"index"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_apis_backend_genid_v1alpha1_GENIDClaimStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GENIDClaimStatus defines the observed state of GENIDClaim",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID defines the ID assigned to the resource",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range defines the range assigned to the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expiryTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiryTime defines when the claim expires",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mac": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MAC defines the claimed MAC address or MAC range in colon-hex notation, only set for the mac type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+	}
+}
+
+func schema_apis_backend_genid_v1alpha1_GENIDEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GENIDEntry is the Schema for the GENIDEntry API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDEntrySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDEntryStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDEntrySpec", "github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDEntryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_genid_v1alpha1_GENIDEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GENIDEntryList contains a list of VLANEntries",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDEntry"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDEntry", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_genid_v1alpha1_GENIDEntrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GENIDEntrySpec defines the desired state of GENIDEntry",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"index": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Index defines the index for the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"indexEntry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IndexEntry identifies if the entry is originated from an IP Index",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"claimType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimType defines the claimType of the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID defines the id of the resource in the tree",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector defines the selector criterias",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count defines the number of ids the entry holds when the entry is a contiguous run of ids claimed by a range",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"index", "indexEntry"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_apis_backend_genid_v1alpha1_GENIDEntryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GENIDEntryStatus defines the observed state of GENIDEntry",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+	}
+}
+
+func schema_apis_backend_genid_v1alpha1_GENIDIndex(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GENIDIndex is the Schema for the GENIDIndex API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndexSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndexStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndexSpec", "github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndexStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_genid_v1alpha1_GENIDIndexClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Claim",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID defines the id of the resource",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399 For the mac type the IDs can be provided in colon-hex notation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"mac": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MAC defines the MAC address of the resource in colon-hex notation, as an alternative for the id. Only applies to the mac type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_apis_backend_genid_v1alpha1_GENIDIndexList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GENIDIndexList contains a list of GENIDIndexs",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndex"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndex", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_genid_v1alpha1_GENIDIndexSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GENIDIndexSpec defines the desired state of GENIDIndex",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinID defines the min ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxID defines the max ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type defines the type of the GENID 16bit, 32bit, 48bit, 64bit, mac",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"claims": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Claims define the embedded claims in the Index",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndexClaim"),
+									},
+								},
+							},
+						},
+					},
+					"oui": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OUI defines the prefix of the MAC addresses in colon-hex notation, e.g. 00:00:5e. The index is restricted to the MAC addresses of the OUI, within the min and max ID. Required for the mac type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"locallyAdministered": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LocallyAdministered sets the locally administered bit in the OUI, e.g. the OUI 00:00:5e results in the MAC addresses 02:00:5e:xx:xx:xx. Only applies to the mac type",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/genid/v1alpha1.GENIDIndexClaim"},
+	}
+}
+
+func schema_apis_backend_genid_v1alpha1_GENIDIndexStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GENIDIndexStatus defines the observed state of GENIDIndex",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinID defines the min ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxID defines the max ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+	}
+}
+
+func schema_apis_backend_ipam_v1alpha1_IPClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPClaim is the Schema for the ipclaim API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPClaimSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPClaimStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPClaimSpec", "github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPClaimStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_ipam_v1alpha1_IPClaimList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPClaimList contains a list of IPClaims",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPClaim"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPClaim", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_ipam_v1alpha1_IPClaimSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPClaimSpec defines the desired state of IPClaim",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"index": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Index defines the index for the IP Entry",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefixType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrefixType network indicates a special type of prefix for which network and broadcast addresses are claimed in the ipam, used for physical, virtual nics devices If no prefixes type is defined the internally this is defaulted to other",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix defines the prefix for the IP claim",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Address defines the address for the IP claim",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range defines the range for the IP claim",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"defaultGateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultGateway defines if the address acts as a default gateway",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"createPrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreatePrefix defines if this prefix must be created. Only used for dynamic prefixes e.g. non /32 ipv4 and non /128 ipv6 prefixes",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"prefixLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrefixLength defines the prefix length for the IP Claim, Must be set when CreatePrefic is set If not present we use assume /32 for ipv4 and /128 for ipv6",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"addressFamily": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddressFamily defines the address family for the IP claim",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"idx": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Index defines the index of the IP Claim, used to get a deterministic IP from a prefix If not present we claim a random prefix from a prefix",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector defines the selector criterias",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"index"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_apis_backend_ipam_v1alpha1_IPClaimStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPClaimStatus defines the observed state of IPClaim",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range defines the range, claimed through the IPAM backend",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Address defines the address, claimed through the IPAM backend",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix defines the prefix, claimed through the IPAM backend",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"defaultGateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultGateway defines the default gateway IP for the claimed prefix DefaultGateway is only relevant for prefix kind = network",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expiryTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiryTime defines when the claim expires",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+	}
+}
+
+func schema_apis_backend_ipam_v1alpha1_IPEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPEntry is the Schema for the ipentry API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPEntrySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPEntryStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPEntrySpec", "github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPEntryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_ipam_v1alpha1_IPEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPEntryList contains a list of IPEntries",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPEntry"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPEntry", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_ipam_v1alpha1_IPEntrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPEntrySpec defines the desired state of IPEntry",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"index": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Index defines the index for the IP Entry",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"indexEntry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IndexEntry identifies if the entry is originated from an IP Index",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"prefixType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrefixType network indicates a special type of prefix for which network and broadcast addresses are claimed in the ipam, used for physical, virtual nics devices If no prefixes type is defined the internally this is defaulted to other",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"claimType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimType defines the claimType of the IP Entry",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix defines the prefix for the IP entry; which can be an expanded prefix from the prefix, range or address",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"defaultGateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultGateway defines if the address acts as a default gateway",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"addressFamily": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddressFamily defines the address family for the IP claim",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"index", "indexEntry", "prefix"},
+			},
+		},
+	}
+}
+
+func schema_apis_backend_ipam_v1alpha1_IPEntryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPEntryStatus defines the observed state of IPEntry",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+	}
+}
+
+func schema_apis_backend_ipam_v1alpha1_IPIndex(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPIndex is the Schema for the IPIndex API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndexSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndexStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndexSpec", "github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndexStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_ipam_v1alpha1_IPIndexList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPIndexList contains a list of IPIndexs",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndex"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.IPIndex", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_ipam_v1alpha1_IPIndexSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPIndexSpec defines the desired state of IPIndex",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"prefixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefixes define the prefixes for the index",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.Prefix"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.Prefix"},
+	}
+}
+
+func schema_apis_backend_ipam_v1alpha1_IPIndexStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPIndexStatus defines the observed state of IPIndex",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"prefixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefixes defines the prefixes, claimed through the IPAM backend",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.Prefix"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition", "github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.Prefix"},
+	}
+}
+
+func schema_apis_backend_ipam_v1alpha1_Prefix(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix defines the ip cidr in prefix notation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefixType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrefixType network indicates a special type of prefix for which network and broadcast addresses are claimed in the ipam, used for physical, virtual nics devices If no prefixes type is defined the internally this is defaulted to other",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"prefix"},
+			},
+		},
+	}
+}
+
+func schema_apis_backend_label_v1alpha1_LabelClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LabelClaim is the Schema for the LabelClaim API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelClaimSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelClaimStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelClaimSpec", "github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelClaimStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_label_v1alpha1_LabelClaimList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LabelClaimList contains a list of LabelClaims",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelClaim"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelClaim", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_label_v1alpha1_LabelClaimSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LabelClaimSpec defines the desired state of LabelClaim",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"index": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Index defines the index for the Label Claim",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID defines the label",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector defines the selector criterias",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"prefixSID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrefixSID allocates a prefix SID within the SRGB of the index, a free SID index is allocated unless the SID index is defined",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"sidIndex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SIDIndex defines the index of the prefix SID within the SRGB of the index, the label is the start of the SRGB plus the SID index",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"index"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_apis_backend_label_v1alpha1_LabelClaimStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LabelClaimStatus defines the observed state of LabelClaim",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID defines the label of the Label claim",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range defines the label range of the Label claim",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expiryTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiryTime defines when the claim expires",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sidIndex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SIDIndex defines the index of the prefix SID within the SRGB of the index",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+	}
+}
+
+func schema_apis_backend_label_v1alpha1_LabelEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LabelEntry is the Schema for the ASentry API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelEntrySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelEntryStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelEntrySpec", "github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelEntryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_label_v1alpha1_LabelEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LabelEntryList contains a list of ASEntries",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelEntry"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelEntry", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_label_v1alpha1_LabelEntrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LabelEntrySpec defines the desired state of LabelEntry",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"index": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Index defines the index for the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"indexEntry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IndexEntry identifies if the entry is originated from an IP Index",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"claimType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimType defines the claimType of the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID defines the id of the resource in the tree",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector defines the selector criterias",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count defines the number of ids the entry holds when the entry is a contiguous run of ids claimed by a range",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"index", "indexEntry"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_apis_backend_label_v1alpha1_LabelEntryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LabelEntryStatus defines the observed state of LabelEntry",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+	}
+}
+
+func schema_apis_backend_label_v1alpha1_LabelIndex(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LabelIndex is the Schema for the LabelIndex API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelIndexSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelIndexStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelIndexSpec", "github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelIndexStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_label_v1alpha1_LabelIndexClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Claim",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID defines the id of the resource",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_apis_backend_label_v1alpha1_LabelIndexList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LabelIndexList contains a list of LabelIndex",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelIndex"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelIndex", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_label_v1alpha1_LabelIndexSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LabelIndexSpec defines the desired state of LabelIndex",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinID defines the min ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxID defines the max ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"claims": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Claims define the embedded claims in the Index",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelIndexClaim"),
+									},
+								},
+							},
+						},
+					},
+					"srgb": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SRGB defines the segment routing global block of the index, <start>-<end>, e.g. 16000-23999. The prefix SIDs are allocated within the SRGB, the label of a prefix SID is the start of the SRGB plus the SID index",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"srlb": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SRLB defines the segment routing local block of the index, <start>-<end>, e.g. 15000-15999. The adjacency SIDs are allocated within the SRLB",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"domain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Domain defines the segment routing domain the SRGB is shared in, the indexes of the nodes in the same domain and namespace must define the same SRGB",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/label/v1alpha1.LabelIndexClaim"},
+	}
+}
+
+func schema_apis_backend_label_v1alpha1_LabelIndexStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LabelIndexStatus defines the observed state of LabelIndex",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinID defines the min ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxID defines the max ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+	}
+}
+
+func schema_apis_backend_quota_v1alpha1_ClaimQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "A ClaimQuota limits the claims of a group in a namespace, optionally restricted to an index. The limits are enforced when a claim is created or updated, before the backend allocates.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/quota/v1alpha1.ClaimQuotaSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/quota/v1alpha1.ClaimQuotaStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/quota/v1alpha1.ClaimQuotaSpec", "github.com/kuidio/kuid/apis/backend/quota/v1alpha1.ClaimQuotaStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_quota_v1alpha1_ClaimQuotaList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClaimQuotaList contains a list of ClaimQuotas",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/quota/v1alpha1.ClaimQuota"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/quota/v1alpha1.ClaimQuota", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_quota_v1alpha1_ClaimQuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClaimQuotaSpec defines the desired state of ClaimQuota",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group defines the api group of the claims the quota applies to, e.g. ipam.be.kuid.dev",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"index": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Index restricts the quota to the claims of the index. When not specified the quota applies to all the claims of the group in the namespace",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"claims": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Claims defines the maximum amount of claims",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"ipv4Addresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPv4Addresses defines the maximum amount of IPv4 addresses the address, prefix and range claims consume together. Only applies to the ipam group.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"minIPv4PrefixLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinIPv4PrefixLength defines the shortest IPv4 prefix that can be claimed, e.g. 24 rejects prefix claims larger than a /24. Only applies to the ipam group.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"minIPv6PrefixLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinIPv6PrefixLength defines the shortest IPv6 prefix that can be claimed, e.g. 64 rejects prefix claims larger than a /64. Only applies to the ipam group.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"ids": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IDs defines the maximum amount of IDs the id and range claims consume together. Does not apply to the ipam group.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"group"},
+			},
+		},
+	}
+}
+
+func schema_apis_backend_quota_v1alpha1_ClaimQuotaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClaimQuotaStatus defines the observed state of ClaimQuota",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used defines the current usage of the claims the quota applies to",
+							Ref:         ref("github.com/kuidio/kuid/apis/backend/quota/v1alpha1.ClaimQuotaUsage"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition", "github.com/kuidio/kuid/apis/backend/quota/v1alpha1.ClaimQuotaUsage"},
+	}
+}
+
+func schema_apis_backend_quota_v1alpha1_ClaimQuotaUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClaimQuotaUsage defines the capacity consumed by the claims",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claims": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Claims defines the amount of claims",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"ipv4Addresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPv4Addresses defines the amount of IPv4 addresses claimed",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"ids": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IDs defines the amount of IDs claimed",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"claims"},
+			},
+		},
+	}
+}
+
+func schema_apis_backend_rd_v1alpha1_RDAdministratorSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RDAdministratorSource defines the claim the administrator of an index is derived from",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPClaim defines the name of the ipam claim of the router ID, the claimed IPv4 address is the administrator of the ipv4Address type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"asClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ASClaim defines the name of the as claim, the claimed AS is the administrator of the 2byteAS and 4byteAS types",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_backend_rd_v1alpha1_RDClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RDClaim is the Schema for the RDClaim API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDClaimSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDClaimStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDClaimSpec", "github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDClaimStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_rd_v1alpha1_RDClaimList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RDClaimList contains a list of RDClaims",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDClaim"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDClaim", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_rd_v1alpha1_RDClaimSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RDClaimSpec defines the desired state of RDClaim",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"index": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Index defines the index for the RD Claim",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID defines the assigned number of the RD",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector defines the selector criterias",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"rd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RD defines the RD in the textual form <administrator>:<assigned number>, e.g. 65000:100, as an alternative for the id. The administrator must be the administrator of the index",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"importRouteTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportRouteTargets defines additional route targets imported by the VRF, the route target of the claimed RD is always imported",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"exportRouteTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportRouteTargets defines additional route targets exported by the VRF, the route target of the claimed RD is always exported",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"index"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_apis_backend_rd_v1alpha1_RDClaimStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RDClaimStatus defines the observed state of RDClaim",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID defines the ID of the RD claim",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range defines the ID range of the RD claim",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expiryTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiryTime defines when the claim expires",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RD defines the claimed RD or RD range in the textual form",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"importRouteTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportRouteTargets defines the route targets imported by the VRF in the textual form target:<administrator>:<assigned number>",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"exportRouteTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportRouteTargets defines the route targets exported by the VRF in the textual form target:<administrator>:<assigned number>",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+	}
+}
+
+func schema_apis_backend_rd_v1alpha1_RDEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RDEntry is the Schema for the ASentry API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDEntrySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDEntryStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDEntrySpec", "github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDEntryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_rd_v1alpha1_RDEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RDEntryList contains a list of ASEntries",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDEntry"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDEntry", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_rd_v1alpha1_RDEntrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RDEntrySpec defines the desired state of RDEntry",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"index": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Index defines the index for the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"indexEntry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IndexEntry identifies if the entry is originated from an IP Index",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"claimType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimType defines the claimType of the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID defines the id of the resource in the tree",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.Prefix"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector defines the selector criterias",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count defines the number of ids the entry holds when the entry is a contiguous run of ids claimed by a range",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
+				Required: []string{"index", "indexEntry"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.Prefix"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_apis_backend_ipam_v1alpha1_IPIndexStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_rd_v1alpha1_RDEntryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPIndexStatus defines the observed state of IPIndex",
+				Description: "RDEntryStatus defines the observed state of RDEntry",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
@@ -3954,69 +7007,296 @@ func schema_apis_backend_ipam_v1alpha1_IPIndexStatus(ref common.ReferenceCallbac
 							},
 						},
 					},
-					"prefixes": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+	}
+}
+
+func schema_apis_backend_rd_v1alpha1_RDIndex(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RDIndex is the Schema for the RDIndex API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Prefixes defines the prefixes, claimed through the IPAM backend",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDIndexSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDIndexStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDIndexSpec", "github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDIndexStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_rd_v1alpha1_RDIndexClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Claim",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID defines the assigned number of the RD",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"rd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RD defines the RD in the textual form <administrator>:<assigned number>, as an alternative for the id. The administrator must be the administrator of the index",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_apis_backend_rd_v1alpha1_RDIndexList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RDIndexList contains a list of RDIndex",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDIndex"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDIndex", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_rd_v1alpha1_RDIndexSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RDIndexSpec defines the desired state of RDIndex",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinID defines the min ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxID defines the max ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"claims": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Claims define the embedded claims in the Index",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.Prefix"),
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDIndexClaim"),
 									},
 								},
 							},
 						},
 					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type defines the type of the RDs and route targets of the index 2byteAS: type 0 RDs, a 2 byte AS administrator followed by a 32bit assigned number ipv4Address: type 1 RDs, an IPv4 address administrator followed by a 16bit assigned number 4byteAS: type 2 RDs, a 4 byte AS administrator followed by a 16bit assigned number",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"administrator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Administrator defines the administrator of the RDs and route targets, an AS for the AS types (asplain or asdot for the 4byteAS type) or an IPv4 address for the ipv4Address type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"administratorFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdministratorFrom derives the administrator from a claim in the namespace of the index, e.g. the router ID of a node, as an alternative for the administrator",
+							Ref:         ref("github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDAdministratorSource"),
+						},
+					},
 				},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition", "github.com/kuidio/kuid/apis/backend/ipam/v1alpha1.Prefix"},
+			"github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDAdministratorSource", "github.com/kuidio/kuid/apis/backend/rd/v1alpha1.RDIndexClaim"},
 	}
 }
 
-func schema_apis_backend_ipam_v1alpha1_Prefix(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_rd_v1alpha1_RDIndexStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "RDIndexStatus defines the observed state of RDIndex",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"prefix": {
+					"minID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Prefix defines the ip cidr in prefix notation.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MinID defines the min ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"prefixType": {
+					"maxID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PrefixType network indicates a special type of prefix for which network and broadcast addresses are claimed in the ipam, used for physical, virtual nics devices If no prefixes type is defined the internally this is defaulted to other",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxID defines the max ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"labels": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels as user defined labels",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
 									},
 								},
 							},
 						},
 					},
+					"administrator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Administrator defines the administrator derived from the administratorFrom claim",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"prefix"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
 	}
 }
 
@@ -4125,7 +7405,7 @@ func schema_apis_backend_vlan_v1alpha1_VLANClaimSpec(ref common.ReferenceCallbac
 				Properties: map[string]spec.Schema{
 					"index": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Index defines the index for the resource",
+							Description: "Index defines the index for the resource, a claim without index claims from the index created by the template in the be.kuid.dev/index-template label for the infra resource in the be.kuid.dev/index-owner-name label or the infra owner reference of the claim",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -4140,7 +7420,7 @@ func schema_apis_backend_vlan_v1alpha1_VLANClaimSpec(ref common.ReferenceCallbac
 					},
 					"range": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Range defines the VLAN range of the resource The following notation is used: start-end <start-VLANID>-<end-VLANID> the VLANs in the range must be consecutive",
+							Description: "Range defines the VLAN range of the resource The following notation is used: start-end <start-VLANID>-<end-VLANID> the VLANs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -4167,8 +7447,14 @@ func schema_apis_backend_vlan_v1alpha1_VLANClaimSpec(ref common.ReferenceCallbac
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"outerID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OuterID defines the outer (S-VLAN) tag of a claim in a QinQ index, the ID and Range define the inner (C-VLAN) tags scoped to the outer tag. A dynamic claim without outer ID in a QinQ index allocates an outer and inner tag pair.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
-				Required: []string{"index"},
 			},
 		},
 		Dependencies: []string{
@@ -4218,6 +7504,13 @@ func schema_apis_backend_vlan_v1alpha1_VLANClaimStatus(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"outerID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OuterID defines the outer (S-VLAN) tag of the QinQ claim, ID defines the inner tag",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
@@ -4381,6 +7674,13 @@ func schema_apis_backend_vlan_v1alpha1_VLANEntrySpec(ref common.ReferenceCallbac
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count defines the number of ids the entry holds when the entry is a contiguous run of ids claimed by a range",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"index", "indexEntry"},
 			},
@@ -4489,7 +7789,7 @@ func schema_apis_backend_vlan_v1alpha1_VLANIndexClaim(ref common.ReferenceCallba
 					},
 					"range": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive",
+							Description: "Range defines the range of the resource The following notation is used: start-end <start-ID>-<end-ID> the IDs in the range must be consecutive multiple segments are separated by a comma, e.g. 100-199,300-399",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -4510,6 +7810,13 @@ func schema_apis_backend_vlan_v1alpha1_VLANIndexClaim(ref common.ReferenceCallba
 							},
 						},
 					},
+					"outerID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OuterID defines the outer (S-VLAN) tag of the claim in a QinQ index",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -4603,49 +7910,247 @@ func schema_apis_backend_vlan_v1alpha1_VLANIndexSpec(ref common.ReferenceCallbac
 							},
 						},
 					},
-					"claims": {
+					"claims": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Claims define the embedded claims in the Index",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexClaim"),
+									},
+								},
+							},
+						},
+					},
+					"reserveProtocolVLANs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReserveProtocolVLANs reserves the VLANs 0 and 4095 as claims owned by the index, they cannot be claimed",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"reserveDefaultVLAN": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReserveDefaultVLAN reserves the default VLAN 1 as a claim owned by the index",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"reservedRanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReservedRanges reserves vendor specific VLAN ranges as claims owned by the index, e.g. 1002-1005. The following notation is used: start-end <start-ID>-<end-ID>",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"qinq": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QinQ enables 802.1ad double tagging, the claims allocate an outer (S-VLAN) and inner (C-VLAN) tag pair and the inner tags are scoped per outer tag. The min and max ID, the reserved VLANs and the ranges without an outer ID apply to the outer tags. QinQ cannot be changed once the index is created.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexClaim"},
+	}
+}
+
+func schema_apis_backend_vlan_v1alpha1_VLANIndexStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VLANIndexStatus defines the observed state of VLANIndex",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinID defines the min VLAN ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxID defines the max VLAN ID the index supports",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+	}
+}
+
+func schema_apis_backend_vlan_v1alpha1_VLANIndexTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "A VLANIndexTemplate creates a VLANIndex for every infra Node, Endpoint or EndpointSet matching the selector, as VLAN IDs are locally significant per node or port. The index is named <owner>.<template>, is labeled with the owner and the template and is garbage collected with its owner.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexTemplateSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexTemplateStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexTemplateSpec", "github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexTemplateStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_backend_vlan_v1alpha1_VLANIndexTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VLANIndexTemplateList contains a list of VLANIndexTemplates",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexTemplate"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexTemplate", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_backend_vlan_v1alpha1_VLANIndexTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VLANIndexTemplateSpec defines the desired state of VLANIndexTemplate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind defines the kind of the infra resources the indexes are created for: Node, Endpoint or EndpointSet",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the infra resources of the kind in the namespace of the template by their labels. When not specified an index is created for every resource of the kind",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"index": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Claims define the embedded claims in the Index",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexClaim"),
-									},
-								},
-							},
+							Description: "Index defines the spec of the indexes created from the template, e.g. the min and max ID, the reserved VLANs and the embedded claims",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexSpec"),
 						},
 					},
 				},
+				Required: []string{"kind", "index"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexClaim"},
+			"github.com/kuidio/kuid/apis/backend/vlan/v1alpha1.VLANIndexSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_apis_backend_vlan_v1alpha1_VLANIndexStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_backend_vlan_v1alpha1_VLANIndexTemplateStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VLANIndexStatus defines the observed state of VLANIndex",
+				Description: "VLANIndexTemplateStatus defines the observed state of VLANIndexTemplate",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"minID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MinID defines the min VLAN ID the index supports",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"maxID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MaxID defines the max VLAN ID the index supports",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Conditions of the resource.",
@@ -4660,6 +8165,13 @@ func schema_apis_backend_vlan_v1alpha1_VLANIndexStatus(ref common.ReferenceCallb
 							},
 						},
 					},
+					"indexes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indexes defines the amount of indexes created from the template",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
@@ -5681,6 +9193,43 @@ func schema_kuid_apis_infra_v1alpha1_AdaptorStatus(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kuid_apis_infra_v1alpha1_BreakoutProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BreakoutProfile describes a breakout adaptor splitting a port in multiple endpoints",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"adaptor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Adaptor defines the name of the adaptor",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpoints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoints defines the amount of endpoints the adaptor provides",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"speed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Speed defines the speed of the breakout endpoints (Gbps), overrides the speed of the port",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"adaptor", "endpoints"},
+			},
+		},
+	}
+}
+
 func schema_kuid_apis_infra_v1alpha1_Cluster(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6548,6 +10097,13 @@ func schema_kuid_apis_infra_v1alpha1_LinkSetSpec(ref common.ReferenceCallback) c
 							},
 						},
 					},
+					"maxNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxNodes defines the maximum number of nodes the endpoints of the LinkSet can span. When not specified the LinkSet can span 2 nodes",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"endpoints"},
 			},
@@ -6875,6 +10431,51 @@ func schema_kuid_apis_infra_v1alpha1_ModuleBayList(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kuid_apis_infra_v1alpha1_ModuleBayProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ModuleBayProfile describes a module bay of the platform and the module deployed in it",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"moduleBay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ModuleBay defines the id of the moduleBay",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"module": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Module defines the id of the module deployed in the moduleBay. When not specified the ports are attached to the moduleBay directly",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports define the ports provided by the module",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/infra/v1alpha1.PortProfile"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"moduleBay"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/infra/v1alpha1.PortProfile"},
+	}
+}
+
 func schema_kuid_apis_infra_v1alpha1_ModuleBaySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7614,81 +11215,251 @@ func schema_kuid_apis_infra_v1alpha1_NodeSpec(ref common.ReferenceCallback) comm
 				Description: "NodeSpec defines the desired state of Node",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"partition": {
+					"partition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Partition defines the partition this resource belongs to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region defines the region of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"site": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Site defines the site of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node defines the name of the node",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rack": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rack defines the rack in which the node is deployed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"position": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Position defines the lowest rack unit (U) the node occupies in the rack, e.g. 10 or U10",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"location": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Location defines the location information where this resource is located in lon/lat coordinates",
+							Ref:         ref("github.com/kuidio/kuid/apis/infra/v1alpha1.Location"),
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider defines the provider implementing this resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"platformType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlatformType define the type of platform implementing the nodespec",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version define the SW version of the node",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels as user defined labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"units": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Units defines the number of rack units (U) the node occupies in the rack, defaults to 1",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"partition", "region", "site", "node", "provider", "platformType"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/infra/v1alpha1.Location"},
+	}
+}
+
+func schema_kuid_apis_infra_v1alpha1_NodeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeStatus defines the observed state of Node",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"systemID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "System ID define the unique system id of the node",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+	}
+}
+
+func schema_kuid_apis_infra_v1alpha1_Partition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Partition defines the partition this resource belongs to",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"region": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Region defines the region of the resource",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"site": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Site defines the site of the resource",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"node": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Node defines the name of the node",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/infra/v1alpha1.PartitionSpec"),
 						},
 					},
-					"rack": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Rack defines the rack in which the node is deployed",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kuidio/kuid/apis/infra/v1alpha1.PartitionStatus"),
 						},
 					},
-					"position": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/infra/v1alpha1.PartitionSpec", "github.com/kuidio/kuid/apis/infra/v1alpha1.PartitionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_kuid_apis_infra_v1alpha1_PartitionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PartitionList contains a list of Partitions",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "relative position in the rack",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"location": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Location defines the location information where this resource is located in lon/lat coordinates",
-							Ref:         ref("github.com/kuidio/kuid/apis/infra/v1alpha1.Location"),
-						},
-					},
-					"provider": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Provider defines the provider implementing this resource.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"platformType": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PlatformType define the type of platform implementing the nodespec",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"version": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version define the SW version of the node",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/infra/v1alpha1.Partition"),
+									},
+								},
+							},
 						},
 					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/infra/v1alpha1.Partition", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_kuid_apis_infra_v1alpha1_PartitionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PartitionSpec defines the desired state of the partition",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
 					"labels": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Labels as user defined labels",
@@ -7706,19 +11477,16 @@ func schema_kuid_apis_infra_v1alpha1_NodeSpec(ref common.ReferenceCallback) comm
 						},
 					},
 				},
-				Required: []string{"partition", "region", "site", "node", "provider", "platformType"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/infra/v1alpha1.Location"},
 	}
 }
 
-func schema_kuid_apis_infra_v1alpha1_NodeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kuid_apis_infra_v1alpha1_PartitionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NodeStatus defines the observed state of Node",
+				Description: "PartitionStatus defines the observed state of Partition",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
@@ -7735,13 +11503,6 @@ func schema_kuid_apis_infra_v1alpha1_NodeStatus(ref common.ReferenceCallback) co
 							},
 						},
 					},
-					"systemID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "System ID define the unique system id of the node",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
 			},
 		},
@@ -7750,11 +11511,12 @@ func schema_kuid_apis_infra_v1alpha1_NodeStatus(ref common.ReferenceCallback) co
 	}
 }
 
-func schema_kuid_apis_infra_v1alpha1_Partition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kuid_apis_infra_v1alpha1_PlatformProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "A PlatformProfile describes a device model: its module bays, the ports per module, the breakout adaptors and the default speeds. Every Node referencing the provider and platformType of the profile is expanded in the ModuleBays, Modules, Ports, Adaptors and Endpoints the profile describes.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -7779,28 +11541,28 @@ func schema_kuid_apis_infra_v1alpha1_Partition(ref common.ReferenceCallback) com
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/infra/v1alpha1.PartitionSpec"),
+							Ref:     ref("github.com/kuidio/kuid/apis/infra/v1alpha1.PlatformProfileSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kuidio/kuid/apis/infra/v1alpha1.PartitionStatus"),
+							Ref:     ref("github.com/kuidio/kuid/apis/infra/v1alpha1.PlatformProfileStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/infra/v1alpha1.PartitionSpec", "github.com/kuidio/kuid/apis/infra/v1alpha1.PartitionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kuidio/kuid/apis/infra/v1alpha1.PlatformProfileSpec", "github.com/kuidio/kuid/apis/infra/v1alpha1.PlatformProfileStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_kuid_apis_infra_v1alpha1_PartitionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kuid_apis_infra_v1alpha1_PlatformProfileList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PartitionList contains a list of Partitions",
+				Description: "PlatformProfileList contains a list of PlatformProfiles",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7830,7 +11592,7 @@ func schema_kuid_apis_infra_v1alpha1_PartitionList(ref common.ReferenceCallback)
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kuidio/kuid/apis/infra/v1alpha1.Partition"),
+										Ref:     ref("github.com/kuidio/kuid/apis/infra/v1alpha1.PlatformProfile"),
 									},
 								},
 							},
@@ -7841,17 +11603,61 @@ func schema_kuid_apis_infra_v1alpha1_PartitionList(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/kuidio/kuid/apis/infra/v1alpha1.Partition", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kuidio/kuid/apis/infra/v1alpha1.PlatformProfile", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_kuid_apis_infra_v1alpha1_PartitionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kuid_apis_infra_v1alpha1_PlatformProfileSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PartitionSpec defines the desired state of the partition",
+				Description: "PlatformProfileSpec defines the desired state of PlatformProfile",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider defines the provider implementing the platform.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"platformType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlatformType defines the type of platform this profile describes. Nodes with a matching provider and platformType are expanded using this profile.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports define the fixed ports of the platform, which are not part of a module",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/infra/v1alpha1.PortProfile"),
+									},
+								},
+							},
+						},
+					},
+					"moduleBays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ModuleBays define the module bays of the platform",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/infra/v1alpha1.ModuleBayProfile"),
+									},
+								},
+							},
+						},
+					},
 					"labels": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Labels as user defined labels",
@@ -7869,16 +11675,19 @@ func schema_kuid_apis_infra_v1alpha1_PartitionSpec(ref common.ReferenceCallback)
 						},
 					},
 				},
+				Required: []string{"provider", "platformType"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/infra/v1alpha1.ModuleBayProfile", "github.com/kuidio/kuid/apis/infra/v1alpha1.PortProfile"},
 	}
 }
 
-func schema_kuid_apis_infra_v1alpha1_PartitionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kuid_apis_infra_v1alpha1_PlatformProfileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PartitionStatus defines the observed state of Partition",
+				Description: "PlatformProfileStatus defines the observed state of PlatformProfile",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
@@ -7999,6 +11808,57 @@ func schema_kuid_apis_infra_v1alpha1_PortList(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_kuid_apis_infra_v1alpha1_PortProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PortProfile describes a range of identical ports",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start defines the id of the first port in the range, defaults to 1",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count defines the amount of ports in the range",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"speed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Speed defines the default speed of the endpoints of the port (Gbps)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vlanTagging": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VLANTagging defines if VLAN tagging is enabled on the endpoints of the port",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"breakout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Breakout defines the breakout adaptor inserted in every port of the range. When not specified every port provides a single endpoint",
+							Ref:         ref("github.com/kuidio/kuid/apis/infra/v1alpha1.BreakoutProfile"),
+						},
+					},
+				},
+				Required: []string{"count"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kuidio/kuid/apis/infra/v1alpha1.BreakoutProfile"},
+	}
+}
+
 func schema_kuid_apis_infra_v1alpha1_PortSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8159,6 +12019,43 @@ func schema_kuid_apis_infra_v1alpha1_Rack(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_kuid_apis_infra_v1alpha1_RackElevationEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RackElevationEntry defines a range of rack units in the rack",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"position": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Position defines the lowest rack unit of the range, starting from 1 at the bottom of the rack",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"units": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Units defines the number of rack units of the range",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node defines the node occupying the range, empty when the range is free",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"position", "units"},
+			},
+		},
+	}
+}
+
 func schema_kuid_apis_infra_v1alpha1_RackList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8239,7 +12136,7 @@ func schema_kuid_apis_infra_v1alpha1_RackSpec(ref common.ReferenceCallback) comm
 					},
 					"height": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The height of the rack, measured in units.",
+							Description: "The height of the rack, measured in rack units (U), e.g. 42 or 42U",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -8297,11 +12194,39 @@ func schema_kuid_apis_infra_v1alpha1_RackStatus(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"usedUnits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsedUnits defines the number of rack units occupied by nodes",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"freeUnits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FreeUnits defines the number of rack units not occupied by nodes",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"elevation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Elevation defines the occupied and free rack unit ranges of the rack, ordered from the bottom to the top of the rack",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kuidio/kuid/apis/infra/v1alpha1.RackElevationEntry"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition"},
+			"github.com/kform-dev/choreo/apis/condition/v1alpha1.Condition", "github.com/kuidio/kuid/apis/infra/v1alpha1.RackElevationEntry"},
 	}
 }
 
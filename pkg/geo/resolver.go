@@ -0,0 +1,101 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geo
+
+import (
+	"fmt"
+
+	"github.com/kuidio/kuid/apis/id"
+	"github.com/kuidio/kuid/apis/infra"
+)
+
+// Source indicates where the effective location of a resource originates from
+type Source string
+
+const (
+	SourceSelf Source = "self"
+	SourceRack Source = "rack"
+	SourceSite Source = "site"
+)
+
+// Resolver resolves the effective location of infra resources.
+// A resource without a location inherits the location of its parent:
+// a node inherits the location of its rack, a rack, node or cluster
+// inherits the location of its site.
+type Resolver struct {
+	sites map[string]*infra.Location
+	racks map[string]*infra.Rack
+}
+
+func NewResolver(sites []*infra.Site, racks []*infra.Rack) *Resolver {
+	r := &Resolver{
+		sites: make(map[string]*infra.Location, len(sites)),
+		racks: make(map[string]*infra.Rack, len(racks)),
+	}
+	for _, site := range sites {
+		if site.Spec.Location != nil {
+			r.sites[siteKey(site.Spec.SiteID)] = site.Spec.Location
+		}
+	}
+	for _, rack := range racks {
+		r.racks[rack.Name] = rack
+	}
+	return r
+}
+
+// Location returns the effective location of the object and where it originates from,
+// nil is returned when no location is found
+func (r *Resolver) Location(obj any) (*infra.Location, Source) {
+	switch obj := obj.(type) {
+	case *infra.Site:
+		if obj.Spec.Location != nil {
+			return obj.Spec.Location, SourceSelf
+		}
+	case *infra.Rack:
+		if obj.Spec.Location != nil {
+			return obj.Spec.Location, SourceSelf
+		}
+		return r.siteLocation(obj.Spec.SiteID)
+	case *infra.Node:
+		if obj.Spec.Location != nil {
+			return obj.Spec.Location, SourceSelf
+		}
+		if obj.Spec.Rack != nil {
+			if rack, ok := r.racks[*obj.Spec.Rack]; ok && rack.Spec.Location != nil {
+				return rack.Spec.Location, SourceRack
+			}
+		}
+		return r.siteLocation(obj.Spec.SiteID)
+	case *infra.Cluster:
+		if obj.Spec.Location != nil {
+			return obj.Spec.Location, SourceSelf
+		}
+		return r.siteLocation(obj.Spec.SiteID)
+	}
+	return nil, ""
+}
+
+func (r *Resolver) siteLocation(siteID id.SiteID) (*infra.Location, Source) {
+	if loc, ok := r.sites[siteKey(siteID)]; ok {
+		return loc, SourceSite
+	}
+	return nil, ""
+}
+
+func siteKey(siteID id.SiteID) string {
+	return fmt.Sprintf("%s.%s", siteID.Region, siteID.Site)
+}
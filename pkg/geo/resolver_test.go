@@ -0,0 +1,182 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kuidio/kuid/apis/id"
+	"github.com/kuidio/kuid/apis/infra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+var (
+	antwerp  = &infra.Location{Latitude: "51.2194", Longitude: "4.4025"}
+	brussels = &infra.Location{Latitude: "50.8503", Longitude: "4.3517"}
+	paris    = &infra.Location{Latitude: "48.8566", Longitude: "2.3522"}
+	siteID   = id.SiteID{Region: "eu", Site: "antwerp"}
+)
+
+func testResolver() *Resolver {
+	return NewResolver(
+		[]*infra.Site{{
+			ObjectMeta: metav1.ObjectMeta{Name: "antwerp"},
+			Spec:       infra.SiteSpec{SiteID: siteID, Location: antwerp},
+		}},
+		[]*infra.Rack{
+			{ObjectMeta: metav1.ObjectMeta{Name: "rack1"}, Spec: infra.RackSpec{SiteID: siteID, Location: brussels}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "rack2"}, Spec: infra.RackSpec{SiteID: siteID}},
+		},
+	)
+}
+
+func testNode(name string, rack *string, loc *infra.Location, site id.SiteID) *infra.Node {
+	return &infra.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: infra.NodeSpec{
+			PartitionNodeID: id.PartitionNodeID{Partition: "dc1", SiteID: site, Node: name},
+			Rack:            rack,
+			Location:        loc,
+		},
+	}
+}
+
+func TestLocation(t *testing.T) {
+	cases := map[string]struct {
+		obj        any
+		wantLoc    *infra.Location
+		wantSource Source
+	}{
+		"NodeSelf": {
+			obj:        testNode("n1", ptr.To("rack1"), paris, siteID),
+			wantLoc:    paris,
+			wantSource: SourceSelf,
+		},
+		"NodeFromRack": {
+			obj:        testNode("n1", ptr.To("rack1"), nil, siteID),
+			wantLoc:    brussels,
+			wantSource: SourceRack,
+		},
+		"NodeFromSite": {
+			// rack2 has no location, the node inherits the site location
+			obj:        testNode("n1", ptr.To("rack2"), nil, siteID),
+			wantLoc:    antwerp,
+			wantSource: SourceSite,
+		},
+		"NodeUnknownSite": {
+			obj: testNode("n1", nil, nil, id.SiteID{Region: "eu", Site: "unknown"}),
+		},
+		"RackFromSite": {
+			obj:        &infra.Rack{Spec: infra.RackSpec{SiteID: siteID}},
+			wantLoc:    antwerp,
+			wantSource: SourceSite,
+		},
+		"ClusterFromSite": {
+			obj: &infra.Cluster{Spec: infra.ClusterSpec{
+				PartitionClusterID: id.PartitionClusterID{SiteID: siteID},
+			}},
+			wantLoc:    antwerp,
+			wantSource: SourceSite,
+		},
+		"Unsupported": {
+			obj: &infra.Link{},
+		},
+	}
+
+	r := testResolver()
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			loc, source := r.Location(tc.obj)
+			if diff := cmp.Diff(tc.wantLoc, loc); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if source != tc.wantSource {
+				t.Errorf("want source %q, got %q", tc.wantSource, source)
+			}
+		})
+	}
+}
+
+func TestNearby(t *testing.T) {
+	objs := []runtime.Object{
+		testNode("origin", nil, antwerp, siteID),
+		testNode("paris", nil, paris, siteID),
+		testNode("brussels", ptr.To("rack1"), nil, siteID),
+		testNode("antwerp", ptr.To("rack2"), nil, siteID),
+		testNode("invalid", nil, &infra.Location{Latitude: "x", Longitude: "0"}, siteID),
+		testNode("unknown", nil, nil, id.SiteID{Region: "eu", Site: "unknown"}),
+	}
+
+	cases := map[string]struct {
+		radius        *float64
+		limit         int
+		wantNames     []string
+		wantUnlocated []string
+	}{
+		"All": {
+			wantNames:     []string{"antwerp", "brussels", "paris"},
+			wantUnlocated: []string{"invalid", "unknown"},
+		},
+		"Radius": {
+			radius:        ptr.To(100.0),
+			wantNames:     []string{"antwerp", "brussels"},
+			wantUnlocated: []string{"invalid", "unknown"},
+		},
+		"Limit": {
+			limit:         1,
+			wantNames:     []string{"antwerp"},
+			wantUnlocated: []string{"invalid", "unknown"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			items, unlocated, err := Nearby(testResolver(), antwerp, objs, "origin", tc.radius, tc.limit)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			names := []string{}
+			for _, item := range items {
+				names = append(names, item.Name)
+			}
+			if diff := cmp.Diff(tc.wantNames, names); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantUnlocated, unlocated); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDistanceKm(t *testing.T) {
+	d, err := antwerp.DistanceKm(brussels)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// antwerp - brussels is about 41 km
+	if math.Abs(d-41) > 1 {
+		t.Errorf("unexpected distance antwerp - brussels: %f", d)
+	}
+	if _, err := antwerp.DistanceKm(&infra.Location{Latitude: "91", Longitude: "0"}); err == nil {
+		t.Errorf("expected an error for an out of range latitude")
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/kuidio/kuid/apis/infra"
+	"github.com/kuidio/kuid/pkg/topology"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+const SubResourceName = "nearby"
+
+var _ rest.Storage = &REST{}
+var _ rest.Connecter = &REST{}
+
+// REST implements the read-only nearby subresource of sites, racks, nodes and clusters.
+// It returns the resources sorted by distance from the (inherited) location of the named resource.
+//
+// GET .../<resource>/<name>/nearby[?resource=sites|racks|nodes|clusters][&radiusKm=x][&limit=n]
+// GET .../<resource>/<name>/nearby?latitude=x&longitude=y[&resource=...][&radiusKm=x][&limit=n]
+//
+// The resource defaults to the resource of the named object. When latitude and longitude
+// are provided, the distance is calculated from this point instead.
+type REST struct {
+	storage  *topology.Storage
+	store    rest.Getter
+	resource string
+	newFn    func() runtime.Object
+}
+
+func NewREST(storage *topology.Storage, store rest.Storage, resource string, newFn func() runtime.Object) (*REST, error) {
+	getter, ok := store.(rest.Getter)
+	if !ok {
+		return nil, fmt.Errorf("%s store does not implement rest.Getter", resource)
+	}
+	return &REST{
+		storage:  storage,
+		store:    getter,
+		resource: resource,
+		newFn:    newFn,
+	}, nil
+}
+
+// New implements rest.Storage
+func (r *REST) New() runtime.Object {
+	return r.newFn()
+}
+
+// Destroy implements rest.Storage
+func (r *REST) Destroy() {}
+
+// ConnectMethods implements rest.Connecter
+func (r *REST) ConnectMethods() []string {
+	return []string{http.MethodGet}
+}
+
+// NewConnectOptions implements rest.Connecter, the query parameters are parsed from the request
+func (r *REST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect implements rest.Connecter
+func (r *REST) Connect(ctx context.Context, name string, _ runtime.Object, responder rest.Responder) (http.Handler, error) {
+	obj, err := r.store.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	sites, err := topology.List[*infra.Site](ctx, r.storage, infra.SitePlural)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	racks, err := topology.List[*infra.Rack](ctx, r.storage, infra.RackPlural)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	resolver := NewResolver(sites, racks)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		params := req.URL.Query()
+		resource := params.Get("resource")
+		if resource == "" {
+			resource = r.resource
+		}
+		objs, err := r.list(ctx, resource)
+		if err != nil {
+			responder.Error(err)
+			return
+		}
+		result, err := Run(resolver, name, obj, r.resource, resource, objs, params)
+		if err != nil {
+			responder.Error(apierrors.NewBadRequest(err.Error()))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(result)
+	}), nil
+}
+
+func (r *REST) list(ctx context.Context, resource string) ([]runtime.Object, error) {
+	switch resource {
+	case infra.SitePlural:
+		objs, err := topology.List[*infra.Site](ctx, r.storage, resource)
+		return toObjects(objs), internalError(err)
+	case infra.RackPlural:
+		objs, err := topology.List[*infra.Rack](ctx, r.storage, resource)
+		return toObjects(objs), internalError(err)
+	case infra.NodePlural:
+		objs, err := topology.List[*infra.Node](ctx, r.storage, resource)
+		return toObjects(objs), internalError(err)
+	case infra.ClusterPlural:
+		objs, err := topology.List[*infra.Cluster](ctx, r.storage, resource)
+		return toObjects(objs), internalError(err)
+	default:
+		return nil, apierrors.NewBadRequest(unsupportedResource(resource).Error())
+	}
+}
+
+// Run executes the nearby query defined by the parameters for the named object
+func Run(resolver *Resolver, name string, obj runtime.Object, objResource, resource string, objs []runtime.Object, params url.Values) (*Result, error) {
+	radius, err := getFloat(params, "radiusKm")
+	if err != nil {
+		return nil, err
+	}
+	if radius != nil && *radius < 0 {
+		return nil, fmt.Errorf("invalid radiusKm %v, must be >= 0", *radius)
+	}
+	limit := 0
+	if s := params.Get("limit"); s != "" {
+		if limit, err = strconv.Atoi(s); err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid limit %q, must be a positive integer", s)
+		}
+	}
+
+	result := &Result{
+		Resource: resource,
+		RadiusKm: radius,
+	}
+	exclude := ""
+	lat, lon := params.Get("latitude"), params.Get("longitude")
+	switch {
+	case lat != "" || lon != "":
+		origin := &infra.Location{Latitude: lat, Longitude: lon}
+		if _, _, err := origin.Coordinates(); err != nil {
+			return nil, err
+		}
+		result.Origin = Origin{Location: *origin}
+	default:
+		origin, source := resolver.Location(obj)
+		if origin == nil {
+			return nil, fmt.Errorf("no location found for %s %s, provide latitude and longitude", objResource, name)
+		}
+		if _, _, err := origin.Coordinates(); err != nil {
+			return nil, fmt.Errorf("invalid location for %s %s, err: %s", objResource, name, err.Error())
+		}
+		result.Origin = Origin{Name: name, Location: *origin, Source: source}
+		if resource == objResource {
+			exclude = name
+		}
+	}
+
+	result.Items, result.Unlocated, err = Nearby(resolver, &result.Origin.Location, objs, exclude, radius, limit)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func getFloat(params url.Values, key string) (*float64, error) {
+	s := params.Get(key)
+	if s == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q, err: %s", key, s, err.Error())
+	}
+	return &v, nil
+}
+
+func internalError(err error) error {
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	return nil
+}
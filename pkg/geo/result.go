@@ -0,0 +1,118 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geo
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kuidio/kuid/apis/infra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Result is the result of a nearby query
+type Result struct {
+	// Origin defines the location distances are calculated from
+	Origin Origin `json:"origin"`
+	// Resource defines the resource that was queried, e.g. sites, racks, nodes or clusters
+	Resource string `json:"resource"`
+	// RadiusKm defines the maximum distance of the items from the origin
+	RadiusKm *float64 `json:"radiusKm,omitempty"`
+	// Items defines the resources sorted by distance from the origin
+	Items []Item `json:"items"`
+	// Unlocated defines the resources for which no valid location could be resolved
+	Unlocated []string `json:"unlocated,omitempty"`
+}
+
+// Origin defines the location distances are calculated from
+type Origin struct {
+	// Name defines the resource the origin location belongs to,
+	// empty when the origin is provided as latitude/longitude
+	Name     string         `json:"name,omitempty"`
+	Location infra.Location `json:"location"`
+	Source   Source         `json:"source,omitempty"`
+}
+
+// Item defines a resource and its distance from the origin
+type Item struct {
+	Name       string         `json:"name"`
+	Location   infra.Location `json:"location"`
+	Source     Source         `json:"source"`
+	DistanceKm float64        `json:"distanceKm"`
+}
+
+// Nearby returns the objects sorted by distance from the origin. When radius is specified
+// only the objects within the radius are returned, when limit is > 0 at most limit objects
+// are returned. The object named exclude is skipped.
+func Nearby(resolver *Resolver, origin *infra.Location, objs []runtime.Object, exclude string, radius *float64, limit int) ([]Item, []string, error) {
+	items := []Item{}
+	unlocated := []string{}
+	for _, obj := range objs {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, nil, err
+		}
+		name := accessor.GetName()
+		if name == exclude {
+			continue
+		}
+		loc, source := resolver.Location(obj)
+		if loc == nil {
+			unlocated = append(unlocated, name)
+			continue
+		}
+		distance, err := origin.DistanceKm(loc)
+		if err != nil {
+			unlocated = append(unlocated, name)
+			continue
+		}
+		if radius != nil && distance > *radius {
+			continue
+		}
+		items = append(items, Item{
+			Name:       name,
+			Location:   *loc,
+			Source:     source,
+			DistanceKm: distance,
+		})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].DistanceKm != items[j].DistanceKm {
+			return items[i].DistanceKm < items[j].DistanceKm
+		}
+		return items[i].Name < items[j].Name
+	})
+	sort.Strings(unlocated)
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	return items, unlocated, nil
+}
+
+func toObjects[T runtime.Object](objs []T) []runtime.Object {
+	l := make([]runtime.Object, 0, len(objs))
+	for _, obj := range objs {
+		l = append(l, obj)
+	}
+	return l
+}
+
+func unsupportedResource(resource string) error {
+	return fmt.Errorf("unsupported resource %q, supported resources: %s, %s, %s, %s",
+		resource, infra.SitePlural, infra.RackPlural, infra.NodePlural, infra.ClusterPlural)
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/kform-dev/choreo/apis/condition"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// Checker tracks the readiness and health of the storage and the backend groups.
+// The server is ready when the storage is open and the cache of every index of every
+// backend group is initialized. The server is degraded when any index failed or the
+// storage is unhealthy.
+type Checker struct {
+	m            sync.RWMutex
+	standby      bool
+	storageReady bool
+	db           *badger.DB
+	groups       map[string]*group
+}
+
+type group struct {
+	indexes  []rest.Lister
+	restored bool
+}
+
+type conditionGetter interface {
+	GetCondition(t condition.ConditionType) condition.Condition
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		groups: map[string]*group{},
+	}
+}
+
+// SetStandby marks the server as standby, a standby server waits for the leadership
+// before it opens the storage
+func (r *Checker) SetStandby(standby bool) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.standby = standby
+}
+
+// SetStorageReady marks the storage as open, when the storage is backed by badger
+// the db is used to check the storage health
+func (r *Checker) SetStorageReady(db *badger.DB) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.storageReady = true
+	r.db = db
+}
+
+// AddGroup adds the index storage of a backend group, the indexes of the group
+// are used to determine the readiness and health of the group
+func (r *Checker) AddGroup(name string, indexes rest.Lister) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	g, ok := r.groups[name]
+	if !ok {
+		g = &group{}
+		r.groups[name] = g
+	}
+	g.indexes = append(g.indexes, indexes)
+}
+
+// SetRestored marks the index caches of the backend group as restored
+func (r *Checker) SetRestored(name string) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if g, ok := r.groups[name]; ok {
+		g.restored = true
+	}
+}
+
+// Status returns the readiness and health of the storage and the backend groups
+func (r *Checker) Status(ctx context.Context) *Status {
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	status := &Status{
+		Standby: r.standby,
+		Storage: StorageStatus{
+			Ready:   r.storageReady,
+			Healthy: true,
+		},
+		Groups: make([]GroupStatus, 0, len(r.groups)),
+	}
+	if !r.storageReady {
+		status.Storage.Message = "storage not open"
+		if r.standby {
+			status.Storage.Message = "standby, waiting for leadership"
+		}
+	}
+	if err := r.checkDB(); err != nil {
+		status.Storage.Healthy = false
+		status.Storage.Message = err.Error()
+	}
+
+	names := make([]string, 0, len(r.groups))
+	for name := range r.groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		status.Groups = append(status.Groups, r.groups[name].status(ctx, name))
+	}
+
+	status.Ready = status.Storage.Ready
+	status.Healthy = status.Storage.Healthy
+	for _, g := range status.Groups {
+		status.Ready = status.Ready && g.Ready
+		status.Healthy = status.Healthy && g.Healthy
+	}
+	return status
+}
+
+func (r *Checker) checkDB() error {
+	if r.db == nil {
+		return nil
+	}
+	if r.db.IsClosed() {
+		return errors.New("badger db is closed")
+	}
+	if err := r.db.View(func(txn *badger.Txn) error { return nil }); err != nil {
+		return fmt.Errorf("badger db unhealthy, err: %s", err.Error())
+	}
+	return nil
+}
+
+func (r *group) status(ctx context.Context, name string) GroupStatus {
+	status := GroupStatus{
+		Group:    name,
+		Restored: r.restored,
+		Healthy:  true,
+	}
+	items, err := r.listIndexes(ctx)
+	if err != nil {
+		status.Healthy = false
+		status.Message = fmt.Sprintf("cannot list indexes, err: %s", err.Error())
+		return status
+	}
+	status.Indexes = len(items)
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			continue
+		}
+		name := fmt.Sprintf("%s/%s", accessor.GetNamespace(), accessor.GetName())
+		obj, ok := item.(conditionGetter)
+		if !ok {
+			continue
+		}
+		c := obj.GetCondition(condition.ConditionTypeReady)
+		switch {
+		case c.Status == metav1.ConditionTrue:
+		case c.Reason == string(condition.ConditionReasonFailed):
+			status.FailedIndexes = append(status.FailedIndexes, name)
+		default:
+			status.NotReadyIndexes = append(status.NotReadyIndexes, name)
+		}
+	}
+	status.Ready = r.restored && len(status.FailedIndexes) == 0 && len(status.NotReadyIndexes) == 0
+	status.Healthy = len(status.FailedIndexes) == 0
+	switch {
+	case !r.restored:
+		status.Message = "index caches not restored"
+	case len(status.FailedIndexes) != 0:
+		status.Message = fmt.Sprintf("failed indexes: %s", strings.Join(status.FailedIndexes, ", "))
+	case len(status.NotReadyIndexes) != 0:
+		status.Message = fmt.Sprintf("indexes not initialized: %s", strings.Join(status.NotReadyIndexes, ", "))
+	}
+	return status
+}
+
+func (r *group) listIndexes(ctx context.Context) ([]runtime.Object, error) {
+	items := []runtime.Object{}
+	for _, indexes := range r.indexes {
+		list, err := indexes.List(ctx, &internalversion.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		objs, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, objs...)
+	}
+	return items, nil
+}
+
+// Readyz implements a controller-runtime healthz.Checker for the readiness of the server
+func (r *Checker) Readyz(req *http.Request) error {
+	status := r.Status(req.Context())
+	if !status.Ready {
+		return errors.New(status.notReadyMessage())
+	}
+	return nil
+}
+
+// Healthz implements a controller-runtime healthz.Checker for the health of the server
+func (r *Checker) Healthz(req *http.Request) error {
+	status := r.Status(req.Context())
+	if !status.Healthy {
+		return errors.New(status.degradedMessage())
+	}
+	return nil
+}
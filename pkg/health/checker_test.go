@@ -0,0 +1,149 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kform-dev/choreo/apis/condition"
+	"github.com/kuidio/kuid/apis/backend/as"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// indexLister lists a fixed set of indexes
+type indexLister struct {
+	items []as.ASIndex
+	err   error
+}
+
+func (r *indexLister) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &as.ASIndexList{Items: r.items}, nil
+}
+
+func (r *indexLister) NewList() runtime.Object { return &as.ASIndexList{} }
+
+func (r *indexLister) ConvertToTable(ctx context.Context, object runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	return nil, nil
+}
+
+func testIndex(name string, c condition.Condition) as.ASIndex {
+	index := as.ASIndex{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name}}
+	index.SetConditions(c)
+	return index
+}
+
+func TestStatus(t *testing.T) {
+	cases := map[string]struct {
+		storageReady bool
+		restored     bool
+		lister       *indexLister
+		wantReady    bool
+		wantHealthy  bool
+		wantFailed   []string
+		wantNotReady []string
+	}{
+		"Ready": {
+			storageReady: true,
+			restored:     true,
+			lister:       &indexLister{items: []as.ASIndex{testIndex("a", condition.Ready())}},
+			wantReady:    true,
+			wantHealthy:  true,
+		},
+		"StorageNotReady": {
+			restored:    true,
+			lister:      &indexLister{},
+			wantReady:   false,
+			wantHealthy: true,
+		},
+		"NotRestored": {
+			storageReady: true,
+			lister:       &indexLister{items: []as.ASIndex{testIndex("a", condition.Ready())}},
+			wantReady:    false,
+			wantHealthy:  true,
+		},
+		"NotReadyIndex": {
+			storageReady: true,
+			restored:     true,
+			lister:       &indexLister{items: []as.ASIndex{testIndex("a", condition.Unknown())}},
+			wantReady:    false,
+			wantHealthy:  true,
+			wantNotReady: []string{"default/a"},
+		},
+		"FailedIndex": {
+			storageReady: true,
+			restored:     true,
+			lister: &indexLister{items: []as.ASIndex{
+				testIndex("a", condition.Ready()),
+				testIndex("b", condition.Failed("restore failed")),
+			}},
+			wantReady:   false,
+			wantHealthy: false,
+			wantFailed:  []string{"default/b"},
+		},
+		"ListError": {
+			storageReady: true,
+			restored:     true,
+			lister:       &indexLister{err: errors.New("list failed")},
+			wantReady:    false,
+			wantHealthy:  false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewChecker()
+			if tc.storageReady {
+				r.SetStorageReady(nil)
+			}
+			r.AddGroup("as", tc.lister)
+			if tc.restored {
+				r.SetRestored("as")
+			}
+
+			status := r.Status(context.Background())
+			if status.Ready != tc.wantReady {
+				t.Errorf("want ready %t, got %t", tc.wantReady, status.Ready)
+			}
+			if status.Healthy != tc.wantHealthy {
+				t.Errorf("want healthy %t, got %t", tc.wantHealthy, status.Healthy)
+			}
+			if diff := cmp.Diff(tc.wantFailed, status.Groups[0].FailedIndexes); diff != "" {
+				t.Errorf("failedIndexes -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantNotReady, status.Groups[0].NotReadyIndexes); diff != "" {
+				t.Errorf("notReadyIndexes -want, +got:\n%s", diff)
+			}
+
+			req := httptest.NewRequest("GET", "/readyz", nil)
+			if err := r.Readyz(req); (err == nil) != tc.wantReady {
+				t.Errorf("want readyz ok %t, got err: %v", tc.wantReady, err)
+			}
+			if err := r.Healthz(req); (err == nil) != tc.wantHealthy {
+				t.Errorf("want healthz ok %t, got err: %v", tc.wantHealthy, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/henderiw/logger/log"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Status defines the readiness and health of the storage and the backend groups
+type Status struct {
+	// Ready indicates the storage is open and all index caches are initialized
+	Ready bool `json:"ready"`
+	// Healthy indicates the storage is healthy and no index failed
+	Healthy bool `json:"healthy"`
+	// Standby indicates the server waits for the leadership
+	Standby bool          `json:"standby,omitempty"`
+	Storage StorageStatus `json:"storage"`
+	Groups  []GroupStatus `json:"groups"`
+}
+
+type StorageStatus struct {
+	Ready   bool   `json:"ready"`
+	Healthy bool   `json:"healthy"`
+	Message string `json:"message,omitempty"`
+}
+
+type GroupStatus struct {
+	Group string `json:"group"`
+	Ready bool   `json:"ready"`
+	// Healthy indicates none of the indexes of the group failed
+	Healthy bool `json:"healthy"`
+	// Restored indicates the index caches of the group were restored at startup
+	Restored bool `json:"restored"`
+	// Indexes defines the number of indexes of the group
+	Indexes         int      `json:"indexes"`
+	FailedIndexes   []string `json:"failedIndexes,omitempty"`
+	NotReadyIndexes []string `json:"notReadyIndexes,omitempty"`
+	Message         string   `json:"message,omitempty"`
+}
+
+func (r *Status) notReadyMessage() string {
+	msgs := []string{}
+	if !r.Storage.Ready || !r.Storage.Healthy {
+		msgs = append(msgs, fmt.Sprintf("storage: %s", r.Storage.Message))
+	}
+	for _, g := range r.Groups {
+		if !g.Ready {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", g.Group, g.Message))
+		}
+	}
+	return fmt.Sprintf("not ready, %s", strings.Join(msgs, "; "))
+}
+
+func (r *Status) degradedMessage() string {
+	msgs := []string{}
+	if !r.Storage.Healthy {
+		msgs = append(msgs, fmt.Sprintf("storage: %s", r.Storage.Message))
+	}
+	for _, g := range r.Groups {
+		if !g.Healthy {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", g.Group, g.Message))
+		}
+	}
+	return fmt.Sprintf("degraded, %s", strings.Join(msgs, "; "))
+}
+
+// Handler returns the http handler serving the health endpoints
+//
+// GET /healthz returns 503 when the server is degraded
+// GET /readyz returns 503 when the server is not ready
+// GET /status returns the per group readiness and health breakdown in json
+// GET /metrics returns the metrics of the controller-runtime registry, such that
+// the backend metrics are also exposed when no reconcilers are running
+func (r *Checker) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", checkHandler(r.Healthz))
+	mux.HandleFunc("/readyz", checkHandler(r.Readyz))
+	mux.HandleFunc("/status", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(r.Status(req.Context()))
+	})
+	mux.Handle("/metrics", promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{}))
+	return mux
+}
+
+func checkHandler(check func(req *http.Request) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if err := check(req); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	}
+}
+
+// Start serves the health endpoints on the address until the context is cancelled
+func (r *Checker) Start(ctx context.Context, addr string) {
+	log := log.FromContext(ctx)
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           r.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+	}()
+	go func() {
+		log.Info("starting health server", "address", addr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("health server failed", "error", err.Error())
+		}
+	}()
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leaderelection
+
+import (
+	"context"
+	"os"
+
+	"k8s.io/client-go/tools/leaderelection"
+)
+
+// Elector elects a single active replica of the kuid server. The backends keep the
+// authoritative allocation state in an in-process cache, only the leader is allowed
+// to serve the storage and the backends.
+type Elector interface {
+	// Run campaigns for the leadership until the context is cancelled. OnStartedLeading
+	// is called with a context that is cancelled when the leadership is lost, OnStoppedLeading
+	// is called when the leadership is lost or released.
+	Run(ctx context.Context, callbacks leaderelection.LeaderCallbacks)
+	// IsLeader returns true if this replica holds the leadership
+	IsLeader() bool
+	// Identity returns the identity of this replica
+	Identity() string
+}
+
+// GetIdentity returns the identity of the replica, the pod name when running in a pod
+// and the hostname otherwise
+func GetIdentity() string {
+	if name := os.Getenv("POD_NAME"); name != "" {
+		return name
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "kuid-server"
+	}
+	return hostname
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leaderelection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/henderiw/logger/log"
+	"k8s.io/client-go/tools/leaderelection"
+)
+
+// NewFileElector returns an elector based on an exclusive lock of a local file.
+// It is a stand-in for the Lease based elector when the replicas share a filesystem
+// but no kubernetes api is available. The lock is released when the process dies.
+func NewFileElector(path, identity string, retryPeriod time.Duration) Elector {
+	return &fileElector{
+		path:        path,
+		identity:    identity,
+		retryPeriod: retryPeriod,
+	}
+}
+
+type fileElector struct {
+	path        string
+	identity    string
+	retryPeriod time.Duration
+
+	m      sync.RWMutex
+	leader bool
+}
+
+func (r *fileElector) Run(ctx context.Context, callbacks leaderelection.LeaderCallbacks) {
+	log := log.FromContext(ctx).With("lock", r.path, "identity", r.identity)
+
+	f, err := r.acquire(ctx)
+	if err != nil {
+		log.Info("leader election stopped", "error", err.Error())
+		return
+	}
+	log.Info("acquired leadership")
+	r.setLeader(true)
+	if callbacks.OnNewLeader != nil {
+		callbacks.OnNewLeader(r.identity)
+	}
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go callbacks.OnStartedLeading(leaderCtx)
+
+	<-ctx.Done()
+	r.setLeader(false)
+	_ = syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+	f.Close()
+	log.Info("released leadership")
+	if callbacks.OnStoppedLeading != nil {
+		callbacks.OnStoppedLeading()
+	}
+}
+
+// acquire retries to lock the file until it succeeds or the context is cancelled
+func (r *fileElector) acquire(ctx context.Context) (*os.File, error) {
+	log := log.FromContext(ctx)
+	ticker := time.NewTicker(r.retryPeriod)
+	defer ticker.Stop()
+	for {
+		f, err := r.tryLock()
+		if err == nil {
+			return f, nil
+		}
+		log.Debug("leadership not acquired", "error", err.Error())
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *fileElector) tryLock() (*os.File, error) {
+	f, err := os.OpenFile(r.path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		f.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, fmt.Errorf("lock held by another replica")
+		}
+		return nil, err
+	}
+	// record the identity of the leader for troubleshooting
+	if err := f.Truncate(0); err == nil {
+		_, _ = f.WriteAt([]byte(r.identity), 0)
+	}
+	return f, nil
+}
+
+func (r *fileElector) setLeader(leader bool) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.leader = leader
+}
+
+func (r *fileElector) IsLeader() bool {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	return r.leader
+}
+
+func (r *fileElector) Identity() string {
+	return r.identity
+}
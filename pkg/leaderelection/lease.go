@@ -0,0 +1,97 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leaderelection
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/henderiw/logger/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+type LeaseConfig struct {
+	Namespace     string
+	Name          string
+	Identity      string
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+}
+
+// NewLeaseElector returns an elector based on a coordination.k8s.io Lease
+func NewLeaseElector(restConfig *rest.Config, cfg LeaseConfig) (Elector, error) {
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &leaseElector{
+		cfg: cfg,
+		lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{Namespace: cfg.Namespace, Name: cfg.Name},
+			Client:    client.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{
+				Identity: cfg.Identity,
+			},
+		},
+	}, nil
+}
+
+type leaseElector struct {
+	cfg  LeaseConfig
+	lock resourcelock.Interface
+	m    sync.RWMutex
+	le   *leaderelection.LeaderElector
+}
+
+func (r *leaseElector) Run(ctx context.Context, callbacks leaderelection.LeaderCallbacks) {
+	le, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            r.lock,
+		LeaseDuration:   r.cfg.LeaseDuration,
+		RenewDeadline:   r.cfg.RenewDeadline,
+		RetryPeriod:     r.cfg.RetryPeriod,
+		Callbacks:       callbacks,
+		ReleaseOnCancel: true,
+		Name:            r.cfg.Name,
+	})
+	if err != nil {
+		log.FromContext(ctx).Error("cannot create leader elector", "error", err.Error())
+		return
+	}
+	r.m.Lock()
+	r.le = le
+	r.m.Unlock()
+	le.Run(ctx)
+}
+
+func (r *leaseElector) IsLeader() bool {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	if r.le == nil {
+		return false
+	}
+	return r.le.IsLeader()
+}
+
+func (r *leaseElector) Identity() string {
+	return r.cfg.Identity
+}
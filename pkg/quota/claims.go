@@ -0,0 +1,127 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"fmt"
+
+	"github.com/kuidio/kuid/apis/backend/as"
+	asbev1alpha1 "github.com/kuidio/kuid/apis/backend/as/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/community"
+	communitybev1alpha1 "github.com/kuidio/kuid/apis/backend/community/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/esi"
+	esibev1alpha1 "github.com/kuidio/kuid/apis/backend/esi/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/extcomm"
+	extcommbev1alpha1 "github.com/kuidio/kuid/apis/backend/extcomm/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/genid"
+	genidbev1alpha1 "github.com/kuidio/kuid/apis/backend/genid/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/ipam"
+	ipambev1alpha1 "github.com/kuidio/kuid/apis/backend/ipam/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/label"
+	labelbev1alpha1 "github.com/kuidio/kuid/apis/backend/label/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/rd"
+	rdbev1alpha1 "github.com/kuidio/kuid/apis/backend/rd/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/vlan"
+	vlanbev1alpha1 "github.com/kuidio/kuid/apis/backend/vlan/v1alpha1"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// claimResource identifies the claims of a backend group
+type claimResource struct {
+	kind   string
+	plural string
+}
+
+// claimResources are the claims of the backend groups the quotas apply to
+var claimResources = map[string]claimResource{
+	as.GroupName:        {kind: as.ASClaimKind, plural: as.ASClaimPlural},
+	community.GroupName: {kind: community.CommunityClaimKind, plural: community.CommunityClaimPlural},
+	esi.GroupName:       {kind: esi.ESIClaimKind, plural: esi.ESIClaimPlural},
+	extcomm.GroupName:   {kind: extcomm.EXTCOMMClaimKind, plural: extcomm.EXTCOMMClaimPlural},
+	genid.GroupName:     {kind: genid.GENIDClaimKind, plural: genid.GENIDClaimPlural},
+	ipam.GroupName:      {kind: ipam.IPClaimKind, plural: ipam.IPClaimPlural},
+	label.GroupName:     {kind: label.LabelClaimKind, plural: label.LabelClaimPlural},
+	rd.GroupName:        {kind: rd.RDClaimKind, plural: rd.RDClaimPlural},
+	vlan.GroupName:      {kind: vlan.VLANClaimKind, plural: vlan.VLANClaimPlural},
+}
+
+// scheme holds the internal and versioned claims and their conversions
+var scheme = runtime.NewScheme()
+
+func init() {
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		as.AddToScheme, asbev1alpha1.AddToScheme,
+		community.AddToScheme, communitybev1alpha1.AddToScheme,
+		esi.AddToScheme, esibev1alpha1.AddToScheme,
+		extcomm.AddToScheme, extcommbev1alpha1.AddToScheme,
+		genid.AddToScheme, genidbev1alpha1.AddToScheme,
+		ipam.AddToScheme, ipambev1alpha1.AddToScheme,
+		label.AddToScheme, labelbev1alpha1.AddToScheme,
+		rd.AddToScheme, rdbev1alpha1.AddToScheme,
+		vlan.AddToScheme, vlanbev1alpha1.AddToScheme,
+	} {
+		if err := addToScheme(scheme); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ClaimResources returns the claim resources quotas apply to by group
+func ClaimResources() map[string]schema.GroupResource {
+	grs := make(map[string]schema.GroupResource, len(claimResources))
+	for group, claim := range claimResources {
+		grs[group] = schema.GroupResource{Group: group, Resource: claim.plural}
+	}
+	return grs
+}
+
+// NewClaimObject returns an empty versioned claim of the group, e.g. to watch the claims
+func NewClaimObject(group string) (client.Object, error) {
+	gvk, err := getClaimGVK(group)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	cobj, ok := obj.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a client.Object", gvk.Kind)
+	}
+	return cobj, nil
+}
+
+// NewClaimStore returns a store providing the claims of the group as internal objects
+func NewClaimStore(c client.Client, group string) (*bebackend.ClientStore, error) {
+	gvk, err := getClaimGVK(group)
+	if err != nil {
+		return nil, err
+	}
+	return bebackend.NewClientStore(c, scheme, gvk, false), nil
+}
+
+func getClaimGVK(group string) (schema.GroupVersionKind, error) {
+	claim, ok := claimResources[group]
+	if !ok {
+		return schema.GroupVersionKind{}, fmt.Errorf("quotas do not apply to group %s", group)
+	}
+	return schema.GroupVersionKind{Group: group, Version: "v1alpha1", Kind: claim.kind}, nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kuidio/kuid/apis/backend/quota"
+	"github.com/kuidio/kuid/pkg/registry/options"
+	"github.com/kuidio/kuid/pkg/reservation"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NewClaimInvoker returns an invoker that enforces the claim quotas of the group before the
+// invoker is called, the invoker allocates the claim in the backend and is nil when the
+// group is not synchronous.
+func NewClaimInvoker(group string, invoker options.BackendInvoker) options.BackendInvoker {
+	return &claimInvoker{
+		group:      group,
+		invoker:    invoker,
+		namespaces: map[string]*reservation.Reservations[runtime.Object]{},
+	}
+}
+
+type claimInvoker struct {
+	group   string
+	invoker options.BackendInvoker
+	// m protects the namespaces
+	m sync.Mutex
+	// namespaces serializes the quota checks of the claims of the group per namespace and
+	// reserves the usage of a claim until the claim is persisted
+	namespaces map[string]*reservation.Reservations[runtime.Object]
+}
+
+func (r *claimInvoker) InvokeCreate(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	if recursion {
+		if r.invoker == nil {
+			return obj, nil
+		}
+		return r.invoker.InvokeCreate(ctx, obj, recursion)
+	}
+	if err := r.reserve(ctx, obj, nil, time.Now()); err != nil {
+		return obj, err
+	}
+	if r.invoker == nil {
+		return obj, nil
+	}
+	newObj, err := r.invoker.InvokeCreate(ctx, obj, recursion)
+	if err != nil {
+		r.release(obj)
+	}
+	return newObj, err
+}
+
+func (r *claimInvoker) InvokeUpdate(ctx context.Context, obj, old runtime.Object, recursion bool) (runtime.Object, runtime.Object, error) {
+	if recursion {
+		if r.invoker == nil {
+			return obj, old, nil
+		}
+		return r.invoker.InvokeUpdate(ctx, obj, old, recursion)
+	}
+	if err := r.reserve(ctx, obj, old, time.Now()); err != nil {
+		return obj, old, err
+	}
+	if r.invoker == nil {
+		return obj, old, nil
+	}
+	newObj, oldObj, err := r.invoker.InvokeUpdate(ctx, obj, old, recursion)
+	if err != nil {
+		r.release(obj)
+	}
+	return newObj, oldObj, err
+}
+
+func (r *claimInvoker) InvokeDelete(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	r.release(obj)
+	if r.invoker == nil {
+		return obj, nil
+	}
+	return r.invoker.InvokeDelete(ctx, obj, recursion)
+}
+
+// Persisted releases the usage of the claim, the store holds the claim or the claim failed
+// to persist
+func (r *claimInvoker) Persisted(ctx context.Context, obj runtime.Object, err error) {
+	r.release(obj)
+	options.Persisted(ctx, r.invoker, obj, err)
+}
+
+func (r *claimInvoker) getReservations(obj runtime.Object) (*reservation.Reservations[runtime.Object], error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	reservations, ok := r.namespaces[accessor.GetNamespace()]
+	if !ok {
+		reservations = reservation.New[runtime.Object]()
+		r.namespaces[accessor.GetNamespace()] = reservations
+	}
+	return reservations, nil
+}
+
+// reserve validates the claim against the quotas that apply to it and reserves the usage
+// of the claim
+func (r *claimInvoker) reserve(ctx context.Context, obj, old runtime.Object, now time.Time) error {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	reservations, err := r.getReservations(obj)
+	if err != nil {
+		return err
+	}
+	return reservations.Reserve(accessor.GetName(), obj.DeepCopyObject(), now, func(reserved map[string]runtime.Object) (bool, error) {
+		quotas, err := listQuotas(ctx, r.group, getIndex(obj))
+		if err != nil || len(quotas) == 0 {
+			return false, err
+		}
+		claims, err := listClaims(ctx, r.group)
+		if err != nil {
+			return false, err
+		}
+		return checkQuotas(r.group, obj, old, quotas, claims, reserved)
+	})
+}
+
+// release removes the reservation of the claim
+func (r *claimInvoker) release(obj runtime.Object) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	if reservations, err := r.getReservations(obj); err == nil {
+		reservations.Release(accessor.GetName())
+	}
+}
+
+// checkQuotas validates the claim against the quotas using the usage of the stored and the
+// reserved claims, it returns true when the usage of the claim must be reserved. On update
+// the quotas are only checked when the claim consumes more than before, such that a claim
+// within a quota that was lowered afterwards can still be updated.
+func checkQuotas(group string, obj, old runtime.Object, quotas []*quota.ClaimQuota, claims []runtime.Object, reserved map[string]runtime.Object) (bool, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false, err
+	}
+	usage, err := GetClaimUsage(obj)
+	if err != nil {
+		return false, err
+	}
+	if old != nil {
+		oldUsage, err := GetClaimUsage(old)
+		if err != nil {
+			return false, err
+		}
+		if !usage.Exceeds(oldUsage) {
+			return false, nil
+		}
+	}
+
+	// the usage of the claim itself is replaced by the requested usage, the usage of the
+	// reserved claims replaces the usage of their stored version
+	others := make([]runtime.Object, 0, len(claims)+len(reserved))
+	for _, claim := range claims {
+		claimAccessor, err := meta.Accessor(claim)
+		if err != nil {
+			return false, err
+		}
+		if claimAccessor.GetName() == accessor.GetName() {
+			continue
+		}
+		if _, ok := reserved[claimAccessor.GetName()]; ok {
+			continue
+		}
+		others = append(others, claim)
+	}
+	for _, claim := range reserved {
+		others = append(others, claim)
+	}
+
+	var msgs []string
+	for _, claimQuota := range quotas {
+		if usage.PrefixLength != nil {
+			if err := claimQuota.CheckPrefixLength(usage.AddressFamily, *usage.PrefixLength); err != nil {
+				msgs = append(msgs, err.Error())
+				continue
+			}
+		}
+		used, err := GetUsage(claimQuota, others)
+		if err != nil {
+			return false, err
+		}
+		if err := claimQuota.CheckUsage(used.Add(usage.ClaimQuotaUsage)); err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	if len(msgs) != 0 {
+		return false, apierrors.NewForbidden(
+			schema.GroupResource{Group: group, Resource: strings.ToLower(reflect.TypeOf(obj).Elem().Name())},
+			accessor.GetName(),
+			fmt.Errorf("%s", strings.Join(msgs, "; ")),
+		)
+	}
+	return true, nil
+}
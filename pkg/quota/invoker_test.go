@@ -0,0 +1,211 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kuidio/kuid/apis/backend/as"
+	"github.com/kuidio/kuid/apis/backend/quota"
+	"github.com/kuidio/kuid/pkg/reservation"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+func getClaim(name, resourceVersion string, id *uint32, idRange *string) *as.ASClaim {
+	return as.BuildASClaim(
+		metav1.ObjectMeta{Namespace: "default", Name: name, ResourceVersion: resourceVersion},
+		&as.ASClaimSpec{Index: "a", ID: id, Range: idRange},
+		nil,
+	).(*as.ASClaim)
+}
+
+func getQuota(claims, ids *int64) *quota.ClaimQuota {
+	return &quota.ClaimQuota{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "quota"},
+		Spec:       quota.ClaimQuotaSpec{Group: as.GroupName, Claims: claims, IDs: ids},
+	}
+}
+
+// reserve reserves the usage of the claim against the quotas and the stored claims
+func reserve(r *reservation.Reservations[runtime.Object], claim, old runtime.Object, quotas []*quota.ClaimQuota, stored []runtime.Object, now time.Time) error {
+	return r.Reserve(claim.(*as.ASClaim).Name, claim, now, func(reserved map[string]runtime.Object) (bool, error) {
+		return checkQuotas(as.GroupName, claim, old, quotas, stored, reserved)
+	})
+}
+
+func TestReserve(t *testing.T) {
+	now := time.Now()
+	quotas := []*quota.ClaimQuota{getQuota(ptr.To[int64](2), nil)}
+	r := reservation.New[runtime.Object]()
+
+	// the claims at the limit are accepted, the in-flight claims count
+	for _, name := range []string{"claim1", "claim2"} {
+		if err := reserve(r, getClaim(name, "", ptr.To[uint32](1), nil), nil, quotas, nil, now); err != nil {
+			t.Fatalf("claim %s: unexpected error: %v", name, err)
+		}
+	}
+	err := reserve(r, getClaim("claim3", "", ptr.To[uint32](3), nil), nil, quotas, nil, now)
+	if !apierrors.IsForbidden(err) {
+		t.Fatalf("claim3: expected forbidden, got %v", err)
+	}
+
+	// the reserved claims replace their stored version
+	stored := []runtime.Object{getClaim("claim1", "1", ptr.To[uint32](1), nil), getClaim("claim2", "1", ptr.To[uint32](2), nil)}
+	r.Release("claim1")
+	r.Release("claim2")
+	err = reserve(r, getClaim("claim3", "", ptr.To[uint32](3), nil), nil, quotas, stored, now)
+	if !apierrors.IsForbidden(err) {
+		t.Fatalf("claim3: expected forbidden, got %v", err)
+	}
+	// an update that does not consume more is not checked nor reserved
+	if err := reserve(r, getClaim("claim1", "1", ptr.To[uint32](5), nil), stored[0], quotas, stored, now); err != nil {
+		t.Fatalf("claim1 update: unexpected error: %v", err)
+	}
+	if r.Len() != 0 {
+		t.Errorf("want no reservations, got %d", r.Len())
+	}
+
+	// a deleted claim frees its usage
+	if err := reserve(r, getClaim("claim3", "", ptr.To[uint32](3), nil), nil, quotas, stored[:1], now); err != nil {
+		t.Fatalf("claim3: unexpected error: %v", err)
+	}
+}
+
+func TestReserveExpires(t *testing.T) {
+	now := time.Now()
+	quotas := []*quota.ClaimQuota{getQuota(ptr.To[int64](1), nil)}
+	r := reservation.New[runtime.Object]()
+
+	if err := reserve(r, getClaim("claim1", "", ptr.To[uint32](1), nil), nil, quotas, nil, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// a claim whose store write is never reported does not hold the quota beyond the timeout
+	if err := reserve(r, getClaim("claim2", "", ptr.To[uint32](2), nil), nil, quotas, nil, now); !apierrors.IsForbidden(err) {
+		t.Fatalf("expected forbidden, got %v", err)
+	}
+	if err := reserve(r, getClaim("claim2", "", ptr.To[uint32](2), nil), nil, quotas, nil, now.Add(reservation.Timeout+time.Second)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestReservePersisted(t *testing.T) {
+	for name, persistErr := range map[string]error{
+		"Persisted":     nil,
+		"AlreadyExists": apierrors.NewAlreadyExists(schema.GroupResource{Group: as.GroupName, Resource: "asclaims"}, "claim1"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			quotas := []*quota.ClaimQuota{getQuota(ptr.To[int64](1), nil)}
+			invoker := NewClaimInvoker(as.GroupName, nil).(*claimInvoker)
+
+			claim := getClaim("claim1", "", ptr.To[uint32](1), nil)
+			r, err := invoker.getReservations(claim)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := reserve(r, claim, nil, quotas, nil, time.Now()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// the store holds the claim or the claim failed to persist, either way the
+			// reservation is released
+			invoker.Persisted(context.Background(), claim, persistErr)
+			if r.Len() != 0 {
+				t.Errorf("want the reservation released, got %d", r.Len())
+			}
+		})
+	}
+}
+func TestReserveIDs(t *testing.T) {
+	cases := map[string]struct {
+		stored      []runtime.Object
+		claim       *as.ASClaim
+		expectedErr bool
+	}{
+		"RangeAtLimit": {
+			claim: getClaim("range", "", nil, ptr.To("1-10")),
+		},
+		"RangeAboveLimit": {
+			claim:       getClaim("range", "", nil, ptr.To("1-11")),
+			expectedErr: true,
+		},
+		"StaticAtLimit": {
+			stored: []runtime.Object{getClaim("range", "1", nil, ptr.To("1-9"))},
+			claim:  getClaim("claim1", "", ptr.To[uint32](10), nil),
+		},
+		"StaticAboveLimit": {
+			stored:      []runtime.Object{getClaim("range", "1", nil, ptr.To("1-10"))},
+			claim:       getClaim("claim1", "", ptr.To[uint32](11), nil),
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			quotas := []*quota.ClaimQuota{getQuota(nil, ptr.To[int64](10))}
+			err := reserve(reservation.New[runtime.Object](), tc.claim, nil, quotas, tc.stored, time.Now())
+			if tc.expectedErr {
+				if !apierrors.IsForbidden(err) {
+					t.Errorf("expected forbidden, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestReserveConcurrent validates the concurrent claims that are not yet persisted
+// cannot exceed the quota together
+func TestReserveConcurrent(t *testing.T) {
+	const limit = 5
+	quotas := []*quota.ClaimQuota{getQuota(ptr.To[int64](limit), nil)}
+	invoker := NewClaimInvoker(as.GroupName, nil).(*claimInvoker)
+
+	var wg sync.WaitGroup
+	var m sync.Mutex
+	accepted := 0
+	for i := 0; i < 4*limit; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			claim := getClaim(fmt.Sprintf("claim%d", i), "", ptr.To(uint32(i)), nil)
+			r, err := invoker.getReservations(claim)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if err := reserve(r, claim, nil, quotas, nil, time.Now()); err == nil {
+				m.Lock()
+				accepted++
+				m.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	if accepted != limit {
+		t.Errorf("want %d accepted claims, got %d", limit, accepted)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"github.com/kuidio/kuid/apis/backend/quota"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// storage provides the claim quotas and the claims of the backend groups to the quota
+// invokers. The stores are added once the apiserver storage is initialized, as long as the
+// quota store is not added no quotas are enforced.
+var storage = &quotaStorage{
+	claims: map[string]*registry.Store{},
+}
+
+type quotaStorage struct {
+	m      sync.RWMutex
+	quotas *registry.Store
+	claims map[string]*registry.Store
+}
+
+// AddQuotaStore adds the store of the claim quotas
+func AddQuotaStore(store *registry.Store) {
+	storage.m.Lock()
+	defer storage.m.Unlock()
+	storage.quotas = store
+}
+
+// AddClaimStore adds the store of the claims of the group
+func AddClaimStore(group string, store *registry.Store) {
+	storage.m.Lock()
+	defer storage.m.Unlock()
+	storage.claims[group] = store
+}
+
+// listQuotas returns the quotas in the namespace of the context that apply to the
+// claims of the group and index
+func listQuotas(ctx context.Context, group, index string) ([]*quota.ClaimQuota, error) {
+	storage.m.RLock()
+	store := storage.quotas
+	storage.m.RUnlock()
+	if store == nil {
+		return nil, nil
+	}
+	items, err := list(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+	quotas := []*quota.ClaimQuota{}
+	for _, item := range items {
+		claimQuota, ok := item.(*quota.ClaimQuota)
+		if !ok || !claimQuota.AppliesTo(group, index) {
+			continue
+		}
+		quotas = append(quotas, claimQuota)
+	}
+	return quotas, nil
+}
+
+// listClaims returns the claims of the group in the namespace of the context
+func listClaims(ctx context.Context, group string) ([]runtime.Object, error) {
+	storage.m.RLock()
+	store, ok := storage.claims[group]
+	storage.m.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("quota storage for the claims of %s not initialized", group)
+	}
+	return list(ctx, store)
+}
+
+func list(ctx context.Context, store *registry.Store) ([]runtime.Object, error) {
+	list, err := store.List(ctx, &internalversion.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return meta.ExtractList(list)
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/henderiw/idxtable/pkg/tree/id64"
+	"github.com/henderiw/iputil"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/ipam"
+	"github.com/kuidio/kuid/apis/backend/quota"
+	"go4.org/netipx"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+// ClaimUsage is the capacity a single claim consumes from a quota
+type ClaimUsage struct {
+	quota.ClaimQuotaUsage
+	// AddressFamily and PrefixLength are set for the ip prefix claims
+	AddressFamily iputil.AddressFamily
+	PrefixLength  *uint32
+}
+
+// Exceeds returns true when the usage consumes more than the other usage
+func (r *ClaimUsage) Exceeds(usage *ClaimUsage) bool {
+	if r.Claims > usage.Claims || r.IPv4Addresses > usage.IPv4Addresses || r.IDs > usage.IDs {
+		return true
+	}
+	if r.PrefixLength == nil {
+		return false
+	}
+	return usage.PrefixLength == nil || r.AddressFamily != usage.AddressFamily || *r.PrefixLength < *usage.PrefixLength
+}
+
+// GetUsage returns the usage of the claims the quota applies to, the claims
+// are expected to be in the namespace of the quota
+func GetUsage(claimQuota *quota.ClaimQuota, claims []runtime.Object) (quota.ClaimQuotaUsage, error) {
+	used := quota.ClaimQuotaUsage{}
+	for _, claim := range claims {
+		if claimQuota.Spec.Index != nil && *claimQuota.Spec.Index != getIndex(claim) {
+			continue
+		}
+		usage, err := GetClaimUsage(claim)
+		if err != nil {
+			return used, err
+		}
+		used = used.Add(usage.ClaimQuotaUsage)
+	}
+	return used, nil
+}
+
+// GetClaimUsage returns the capacity the claim consumes. The allocated status is used when
+// the claim spec does not define the id, prefix or address itself.
+// Dynamic ip claims without an address family count as IPv4 as long as they are not allocated.
+func GetClaimUsage(obj runtime.Object) (*ClaimUsage, error) {
+	switch claim := obj.(type) {
+	case *ipam.IPClaim:
+		return getIPClaimUsage(claim)
+	case backend.ClaimObject:
+		return getClaimUsage(claim)
+	default:
+		return nil, fmt.Errorf("unexpected claim object, got %T", obj)
+	}
+}
+
+func getClaimUsage(claim backend.ClaimObject) (*ClaimUsage, error) {
+	usage := &ClaimUsage{ClaimQuotaUsage: quota.ClaimQuotaUsage{Claims: 1}}
+	switch claim.GetClaimType() {
+	case backend.ClaimType_StaticID, backend.ClaimType_DynamicID:
+		usage.IDs = 1
+	case backend.ClaimType_Range:
+		if claim.GetRange() == nil {
+			return usage, nil
+		}
+		ranges, err := backend.ParseRangeSegments(*claim.GetRange(), id64.ParseRange)
+		if err != nil {
+			return nil, err
+		}
+		usage.IDs = int64(backend.GetIDRangesSize(backend.GetIDRanges(ranges)))
+	}
+	return usage, nil
+}
+
+func getIPClaimUsage(claim *ipam.IPClaim) (*ClaimUsage, error) {
+	usage := &ClaimUsage{ClaimQuotaUsage: quota.ClaimQuotaUsage{Claims: 1}}
+	switch claim.GetIPClaimSummaryType() {
+	case ipam.IPClaimSummaryType_Address:
+		address := claim.Spec.Address
+		if address == nil {
+			address = claim.Status.Address
+		}
+		af, err := getAddressFamily(address, claim.Spec.AddressFamily)
+		if err != nil {
+			return nil, err
+		}
+		if af != iputil.AddressFamilyIpv6 {
+			usage.IPv4Addresses = 1
+		}
+	case ipam.IPClaimSummaryType_Prefix:
+		prefix := claim.Spec.Prefix
+		if prefix == nil {
+			prefix = claim.Status.Prefix
+		}
+		if prefix != nil {
+			pi, err := iputil.New(*prefix)
+			if err != nil {
+				return nil, err
+			}
+			usage.AddressFamily = pi.GetAddressFamily()
+			usage.PrefixLength = ptr.To(uint32(pi.GetPrefixLength().Int()))
+		} else if claim.Spec.PrefixLength != nil {
+			usage.PrefixLength = claim.Spec.PrefixLength
+			usage.AddressFamily = iputil.AddressFamilyIpv4
+			if claim.Spec.AddressFamily != nil {
+				usage.AddressFamily = *claim.Spec.AddressFamily
+			}
+			if *claim.Spec.PrefixLength > 32 {
+				usage.AddressFamily = iputil.AddressFamilyIpv6
+			}
+		}
+		if usage.AddressFamily == iputil.AddressFamilyIpv4 && usage.PrefixLength != nil {
+			usage.IPv4Addresses = int64(1) << (32 - *usage.PrefixLength)
+		}
+	case ipam.IPClaimSummaryType_Range:
+		rangeString := claim.Spec.Range
+		if rangeString == nil {
+			rangeString = claim.Status.Range
+		}
+		if rangeString == nil {
+			return usage, nil
+		}
+		ipRange, err := netipx.ParseIPRange(*rangeString)
+		if err != nil {
+			return nil, err
+		}
+		if ipRange.From().Is4() {
+			usage.IPv4Addresses = int64(ipv4ToUint32(ipRange.To())-ipv4ToUint32(ipRange.From())) + 1
+		}
+	}
+	return usage, nil
+}
+
+// getAddressFamily returns the address family of the address, when no address is
+// provided the address family of the spec is used
+func getAddressFamily(address *string, af *iputil.AddressFamily) (iputil.AddressFamily, error) {
+	if address != nil {
+		pi, err := iputil.New(*address)
+		if err != nil {
+			return iputil.AddressFamilyUnknown, err
+		}
+		return pi.GetAddressFamily(), nil
+	}
+	if af != nil {
+		return *af, nil
+	}
+	return iputil.AddressFamilyUnknown, nil
+}
+
+func getIndex(obj runtime.Object) string {
+	claim, ok := obj.(interface{ GetIndex() string })
+	if !ok {
+		return ""
+	}
+	return claim.GetIndex()
+}
+
+func ipv4ToUint32(addr netip.Addr) uint32 {
+	b := addr.As4()
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
@@ -18,13 +18,25 @@ package all
 
 import (
 	_ "github.com/kuidio/kuid/pkg/reconcilers/asindex"
+	_ "github.com/kuidio/kuid/pkg/reconcilers/claimquota"
+	_ "github.com/kuidio/kuid/pkg/reconcilers/communityindex"
+	_ "github.com/kuidio/kuid/pkg/reconcilers/esiindex"
 	_ "github.com/kuidio/kuid/pkg/reconcilers/extcommindex"
 	_ "github.com/kuidio/kuid/pkg/reconcilers/genidindex"
 	_ "github.com/kuidio/kuid/pkg/reconcilers/ipclaim"
 	_ "github.com/kuidio/kuid/pkg/reconcilers/ipindex"
+	_ "github.com/kuidio/kuid/pkg/reconcilers/labelindex"
+	_ "github.com/kuidio/kuid/pkg/reconcilers/platformprofile"
+	_ "github.com/kuidio/kuid/pkg/reconcilers/rack"
+	_ "github.com/kuidio/kuid/pkg/reconcilers/rdindex"
 	_ "github.com/kuidio/kuid/pkg/reconcilers/vlanindex"
+	_ "github.com/kuidio/kuid/pkg/reconcilers/vlanindextemplate"
 	_ "github.com/kuidio/kuid/pkg/reconcilers/asclaim"
+	_ "github.com/kuidio/kuid/pkg/reconcilers/communityclaim"
+	_ "github.com/kuidio/kuid/pkg/reconcilers/esiclaim"
 	_ "github.com/kuidio/kuid/pkg/reconcilers/extcommclaim"
 	_ "github.com/kuidio/kuid/pkg/reconcilers/genidclaim"
+	_ "github.com/kuidio/kuid/pkg/reconcilers/labelclaim"
+	_ "github.com/kuidio/kuid/pkg/reconcilers/rdclaim"
 	_ "github.com/kuidio/kuid/pkg/reconcilers/vlanclaim"
 )
@@ -0,0 +1,176 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package claimquota
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/henderiw/logger/log"
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/quota"
+	quotabev1alpha1 "github.com/kuidio/kuid/apis/backend/quota/v1alpha1"
+	bebackend "github.com/kuidio/kuid/pkg/backend"
+	claimquota "github.com/kuidio/kuid/pkg/quota"
+	"github.com/kuidio/kuid/pkg/reconcilers"
+	"github.com/kuidio/kuid/pkg/reconcilers/ctrlconfig"
+	"github.com/kuidio/kuid/pkg/reconcilers/eventhandler"
+	"github.com/kuidio/kuid/pkg/reconcilers/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func init() {
+	reconcilers.Register(quota.GroupName, quotabev1alpha1.ClaimQuotaKind, &reconciler{})
+}
+
+const (
+	reconcilerName = "ClaimQuotaController"
+	// errors
+	errGetCr        = "cannot get cr"
+	errUpdateStatus = "cannot update status"
+)
+
+// SetupWithManager sets up the controller with the Manager.
+// The controller reports the usage of the claims the quota applies to in the quota status.
+func (r *reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, c interface{}) (map[schema.GroupVersionKind]chan event.GenericEvent, error) {
+	cfg, ok := c.(*ctrlconfig.ControllerConfig)
+	if !ok {
+		return nil, fmt.Errorf("cannot initialize, expecting controllerConfig, got: %s", reflect.TypeOf(c).Name())
+	}
+
+	r.Client = mgr.GetClient()
+	r.backends = cfg.Backends
+	r.recorder = mgr.GetEventRecorderFor(reconcilerName)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(reconcilerName).
+		For(&quotabev1alpha1.ClaimQuota{})
+	// watch the claims of the enabled backend groups quotas apply to
+	for group := range cfg.Backends {
+		claim, err := claimquota.NewClaimObject(group)
+		if err != nil {
+			continue
+		}
+		b = b.Watches(claim, &eventhandler.ClaimQuotaEventHandler{Client: mgr.GetClient(), Group: group})
+	}
+	return nil, b.Complete(r)
+}
+
+type reconciler struct {
+	client.Client
+	backends map[string]bebackend.Backend
+	recorder record.EventRecorder
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx = ctrlconfig.InitContext(ctx, reconcilerName, req.NamespacedName)
+	log := log.FromContext(ctx)
+	log.Info("reconcile")
+
+	cr := &quotabev1alpha1.ClaimQuota{}
+	if err := r.Get(ctx, req.NamespacedName, cr); err != nil {
+		// if the resource no longer exists the reconcile loop is done
+		if resource.IgnoreNotFound(err) != nil {
+			log.Error(errGetCr, "error", err)
+			return ctrl.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetCr)
+		}
+		return ctrl.Result{}, nil
+	}
+	crOrig := cr.DeepCopy()
+
+	if !cr.GetDeletionTimestamp().IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	claimQuota := &quota.ClaimQuota{}
+	if err := quotabev1alpha1.Convert_v1alpha1_ClaimQuota_To_quota_ClaimQuota(cr, claimQuota, nil); err != nil {
+		return ctrl.Result{}, errors.Wrap(r.handleError(ctx, crOrig, nil, "cannot convert quota", err), errUpdateStatus)
+	}
+	if _, ok := r.backends[claimQuota.Spec.Group]; !ok {
+		return ctrl.Result{}, errors.Wrap(r.handleError(ctx, crOrig, nil, fmt.Sprintf("group %s not enabled", claimQuota.Spec.Group), nil), errUpdateStatus)
+	}
+	store, err := claimquota.NewClaimStore(r.Client, claimQuota.Spec.Group)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(r.handleError(ctx, crOrig, nil, "invalid group", err), errUpdateStatus)
+	}
+	claims, err := store.List(ctx, client.InNamespace(cr.GetNamespace()))
+	if err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, crOrig, nil, "cannot list claims", err), errUpdateStatus)
+	}
+	used, err := claimquota.GetUsage(claimQuota, claims)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(r.handleError(ctx, crOrig, nil, "cannot compute usage", err), errUpdateStatus)
+	}
+	// the usage exceeds the quota when the quota is lowered below the current usage
+	if err := claimQuota.CheckUsage(used); err != nil {
+		return ctrl.Result{}, errors.Wrap(r.handleError(ctx, crOrig, &used, err.Error(), nil), errUpdateStatus)
+	}
+	return ctrl.Result{}, errors.Wrap(r.handleSuccess(ctx, crOrig, used), errUpdateStatus)
+}
+
+func setUsage(cr *quotabev1alpha1.ClaimQuota, used quota.ClaimQuotaUsage) {
+	cr.Status.Used = &quotabev1alpha1.ClaimQuotaUsage{
+		Claims:        used.Claims,
+		IPv4Addresses: used.IPv4Addresses,
+		IDs:           used.IDs,
+	}
+}
+
+func (r *reconciler) handleSuccess(ctx context.Context, cr *quotabev1alpha1.ClaimQuota, used quota.ClaimQuotaUsage) error {
+	// take a snapshot of the current object
+	patch := client.MergeFrom(cr.DeepCopy())
+	// update status
+	setUsage(cr, used)
+	cr.Status.SetConditions(condv1alpha1.Ready())
+	r.recorder.Eventf(cr, corev1.EventTypeNormal, quotabev1alpha1.ClaimQuotaKind, "ready")
+
+	return r.Client.Status().Patch(ctx, cr, patch, &client.SubResourcePatchOptions{
+		PatchOptions: client.PatchOptions{
+			FieldManager: reconcilerName,
+		},
+	})
+}
+
+func (r *reconciler) handleError(ctx context.Context, cr *quotabev1alpha1.ClaimQuota, used *quota.ClaimQuotaUsage, msg string, err error) error {
+	log := log.FromContext(ctx)
+	// take a snapshot of the current object
+	patch := client.MergeFrom(cr.DeepCopy())
+
+	if err != nil {
+		msg = fmt.Sprintf("%s err %s", msg, err.Error())
+	}
+	if used != nil {
+		setUsage(cr, *used)
+	}
+	cr.Status.SetConditions(condv1alpha1.Failed(msg))
+	log.Error(msg)
+	r.recorder.Eventf(cr, corev1.EventTypeWarning, quotabev1alpha1.ClaimQuotaKind, msg)
+
+	return r.Client.Status().Patch(ctx, cr, patch, &client.SubResourcePatchOptions{
+		PatchOptions: client.PatchOptions{
+			FieldManager: reconcilerName,
+		},
+	})
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package communityclaim
+
+import (
+	"github.com/kuidio/kuid/apis/backend/community"
+	communitybev1alpha1 "github.com/kuidio/kuid/apis/backend/community/v1alpha1"
+	"github.com/kuidio/kuid/pkg/reconcilers"
+	"github.com/kuidio/kuid/pkg/reconcilers/generic"
+)
+
+func init() {
+	reconcilers.Register(community.GroupName, communitybev1alpha1.CommunityClaimKind, generic.NewClaimReconciler(generic.Types[*communitybev1alpha1.CommunityClaim, *community.CommunityClaim]{
+		Group:               communitybev1alpha1.SchemeGroupVersion.Group,
+		Kind:                communitybev1alpha1.CommunityClaimKind,
+		New:                 func() *communitybev1alpha1.CommunityClaim { return &communitybev1alpha1.CommunityClaim{} },
+		NewInternal:         func() *community.CommunityClaim { return &community.CommunityClaim{} },
+		ConvertToInternal:   communitybev1alpha1.Convert_v1alpha1_CommunityClaim_To_community_CommunityClaim,
+		ConvertFromInternal: communitybev1alpha1.Convert_community_CommunityClaim_To_v1alpha1_CommunityClaim,
+	}))
+}
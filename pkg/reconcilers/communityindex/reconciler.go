@@ -0,0 +1,35 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package communityindex
+
+import (
+	"github.com/kuidio/kuid/apis/backend/community"
+	communitybev1alpha1 "github.com/kuidio/kuid/apis/backend/community/v1alpha1"
+	"github.com/kuidio/kuid/pkg/reconcilers"
+	"github.com/kuidio/kuid/pkg/reconcilers/generic"
+)
+
+func init() {
+	reconcilers.Register(community.GroupName, communitybev1alpha1.CommunityIndexKind, generic.NewIndexReconciler(generic.Types[*communitybev1alpha1.CommunityIndex, *community.CommunityIndex]{
+		Group:               communitybev1alpha1.SchemeGroupVersion.Group,
+		Kind:                communitybev1alpha1.CommunityIndexKind,
+		New:                 func() *communitybev1alpha1.CommunityIndex { return &communitybev1alpha1.CommunityIndex{} },
+		NewInternal:         func() *community.CommunityIndex { return &community.CommunityIndex{} },
+		ConvertToInternal:   communitybev1alpha1.Convert_v1alpha1_CommunityIndex_To_community_CommunityIndex,
+		ConvertFromInternal: communitybev1alpha1.Convert_community_CommunityIndex_To_v1alpha1_CommunityIndex,
+	}, generic.IndexOptions[*communitybev1alpha1.CommunityIndex, *community.CommunityIndex]{}))
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package esiclaim
+
+import (
+	"github.com/kuidio/kuid/apis/backend/esi"
+	esibev1alpha1 "github.com/kuidio/kuid/apis/backend/esi/v1alpha1"
+	"github.com/kuidio/kuid/pkg/reconcilers"
+	"github.com/kuidio/kuid/pkg/reconcilers/generic"
+)
+
+func init() {
+	reconcilers.Register(esi.GroupName, esibev1alpha1.ESIClaimKind, generic.NewClaimReconciler(generic.Types[*esibev1alpha1.ESIClaim, *esi.ESIClaim]{
+		Group:               esibev1alpha1.SchemeGroupVersion.Group,
+		Kind:                esibev1alpha1.ESIClaimKind,
+		New:                 func() *esibev1alpha1.ESIClaim { return &esibev1alpha1.ESIClaim{} },
+		NewInternal:         func() *esi.ESIClaim { return &esi.ESIClaim{} },
+		ConvertToInternal:   esibev1alpha1.Convert_v1alpha1_ESIClaim_To_esi_ESIClaim,
+		ConvertFromInternal: esibev1alpha1.Convert_esi_ESIClaim_To_v1alpha1_ESIClaim,
+	}))
+}
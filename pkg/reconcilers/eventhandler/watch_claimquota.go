@@ -0,0 +1,78 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventhandler
+
+import (
+	"context"
+
+	"github.com/henderiw/logger/log"
+	quotabev1alpha1 "github.com/kuidio/kuid/apis/backend/quota/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ClaimQuotaEventHandler enqueues the claimQuotas of the group in the namespace of the claim
+type ClaimQuotaEventHandler struct {
+	Client client.Client
+	Group  string
+}
+
+// Create enqueues a request
+func (r *ClaimQuotaEventHandler) Create(ctx context.Context, evt event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+// Update enqueues a request
+func (r *ClaimQuotaEventHandler) Update(ctx context.Context, evt event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.ObjectNew, q)
+}
+
+// Delete enqueues a request
+func (r *ClaimQuotaEventHandler) Delete(ctx context.Context, evt event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+// Generic enqueues a request
+func (r *ClaimQuotaEventHandler) Generic(ctx context.Context, evt event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+func (r *ClaimQuotaEventHandler) add(ctx context.Context, obj client.Object, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	log := log.FromContext(ctx)
+
+	opts := []client.ListOption{
+		client.InNamespace(obj.GetNamespace()),
+	}
+	quotas := &quotabev1alpha1.ClaimQuotaList{}
+	if err := r.Client.List(ctx, quotas, opts...); err != nil {
+		log.Error("cannot list object", "error", err)
+		return
+	}
+	for _, quota := range quotas.Items {
+		if quota.Spec.Group != r.Group {
+			continue
+		}
+		key := types.NamespacedName{
+			Namespace: quota.GetNamespace(),
+			Name:      quota.GetName()}
+		log.Info("event requeue", "key", key.String())
+		queue.Add(reconcile.Request{NamespacedName: key})
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventhandler
+
+import (
+	"context"
+
+	"github.com/henderiw/logger/log"
+	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NodeRackEventHandler enqueues the rack referenced by the node
+type NodeRackEventHandler struct{}
+
+// Create enqueues a request
+func (r *NodeRackEventHandler) Create(ctx context.Context, evt event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+// Update enqueues a request for the rack of the old and the new node
+func (r *NodeRackEventHandler) Update(ctx context.Context, evt event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.ObjectOld, q)
+	r.add(ctx, evt.ObjectNew, q)
+}
+
+// Delete enqueues a request
+func (r *NodeRackEventHandler) Delete(ctx context.Context, evt event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+// Generic enqueues a request
+func (r *NodeRackEventHandler) Generic(ctx context.Context, evt event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+func (r *NodeRackEventHandler) add(ctx context.Context, obj client.Object, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	cr, ok := obj.(*infrav1alpha1.Node)
+	if !ok {
+		return
+	}
+	if cr.Spec.Rack == nil || *cr.Spec.Rack == "" {
+		return
+	}
+	log := log.FromContext(ctx)
+
+	key := types.NamespacedName{
+		Namespace: cr.GetNamespace(),
+		Name:      *cr.Spec.Rack}
+	log.Info("event requeue", "key", key.String())
+	queue.Add(reconcile.Request{NamespacedName: key})
+}
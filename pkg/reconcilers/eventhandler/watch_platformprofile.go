@@ -0,0 +1,82 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventhandler
+
+import (
+	"context"
+
+	"github.com/henderiw/logger/log"
+	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// PlatformProfileEventHandler enqueues the nodes that reference the provider and
+// platformType of the platformProfile
+type PlatformProfileEventHandler struct {
+	Client client.Client
+}
+
+// Create enqueues a request
+func (r *PlatformProfileEventHandler) Create(ctx context.Context, evt event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+// Update enqueues a request for the nodes matching the old and the new profile
+func (r *PlatformProfileEventHandler) Update(ctx context.Context, evt event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.ObjectOld, q)
+	r.add(ctx, evt.ObjectNew, q)
+}
+
+// Delete enqueues a request
+func (r *PlatformProfileEventHandler) Delete(ctx context.Context, evt event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+// Generic enqueues a request
+func (r *PlatformProfileEventHandler) Generic(ctx context.Context, evt event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+func (r *PlatformProfileEventHandler) add(ctx context.Context, obj client.Object, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	cr, ok := obj.(*infrav1alpha1.PlatformProfile)
+	if !ok {
+		return
+	}
+	log := log.FromContext(ctx)
+
+	opts := []client.ListOption{
+		client.InNamespace(cr.Namespace),
+	}
+	nodes := &infrav1alpha1.NodeList{}
+	if err := r.Client.List(ctx, nodes, opts...); err != nil {
+		log.Error("cannot list object", "error", err)
+		return
+	}
+	for _, node := range nodes.Items {
+		if node.Spec.Provider == cr.Spec.Provider && node.Spec.PlatformType == cr.Spec.PlatformType {
+			key := types.NamespacedName{
+				Namespace: node.GetNamespace(),
+				Name:      node.GetName()}
+			log.Info("event requeue", "key", key.String())
+			queue.Add(reconcile.Request{NamespacedName: key})
+		}
+	}
+}
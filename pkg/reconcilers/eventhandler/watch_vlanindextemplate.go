@@ -0,0 +1,117 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "VLAN IS" BVLANIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventhandler
+
+import (
+	"context"
+
+	"github.com/henderiw/logger/log"
+	"github.com/kuidio/kuid/apis/backend"
+	vlanbev1alpha1 "github.com/kuidio/kuid/apis/backend/vlan/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// VLANIndexTemplateEventHandler enqueues the vlanIndexTemplates of the kind in the namespace
+// of the infra resource
+type VLANIndexTemplateEventHandler struct {
+	Client client.Client
+	Kind   string
+}
+
+// Create enqueues a request
+func (r *VLANIndexTemplateEventHandler) Create(ctx context.Context, evt event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+// Update enqueues a request
+func (r *VLANIndexTemplateEventHandler) Update(ctx context.Context, evt event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.ObjectNew, q)
+}
+
+// Delete enqueues a request
+func (r *VLANIndexTemplateEventHandler) Delete(ctx context.Context, evt event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+// Generic enqueues a request
+func (r *VLANIndexTemplateEventHandler) Generic(ctx context.Context, evt event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+func (r *VLANIndexTemplateEventHandler) add(ctx context.Context, obj client.Object, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	log := log.FromContext(ctx)
+
+	opts := []client.ListOption{
+		client.InNamespace(obj.GetNamespace()),
+	}
+	templates := &vlanbev1alpha1.VLANIndexTemplateList{}
+	if err := r.Client.List(ctx, templates, opts...); err != nil {
+		log.Error("cannot list object", "error", err)
+		return
+	}
+	for _, template := range templates.Items {
+		if template.Spec.Kind != r.Kind {
+			continue
+		}
+		key := types.NamespacedName{
+			Namespace: template.GetNamespace(),
+			Name:      template.GetName()}
+		log.Info("event requeue", "key", key.String())
+		queue.Add(reconcile.Request{NamespacedName: key})
+	}
+}
+
+// VLANIndexTemplateIndexEventHandler enqueues the vlanIndexTemplate the index was created from
+type VLANIndexTemplateIndexEventHandler struct{}
+
+// Create enqueues a request
+func (r *VLANIndexTemplateIndexEventHandler) Create(ctx context.Context, evt event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+// Update enqueues a request
+func (r *VLANIndexTemplateIndexEventHandler) Update(ctx context.Context, evt event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.ObjectNew, q)
+}
+
+// Delete enqueues a request
+func (r *VLANIndexTemplateIndexEventHandler) Delete(ctx context.Context, evt event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+// Generic enqueues a request
+func (r *VLANIndexTemplateIndexEventHandler) Generic(ctx context.Context, evt event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	r.add(ctx, evt.Object, q)
+}
+
+func (r *VLANIndexTemplateIndexEventHandler) add(ctx context.Context, obj client.Object, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	template, ok := obj.GetLabels()[backend.KuidIndexTemplateKey]
+	if !ok || template == "" {
+		return
+	}
+	log := log.FromContext(ctx)
+
+	key := types.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      template}
+	log.Info("event requeue", "key", key.String())
+	queue.Add(reconcile.Request{NamespacedName: key})
+}
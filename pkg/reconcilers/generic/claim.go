@@ -0,0 +1,110 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generic
+
+import (
+	"context"
+	"strings"
+
+	"github.com/henderiw/logger/log"
+	"github.com/kuidio/kuid/pkg/reconcilers"
+	"github.com/kuidio/kuid/pkg/reconcilers/ctrlconfig"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+type claimReconciler[T Object, I runtime.Object] struct {
+	reconciler[T, I]
+}
+
+// NewClaimReconciler returns a reconciler that claims the claim of the group in the
+// backend and releases it when the claim is deleted
+func NewClaimReconciler[T Object, I runtime.Object](types Types[T, I]) reconcilers.Reconciler {
+	return &claimReconciler[T, I]{
+		reconciler: newReconciler(types),
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *claimReconciler[T, I]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, c any) (map[schema.GroupVersionKind]chan event.GenericEvent, error) {
+	b, err := r.setup(mgr, c)
+	if err != nil {
+		return nil, err
+	}
+	return nil, b.Complete(r)
+}
+
+func (r *claimReconciler[T, I]) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx = ctrlconfig.InitContext(ctx, r.name, req.NamespacedName)
+	log := log.FromContext(ctx)
+	log.Info("reconcile")
+
+	claim, found, err := r.get(ctx, req)
+	if !found {
+		return ctrl.Result{}, err
+	}
+	claimOrig := claim.DeepCopyObject().(T)
+
+	if !claim.GetDeletionTimestamp().IsZero() {
+		intClaim := r.NewInternal()
+		if err := r.ConvertToInternal(claim, intClaim, nil); err != nil {
+			return ctrl.Result{Requeue: true},
+				errors.Wrap(r.handleError(ctx, claimOrig, "cannot convert claim before delete claim", err), errUpdateStatus)
+		}
+
+		if err := r.be.Release(ctx, intClaim, false); err != nil {
+			if !strings.Contains(err.Error(), "not initialized") {
+				return ctrl.Result{Requeue: true},
+					errors.Wrap(r.handleError(ctx, claimOrig, "cannot delete claim", err), errUpdateStatus)
+			}
+		}
+		if err := r.ConvertFromInternal(intClaim, claim, nil); err != nil {
+			return ctrl.Result{Requeue: true},
+				errors.Wrap(r.handleError(ctx, claimOrig, "cannot convert claim after delete claim", err), errUpdateStatus)
+		}
+
+		if err := r.finalizer.RemoveFinalizer(ctx, claim); err != nil {
+			return ctrl.Result{Requeue: true},
+				errors.Wrap(r.handleError(ctx, claimOrig, "cannot delete finalizer", err), errUpdateStatus)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.finalizer.AddFinalizer(ctx, claim); err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, claimOrig, "cannot add finalizer", err), errUpdateStatus)
+	}
+
+	intClaim := r.NewInternal()
+	if err := r.ConvertToInternal(claim, intClaim, nil); err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, claimOrig, "cannot convert claim before claim", err), errUpdateStatus)
+	}
+	if err := r.be.Claim(ctx, intClaim, false); err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, claimOrig, "cannot claim", err), errUpdateStatus)
+	}
+	if err := r.ConvertFromInternal(intClaim, claim, nil); err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, claimOrig, "cannot convert claim after claim", err), errUpdateStatus)
+	}
+
+	return ctrl.Result{}, errors.Wrap(r.handleSuccess(ctx, claimOrig, nil), errUpdateStatus)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generic
+
+import (
+	"context"
+
+	"github.com/henderiw/logger/log"
+	"github.com/kuidio/kuid/pkg/reconcilers"
+	"github.com/kuidio/kuid/pkg/reconcilers/ctrlconfig"
+	"github.com/kuidio/kuid/pkg/reconcilers/resource"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// IndexOptions defines the optional group specific steps of the index reconciler
+type IndexOptions[T Object, I runtime.Object] struct {
+	// Prepare prepares the internal index before it is created in the backend, e.g. to
+	// validate or resolve the fields that depend on other resources
+	Prepare func(ctx context.Context, c client.Client, index I) error
+	// SetStatus sets the status of the versioned index from the created internal index
+	SetStatus func(index T, intIndex I)
+}
+
+type indexReconciler[T Object, I runtime.Object] struct {
+	reconciler[T, I]
+	opts IndexOptions[T, I]
+}
+
+// NewIndexReconciler returns a reconciler that creates the index of the group in the
+// backend and deletes it from the backend when the index is deleted
+func NewIndexReconciler[T Object, I runtime.Object](types Types[T, I], opts IndexOptions[T, I]) reconcilers.Reconciler {
+	return &indexReconciler[T, I]{
+		reconciler: newReconciler(types),
+		opts:       opts,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *indexReconciler[T, I]) SetupWithManager(ctx context.Context, mgr ctrl.Manager, c any) (map[schema.GroupVersionKind]chan event.GenericEvent, error) {
+	b, err := r.setup(mgr, c)
+	if err != nil {
+		return nil, err
+	}
+	return nil, b.Complete(r)
+}
+
+func (r *indexReconciler[T, I]) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx = ctrlconfig.InitContext(ctx, r.name, req.NamespacedName)
+	log := log.FromContext(ctx)
+	log.Info("reconcile")
+
+	index, found, err := r.get(ctx, req)
+	if !found {
+		return ctrl.Result{}, err
+	}
+	indexOrig := index.DeepCopyObject().(T)
+
+	if !index.GetDeletionTimestamp().IsZero() {
+		// Prefixes are not to be deleted as the sync delete index takes care and garbage collector
+		// takes care of this
+		intIndex := r.NewInternal()
+		if err := r.ConvertToInternal(index, intIndex, nil); err != nil {
+			return ctrl.Result{Requeue: true},
+				errors.Wrap(r.handleError(ctx, indexOrig, "cannot convert index before delete", err), errUpdateStatus)
+		}
+		if err := r.be.DeleteIndex(ctx, intIndex); err != nil {
+			if resource.IgnoreNotFound(err) != nil {
+				return ctrl.Result{Requeue: true},
+					errors.Wrap(r.handleError(ctx, indexOrig, "cannot delete index", err), errUpdateStatus)
+			}
+		}
+		if err := r.ConvertFromInternal(intIndex, index, nil); err != nil {
+			return ctrl.Result{Requeue: true},
+				errors.Wrap(r.handleError(ctx, indexOrig, "cannot convert index after delete", err), errUpdateStatus)
+		}
+
+		// We use owner reference so the k8s garbage collector takes care of the cleanup
+		if err := r.finalizer.RemoveFinalizer(ctx, index); err != nil {
+			return ctrl.Result{Requeue: true},
+				errors.Wrap(r.handleError(ctx, indexOrig, "cannot remove finalizer", err), errUpdateStatus)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.finalizer.AddFinalizer(ctx, index); err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, indexOrig, "cannot add finalizer", err), errUpdateStatus)
+	}
+
+	// create index
+	intIndex := r.NewInternal()
+	if err := r.ConvertToInternal(index, intIndex, nil); err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, indexOrig, "cannot convert index before create", err), errUpdateStatus)
+	}
+	if r.opts.Prepare != nil {
+		if err := r.opts.Prepare(ctx, r.Client, intIndex); err != nil {
+			return ctrl.Result{Requeue: true},
+				errors.Wrap(r.handleError(ctx, indexOrig, "cannot prepare index", err), errUpdateStatus)
+		}
+	}
+	if err := r.be.CreateIndex(ctx, intIndex); err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, indexOrig, "cannot apply index", err), errUpdateStatus)
+	}
+	if err := r.ConvertFromInternal(intIndex, index, nil); err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, indexOrig, "cannot convert index after create", err), errUpdateStatus)
+	}
+
+	// updating the index is taken care of by the createIndex code
+
+	var setStatus func(T)
+	if r.opts.SetStatus != nil {
+		setStatus = func(index T) { r.opts.SetStatus(index, intIndex) }
+	}
+	return ctrl.Result{}, errors.Wrap(r.handleSuccess(ctx, indexOrig, setStatus), errUpdateStatus)
+}
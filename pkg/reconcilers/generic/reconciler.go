@@ -0,0 +1,153 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generic
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/henderiw/logger/log"
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	"github.com/kuidio/kuid/pkg/backend"
+	"github.com/kuidio/kuid/pkg/reconcilers/ctrlconfig"
+	"github.com/kuidio/kuid/pkg/reconcilers/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// errors
+	errGetCr        = "cannot get cr"
+	errUpdateStatus = "cannot update status"
+)
+
+// Object is the versioned index or claim a reconciler acts on
+type Object interface {
+	client.Object
+	SetConditions(c ...condv1alpha1.Condition)
+}
+
+// Types defines the versioned type T and the internal type I of the index or claim of
+// a backend group and the conversions between them
+type Types[T Object, I runtime.Object] struct {
+	// Group is the group of the backend the objects belong to
+	Group string
+	// Kind is the kind of the versioned object
+	Kind string
+	// New returns a new versioned object
+	New func() T
+	// NewInternal returns a new internal object
+	NewInternal func() I
+	// ConvertToInternal converts the versioned object to the internal object
+	ConvertToInternal func(in T, out I, s conversion.Scope) error
+	// ConvertFromInternal converts the internal object to the versioned object
+	ConvertFromInternal func(in I, out T, s conversion.Scope) error
+}
+
+// reconciler holds the parts that are shared by the index and claim reconcilers
+type reconciler[T Object, I runtime.Object] struct {
+	client.Client
+	Types[T, I]
+	name      string
+	finalizer *resource.APIFinalizer
+	recorder  record.EventRecorder
+	be        backend.Backend
+}
+
+func newReconciler[T Object, I runtime.Object](types Types[T, I]) reconciler[T, I] {
+	return reconciler[T, I]{
+		Types: types,
+		name:  fmt.Sprintf("%sController", types.Kind),
+	}
+}
+
+// setup initializes the reconciler and returns the builder of the controller
+func (r *reconciler[T, I]) setup(mgr ctrl.Manager, c any) (*ctrl.Builder, error) {
+	cfg, ok := c.(*ctrlconfig.ControllerConfig)
+	if !ok {
+		return nil, fmt.Errorf("cannot initialize, expecting controllerConfig, got: %s", reflect.TypeOf(c).Name())
+	}
+
+	r.Client = mgr.GetClient()
+	r.finalizer = resource.NewAPIFinalizer(mgr.GetClient(), fmt.Sprintf("%s.%s/finalizer", strings.ToLower(r.Kind), r.Group), r.name)
+	r.recorder = mgr.GetEventRecorderFor(r.name)
+	r.be = cfg.Backends[r.Group]
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(r.name).
+		For(r.New()), nil
+}
+
+// get returns the object of the request, found is false when the object no longer exists
+func (r *reconciler[T, I]) get(ctx context.Context, req ctrl.Request) (obj T, found bool, err error) {
+	log := log.FromContext(ctx)
+	obj = r.New()
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		// if the resource no longer exists the reconcile loop is done
+		if resource.IgnoreNotFound(err) != nil {
+			log.Error(errGetCr, "error", err)
+			return obj, false, errors.Wrap(resource.IgnoreNotFound(err), errGetCr)
+		}
+		return obj, false, nil
+	}
+	return obj, true, nil
+}
+
+// handleSuccess sets the ready condition on the object, setStatus is an optional function
+// that updates the status of the object before it is patched
+func (r *reconciler[T, I]) handleSuccess(ctx context.Context, obj T, setStatus func(T)) error {
+	// take a snapshot of the current object
+	patch := client.MergeFrom(obj.DeepCopyObject().(T))
+	// update status
+	if setStatus != nil {
+		setStatus(obj)
+	}
+	obj.SetConditions(condv1alpha1.Ready())
+	r.recorder.Eventf(obj, corev1.EventTypeNormal, r.Kind, "ready")
+
+	return r.Client.Status().Patch(ctx, obj, patch, &client.SubResourcePatchOptions{
+		PatchOptions: client.PatchOptions{
+			FieldManager: "backend",
+		},
+	})
+}
+
+func (r *reconciler[T, I]) handleError(ctx context.Context, obj T, msg string, err error) error {
+	log := log.FromContext(ctx)
+	// take a snapshot of the current object
+	patch := client.MergeFrom(obj.DeepCopyObject().(T))
+
+	if err != nil {
+		msg = fmt.Sprintf("%s err %s", msg, err.Error())
+	}
+	obj.SetConditions(condv1alpha1.Failed(msg))
+	log.Error(msg)
+	r.recorder.Eventf(obj, corev1.EventTypeWarning, r.Kind, msg)
+
+	return r.Client.Status().Patch(ctx, obj, patch, &client.SubResourcePatchOptions{
+		PatchOptions: client.PatchOptions{
+			FieldManager: "backend",
+		},
+	})
+}
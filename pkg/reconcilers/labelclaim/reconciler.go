@@ -0,0 +1,35 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package labelclaim
+
+import (
+	"github.com/kuidio/kuid/apis/backend/label"
+	labelbev1alpha1 "github.com/kuidio/kuid/apis/backend/label/v1alpha1"
+	"github.com/kuidio/kuid/pkg/reconcilers"
+	"github.com/kuidio/kuid/pkg/reconcilers/generic"
+)
+
+func init() {
+	reconcilers.Register(label.GroupName, labelbev1alpha1.LabelClaimKind, generic.NewClaimReconciler(generic.Types[*labelbev1alpha1.LabelClaim, *label.LabelClaim]{
+		Group:               labelbev1alpha1.SchemeGroupVersion.Group,
+		Kind:                labelbev1alpha1.LabelClaimKind,
+		New:                 func() *labelbev1alpha1.LabelClaim { return &labelbev1alpha1.LabelClaim{} },
+		NewInternal:         func() *label.LabelClaim { return &label.LabelClaim{} },
+		ConvertToInternal:   labelbev1alpha1.Convert_v1alpha1_LabelClaim_To_label_LabelClaim,
+		ConvertFromInternal: labelbev1alpha1.Convert_label_LabelClaim_To_v1alpha1_LabelClaim,
+	}))
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package labelindex
+
+import (
+	"context"
+
+	"github.com/kuidio/kuid/apis/backend/label"
+	labelbev1alpha1 "github.com/kuidio/kuid/apis/backend/label/v1alpha1"
+	"github.com/kuidio/kuid/pkg/reconcilers"
+	"github.com/kuidio/kuid/pkg/reconcilers/generic"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	reconcilers.Register(label.GroupName, labelbev1alpha1.LabelIndexKind, generic.NewIndexReconciler(generic.Types[*labelbev1alpha1.LabelIndex, *label.LabelIndex]{
+		Group:               labelbev1alpha1.SchemeGroupVersion.Group,
+		Kind:                labelbev1alpha1.LabelIndexKind,
+		New:                 func() *labelbev1alpha1.LabelIndex { return &labelbev1alpha1.LabelIndex{} },
+		NewInternal:         func() *label.LabelIndex { return &label.LabelIndex{} },
+		ConvertToInternal:   labelbev1alpha1.Convert_v1alpha1_LabelIndex_To_label_LabelIndex,
+		ConvertFromInternal: labelbev1alpha1.Convert_label_LabelIndex_To_v1alpha1_LabelIndex,
+	}, generic.IndexOptions[*labelbev1alpha1.LabelIndex, *label.LabelIndex]{
+		Prepare: validateDomain,
+	}))
+}
+
+// validateDomain validates the SRGB of the index is consistent with the SRGB of the other
+// indexes in the namespace and segment routing domain of the index
+func validateDomain(ctx context.Context, c client.Client, index *label.LabelIndex) error {
+	if index.Spec.Domain == nil {
+		return nil
+	}
+	indexList := &labelbev1alpha1.LabelIndexList{}
+	if err := c.List(ctx, indexList, client.InNamespace(index.GetNamespace())); err != nil {
+		return err
+	}
+	indexes := make([]*label.LabelIndex, 0, len(indexList.Items))
+	for i := range indexList.Items {
+		intIndex := &label.LabelIndex{}
+		if err := labelbev1alpha1.Convert_v1alpha1_LabelIndex_To_label_LabelIndex(&indexList.Items[i], intIndex, nil); err != nil {
+			return err
+		}
+		indexes = append(indexes, intIndex)
+	}
+	return errors.Wrap(index.ValidateDomain(indexes), "invalid srgb domain")
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platformprofile
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/kuidio/kuid/apis/backend"
+	idv1alpha1 "github.com/kuidio/kuid/apis/id/v1alpha1"
+	"github.com/kuidio/kuid/apis/infra"
+	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// expand returns the moduleBays, modules, ports, adaptors and endpoints of the node
+// as described by the platformProfile
+func expand(node *infrav1alpha1.Node, profile *infrav1alpha1.PlatformProfile) []client.Object {
+	objs := []client.Object{}
+	objs = append(objs, expandPorts(node, profile, nil, nil, profile.Spec.Ports)...)
+
+	for _, moduleBayProfile := range profile.Spec.ModuleBays {
+		moduleBay := moduleBayProfile.ModuleBay
+		objs = append(objs, &infrav1alpha1.ModuleBay{
+			TypeMeta:   metav1.TypeMeta{APIVersion: infrav1alpha1.SchemeGroupVersion.Identifier(), Kind: infrav1alpha1.ModuleBayKind},
+			ObjectMeta: objectMeta(node, profile, fmt.Sprintf("%s-mb%d", node.GetName(), moduleBay)),
+			Spec: infrav1alpha1.ModuleBaySpec{
+				PartitionNodeID: node.Spec.PartitionNodeID,
+				Position:        moduleBay,
+			},
+		})
+		if moduleBayProfile.Module != nil {
+			objs = append(objs, &infrav1alpha1.Module{
+				TypeMeta:   metav1.TypeMeta{APIVersion: infrav1alpha1.SchemeGroupVersion.Identifier(), Kind: infrav1alpha1.ModuleKind},
+				ObjectMeta: objectMeta(node, profile, fmt.Sprintf("%s-mb%d-m%d", node.GetName(), moduleBay, *moduleBayProfile.Module)),
+				Spec: infrav1alpha1.ModuleSpec{
+					PartitionNodeID: node.Spec.PartitionNodeID,
+					ModuleBay:       moduleBay,
+				},
+			})
+		}
+		objs = append(objs, expandPorts(node, profile, ptr.To(moduleBay), moduleBayProfile.Module, moduleBayProfile.Ports)...)
+	}
+	return objs
+}
+
+func expandPorts(node *infrav1alpha1.Node, profile *infrav1alpha1.PlatformProfile, moduleBay, module *uint32, portProfiles []infrav1alpha1.PortProfile) []client.Object {
+	objs := []client.Object{}
+	for _, portProfile := range portProfiles {
+		ports := infra.PortProfile{Start: portProfile.Start, Count: portProfile.Count}.GetPorts()
+		for _, port := range ports {
+			portID := idv1alpha1.PartitionPortID{
+				PartitionNodeID: node.Spec.PartitionNodeID,
+				ModuleBay:       moduleBay,
+				Module:          module,
+				Port:            port,
+			}
+			portName := getPortName(node.GetName(), moduleBay, module, port)
+			objs = append(objs, &infrav1alpha1.Port{
+				TypeMeta:   metav1.TypeMeta{APIVersion: infrav1alpha1.SchemeGroupVersion.Identifier(), Kind: infrav1alpha1.PortKind},
+				ObjectMeta: objectMeta(node, profile, portName),
+				Spec: infrav1alpha1.PortSpec{
+					PartitionPortID: portID,
+				},
+			})
+
+			endpoints := uint32(1)
+			speed := portProfile.Speed
+			var adaptor *string
+			if portProfile.Breakout != nil {
+				endpoints = portProfile.Breakout.Endpoints
+				if portProfile.Breakout.Speed != nil {
+					speed = portProfile.Breakout.Speed
+				}
+				adaptor = ptr.To(portProfile.Breakout.Adaptor)
+				objs = append(objs, &infrav1alpha1.Adaptor{
+					TypeMeta:   metav1.TypeMeta{APIVersion: infrav1alpha1.SchemeGroupVersion.Identifier(), Kind: infrav1alpha1.AdaptorKind},
+					ObjectMeta: objectMeta(node, profile, fmt.Sprintf("%s-%s", portName, strings.ToLower(*adaptor))),
+					Spec: infrav1alpha1.AdaptorSpec{
+						PartitionAdaptorID: idv1alpha1.PartitionAdaptorID{
+							PartitionPortID: portID,
+							Adaptor:         *adaptor,
+						},
+					},
+				})
+			}
+			for ep := uint32(1); ep <= endpoints; ep++ {
+				objs = append(objs, &infrav1alpha1.Endpoint{
+					TypeMeta:   metav1.TypeMeta{APIVersion: infrav1alpha1.SchemeGroupVersion.Identifier(), Kind: infrav1alpha1.EndpointKind},
+					ObjectMeta: objectMeta(node, profile, fmt.Sprintf("%s-e%d", portName, ep)),
+					Spec: infrav1alpha1.EndpointSpec{
+						PartitionEndpointID: idv1alpha1.PartitionEndpointID{
+							PartitionNodeID: node.Spec.PartitionNodeID,
+							ModuleBay:       moduleBay,
+							Module:          module,
+							Port:            port,
+							Adaptor:         adaptor,
+							Endpoint:        ep,
+						},
+						Speed:       speed,
+						VLANTagging: portProfile.VLANTagging,
+					},
+				})
+			}
+		}
+	}
+	return objs
+}
+
+// getPortName returns the name of the port resource
+// <node>-p<port> for fixed ports
+// <node>-mb<moduleBay>-p<port> for ports attached to a moduleBay
+// <node>-mb<moduleBay>-m<module>-p<port> for ports of a module
+func getPortName(node string, moduleBay, module *uint32, port uint32) string {
+	var sb strings.Builder
+	sb.WriteString(node)
+	if moduleBay != nil {
+		sb.WriteString(fmt.Sprintf("-mb%d", *moduleBay))
+	}
+	if module != nil {
+		sb.WriteString(fmt.Sprintf("-m%d", *module))
+	}
+	sb.WriteString(fmt.Sprintf("-p%d", port))
+	return sb.String()
+}
+
+func objectMeta(node *infrav1alpha1.Node, profile *infrav1alpha1.PlatformProfile, name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: node.GetNamespace(),
+		Labels: map[string]string{
+			backend.KuidINVNodeKey:            node.GetName(),
+			backend.KuidINVPlatformProfileKey: profile.GetName(),
+		},
+		OwnerReferences: []metav1.OwnerReference{
+			{
+				APIVersion: infrav1alpha1.SchemeGroupVersion.Identifier(),
+				Kind:       infrav1alpha1.NodeKind,
+				Name:       node.GetName(),
+				UID:        node.GetUID(),
+				Controller: ptr.To(true),
+			},
+		},
+	}
+}
+
+// update copies the spec and the generated labels of the desired object into the existing object,
+// it returns true when the existing object was changed
+func update(existing, desired client.Object) bool {
+	changed := false
+	labels := existing.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for k, v := range desired.GetLabels() {
+		if labels[k] != v {
+			labels[k] = v
+			changed = true
+		}
+	}
+	existing.SetLabels(labels)
+
+	existingSpec := reflect.ValueOf(existing).Elem().FieldByName("Spec")
+	desiredSpec := reflect.ValueOf(desired).Elem().FieldByName("Spec")
+	if !apiequality.Semantic.DeepEqual(existingSpec.Interface(), desiredSpec.Interface()) {
+		existingSpec.Set(desiredSpec)
+		changed = true
+	}
+	return changed
+}
+
+func getItems(list client.ObjectList) []client.Object {
+	objs := []client.Object{}
+	items := reflect.ValueOf(list).Elem().FieldByName("Items")
+	for i := 0; i < items.Len(); i++ {
+		objs = append(objs, items.Index(i).Addr().Interface().(client.Object))
+	}
+	return objs
+}
+
+func objectKey(obj client.Object) string {
+	return fmt.Sprintf("%s.%s", reflect.TypeOf(obj).Elem().Name(), obj.GetName())
+}
+
+func isOwnedBy(obj client.Object, node *infrav1alpha1.Node) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == node.GetUID() {
+			return true
+		}
+	}
+	return false
+}
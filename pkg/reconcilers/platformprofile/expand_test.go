@@ -0,0 +1,183 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platformprofile
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kuidio/kuid/apis/backend"
+	idv1alpha1 "github.com/kuidio/kuid/apis/id/v1alpha1"
+	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func testNode() *infrav1alpha1.Node {
+	return &infrav1alpha1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "leaf1", Namespace: "default", UID: "node-uid"},
+		Spec: infrav1alpha1.NodeSpec{
+			PartitionNodeID: idv1alpha1.PartitionNodeID{Partition: "dc1", Node: "leaf1"},
+		},
+	}
+}
+
+func testProfile(spec infrav1alpha1.PlatformProfileSpec) *infrav1alpha1.PlatformProfile {
+	return &infrav1alpha1.PlatformProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "ixr", Namespace: "default"},
+		Spec:       spec,
+	}
+}
+
+func objectKeys(objs []client.Object) []string {
+	keys := make([]string, 0, len(objs))
+	for _, obj := range objs {
+		keys = append(keys, objectKey(obj))
+	}
+	return keys
+}
+
+func TestExpand(t *testing.T) {
+	cases := map[string]struct {
+		spec infrav1alpha1.PlatformProfileSpec
+		want []string
+	}{
+		"FixedPorts": {
+			spec: infrav1alpha1.PlatformProfileSpec{
+				Ports: []infrav1alpha1.PortProfile{{Count: 2}},
+			},
+			want: []string{
+				"Port.leaf1-p1", "Endpoint.leaf1-p1-e1",
+				"Port.leaf1-p2", "Endpoint.leaf1-p2-e1",
+			},
+		},
+		"PortStart": {
+			spec: infrav1alpha1.PlatformProfileSpec{
+				Ports: []infrav1alpha1.PortProfile{{Start: ptr.To[uint32](49), Count: 1}},
+			},
+			want: []string{"Port.leaf1-p49", "Endpoint.leaf1-p49-e1"},
+		},
+		"Breakout": {
+			spec: infrav1alpha1.PlatformProfileSpec{
+				Ports: []infrav1alpha1.PortProfile{{
+					Count:    1,
+					Breakout: &infrav1alpha1.BreakoutProfile{Adaptor: "QSFP28-4x25G", Endpoints: 2},
+				}},
+			},
+			want: []string{
+				"Port.leaf1-p1", "Adaptor.leaf1-p1-qsfp28-4x25g",
+				"Endpoint.leaf1-p1-e1", "Endpoint.leaf1-p1-e2",
+			},
+		},
+		"ModuleBays": {
+			spec: infrav1alpha1.PlatformProfileSpec{
+				ModuleBays: []infrav1alpha1.ModuleBayProfile{
+					{ModuleBay: 1, Module: ptr.To[uint32](1), Ports: []infrav1alpha1.PortProfile{{Count: 1}}},
+					{ModuleBay: 2, Ports: []infrav1alpha1.PortProfile{{Count: 1}}},
+				},
+			},
+			want: []string{
+				"ModuleBay.leaf1-mb1", "Module.leaf1-mb1-m1",
+				"Port.leaf1-mb1-m1-p1", "Endpoint.leaf1-mb1-m1-p1-e1",
+				"ModuleBay.leaf1-mb2",
+				"Port.leaf1-mb2-p1", "Endpoint.leaf1-mb2-p1-e1",
+			},
+		},
+		"Empty": {
+			spec: infrav1alpha1.PlatformProfileSpec{},
+			want: []string{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			objs := expand(testNode(), testProfile(tc.spec))
+			if diff := cmp.Diff(tc.want, objectKeys(objs)); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandEndpoint(t *testing.T) {
+	profile := testProfile(infrav1alpha1.PlatformProfileSpec{
+		Ports: []infrav1alpha1.PortProfile{{
+			Count:       1,
+			Speed:       ptr.To("100"),
+			VLANTagging: true,
+			Breakout:    &infrav1alpha1.BreakoutProfile{Adaptor: "QSFP28-4x25G", Endpoints: 1, Speed: ptr.To("25")},
+		}},
+	})
+	node := testNode()
+
+	var ep *infrav1alpha1.Endpoint
+	for _, obj := range expand(node, profile) {
+		if e, ok := obj.(*infrav1alpha1.Endpoint); ok {
+			ep = e
+		}
+	}
+	if ep == nil {
+		t.Fatalf("expected an endpoint")
+	}
+	want := infrav1alpha1.EndpointSpec{
+		PartitionEndpointID: idv1alpha1.PartitionEndpointID{
+			PartitionNodeID: node.Spec.PartitionNodeID,
+			Port:            1,
+			Adaptor:         ptr.To("QSFP28-4x25G"),
+			Endpoint:        1,
+		},
+		Speed:       ptr.To("25"),
+		VLANTagging: true,
+	}
+	if diff := cmp.Diff(want, ep.Spec); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+	wantLabels := map[string]string{
+		backend.KuidINVNodeKey:            "leaf1",
+		backend.KuidINVPlatformProfileKey: "ixr",
+	}
+	if diff := cmp.Diff(wantLabels, ep.GetLabels()); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+	if !isOwnedBy(ep, node) {
+		t.Errorf("expected the endpoint to be owned by the node")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	existing := &infrav1alpha1.Port{
+		ObjectMeta: metav1.ObjectMeta{Name: "leaf1-p1", Labels: map[string]string{"user": "label"}},
+		Spec:       infrav1alpha1.PortSpec{PartitionPortID: idv1alpha1.PartitionPortID{Port: 2}},
+	}
+	desired := &infrav1alpha1.Port{
+		ObjectMeta: metav1.ObjectMeta{Name: "leaf1-p1", Labels: map[string]string{backend.KuidINVNodeKey: "leaf1"}},
+		Spec:       infrav1alpha1.PortSpec{PartitionPortID: idv1alpha1.PartitionPortID{Port: 1}},
+	}
+	if !update(existing, desired) {
+		t.Fatalf("expected the port to change")
+	}
+	if diff := cmp.Diff(desired.Spec, existing.Spec); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"user": "label", backend.KuidINVNodeKey: "leaf1"}, existing.GetLabels()); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+	if update(existing, desired) {
+		t.Errorf("expected no change on the second update")
+	}
+}
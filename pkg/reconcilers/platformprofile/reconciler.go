@@ -0,0 +1,235 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platformprofile
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/henderiw/logger/log"
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/infra"
+	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
+	"github.com/kuidio/kuid/pkg/reconcilers"
+	"github.com/kuidio/kuid/pkg/reconcilers/ctrlconfig"
+	"github.com/kuidio/kuid/pkg/reconcilers/eventhandler"
+	"github.com/kuidio/kuid/pkg/reconcilers/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func init() {
+	reconcilers.Register(infra.GroupName, infrav1alpha1.PlatformProfileKind, &reconciler{})
+}
+
+const (
+	reconcilerName = "PlatformProfileController"
+	// errors
+	errGetCr        = "cannot get cr"
+	errUpdateStatus = "cannot update status"
+)
+
+// SetupWithManager sets up the controller with the Manager.
+// The controller reconciles nodes and expands them in the resources described
+// by the platformProfile matching the provider and platformType of the node.
+func (r *reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, c interface{}) (map[schema.GroupVersionKind]chan event.GenericEvent, error) {
+	if _, ok := c.(*ctrlconfig.ControllerConfig); !ok {
+		return nil, fmt.Errorf("cannot initialize, expecting controllerConfig, got: %s", reflect.TypeOf(c).Name())
+	}
+
+	r.Client = mgr.GetClient()
+	r.recorder = mgr.GetEventRecorderFor(reconcilerName)
+
+	return nil, ctrl.NewControllerManagedBy(mgr).
+		Named(reconcilerName).
+		For(&infrav1alpha1.Node{}).
+		Owns(&infrav1alpha1.ModuleBay{}).
+		Owns(&infrav1alpha1.Module{}).
+		Owns(&infrav1alpha1.Port{}).
+		Owns(&infrav1alpha1.Adaptor{}).
+		Owns(&infrav1alpha1.Endpoint{}).
+		Watches(&infrav1alpha1.PlatformProfile{},
+			&eventhandler.PlatformProfileEventHandler{
+				Client: mgr.GetClient(),
+			}).
+		Complete(r)
+}
+
+type reconciler struct {
+	client.Client
+	recorder record.EventRecorder
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx = ctrlconfig.InitContext(ctx, reconcilerName, req.NamespacedName)
+	log := log.FromContext(ctx)
+	log.Info("reconcile")
+
+	node := &infrav1alpha1.Node{}
+	if err := r.Get(ctx, req.NamespacedName, node); err != nil {
+		// if the resource no longer exists the reconcile loop is done
+		if resource.IgnoreNotFound(err) != nil {
+			log.Error(errGetCr, "error", err)
+			return ctrl.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetCr)
+		}
+		return ctrl.Result{}, nil
+	}
+	nodeOrig := node.DeepCopy()
+
+	if !node.GetDeletionTimestamp().IsZero() {
+		// We use owner reference so the k8s garbage collector takes care of the cleanup
+		return ctrl.Result{}, nil
+	}
+
+	profile, err := r.getPlatformProfile(ctx, node)
+	if err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, nodeOrig, "cannot get platformProfile", err), errUpdateStatus)
+	}
+	desired := []client.Object{}
+	if profile != nil {
+		desired = expand(node, profile)
+	}
+
+	for _, obj := range desired {
+		if err := r.apply(ctx, obj); err != nil {
+			return ctrl.Result{Requeue: true},
+				errors.Wrap(r.handleError(ctx, nodeOrig, fmt.Sprintf("cannot apply %s %s", reflect.TypeOf(obj).Elem().Name(), obj.GetName()), err), errUpdateStatus)
+		}
+	}
+	if err := r.prune(ctx, node, desired); err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, nodeOrig, "cannot prune stale resources", err), errUpdateStatus)
+	}
+	if profile == nil {
+		// the node is not managed by a platformProfile, the status is left untouched
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{}, errors.Wrap(r.handleSuccess(ctx, nodeOrig, profile), errUpdateStatus)
+}
+
+// getPlatformProfile returns the platformProfile matching the provider and platformType of the node;
+// nil is returned when no profile matches
+func (r *reconciler) getPlatformProfile(ctx context.Context, node *infrav1alpha1.Node) (*infrav1alpha1.PlatformProfile, error) {
+	profiles := &infrav1alpha1.PlatformProfileList{}
+	if err := r.List(ctx, profiles, client.InNamespace(node.GetNamespace())); err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles.Items {
+		if !profile.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		if profile.Spec.Provider == node.Spec.Provider && profile.Spec.PlatformType == node.Spec.PlatformType {
+			return &profile, nil
+		}
+	}
+	return nil, nil
+}
+
+// apply creates the object if it does not exist or updates the spec and labels
+// when they differ from the desired state
+func (r *reconciler) apply(ctx context.Context, desired client.Object) error {
+	existing := desired.DeepCopyObject().(client.Object)
+	if err := r.Get(ctx, client.ObjectKeyFromObject(desired), existing); err != nil {
+		if resource.IgnoreNotFound(err) != nil {
+			return err
+		}
+		return r.Create(ctx, desired)
+	}
+	if !update(existing, desired) {
+		return nil
+	}
+	return r.Update(ctx, existing)
+}
+
+// prune deletes the resources generated for the node that are no longer part of the desired state
+func (r *reconciler) prune(ctx context.Context, node *infrav1alpha1.Node, desired []client.Object) error {
+	desiredKeys := map[string]struct{}{}
+	for _, obj := range desired {
+		desiredKeys[objectKey(obj)] = struct{}{}
+	}
+
+	opts := []client.ListOption{
+		client.InNamespace(node.GetNamespace()),
+		client.MatchingLabels{backend.KuidINVNodeKey: node.GetName()},
+		client.HasLabels{backend.KuidINVPlatformProfileKey},
+	}
+	for _, list := range []client.ObjectList{
+		&infrav1alpha1.EndpointList{},
+		&infrav1alpha1.AdaptorList{},
+		&infrav1alpha1.PortList{},
+		&infrav1alpha1.ModuleList{},
+		&infrav1alpha1.ModuleBayList{},
+	} {
+		if err := r.List(ctx, list, opts...); err != nil {
+			return err
+		}
+		for _, obj := range getItems(list) {
+			if !isOwnedBy(obj, node) {
+				continue
+			}
+			if _, ok := desiredKeys[objectKey(obj)]; ok {
+				continue
+			}
+			if err := r.Delete(ctx, obj); resource.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *reconciler) handleSuccess(ctx context.Context, node *infrav1alpha1.Node, profile *infrav1alpha1.PlatformProfile) error {
+	// take a snapshot of the current object
+	patch := client.MergeFrom(node.DeepCopy())
+	// update status
+	node.Status.SetConditions(condv1alpha1.Ready())
+	r.recorder.Eventf(node, corev1.EventTypeNormal, infrav1alpha1.NodeKind, "expanded using platformProfile %s", profile.GetName())
+
+	return r.Client.Status().Patch(ctx, node, patch, &client.SubResourcePatchOptions{
+		PatchOptions: client.PatchOptions{
+			FieldManager: reconcilerName,
+		},
+	})
+}
+
+func (r *reconciler) handleError(ctx context.Context, node *infrav1alpha1.Node, msg string, err error) error {
+	log := log.FromContext(ctx)
+	// take a snapshot of the current object
+	patch := client.MergeFrom(node.DeepCopy())
+
+	if err != nil {
+		msg = fmt.Sprintf("%s err %s", msg, err.Error())
+	}
+	node.Status.SetConditions(condv1alpha1.Failed(msg))
+	log.Error(msg)
+	r.recorder.Eventf(node, corev1.EventTypeWarning, infrav1alpha1.NodeKind, msg)
+
+	return r.Client.Status().Patch(ctx, node, patch, &client.SubResourcePatchOptions{
+		PatchOptions: client.PatchOptions{
+			FieldManager: reconcilerName,
+		},
+	})
+}
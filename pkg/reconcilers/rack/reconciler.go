@@ -0,0 +1,206 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rack
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/henderiw/logger/log"
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	"github.com/kuidio/kuid/apis/infra"
+	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
+	"github.com/kuidio/kuid/pkg/reconcilers"
+	"github.com/kuidio/kuid/pkg/reconcilers/ctrlconfig"
+	"github.com/kuidio/kuid/pkg/reconcilers/eventhandler"
+	"github.com/kuidio/kuid/pkg/reconcilers/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func init() {
+	reconcilers.Register(infra.GroupName, infrav1alpha1.RackKind, &reconciler{})
+}
+
+const (
+	reconcilerName = "RackController"
+	// errors
+	errGetCr        = "cannot get cr"
+	errUpdateStatus = "cannot update status"
+)
+
+// SetupWithManager sets up the controller with the Manager.
+// The controller computes the rack elevation from the nodes positioned in the rack.
+func (r *reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, c interface{}) (map[schema.GroupVersionKind]chan event.GenericEvent, error) {
+	if _, ok := c.(*ctrlconfig.ControllerConfig); !ok {
+		return nil, fmt.Errorf("cannot initialize, expecting controllerConfig, got: %s", reflect.TypeOf(c).Name())
+	}
+
+	r.Client = mgr.GetClient()
+	r.recorder = mgr.GetEventRecorderFor(reconcilerName)
+
+	return nil, ctrl.NewControllerManagedBy(mgr).
+		Named(reconcilerName).
+		For(&infrav1alpha1.Rack{}).
+		Watches(&infrav1alpha1.Node{}, &eventhandler.NodeRackEventHandler{}).
+		Complete(r)
+}
+
+type reconciler struct {
+	client.Client
+	recorder record.EventRecorder
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx = ctrlconfig.InitContext(ctx, reconcilerName, req.NamespacedName)
+	log := log.FromContext(ctx)
+	log.Info("reconcile")
+
+	rack := &infrav1alpha1.Rack{}
+	if err := r.Get(ctx, req.NamespacedName, rack); err != nil {
+		// if the resource no longer exists the reconcile loop is done
+		if resource.IgnoreNotFound(err) != nil {
+			log.Error(errGetCr, "error", err)
+			return ctrl.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetCr)
+		}
+		return ctrl.Result{}, nil
+	}
+	rackOrig := rack.DeepCopy()
+
+	if !rack.GetDeletionTimestamp().IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	height := uint32(0)
+	if rack.Spec.Height != "" {
+		var err error
+		if height, err = infra.ParseRackUnits(rack.Spec.Height); err != nil {
+			return ctrl.Result{}, errors.Wrap(r.handleError(ctx, rackOrig, nil, 0, "invalid height", err), errUpdateStatus)
+		}
+	}
+
+	slots, err := r.getRackSlots(ctx, rack)
+	if err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, rackOrig, nil, 0, "cannot list nodes", err), errUpdateStatus)
+	}
+	elevation, used, conflicts := infra.BuildRackElevation(height, slots)
+	if len(conflicts) != 0 {
+		msgs := make([]string, 0, len(conflicts))
+		for _, conflict := range conflicts {
+			msgs = append(msgs, conflict.Error())
+		}
+		return ctrl.Result{}, errors.Wrap(r.handleError(ctx, rackOrig, elevation, used, strings.Join(msgs, "; "), nil), errUpdateStatus)
+	}
+
+	return ctrl.Result{}, errors.Wrap(r.handleSuccess(ctx, rackOrig, elevation, used), errUpdateStatus)
+}
+
+// getRackSlots returns the slots of the nodes positioned in the rack
+func (r *reconciler) getRackSlots(ctx context.Context, rack *infrav1alpha1.Rack) ([]infra.RackSlot, error) {
+	nodes := &infrav1alpha1.NodeList{}
+	if err := r.List(ctx, nodes, client.InNamespace(rack.GetNamespace())); err != nil {
+		return nil, err
+	}
+	return getRackSlots(rack.GetName(), nodes.Items)
+}
+
+// getRackSlots returns the slots of the nodes positioned in the rack,
+// nodes with an invalid position are ignored as they are rejected by the apiserver
+func getRackSlots(rack string, nodes []infrav1alpha1.Node) ([]infra.RackSlot, error) {
+	slots := []infra.RackSlot{}
+	for i := range nodes {
+		if nodes[i].Spec.Rack == nil || *nodes[i].Spec.Rack != rack {
+			continue
+		}
+		node := &infra.Node{}
+		if err := infrav1alpha1.Convert_v1alpha1_Node_To_infra_Node(&nodes[i], node, nil); err != nil {
+			return nil, err
+		}
+		slot, err := node.GetRackSlot()
+		if err != nil || slot == nil {
+			continue
+		}
+		slots = append(slots, *slot)
+	}
+	return slots, nil
+}
+
+// setElevation sets the elevation and the used units of the rack in the status,
+// the free units are only set when the height of the rack is known
+func setElevation(rack *infrav1alpha1.Rack, height uint32, elevation []infra.RackElevationEntry, used uint32) {
+	rack.Status.Elevation = make([]infrav1alpha1.RackElevationEntry, 0, len(elevation))
+	for _, entry := range elevation {
+		rack.Status.Elevation = append(rack.Status.Elevation, infrav1alpha1.RackElevationEntry{
+			Position: entry.Position,
+			Units:    entry.Units,
+			Node:     entry.Node,
+		})
+	}
+	rack.Status.UsedUnits = ptr.To(used)
+	rack.Status.FreeUnits = nil
+	if height != 0 && height >= used {
+		rack.Status.FreeUnits = ptr.To(height - used)
+	}
+}
+
+func (r *reconciler) handleSuccess(ctx context.Context, rack *infrav1alpha1.Rack, elevation []infra.RackElevationEntry, used uint32) error {
+	// take a snapshot of the current object
+	patch := client.MergeFrom(rack.DeepCopy())
+	// update status
+	height, _ := infra.ParseRackUnits(rack.Spec.Height)
+	setElevation(rack, height, elevation, used)
+	rack.Status.SetConditions(condv1alpha1.Ready())
+	r.recorder.Eventf(rack, corev1.EventTypeNormal, infrav1alpha1.RackKind, "ready")
+
+	return r.Client.Status().Patch(ctx, rack, patch, &client.SubResourcePatchOptions{
+		PatchOptions: client.PatchOptions{
+			FieldManager: reconcilerName,
+		},
+	})
+}
+
+func (r *reconciler) handleError(ctx context.Context, rack *infrav1alpha1.Rack, elevation []infra.RackElevationEntry, used uint32, msg string, err error) error {
+	log := log.FromContext(ctx)
+	// take a snapshot of the current object
+	patch := client.MergeFrom(rack.DeepCopy())
+
+	if err != nil {
+		msg = fmt.Sprintf("%s err %s", msg, err.Error())
+	}
+	if elevation != nil {
+		height, _ := infra.ParseRackUnits(rack.Spec.Height)
+		setElevation(rack, height, elevation, used)
+	}
+	rack.Status.SetConditions(condv1alpha1.Failed(msg))
+	log.Error(msg)
+	r.recorder.Eventf(rack, corev1.EventTypeWarning, infrav1alpha1.RackKind, msg)
+
+	return r.Client.Status().Patch(ctx, rack, patch, &client.SubResourcePatchOptions{
+		PatchOptions: client.PatchOptions{
+			FieldManager: reconcilerName,
+		},
+	})
+}
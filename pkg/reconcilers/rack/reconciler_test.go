@@ -0,0 +1,115 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rack
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kuidio/kuid/apis/infra"
+	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func testNode(name string, rack, position *string, units *uint32) infrav1alpha1.Node {
+	return infrav1alpha1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: infrav1alpha1.NodeSpec{
+			Rack:     rack,
+			Position: position,
+			Units:    units,
+		},
+	}
+}
+
+func TestGetRackSlots(t *testing.T) {
+	nodes := []infrav1alpha1.Node{
+		testNode("n1", ptr.To("rack1"), ptr.To("U10"), nil),
+		testNode("n2", ptr.To("rack1"), ptr.To("20"), ptr.To[uint32](2)),
+		testNode("other-rack", ptr.To("rack2"), ptr.To("1"), nil),
+		testNode("no-rack", nil, ptr.To("1"), nil),
+		testNode("no-position", ptr.To("rack1"), nil, nil),
+		testNode("invalid-position", ptr.To("rack1"), ptr.To("x"), nil),
+	}
+	slots, err := getRackSlots("rack1", nodes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []infra.RackSlot{
+		{Node: "n1", Position: 10, Units: 1},
+		{Node: "n2", Position: 20, Units: 2},
+	}
+	if diff := cmp.Diff(want, slots); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+}
+
+func TestSetElevation(t *testing.T) {
+	cases := map[string]struct {
+		height    uint32
+		used      uint32
+		wantFree  *uint32
+		wantUsed  *uint32
+		elevation []infra.RackElevationEntry
+	}{
+		"KnownHeight": {
+			height:    42,
+			used:      3,
+			wantFree:  ptr.To[uint32](39),
+			wantUsed:  ptr.To[uint32](3),
+			elevation: []infra.RackElevationEntry{{Position: 1, Units: 3, Node: "n1"}},
+		},
+		"UnknownHeight": {
+			height:    0,
+			used:      0,
+			wantFree:  nil,
+			wantUsed:  ptr.To[uint32](0),
+			elevation: []infra.RackElevationEntry{},
+		},
+		"UnknownHeightUsed": {
+			height:    0,
+			used:      2,
+			wantFree:  nil,
+			wantUsed:  ptr.To[uint32](2),
+			elevation: []infra.RackElevationEntry{{Position: 1, Units: 2, Node: "n1"}},
+		},
+		"Overcommitted": {
+			height:    2,
+			used:      3,
+			wantFree:  nil,
+			wantUsed:  ptr.To[uint32](3),
+			elevation: []infra.RackElevationEntry{{Position: 1, Units: 3, Node: "n1"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rack := &infrav1alpha1.Rack{}
+			setElevation(rack, tc.height, tc.elevation, tc.used)
+			if diff := cmp.Diff(tc.wantFree, rack.Status.FreeUnits); diff != "" {
+				t.Errorf("freeUnits -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantUsed, rack.Status.UsedUnits); diff != "" {
+				t.Errorf("usedUnits -want, +got:\n%s", diff)
+			}
+			if len(rack.Status.Elevation) != len(tc.elevation) {
+				t.Errorf("want %d elevation entries, got %d", len(tc.elevation), len(rack.Status.Elevation))
+			}
+		})
+	}
+}
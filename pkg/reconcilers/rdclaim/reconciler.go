@@ -0,0 +1,35 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rdclaim
+
+import (
+	"github.com/kuidio/kuid/apis/backend/rd"
+	rdbev1alpha1 "github.com/kuidio/kuid/apis/backend/rd/v1alpha1"
+	"github.com/kuidio/kuid/pkg/reconcilers"
+	"github.com/kuidio/kuid/pkg/reconcilers/generic"
+)
+
+func init() {
+	reconcilers.Register(rd.GroupName, rdbev1alpha1.RDClaimKind, generic.NewClaimReconciler(generic.Types[*rdbev1alpha1.RDClaim, *rd.RDClaim]{
+		Group:               rdbev1alpha1.SchemeGroupVersion.Group,
+		Kind:                rdbev1alpha1.RDClaimKind,
+		New:                 func() *rdbev1alpha1.RDClaim { return &rdbev1alpha1.RDClaim{} },
+		NewInternal:         func() *rd.RDClaim { return &rd.RDClaim{} },
+		ConvertToInternal:   rdbev1alpha1.Convert_v1alpha1_RDClaim_To_rd_RDClaim,
+		ConvertFromInternal: rdbev1alpha1.Convert_rd_RDClaim_To_v1alpha1_RDClaim,
+	}))
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rdindex
+
+import (
+	"context"
+	"fmt"
+
+	asbev1alpha1 "github.com/kuidio/kuid/apis/backend/as/v1alpha1"
+	ipambev1alpha1 "github.com/kuidio/kuid/apis/backend/ipam/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend/rd"
+	rdbev1alpha1 "github.com/kuidio/kuid/apis/backend/rd/v1alpha1"
+	"github.com/kuidio/kuid/pkg/reconcilers"
+	"github.com/kuidio/kuid/pkg/reconcilers/generic"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	reconcilers.Register(rd.GroupName, rdbev1alpha1.RDIndexKind, generic.NewIndexReconciler(generic.Types[*rdbev1alpha1.RDIndex, *rd.RDIndex]{
+		Group:               rdbev1alpha1.SchemeGroupVersion.Group,
+		Kind:                rdbev1alpha1.RDIndexKind,
+		New:                 func() *rdbev1alpha1.RDIndex { return &rdbev1alpha1.RDIndex{} },
+		NewInternal:         func() *rd.RDIndex { return &rd.RDIndex{} },
+		ConvertToInternal:   rdbev1alpha1.Convert_v1alpha1_RDIndex_To_rd_RDIndex,
+		ConvertFromInternal: rdbev1alpha1.Convert_rd_RDIndex_To_v1alpha1_RDIndex,
+	}, generic.IndexOptions[*rdbev1alpha1.RDIndex, *rd.RDIndex]{
+		Prepare: func(ctx context.Context, c client.Client, index *rd.RDIndex) error {
+			return errors.Wrap(resolveAdministrator(ctx, c, index), "cannot resolve administrator")
+		},
+		SetStatus: func(index *rdbev1alpha1.RDIndex, intIndex *rd.RDIndex) {
+			index.Status.Administrator = intIndex.Status.Administrator
+		},
+	}))
+}
+
+// resolveAdministrator derives the administrator of the index from the ipam or as claim
+// in the namespace of the index, once resolved the administrator is kept in the status
+func resolveAdministrator(ctx context.Context, c client.Client, index *rd.RDIndex) error {
+	if index.IsAdministratorResolved() {
+		return nil
+	}
+	from := index.Spec.AdministratorFrom
+	switch {
+	case from.IPClaim != nil:
+		claim := &ipambev1alpha1.IPClaim{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: index.GetNamespace(), Name: *from.IPClaim}, claim); err != nil {
+			return err
+		}
+		return index.SetAdministratorFromAddress(claim.Status.Address)
+	case from.ASClaim != nil:
+		claim := &asbev1alpha1.ASClaim{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: index.GetNamespace(), Name: *from.ASClaim}, claim); err != nil {
+			return err
+		}
+		return index.SetAdministratorFromAS(claim.Status.ID)
+	default:
+		return fmt.Errorf("an ipClaim or asClaim is required")
+	}
+}
@@ -99,6 +99,7 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			return ctrl.Result{Requeue: true},
 				errors.Wrap(r.handleError(ctx, claimOrig, "cannot convert claim before delete claim", err), errUpdateStatus)
 		}
+		intClaim.ResolveIndex()
 
 		if err := r.be.Release(ctx, intClaim, false); err != nil {
 			if !strings.Contains(err.Error(), "not initialized") {
@@ -128,6 +129,8 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{Requeue: true},
 			errors.Wrap(r.handleError(ctx, claimOrig, "cannot convert claim before claim", err), errUpdateStatus)
 	}
+	// a claim without index claims from the index of the index template
+	intClaim.ResolveIndex()
 	if err := r.be.Claim(ctx, intClaim, false); err != nil {
 		return ctrl.Result{Requeue: true},
 			errors.Wrap(r.handleError(ctx, claimOrig, "cannot claim", err), errUpdateStatus)
@@ -0,0 +1,128 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "VLAN IS" BVLANIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vlanindextemplate
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/kuidio/kuid/apis/backend"
+	vlanbev1alpha1 "github.com/kuidio/kuid/apis/backend/vlan/v1alpha1"
+	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// expand returns the index the template describes for the infra resource owner.
+// The index is named <owner>.<template> and is owned by the infra resource such that
+// the garbage collector deletes the index with its owner
+func expand(template *vlanbev1alpha1.VLANIndexTemplate, owner client.Object) *vlanbev1alpha1.VLANIndex {
+	indexLabels := map[string]string{
+		backend.KuidIndexTemplateKey:  template.GetName(),
+		backend.KuidIndexOwnerKindKey: template.Spec.Kind,
+		backend.KuidIndexOwnerNameKey: owner.GetName(),
+	}
+	switch owner := owner.(type) {
+	case *infrav1alpha1.Node:
+		indexLabels[backend.KuidINVNodeKey] = owner.GetName()
+	case *infrav1alpha1.Endpoint:
+		indexLabels[backend.KuidINVNodeKey] = owner.Spec.Node
+	}
+
+	return &vlanbev1alpha1.VLANIndex{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: vlanbev1alpha1.SchemeGroupVersion.Identifier(),
+			Kind:       vlanbev1alpha1.VLANIndexKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      template.GetIndexName(owner.GetName()),
+			Namespace: template.GetNamespace(),
+			Labels:    indexLabels,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: infrav1alpha1.SchemeGroupVersion.Identifier(),
+					Kind:       template.Spec.Kind,
+					Name:       owner.GetName(),
+					UID:        owner.GetUID(),
+				},
+			},
+		},
+		Spec: *template.Spec.Index.DeepCopy(),
+	}
+}
+
+// update copies the spec, the owner references and the template labels of the desired index
+// into the existing index, it returns true when the existing index was changed
+func update(existing, desired *vlanbev1alpha1.VLANIndex) bool {
+	changed := false
+	existingLabels := existing.GetLabels()
+	if existingLabels == nil {
+		existingLabels = map[string]string{}
+	}
+	for k, v := range desired.GetLabels() {
+		if existingLabels[k] != v {
+			existingLabels[k] = v
+			changed = true
+		}
+	}
+	existing.SetLabels(existingLabels)
+
+	// the owner changes when the infra resource is recreated with the same name
+	if !apiequality.Semantic.DeepEqual(existing.GetOwnerReferences(), desired.GetOwnerReferences()) {
+		existing.SetOwnerReferences(desired.GetOwnerReferences())
+		changed = true
+	}
+	if !apiequality.Semantic.DeepEqual(existing.Spec, desired.Spec) {
+		existing.Spec = desired.Spec
+		changed = true
+	}
+	return changed
+}
+
+// newOwnerList returns an empty list of the infra resources of the kind
+func newOwnerList(kind string) (client.ObjectList, error) {
+	switch kind {
+	case infrav1alpha1.NodeKind:
+		return &infrav1alpha1.NodeList{}, nil
+	case infrav1alpha1.EndpointKind:
+		return &infrav1alpha1.EndpointList{}, nil
+	case infrav1alpha1.EndpointSetKind:
+		return &infrav1alpha1.EndpointSetList{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", kind)
+	}
+}
+
+// getSelector returns the label selector of the template, all the resources are selected
+// when the template has no selector
+func getSelector(template *vlanbev1alpha1.VLANIndexTemplate) (labels.Selector, error) {
+	if template.Spec.Selector == nil {
+		return labels.Everything(), nil
+	}
+	return metav1.LabelSelectorAsSelector(template.Spec.Selector)
+}
+
+func getItems(list client.ObjectList) []client.Object {
+	objs := []client.Object{}
+	items := reflect.ValueOf(list).Elem().FieldByName("Items")
+	for i := 0; i < items.Len(); i++ {
+		objs = append(objs, items.Index(i).Addr().Interface().(client.Object))
+	}
+	return objs
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vlanindextemplate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kuidio/kuid/apis/backend"
+	vlanbev1alpha1 "github.com/kuidio/kuid/apis/backend/vlan/v1alpha1"
+	idv1alpha1 "github.com/kuidio/kuid/apis/id/v1alpha1"
+	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func getTemplate(kind string, spec vlanbev1alpha1.VLANIndexSpec) *vlanbev1alpha1.VLANIndexTemplate {
+	return &vlanbev1alpha1.VLANIndexTemplate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "access"},
+		Spec: vlanbev1alpha1.VLANIndexTemplateSpec{
+			Kind: kind,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"node-type": "leaf"},
+			},
+			Index: spec,
+		},
+	}
+}
+
+func getNode(name string, labels map[string]string) *infrav1alpha1.Node {
+	return &infrav1alpha1.Node{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels, UID: types.UID("uid-" + name)},
+	}
+}
+
+func getEndpoint(name, node string) *infrav1alpha1.Endpoint {
+	return &infrav1alpha1.Endpoint{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: types.UID("uid-" + name)},
+		Spec: infrav1alpha1.EndpointSpec{
+			PartitionEndpointID: idv1alpha1.PartitionEndpointID{
+				PartitionNodeID: idv1alpha1.PartitionNodeID{Node: node},
+			},
+		},
+	}
+}
+
+func TestExpand(t *testing.T) {
+	spec := vlanbev1alpha1.VLANIndexSpec{
+		MinID:              ptr.To[uint32](1),
+		MaxID:              ptr.To[uint32](4094),
+		ReserveDefaultVLAN: true,
+		ReservedRanges:     []string{"1002-1005"},
+	}
+
+	cases := map[string]struct {
+		kind       string
+		owner      client.Object
+		wantName   string
+		wantLabels map[string]string
+	}{
+		"Node": {
+			kind:     infrav1alpha1.NodeKind,
+			owner:    getNode("leaf1", nil),
+			wantName: "leaf1.access",
+			wantLabels: map[string]string{
+				backend.KuidIndexTemplateKey:  "access",
+				backend.KuidIndexOwnerKindKey: infrav1alpha1.NodeKind,
+				backend.KuidIndexOwnerNameKey: "leaf1",
+				backend.KuidINVNodeKey:        "leaf1",
+			},
+		},
+		"Endpoint": {
+			kind:     infrav1alpha1.EndpointKind,
+			owner:    getEndpoint("leaf1.e1-1", "leaf1"),
+			wantName: "leaf1.e1-1.access",
+			wantLabels: map[string]string{
+				backend.KuidIndexTemplateKey:  "access",
+				backend.KuidIndexOwnerKindKey: infrav1alpha1.EndpointKind,
+				backend.KuidIndexOwnerNameKey: "leaf1.e1-1",
+				backend.KuidINVNodeKey:        "leaf1",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			template := getTemplate(tc.kind, spec)
+
+			got := expand(template, tc.owner)
+			if diff := cmp.Diff(tc.wantName, got.GetName()); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(namespace, got.GetNamespace()); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantLabels, got.GetLabels()); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			wantOwners := []metav1.OwnerReference{{
+				APIVersion: infrav1alpha1.SchemeGroupVersion.Identifier(),
+				Kind:       tc.kind,
+				Name:       tc.owner.GetName(),
+				UID:        tc.owner.GetUID(),
+			}}
+			if diff := cmp.Diff(wantOwners, got.GetOwnerReferences()); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(spec, got.Spec); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			// the spec of the index is a copy of the template
+			got.Spec.ReservedRanges[0] = "100-200"
+			if diff := cmp.Diff("1002-1005", template.Spec.Index.ReservedRanges[0]); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	template := getTemplate(infrav1alpha1.NodeKind, vlanbev1alpha1.VLANIndexSpec{MaxID: ptr.To[uint32](1000)})
+	desired := expand(template, getNode("leaf1", nil))
+
+	cases := map[string]struct {
+		existing    func() *vlanbev1alpha1.VLANIndex
+		wantChanged bool
+	}{
+		"Equal": {
+			existing:    func() *vlanbev1alpha1.VLANIndex { return desired.DeepCopy() },
+			wantChanged: false,
+		},
+		"Spec": {
+			existing: func() *vlanbev1alpha1.VLANIndex {
+				existing := desired.DeepCopy()
+				existing.Spec.MaxID = ptr.To[uint32](2000)
+				return existing
+			},
+			wantChanged: true,
+		},
+		"Owner": {
+			existing: func() *vlanbev1alpha1.VLANIndex {
+				existing := desired.DeepCopy()
+				existing.OwnerReferences[0].UID = "uid-old"
+				return existing
+			},
+			wantChanged: true,
+		},
+		"Labels": {
+			existing: func() *vlanbev1alpha1.VLANIndex {
+				existing := desired.DeepCopy()
+				existing.Labels = map[string]string{"a": "b"}
+				return existing
+			},
+			wantChanged: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			existing := tc.existing()
+			userLabels := map[string]string{}
+			for k, v := range existing.GetLabels() {
+				if _, ok := desired.GetLabels()[k]; !ok {
+					userLabels[k] = v
+				}
+			}
+
+			changed := update(existing, desired)
+			if diff := cmp.Diff(tc.wantChanged, changed); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(desired.Spec, existing.Spec); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(desired.GetOwnerReferences(), existing.GetOwnerReferences()); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			// the labels that are not set by the template are kept
+			for k, v := range userLabels {
+				if diff := cmp.Diff(v, existing.GetLabels()[k]); diff != "" {
+					t.Errorf("-want, +got:\n%s", diff)
+				}
+			}
+			for k, v := range desired.GetLabels() {
+				if diff := cmp.Diff(v, existing.GetLabels()[k]); diff != "" {
+					t.Errorf("-want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "VLAN IS" BVLANIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vlanindextemplate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/henderiw/logger/log"
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend"
+	"github.com/kuidio/kuid/apis/backend/vlan"
+	vlanbev1alpha1 "github.com/kuidio/kuid/apis/backend/vlan/v1alpha1"
+	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
+	"github.com/kuidio/kuid/pkg/reconcilers"
+	"github.com/kuidio/kuid/pkg/reconcilers/ctrlconfig"
+	"github.com/kuidio/kuid/pkg/reconcilers/eventhandler"
+	"github.com/kuidio/kuid/pkg/reconcilers/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func init() {
+	reconcilers.Register(vlan.GroupName, vlanbev1alpha1.VLANIndexTemplateKind, &reconciler{})
+}
+
+const (
+	reconcilerName = "VLANIndexTemplateController"
+	finalizer      = "vlanindextemplate.vlan.be.kuid.dev/finalizer"
+	// errors
+	errGetCr        = "cannot get cr"
+	errUpdateStatus = "cannot update status"
+)
+
+// SetupWithManager sets up the controller with the Manager.
+// The controller creates a VLANIndex for every infra resource matching the template.
+// The controller is not started when the infra group is not enabled.
+func (r *reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, c interface{}) (map[schema.GroupVersionKind]chan event.GenericEvent, error) {
+	if _, ok := c.(*ctrlconfig.ControllerConfig); !ok {
+		return nil, fmt.Errorf("cannot initialize, expecting controllerConfig, got: %s", reflect.TypeOf(c).Name())
+	}
+	if !mgr.GetScheme().Recognizes(infrav1alpha1.SchemeGroupVersion.WithKind(infrav1alpha1.NodeKind)) {
+		log.FromContext(ctx).Info("infra group not enabled, vlanIndexTemplates are not reconciled")
+		return nil, nil
+	}
+
+	r.Client = mgr.GetClient()
+	r.finalizer = resource.NewAPIFinalizer(mgr.GetClient(), finalizer, reconcilerName)
+	r.recorder = mgr.GetEventRecorderFor(reconcilerName)
+
+	return nil, ctrl.NewControllerManagedBy(mgr).
+		Named(reconcilerName).
+		For(&vlanbev1alpha1.VLANIndexTemplate{}).
+		Watches(&vlanbev1alpha1.VLANIndex{}, &eventhandler.VLANIndexTemplateIndexEventHandler{}).
+		Watches(&infrav1alpha1.Node{}, &eventhandler.VLANIndexTemplateEventHandler{Client: mgr.GetClient(), Kind: infrav1alpha1.NodeKind}).
+		Watches(&infrav1alpha1.Endpoint{}, &eventhandler.VLANIndexTemplateEventHandler{Client: mgr.GetClient(), Kind: infrav1alpha1.EndpointKind}).
+		Watches(&infrav1alpha1.EndpointSet{}, &eventhandler.VLANIndexTemplateEventHandler{Client: mgr.GetClient(), Kind: infrav1alpha1.EndpointSetKind}).
+		Complete(r)
+}
+
+type reconciler struct {
+	client.Client
+	finalizer *resource.APIFinalizer
+	recorder  record.EventRecorder
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx = ctrlconfig.InitContext(ctx, reconcilerName, req.NamespacedName)
+	log := log.FromContext(ctx)
+	log.Info("reconcile")
+
+	template := &vlanbev1alpha1.VLANIndexTemplate{}
+	if err := r.Get(ctx, req.NamespacedName, template); err != nil {
+		// if the resource no longer exists the reconcile loop is done
+		if resource.IgnoreNotFound(err) != nil {
+			log.Error(errGetCr, "error", err)
+			return ctrl.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetCr)
+		}
+		return ctrl.Result{}, nil
+	}
+	templateOrig := template.DeepCopy()
+
+	if !template.GetDeletionTimestamp().IsZero() {
+		// the indexes are owned by the infra resources, the indexes created from the
+		// template are deleted with the template
+		if err := r.prune(ctx, template, nil); err != nil {
+			return ctrl.Result{Requeue: true},
+				errors.Wrap(r.handleError(ctx, templateOrig, "cannot delete indexes", err), errUpdateStatus)
+		}
+		if err := r.finalizer.RemoveFinalizer(ctx, template); err != nil {
+			return ctrl.Result{Requeue: true},
+				errors.Wrap(r.handleError(ctx, templateOrig, "cannot remove finalizer", err), errUpdateStatus)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.finalizer.AddFinalizer(ctx, template); err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, templateOrig, "cannot add finalizer", err), errUpdateStatus)
+	}
+
+	owners, err := r.getOwners(ctx, template)
+	if err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, templateOrig, fmt.Sprintf("cannot list %s resources", template.Spec.Kind), err), errUpdateStatus)
+	}
+	desired := make([]*vlanbev1alpha1.VLANIndex, 0, len(owners))
+	for _, owner := range owners {
+		desired = append(desired, expand(template, owner))
+	}
+	for _, index := range desired {
+		if err := r.apply(ctx, template, index); err != nil {
+			return ctrl.Result{Requeue: true},
+				errors.Wrap(r.handleError(ctx, templateOrig, fmt.Sprintf("cannot apply index %s", index.GetName()), err), errUpdateStatus)
+		}
+	}
+	if err := r.prune(ctx, template, desired); err != nil {
+		return ctrl.Result{Requeue: true},
+			errors.Wrap(r.handleError(ctx, templateOrig, "cannot prune stale indexes", err), errUpdateStatus)
+	}
+
+	return ctrl.Result{}, errors.Wrap(r.handleSuccess(ctx, templateOrig, len(desired)), errUpdateStatus)
+}
+
+// getOwners returns the infra resources of the kind of the template that match the selector
+func (r *reconciler) getOwners(ctx context.Context, template *vlanbev1alpha1.VLANIndexTemplate) ([]client.Object, error) {
+	list, err := newOwnerList(template.Spec.Kind)
+	if err != nil {
+		return nil, err
+	}
+	selector, err := getSelector(template)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.List(ctx, list, client.InNamespace(template.GetNamespace())); err != nil {
+		return nil, err
+	}
+	owners := []client.Object{}
+	for _, obj := range getItems(list) {
+		if !obj.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		if !selector.Matches(labels.Set(obj.GetLabels())) {
+			continue
+		}
+		owners = append(owners, obj)
+	}
+	return owners, nil
+}
+
+// apply creates the index if it does not exist or updates the spec and labels
+// when they differ from the template. An index that was not created from the template
+// is not overwritten
+func (r *reconciler) apply(ctx context.Context, template *vlanbev1alpha1.VLANIndexTemplate, desired *vlanbev1alpha1.VLANIndex) error {
+	existing := &vlanbev1alpha1.VLANIndex{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(desired), existing); err != nil {
+		if resource.IgnoreNotFound(err) != nil {
+			return err
+		}
+		return r.Create(ctx, desired)
+	}
+	if existing.GetLabels()[backend.KuidIndexTemplateKey] != template.GetName() {
+		return fmt.Errorf("index %s exists and is not created from template %s", existing.GetName(), template.GetName())
+	}
+	if !update(existing, desired) {
+		return nil
+	}
+	return r.Update(ctx, existing)
+}
+
+// prune deletes the indexes created from the template that are no longer part of the desired state,
+// e.g. when the infra resource no longer matches the selector
+func (r *reconciler) prune(ctx context.Context, template *vlanbev1alpha1.VLANIndexTemplate, desired []*vlanbev1alpha1.VLANIndex) error {
+	desiredNames := map[string]struct{}{}
+	for _, index := range desired {
+		desiredNames[index.GetName()] = struct{}{}
+	}
+
+	indexes := &vlanbev1alpha1.VLANIndexList{}
+	opts := []client.ListOption{
+		client.InNamespace(template.GetNamespace()),
+		client.MatchingLabels{backend.KuidIndexTemplateKey: template.GetName()},
+	}
+	if err := r.List(ctx, indexes, opts...); err != nil {
+		return err
+	}
+	for _, index := range indexes.Items {
+		if _, ok := desiredNames[index.GetName()]; ok {
+			continue
+		}
+		if err := r.Delete(ctx, &index); resource.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *reconciler) handleSuccess(ctx context.Context, template *vlanbev1alpha1.VLANIndexTemplate, indexes int) error {
+	// take a snapshot of the current object
+	patch := client.MergeFrom(template.DeepCopy())
+	// update status
+	template.Status.Indexes = int64(indexes)
+	template.SetConditions(condv1alpha1.Ready())
+	r.recorder.Eventf(template, corev1.EventTypeNormal, vlanbev1alpha1.VLANIndexTemplateKind, "ready")
+
+	return r.Client.Status().Patch(ctx, template, patch, &client.SubResourcePatchOptions{
+		PatchOptions: client.PatchOptions{
+			FieldManager: reconcilerName,
+		},
+	})
+}
+
+func (r *reconciler) handleError(ctx context.Context, template *vlanbev1alpha1.VLANIndexTemplate, msg string, err error) error {
+	log := log.FromContext(ctx)
+	// take a snapshot of the current object
+	patch := client.MergeFrom(template.DeepCopy())
+
+	if err != nil {
+		msg = fmt.Sprintf("%s err %s", msg, err.Error())
+	}
+	template.SetConditions(condv1alpha1.Failed(msg))
+	log.Error(msg)
+	r.recorder.Eventf(template, corev1.EventTypeWarning, vlanbev1alpha1.VLANIndexTemplateKind, msg)
+
+	return r.Client.Status().Patch(ctx, template, patch, &client.SubResourcePatchOptions{
+		PatchOptions: client.PatchOptions{
+			FieldManager: reconcilerName,
+		},
+	})
+}
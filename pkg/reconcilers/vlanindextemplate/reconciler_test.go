@@ -0,0 +1,170 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vlanindextemplate
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	condv1alpha1 "github.com/kform-dev/choreo/apis/condition/v1alpha1"
+	"github.com/kuidio/kuid/apis/backend"
+	vlanbev1alpha1 "github.com/kuidio/kuid/apis/backend/vlan/v1alpha1"
+	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
+	"github.com/kuidio/kuid/pkg/reconcilers/resource"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const namespace = "dummy"
+
+func newReconciler(t *testing.T, objs ...client.Object) *reconciler {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, vlanbev1alpha1.AddToScheme(scheme))
+	assert.NoError(t, infrav1alpha1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&vlanbev1alpha1.VLANIndexTemplate{}).
+		Build()
+	return &reconciler{
+		Client:    c,
+		finalizer: resource.NewAPIFinalizer(c, finalizer, reconcilerName),
+		recorder:  record.NewFakeRecorder(100),
+	}
+}
+
+func reconcile(ctx context.Context, r *reconciler, template *vlanbev1alpha1.VLANIndexTemplate) error {
+	_, err := reconcileResult(ctx, r, template)
+	return err
+}
+
+func reconcileResult(ctx context.Context, r *reconciler, template *vlanbev1alpha1.VLANIndexTemplate) (ctrl.Result, error) {
+	return r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(template)})
+}
+
+func listIndexes(ctx context.Context, t *testing.T, r *reconciler) []string {
+	indexes := &vlanbev1alpha1.VLANIndexList{}
+	assert.NoError(t, r.List(ctx, indexes, client.InNamespace(namespace)))
+	names := []string{}
+	for _, index := range indexes.Items {
+		names = append(names, index.GetName())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func getStatus(ctx context.Context, t *testing.T, r *reconciler, template *vlanbev1alpha1.VLANIndexTemplate) *vlanbev1alpha1.VLANIndexTemplate {
+	got := &vlanbev1alpha1.VLANIndexTemplate{}
+	assert.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(template), got))
+	return got
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	template := getTemplate(infrav1alpha1.NodeKind, vlanbev1alpha1.VLANIndexSpec{MaxID: ptr.To[uint32](1000)})
+	r := newReconciler(t,
+		template,
+		getNode("leaf1", map[string]string{"node-type": "leaf"}),
+		getNode("leaf2", map[string]string{"node-type": "leaf"}),
+		getNode("spine1", map[string]string{"node-type": "spine"}),
+	)
+
+	// expand creates an index for every node that matches the selector
+	assert.NoError(t, reconcile(ctx, r, template))
+	assert.Equal(t, []string{"leaf1.access", "leaf2.access"}, listIndexes(ctx, t, r))
+	got := getStatus(ctx, t, r, template)
+	assert.Equal(t, int64(2), got.Status.Indexes)
+	assert.Equal(t, string(condv1alpha1.ConditionReasonReady), got.GetCondition(condv1alpha1.ConditionTypeReady).Reason)
+	assert.Contains(t, got.GetFinalizers(), finalizer)
+
+	// apply updates the spec of the existing indexes and keeps their user labels
+	index := &vlanbev1alpha1.VLANIndex{}
+	assert.NoError(t, r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "leaf1.access"}, index))
+	index.Labels["a"] = "b"
+	assert.NoError(t, r.Update(ctx, index))
+	template = getStatus(ctx, t, r, template)
+	template.Spec.Index.MaxID = ptr.To[uint32](2000)
+	assert.NoError(t, r.Update(ctx, template))
+	assert.NoError(t, reconcile(ctx, r, template))
+	assert.NoError(t, r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "leaf1.access"}, index))
+	assert.Equal(t, ptr.To[uint32](2000), index.Spec.MaxID)
+	assert.Equal(t, "b", index.Labels["a"])
+
+	// prune deletes the index of a node that no longer matches the selector
+	node := &infrav1alpha1.Node{}
+	assert.NoError(t, r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "leaf2"}, node))
+	node.Labels["node-type"] = "spine"
+	assert.NoError(t, r.Update(ctx, node))
+	assert.NoError(t, reconcile(ctx, r, template))
+	assert.Equal(t, []string{"leaf1.access"}, listIndexes(ctx, t, r))
+	assert.Equal(t, int64(1), getStatus(ctx, t, r, template).Status.Indexes)
+
+	// the indexes of a deleted template are deleted with the template
+	assert.NoError(t, r.Delete(ctx, template))
+	assert.NoError(t, reconcile(ctx, r, template))
+	assert.Equal(t, []string{}, listIndexes(ctx, t, r))
+	assert.Error(t, r.Get(ctx, client.ObjectKeyFromObject(template), &vlanbev1alpha1.VLANIndexTemplate{}))
+}
+
+func TestReconcileForeignIndex(t *testing.T) {
+	ctx := context.Background()
+	template := getTemplate(infrav1alpha1.NodeKind, vlanbev1alpha1.VLANIndexSpec{MaxID: ptr.To[uint32](1000)})
+	// an index with the name of the index of the template that is not created from the template
+	foreign := &vlanbev1alpha1.VLANIndex{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "leaf1.access"},
+		Spec:       vlanbev1alpha1.VLANIndexSpec{MaxID: ptr.To[uint32](10)},
+	}
+	// an index created from another template with the same owner
+	other := &vlanbev1alpha1.VLANIndex{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "leaf1.other",
+			Labels:    map[string]string{backend.KuidIndexTemplateKey: "other"},
+		},
+	}
+	r := newReconciler(t,
+		template,
+		foreign,
+		other,
+		getNode("leaf1", map[string]string{"node-type": "leaf"}),
+	)
+
+	// the failure is reported in the status of the template and the template is requeued
+	result, err := reconcileResult(ctx, r, template)
+	assert.NoError(t, err)
+	assert.True(t, result.Requeue)
+	got := getStatus(ctx, t, r, template)
+	assert.Equal(t, string(condv1alpha1.ConditionReasonFailed), got.GetCondition(condv1alpha1.ConditionTypeReady).Reason)
+
+	// the foreign index is not overwritten
+	index := &vlanbev1alpha1.VLANIndex{}
+	assert.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(foreign), index))
+	assert.Equal(t, ptr.To[uint32](10), index.Spec.MaxID)
+	assert.Empty(t, index.GetLabels()[backend.KuidIndexTemplateKey])
+
+	// the index of the other template is not pruned
+	assert.NoError(t, r.Delete(ctx, template))
+	assert.NoError(t, reconcile(ctx, r, template))
+	assert.Equal(t, []string{"leaf1.access", "leaf1.other"}, listIndexes(ctx, t, r))
+}
@@ -106,7 +106,15 @@ func (r *strategy) InvokeCreate(ctx context.Context, obj runtime.Object, recursi
 	return r.opts.BackendInvoker.InvokeCreate(ctx, obj, recursion)
 }
 
+// Create writes the object to the store and reports the outcome of the write to the backend
+// invoker
 func (r *strategy) Create(ctx context.Context, key types.NamespacedName, obj runtime.Object, dryrun bool) (runtime.Object, error) {
+	newObj, err := r.create(ctx, key, obj, dryrun)
+	options.Persisted(ctx, r.opts.BackendInvoker, obj, err)
+	return newObj, err
+}
+
+func (r *strategy) create(ctx context.Context, key types.NamespacedName, obj runtime.Object, dryrun bool) (runtime.Object, error) {
 	if dryrun {
 		if r.opts != nil && r.opts.DryRunner != nil {
 			return r.opts.DryRunner.DryRunCreate(ctx, key, obj, dryrun)
@@ -148,7 +156,15 @@ func (r *strategy) InvokeUpdate(ctx context.Context, obj, old runtime.Object, re
 	return r.opts.BackendInvoker.InvokeUpdate(ctx, obj, old, recursion)
 }
 
+// Update writes the object to the store and reports the outcome of the write to the backend
+// invoker
 func (r *strategy) Update(ctx context.Context, key types.NamespacedName, obj, old runtime.Object, dryrun bool) (runtime.Object, error) {
+	newObj, err := r.update(ctx, key, obj, old, dryrun)
+	options.Persisted(ctx, r.opts.BackendInvoker, obj, err)
+	return newObj, err
+}
+
+func (r *strategy) update(ctx context.Context, key types.NamespacedName, obj, old runtime.Object, dryrun bool) (runtime.Object, error) {
 	if r.obj.IsEqual(ctx, obj, old) {
 		return obj, nil
 	}
@@ -56,3 +56,18 @@ type BackendInvoker interface {
 	InvokeUpdate(ctx context.Context, obj, old runtime.Object, recursion bool) (runtime.Object, runtime.Object, error)
 	InvokeDelete(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error)
 }
+
+// PersistHook is implemented by the backend invokers that hold state of an object from the
+// invoke until the store writes the object. The strategy reports the outcome of the write,
+// err is nil when the object is persisted or the request is a dry run.
+type PersistHook interface {
+	Persisted(ctx context.Context, obj runtime.Object, err error)
+}
+
+// Persisted reports the outcome of the store write of the object to the invoker when the
+// invoker implements the PersistHook
+func Persisted(ctx context.Context, invoker BackendInvoker, obj runtime.Object, err error) {
+	if hook, ok := invoker.(PersistHook); ok {
+		hook.Persisted(ctx, obj, err)
+	}
+}
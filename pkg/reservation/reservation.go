@@ -0,0 +1,92 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package reservation reserves the objects a backend invoker accepted until they are
+// persisted. The invoker runs before the store writes the object, so a check that lists the
+// store does not see the objects other requests are about to write. The reservations close
+// that gap: the checks are serialized and each accepted object is reserved until the
+// strategy reports the outcome of its store write through the options.PersistHook of the
+// invoker.
+package reservation
+
+import (
+	"sync"
+	"time"
+)
+
+// Timeout defines how long an object remains reserved when the outcome of its store write
+// is never reported, e.g. when the request fails between the invoke and the store write
+const Timeout = 30 * time.Second
+
+// Reservations reserves the accepted objects that are not yet persisted by key
+type Reservations[T any] struct {
+	m        sync.Mutex
+	reserved map[string]reservation[T]
+}
+
+type reservation[T any] struct {
+	obj     T
+	expires time.Time
+}
+
+func New[T any]() *Reservations[T] {
+	return &Reservations[T]{
+		reserved: map[string]reservation[T]{},
+	}
+}
+
+// CheckFn checks an object against the objects reserved under the other keys, it returns
+// true when the object must be reserved. The store is listed within the check such that
+// every object is either found in the store or in the reservations.
+type CheckFn[T any] func(reserved map[string]T) (bool, error)
+
+// Reserve runs the check serialized with the other checks and reserves the object under the
+// key when the check accepts it
+func (r *Reservations[T]) Reserve(key string, obj T, now time.Time, check CheckFn[T]) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	reserved := make(map[string]T, len(r.reserved))
+	for k, res := range r.reserved {
+		if now.After(res.expires) {
+			delete(r.reserved, k)
+			continue
+		}
+		if k != key {
+			reserved[k] = res.obj
+		}
+	}
+	ok, err := check(reserved)
+	if err != nil || !ok {
+		return err
+	}
+	r.reserved[key] = reservation[T]{obj: obj, expires: now.Add(Timeout)}
+	return nil
+}
+
+// Release removes the reservation of the key, the object is persisted, failed to persist or
+// is deleted
+func (r *Reservations[T]) Release(key string) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	delete(r.reserved, key)
+}
+
+// Len returns the number of reserved objects
+func (r *Reservations[T]) Len() int {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return len(r.reserved)
+}
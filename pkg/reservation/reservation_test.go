@@ -0,0 +1,113 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reservation
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// checkLimit accepts the object when less than limit objects are reserved
+func checkLimit(limit int) CheckFn[string] {
+	return func(reserved map[string]string) (bool, error) {
+		if len(reserved) >= limit {
+			return false, fmt.Errorf("limit %d reached", limit)
+		}
+		return true, nil
+	}
+}
+
+func TestReserve(t *testing.T) {
+	now := time.Now()
+	r := New[string]()
+
+	if err := r.Reserve("a", "a", now, checkLimit(1)); err != nil {
+		t.Fatalf("a: unexpected error: %v", err)
+	}
+	if err := r.Reserve("b", "b", now, checkLimit(1)); err == nil {
+		t.Fatalf("b: expected the limit error")
+	}
+	// the object itself is not part of the reserved objects, e.g. on a retry
+	if err := r.Reserve("a", "a", now, checkLimit(1)); err != nil {
+		t.Fatalf("a: unexpected error: %v", err)
+	}
+	// a rejected object is not reserved
+	if r.Len() != 1 {
+		t.Errorf("want 1 reservation, got %d", r.Len())
+	}
+
+	// a released object no longer counts
+	r.Release("a")
+	if err := r.Reserve("b", "b", now, checkLimit(1)); err != nil {
+		t.Fatalf("b: unexpected error: %v", err)
+	}
+}
+
+func TestReserveNotAccepted(t *testing.T) {
+	r := New[string]()
+	// the check decides not to reserve the object, e.g. an update that consumes less
+	if err := r.Reserve("a", "a", time.Now(), func(map[string]string) (bool, error) { return false, nil }); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Len() != 0 {
+		t.Errorf("want no reservations, got %d", r.Len())
+	}
+}
+
+func TestReserveExpires(t *testing.T) {
+	now := time.Now()
+	r := New[string]()
+
+	if err := r.Reserve("a", "a", now, checkLimit(1)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// an object whose store write is never reported is not reserved beyond the timeout
+	if err := r.Reserve("b", "b", now.Add(Timeout+time.Second), checkLimit(1)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Len() != 1 {
+		t.Errorf("want the expired reservation removed, got %d reservations", r.Len())
+	}
+}
+
+// TestReserveConcurrent validates the concurrent checks are serialized
+func TestReserveConcurrent(t *testing.T) {
+	const limit = 5
+	r := New[string]()
+
+	var wg sync.WaitGroup
+	var m sync.Mutex
+	accepted := 0
+	for i := 0; i < 4*limit; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := fmt.Sprintf("obj%d", i)
+			if err := r.Reserve(key, key, time.Now(), checkLimit(limit)); err == nil {
+				m.Lock()
+				accepted++
+				m.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	if accepted != limit {
+		t.Errorf("want %d accepted objects, got %d", limit, accepted)
+	}
+}
@@ -0,0 +1,370 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kuidio/kuid/apis/id"
+	"github.com/kuidio/kuid/apis/infra"
+)
+
+type EdgeKind string
+
+const (
+	EdgeKindLink    EdgeKind = "Link"
+	EdgeKindLinkSet EdgeKind = "LinkSet"
+)
+
+// Edge connects 2 node endpoints in the topology graph
+type Edge struct {
+	// Kind defines the resource from which the edge is derived
+	Kind EdgeKind `json:"kind"`
+	// Name defines the name of the Link or LinkSet
+	Name string `json:"name"`
+	// Local defines the endpoint on the local side of the edge
+	Local EndpointRef `json:"local"`
+	// Remote defines the endpoint on the remote side of the edge
+	Remote EndpointRef `json:"remote"`
+}
+
+// EndpointRef references an endpoint of a node in the topology graph
+type EndpointRef struct {
+	// Node defines the name of the node the endpoint belongs to
+	Node string `json:"node"`
+	// Endpoint defines the identifier of the endpoint on the node
+	Endpoint string `json:"endpoint"`
+	// Name defines the name of the Endpoint resource, if the Endpoint exists
+	Name string `json:"name,omitempty"`
+	// Speed defines the speed of the Endpoint resource, if the Endpoint exists
+	Speed string `json:"speed,omitempty"`
+	// EndpointSet defines the name of the EndpointSet the endpoint belongs to
+	EndpointSet string `json:"endpointSet,omitempty"`
+
+	nodeKey string
+	id      id.PartitionEndpointID
+}
+
+func (r Edge) reverse() Edge {
+	return Edge{
+		Kind:   r.Kind,
+		Name:   r.Name,
+		Local:  r.Remote,
+		Remote: r.Local,
+	}
+}
+
+// Graph is a read-only topology graph of the infra Nodes, Endpoints, Links, LinkSets and EndpointSets
+// of a namespace. The nodes of the graph are identified by their PartitionNodeID.
+type Graph struct {
+	// nodeNames maps the node key to the name of the node resource
+	nodeNames map[string]string
+	// nodeKeys maps the name of the node resource to the node key
+	nodeKeys map[string]string
+	// adjacency holds the edges per node key, oriented with the node as the local side
+	adjacency map[string][]Edge
+	// edges holds all the edges of the graph
+	edges []Edge
+}
+
+// NewGraph builds the topology graph from the infra resources
+func NewGraph(nodes []*infra.Node, endpoints []*infra.Endpoint, links []*infra.Link, linkSets []*infra.LinkSet, endpointSets []*infra.EndpointSet) *Graph {
+	r := &Graph{
+		nodeNames: map[string]string{},
+		nodeKeys:  map[string]string{},
+		adjacency: map[string][]Edge{},
+		edges:     []Edge{},
+	}
+	for _, node := range nodes {
+		key := nodeKey(node.Spec.PartitionNodeID)
+		r.nodeNames[key] = node.GetName()
+		r.nodeKeys[node.GetName()] = key
+		r.adjacency[key] = []Edge{}
+	}
+	endpointResources := map[string]*infra.Endpoint{}
+	for _, endpoint := range endpoints {
+		endpointResources[endpointKey(endpoint.Spec.PartitionEndpointID)] = endpoint
+	}
+	endpointSetNames := map[string]string{}
+	for _, endpointSet := range endpointSets {
+		for _, epID := range endpointSet.Spec.Endpoints {
+			if epID == nil {
+				continue
+			}
+			endpointSetNames[endpointKey(*epID)] = endpointSet.GetName()
+		}
+	}
+	newEndpointRef := func(epID id.PartitionEndpointID) EndpointRef {
+		key := nodeKey(epID.PartitionNodeID)
+		if _, ok := r.nodeNames[key]; !ok {
+			// the node is referenced by a link but the node resource does not exist
+			r.nodeNames[key] = key
+			r.adjacency[key] = []Edge{}
+		}
+		ref := EndpointRef{
+			Node:        r.nodeNames[key],
+			Endpoint:    endpointString(epID),
+			EndpointSet: endpointSetNames[endpointKey(epID)],
+			nodeKey:     key,
+			id:          epID,
+		}
+		if endpoint, ok := endpointResources[endpointKey(epID)]; ok {
+			ref.Name = endpoint.GetName()
+			if endpoint.Spec.Speed != nil {
+				ref.Speed = *endpoint.Spec.Speed
+			}
+		}
+		return ref
+	}
+
+	for _, link := range links {
+		if len(link.Spec.Endpoints) != 2 || link.Spec.Endpoints[0] == nil || link.Spec.Endpoints[1] == nil {
+			// only point to point links are part of the graph
+			continue
+		}
+		r.addEdge(Edge{
+			Kind:   EdgeKindLink,
+			Name:   link.GetName(),
+			Local:  newEndpointRef(*link.Spec.Endpoints[0]),
+			Remote: newEndpointRef(*link.Spec.Endpoints[1]),
+		})
+	}
+	for _, linkSet := range linkSets {
+		// a linkSet connects all its endpoints that belong to a different node
+		for i, local := range linkSet.Spec.Endpoints {
+			for _, remote := range linkSet.Spec.Endpoints[i+1:] {
+				if local == nil || remote == nil || nodeKey(local.PartitionNodeID) == nodeKey(remote.PartitionNodeID) {
+					continue
+				}
+				r.addEdge(Edge{
+					Kind:   EdgeKindLinkSet,
+					Name:   linkSet.GetName(),
+					Local:  newEndpointRef(*local),
+					Remote: newEndpointRef(*remote),
+				})
+			}
+		}
+	}
+	// sort the adjacency to make the path and neighbor queries deterministic
+	for key := range r.adjacency {
+		sort.SliceStable(r.adjacency[key], func(i, j int) bool {
+			return edgeLess(r.adjacency[key][i], r.adjacency[key][j])
+		})
+	}
+	sort.SliceStable(r.edges, func(i, j int) bool {
+		return edgeLess(r.edges[i], r.edges[j])
+	})
+	return r
+}
+
+func (r *Graph) addEdge(edge Edge) {
+	r.edges = append(r.edges, edge)
+	r.adjacency[edge.Local.nodeKey] = append(r.adjacency[edge.Local.nodeKey], edge)
+	if edge.Local.nodeKey != edge.Remote.nodeKey {
+		r.adjacency[edge.Remote.nodeKey] = append(r.adjacency[edge.Remote.nodeKey], edge.reverse())
+	}
+}
+
+// HasNode returns true if the node resource is part of the graph
+func (r *Graph) HasNode(name string) bool {
+	_, ok := r.nodeKeys[name]
+	return ok
+}
+
+// PortSelector selects the endpoints of a node by moduleBay, module and port
+type PortSelector struct {
+	ModuleBay *uint32
+	Module    *uint32
+	Port      *uint32
+}
+
+func (r PortSelector) matches(epID id.PartitionEndpointID) bool {
+	if r.ModuleBay != nil && (epID.ModuleBay == nil || *epID.ModuleBay != *r.ModuleBay) {
+		return false
+	}
+	if r.Module != nil && (epID.Module == nil || *epID.Module != *r.Module) {
+		return false
+	}
+	if r.Port != nil && epID.Port != *r.Port {
+		return false
+	}
+	return true
+}
+
+// Neighbors returns the edges of the node, the local side of each edge is the node.
+// The edges are filtered by the local endpoint using the port selector.
+func (r *Graph) Neighbors(name string, selector PortSelector) ([]Edge, error) {
+	key, ok := r.nodeKeys[name]
+	if !ok {
+		return nil, fmt.Errorf("node %s not found", name)
+	}
+	edges := []Edge{}
+	for _, edge := range r.adjacency[key] {
+		if selector.matches(edge.Local.id) {
+			edges = append(edges, edge)
+		}
+	}
+	return edges, nil
+}
+
+// ShortestPath returns the edges on the shortest path (in hops) between 2 nodes;
+// nil is returned when the nodes are not connected
+func (r *Graph) ShortestPath(from, to string) ([]Edge, error) {
+	fromKey, ok := r.nodeKeys[from]
+	if !ok {
+		return nil, fmt.Errorf("node %s not found", from)
+	}
+	toKey, ok := r.nodeKeys[to]
+	if !ok {
+		return nil, fmt.Errorf("node %s not found", to)
+	}
+	if fromKey == toKey {
+		return []Edge{}, nil
+	}
+	// breadth first search, prev holds the edge used to reach a node
+	prev := map[string]Edge{}
+	visited := map[string]bool{fromKey: true}
+	queue := []string{fromKey}
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		for _, edge := range r.adjacency[key] {
+			if visited[edge.Remote.nodeKey] {
+				continue
+			}
+			visited[edge.Remote.nodeKey] = true
+			prev[edge.Remote.nodeKey] = edge
+			if edge.Remote.nodeKey == toKey {
+				path := []Edge{}
+				for key := toKey; key != fromKey; key = prev[key].Local.nodeKey {
+					path = append([]Edge{prev[key]}, path...)
+				}
+				return path, nil
+			}
+			queue = append(queue, edge.Remote.nodeKey)
+		}
+	}
+	return nil, nil
+}
+
+// Component returns the sorted names of the nodes in the connected component of the node
+func (r *Graph) Component(name string) ([]string, error) {
+	key, ok := r.nodeKeys[name]
+	if !ok {
+		return nil, fmt.Errorf("node %s not found", name)
+	}
+	return r.component(key, map[string]bool{}), nil
+}
+
+// Components returns all the connected components of the graph
+func (r *Graph) Components() [][]string {
+	keys := make([]string, 0, len(r.adjacency))
+	for key := range r.adjacency {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	components := [][]string{}
+	visited := map[string]bool{}
+	for _, key := range keys {
+		if visited[key] {
+			continue
+		}
+		components = append(components, r.component(key, visited))
+	}
+	return components
+}
+
+func (r *Graph) component(key string, visited map[string]bool) []string {
+	names := []string{}
+	visited[key] = true
+	queue := []string{key}
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		names = append(names, r.nodeNames[key])
+		for _, edge := range r.adjacency[key] {
+			if !visited[edge.Remote.nodeKey] {
+				visited[edge.Remote.nodeKey] = true
+				queue = append(queue, edge.Remote.nodeKey)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Edges returns the edges between the nodes
+func (r *Graph) Edges(names []string) []Edge {
+	keys := map[string]bool{}
+	for _, name := range names {
+		if key, ok := r.nodeKeys[name]; ok {
+			keys[key] = true
+		}
+	}
+	edges := []Edge{}
+	for _, edge := range r.edges {
+		if keys[edge.Local.nodeKey] && keys[edge.Remote.nodeKey] {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+func nodeKey(nodeID id.PartitionNodeID) string {
+	return fmt.Sprintf("%s.%s.%s.%s", nodeID.Partition, nodeID.Region, nodeID.Site, nodeID.Node)
+}
+
+func endpointKey(epID id.PartitionEndpointID) string {
+	return fmt.Sprintf("%s.%s", nodeKey(epID.PartitionNodeID), endpointString(epID))
+}
+
+// endpointString returns the identifier of the endpoint within the node
+// e.g. mb1-m1-p3-e1, when the endpoint has an internal name the name is returned
+func endpointString(epID id.PartitionEndpointID) string {
+	if epID.Name != nil && *epID.Name != "" {
+		return *epID.Name
+	}
+	parts := []string{}
+	if epID.ModuleBay != nil {
+		parts = append(parts, fmt.Sprintf("mb%d", *epID.ModuleBay))
+	}
+	if epID.Module != nil {
+		parts = append(parts, fmt.Sprintf("m%d", *epID.Module))
+	}
+	parts = append(parts, fmt.Sprintf("p%d", epID.Port))
+	if epID.Adaptor != nil && *epID.Adaptor != "" {
+		parts = append(parts, *epID.Adaptor)
+	}
+	parts = append(parts, fmt.Sprintf("e%d", epID.Endpoint))
+	return strings.Join(parts, "-")
+}
+
+func edgeLess(a, b Edge) bool {
+	if a.Local.Node != b.Local.Node {
+		return a.Local.Node < b.Local.Node
+	}
+	if a.Local.Endpoint != b.Local.Endpoint {
+		return a.Local.Endpoint < b.Local.Endpoint
+	}
+	if a.Kind != b.Kind {
+		return a.Kind < b.Kind
+	}
+	return a.Name < b.Name
+}
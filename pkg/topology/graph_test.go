@@ -0,0 +1,349 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kuidio/kuid/apis/id"
+	"github.com/kuidio/kuid/apis/infra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func testNodeID(node string) id.PartitionNodeID {
+	return id.PartitionNodeID{Partition: "dc1", SiteID: id.SiteID{Region: "r1", Site: "s1"}, Node: node}
+}
+
+func testEndpointID(node string, port uint32) *id.PartitionEndpointID {
+	return &id.PartitionEndpointID{PartitionNodeID: testNodeID(node), Port: port, Endpoint: 1}
+}
+
+func testLink(name string, local, remote *id.PartitionEndpointID) *infra.Link {
+	return &infra.Link{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       infra.LinkSpec{Endpoints: []*id.PartitionEndpointID{local, remote}},
+	}
+}
+
+// testGraph returns the graph of the following topology
+//
+//	leaf1 -- spine1 -- leaf2
+//	leaf1 -- spine2 -- leaf2
+//	leaf3 == leaf4 (linkSet)
+//	oob
+func testGraph() *Graph {
+	nodes := []*infra.Node{}
+	for _, name := range []string{"leaf1", "leaf2", "leaf3", "leaf4", "spine1", "spine2", "oob"} {
+		nodes = append(nodes, &infra.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       infra.NodeSpec{PartitionNodeID: testNodeID(name)},
+		})
+	}
+	speed := "100"
+	endpoints := []*infra.Endpoint{{
+		ObjectMeta: metav1.ObjectMeta{Name: "leaf1-p1-e1"},
+		Spec:       infra.EndpointSpec{PartitionEndpointID: *testEndpointID("leaf1", 1), Speed: &speed},
+	}}
+	links := []*infra.Link{
+		testLink("leaf1-spine1", testEndpointID("leaf1", 1), testEndpointID("spine1", 1)),
+		testLink("leaf1-spine2", testEndpointID("leaf1", 2), testEndpointID("spine2", 1)),
+		testLink("leaf2-spine1", testEndpointID("leaf2", 1), testEndpointID("spine1", 2)),
+		testLink("leaf2-spine2", testEndpointID("leaf2", 2), testEndpointID("spine2", 2)),
+		// a link with a single endpoint is not part of the graph
+		{ObjectMeta: metav1.ObjectMeta{Name: "dangling"}, Spec: infra.LinkSpec{Endpoints: []*id.PartitionEndpointID{testEndpointID("oob", 1)}}},
+	}
+	linkSets := []*infra.LinkSet{{
+		ObjectMeta: metav1.ObjectMeta{Name: "leaf3-leaf4"},
+		Spec: infra.LinkSetSpec{Endpoints: []*id.PartitionEndpointID{
+			testEndpointID("leaf3", 1), testEndpointID("leaf3", 2), testEndpointID("leaf4", 1),
+		}},
+	}}
+	endpointSets := []*infra.EndpointSet{{
+		ObjectMeta: metav1.ObjectMeta{Name: "lag1"},
+		Spec:       infra.EndpointSetSpec{Endpoints: []*id.PartitionEndpointID{testEndpointID("leaf2", 1), testEndpointID("leaf2", 2)}},
+	}}
+	return NewGraph(nodes, endpoints, links, linkSets, endpointSets)
+}
+
+func edgeNames(edges []Edge) []string {
+	names := []string{}
+	for _, edge := range edges {
+		names = append(names, edge.Local.Node+"->"+edge.Remote.Node)
+	}
+	return names
+}
+
+func TestNeighbors(t *testing.T) {
+	port := uint32(2)
+	cases := map[string]struct {
+		node        string
+		selector    PortSelector
+		want        []string
+		expectedErr bool
+	}{
+		"Leaf": {
+			node: "leaf1",
+			want: []string{"leaf1->spine1", "leaf1->spine2"},
+		},
+		"Spine": {
+			node: "spine1",
+			want: []string{"spine1->leaf1", "spine1->leaf2"},
+		},
+		"PortSelector": {
+			node:     "leaf1",
+			selector: PortSelector{Port: &port},
+			want:     []string{"leaf1->spine2"},
+		},
+		"LinkSet": {
+			node: "leaf4",
+			want: []string{"leaf4->leaf3", "leaf4->leaf3"},
+		},
+		"Isolated": {
+			node: "oob",
+			want: []string{},
+		},
+		"NotFound": {
+			node:        "leaf9",
+			expectedErr: true,
+		},
+	}
+
+	g := testGraph()
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			edges, err := g.Neighbors(tc.node, tc.selector)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, edgeNames(edges)); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNeighborsEndpointRef(t *testing.T) {
+	g := testGraph()
+	edges, err := g.Neighbors("leaf1", PortSelector{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	local := edges[0].Local
+	if local.Node != "leaf1" || local.Endpoint != "p1-e1" || local.Name != "leaf1-p1-e1" || local.Speed != "100" {
+		t.Errorf("unexpected local endpoint: %+v", local)
+	}
+
+	edges, err = g.Neighbors("spine1", PortSelector{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if edges[1].Remote.EndpointSet != "lag1" {
+		t.Errorf("expected endpointSet lag1, got %q", edges[1].Remote.EndpointSet)
+	}
+}
+
+func TestShortestPath(t *testing.T) {
+	cases := map[string]struct {
+		from        string
+		to          string
+		want        []string
+		expectedErr bool
+	}{
+		"TwoHops": {
+			from: "leaf1",
+			to:   "leaf2",
+			want: []string{"leaf1->spine1", "spine1->leaf2"},
+		},
+		"OneHop": {
+			from: "spine2",
+			to:   "leaf2",
+			want: []string{"spine2->leaf2"},
+		},
+		"SameNode": {
+			from: "leaf1",
+			to:   "leaf1",
+			want: []string{},
+		},
+		"NotConnected": {
+			from: "leaf1",
+			to:   "leaf3",
+			want: nil,
+		},
+		"NotFound": {
+			from:        "leaf1",
+			to:          "leaf9",
+			expectedErr: true,
+		},
+	}
+
+	g := testGraph()
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			path, err := g.ShortestPath(tc.from, tc.to)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.want == nil {
+				if path != nil {
+					t.Errorf("expected no path, got %v", edgeNames(path))
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.want, edgeNames(path)); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestComponents(t *testing.T) {
+	g := testGraph()
+
+	want := [][]string{
+		{"leaf1", "leaf2", "spine1", "spine2"},
+		{"leaf3", "leaf4"},
+		{"oob"},
+	}
+	if diff := cmp.Diff(want, g.Components()); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+
+	component, err := g.Component("spine2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want[0], component); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+	if _, err := g.Component("leaf9"); err == nil {
+		t.Errorf("expected an error")
+	}
+
+	if diff := cmp.Diff([]string{"leaf3->leaf4", "leaf3->leaf4"}, edgeNames(g.Edges(want[1]))); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+}
+
+func TestRun(t *testing.T) {
+	cases := map[string]struct {
+		node          string
+		params        url.Values
+		wantNodes     []string
+		wantConnected *bool
+		expectedErr   bool
+	}{
+		"Default": {
+			node:      "leaf1",
+			params:    url.Values{},
+			wantNodes: []string{"leaf1", "spine1", "spine2"},
+		},
+		"Path": {
+			node:          "leaf1",
+			params:        url.Values{"query": {"path"}, "to": {"leaf2"}},
+			wantNodes:     []string{"leaf1", "spine1", "leaf2"},
+			wantConnected: ptr.To(true),
+		},
+		"PathNotConnected": {
+			node:          "leaf1",
+			params:        url.Values{"query": {"path"}, "to": {"oob"}},
+			wantNodes:     []string{"leaf1"},
+			wantConnected: ptr.To(false),
+		},
+		"PathWithoutTo": {
+			node:        "leaf1",
+			params:      url.Values{"query": {"path"}},
+			expectedErr: true,
+		},
+		"Component": {
+			node:          "leaf3",
+			params:        url.Values{"query": {"component"}, "to": {"leaf4"}},
+			wantNodes:     []string{"leaf3", "leaf4"},
+			wantConnected: ptr.To(true),
+		},
+		"InvalidPort": {
+			node:        "leaf1",
+			params:      url.Values{"port": {"x"}},
+			expectedErr: true,
+		},
+		"InvalidQuery": {
+			node:        "leaf1",
+			params:      url.Values{"query": {"x"}},
+			expectedErr: true,
+		},
+	}
+
+	g := testGraph()
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := Run(g, tc.node, tc.params)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantNodes, result.Nodes); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantConnected, result.Connected); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteDOT(t *testing.T) {
+	result, err := Run(testGraph(), "leaf3", url.Values{"query": {"components"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := result.WriteDOT(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dot := buf.String()
+	for _, s := range []string{
+		"graph topology {\n",
+		"subgraph cluster_2 {\n    \"oob\";\n  }",
+		"\"leaf1\" -- \"spine1\" [label=\"leaf1-spine1\", taillabel=\"p1-e1\", headlabel=\"p1-e1\"];",
+		"\"leaf3\" -- \"leaf4\" [label=\"leaf3-leaf4\", taillabel=\"p1-e1\", headlabel=\"p1-e1\", style=dashed];",
+	} {
+		if !strings.Contains(dot, s) {
+			t.Errorf("expected %q in:\n%s", s, dot)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kform-dev/choreo/apis/condition"
+	"github.com/kuidio/kuid/apis/infra"
+	"github.com/kuidio/kuid/pkg/registry/options"
+	"github.com/kuidio/kuid/pkg/reservation"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// NewLinkInvoker returns an invoker that enforces a single link per endpoint
+// and reports speed and vlanTagging mismatches between the link endpoints
+// in the link status
+func NewLinkInvoker(storage *Storage) options.BackendInvoker {
+	return &linkInvoker{
+		storage:      storage,
+		reservations: reservation.New[*infra.Link](),
+	}
+}
+
+type linkInvoker struct {
+	storage *Storage
+	// reservations serializes the endpoint exclusivity checks and reserves the endpoints
+	// of a link until the link is persisted
+	reservations *reservation.Reservations[*infra.Link]
+}
+
+func (r *linkInvoker) InvokeCreate(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	return obj, r.check(ctx, obj)
+}
+
+func (r *linkInvoker) InvokeUpdate(ctx context.Context, obj, old runtime.Object, recursion bool) (runtime.Object, runtime.Object, error) {
+	return obj, old, r.check(ctx, obj)
+}
+
+func (r *linkInvoker) InvokeDelete(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	r.release(obj)
+	return obj, nil
+}
+
+// Persisted releases the endpoints of the link, the store holds the link or the link
+// failed to persist
+func (r *linkInvoker) Persisted(ctx context.Context, obj runtime.Object, err error) {
+	r.release(obj)
+}
+
+func (r *linkInvoker) release(obj runtime.Object) {
+	if link, ok := obj.(*infra.Link); ok {
+		r.reservations.Release(getReservationKey(link))
+	}
+}
+
+func (r *linkInvoker) check(ctx context.Context, obj runtime.Object) error {
+	link, ok := obj.(*infra.Link)
+	if !ok {
+		return fmt.Errorf("unexpected object, want %s, got %T", infra.LinkKind, obj)
+	}
+
+	if err := r.reserve(ctx, link, time.Now()); err != nil {
+		return err
+	}
+
+	endpoints, err := List[*infra.Endpoint](ctx, r.storage, infra.EndpointPlural)
+	if err != nil {
+		// the link is not persisted
+		r.release(link)
+		return err
+	}
+	epSpecs := make(map[string]infra.EndpointSpec, len(endpoints))
+	for _, ep := range endpoints {
+		epSpecs[endpointKey(ep.Spec.PartitionEndpointID)] = ep.Spec
+	}
+
+	var mismatches []string
+	if len(link.Spec.Endpoints) == 2 && link.Spec.Endpoints[0] != nil && link.Spec.Endpoints[1] != nil {
+		aKey, bKey := endpointKey(*link.Spec.Endpoints[0]), endpointKey(*link.Spec.Endpoints[1])
+		a, aok := epSpecs[aKey]
+		b, bok := epSpecs[bKey]
+		if aok && bok {
+			if a.Speed != nil && b.Speed != nil && *a.Speed != *b.Speed {
+				mismatches = append(mismatches, fmt.Sprintf("speed mismatch %s: %s, %s: %s", aKey, *a.Speed, bKey, *b.Speed))
+			}
+			if a.VLANTagging != b.VLANTagging {
+				mismatches = append(mismatches, fmt.Sprintf("vlanTagging mismatch %s: %t, %s: %t", aKey, a.VLANTagging, bKey, b.VLANTagging))
+			}
+		}
+	}
+	if len(mismatches) > 0 {
+		link.SetConditions(condition.Failed(strings.Join(mismatches, "; ")))
+		return nil
+	}
+	link.SetConditions(condition.Ready())
+	return nil
+}
+
+// reserve validates the endpoints of the link against the stored and the reserved links and
+// reserves the endpoints of the link
+func (r *linkInvoker) reserve(ctx context.Context, link *infra.Link, now time.Time) error {
+	return r.reservations.Reserve(getReservationKey(link), link.DeepCopy(), now, func(reserved map[string]*infra.Link) (bool, error) {
+		links, err := List[*infra.Link](ctx, r.storage, infra.LinkPlural)
+		if err != nil {
+			return false, err
+		}
+		return true, checkEndpoints(link, links, reserved)
+	})
+}
+
+// checkEndpoints validates the endpoints of the link are not used by another stored or
+// reserved link in the namespace of the link. The reserved version of a link replaces its
+// stored version.
+func checkEndpoints(link *infra.Link, links []*infra.Link, reserved map[string]*infra.Link) error {
+	reservedBy := map[string]string{}
+	for _, l := range reserved {
+		if l.GetNamespace() != link.GetNamespace() || l.GetName() == link.GetName() {
+			continue
+		}
+		for _, epID := range l.Spec.Endpoints {
+			if epID != nil {
+				reservedBy[endpointKey(*epID)] = l.GetName()
+			}
+		}
+	}
+	used := map[string]string{}
+	for _, l := range links {
+		if l.GetNamespace() != link.GetNamespace() || l.GetName() == link.GetName() {
+			continue
+		}
+		if _, ok := reserved[getReservationKey(l)]; ok {
+			continue
+		}
+		for _, epID := range l.Spec.Endpoints {
+			if epID != nil {
+				used[endpointKey(*epID)] = l.GetName()
+			}
+		}
+	}
+
+	for _, epID := range link.Spec.Endpoints {
+		if epID == nil {
+			continue
+		}
+		key := endpointKey(*epID)
+		if other, ok := used[key]; ok {
+			return apierrors.NewBadRequest(fmt.Sprintf("endpoint %s is already used by link %s", key, other))
+		}
+		if other, ok := reservedBy[key]; ok {
+			return apierrors.NewConflict(infra.Resource(infra.LinkPlural), link.GetName(),
+				fmt.Errorf("endpoint %s is being claimed by link %s", key, other))
+		}
+	}
+	return nil
+}
+
+func getReservationKey(link *infra.Link) string {
+	return types.NamespacedName{Namespace: link.GetNamespace(), Name: link.GetName()}.String()
+}
+
+// NewLinkSetInvoker returns an invoker that reports in the linkSet status
+// when the linkSet members span more nodes than allowed
+func NewLinkSetInvoker() options.BackendInvoker {
+	return &linkSetInvoker{}
+}
+
+type linkSetInvoker struct{}
+
+func (r *linkSetInvoker) InvokeCreate(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	return obj, r.check(obj)
+}
+
+func (r *linkSetInvoker) InvokeUpdate(ctx context.Context, obj, old runtime.Object, recursion bool) (runtime.Object, runtime.Object, error) {
+	return obj, old, r.check(obj)
+}
+
+func (r *linkSetInvoker) InvokeDelete(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	return obj, nil
+}
+
+func (r *linkSetInvoker) check(obj runtime.Object) error {
+	linkSet, ok := obj.(*infra.LinkSet)
+	if !ok {
+		return fmt.Errorf("unexpected object, want %s, got %T", infra.LinkSetKind, obj)
+	}
+	nodes := map[string]struct{}{}
+	for _, epID := range linkSet.Spec.Endpoints {
+		if epID != nil {
+			nodes[nodeKey(epID.PartitionNodeID)] = struct{}{}
+		}
+	}
+	if len(nodes) > linkSet.GetMaxNodes() {
+		names := make([]string, 0, len(nodes))
+		for name := range nodes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		linkSet.SetConditions(condition.Failed(fmt.Sprintf("linkSet spans %d nodes, max %d allowed: %s",
+			len(nodes), linkSet.GetMaxNodes(), strings.Join(names, ", "))))
+		return nil
+	}
+	linkSet.SetConditions(condition.Ready())
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kuidio/kuid/apis/infra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// reserve reserves the link against the stored links
+func reserve(r *linkInvoker, link *infra.Link, links []*infra.Link, now time.Time) error {
+	return r.reservations.Reserve(getReservationKey(link), link, now, func(reserved map[string]*infra.Link) (bool, error) {
+		return true, checkEndpoints(link, links, reserved)
+	})
+}
+
+func TestLinkReservation(t *testing.T) {
+	now := time.Now()
+	r := NewLinkInvoker(NewStorage()).(*linkInvoker)
+
+	stored := testLink("leaf1-spine1", testEndpointID("leaf1", 1), testEndpointID("spine1", 1))
+	links := []*infra.Link{stored}
+
+	// an endpoint used by a stored link is rejected
+	if err := reserve(r, testLink("other", testEndpointID("leaf1", 1), testEndpointID("spine2", 1)), links, now); !apierrors.IsBadRequest(err) {
+		t.Fatalf("expected a bad request, got: %v", err)
+	}
+
+	// a link that is not yet persisted reserves its endpoints
+	if err := reserve(r, testLink("leaf1-spine2", testEndpointID("leaf1", 2), testEndpointID("spine2", 1)), links, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := reserve(r, testLink("leaf2-spine2", testEndpointID("leaf2", 1), testEndpointID("spine2", 1)), links, now); !apierrors.IsConflict(err) {
+		t.Fatalf("expected a conflict, got: %v", err)
+	}
+	// the same link can be re-validated, e.g. on a retry
+	if err := reserve(r, testLink("leaf1-spine2", testEndpointID("leaf1", 2), testEndpointID("spine2", 1)), links, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the reserved version of a stored link replaces the stored version
+	if err := reserve(r, testLink("leaf1-spine1", testEndpointID("leaf1", 3), testEndpointID("spine1", 1)), links, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := reserve(r, testLink("leaf3-spine1", testEndpointID("leaf1", 1), testEndpointID("spine1", 2)), links, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestLinkReservationPersisted(t *testing.T) {
+	for name, persistErr := range map[string]error{
+		"Persisted":     nil,
+		"AlreadyExists": apierrors.NewAlreadyExists(infra.Resource(infra.LinkPlural), "leaf1-spine1"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := NewLinkInvoker(NewStorage()).(*linkInvoker)
+
+			link := testLink("leaf1-spine1", testEndpointID("leaf1", 1), testEndpointID("spine1", 1))
+			if err := reserve(r, link, nil, time.Now()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// the store holds the link or the link failed to persist, either way the endpoints
+			// are no longer reserved
+			r.Persisted(context.Background(), link, persistErr)
+			if r.reservations.Len() != 0 {
+				t.Errorf("expected no reservations, got: %d", r.reservations.Len())
+			}
+		})
+	}
+}
+
+func TestLinkReservationDelete(t *testing.T) {
+	now := time.Now()
+	r := NewLinkInvoker(NewStorage()).(*linkInvoker)
+
+	link := testLink("leaf1-spine1", testEndpointID("leaf1", 1), testEndpointID("spine1", 1))
+	if err := reserve(r, link, nil, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := r.InvokeDelete(context.Background(), link, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := reserve(r, testLink("leaf2-spine1", testEndpointID("leaf2", 1), testEndpointID("spine1", 1)), nil, now); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
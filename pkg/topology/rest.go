@@ -0,0 +1,217 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/kuidio/kuid/apis/infra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+const SubResourceName = "topology"
+
+var _ rest.Storage = &REST{}
+var _ rest.Connecter = &REST{}
+
+// REST implements the read-only topology subresource of a Node
+//
+// GET .../nodes/<name>/topology?query=neighbors[&moduleBay=x][&module=y][&port=z]
+// GET .../nodes/<name>/topology?query=path&to=<node>
+// GET .../nodes/<name>/topology?query=component[&to=<node>]
+// GET .../nodes/<name>/topology?query=components
+//
+// The result is returned in json or, when format=dot, in Graphviz DOT format
+type REST struct {
+	storage   *Storage
+	nodeStore rest.Getter
+}
+
+func NewREST(storage *Storage, nodeStore rest.Storage) (*REST, error) {
+	getter, ok := nodeStore.(rest.Getter)
+	if !ok {
+		return nil, fmt.Errorf("node store does not implement rest.Getter")
+	}
+	return &REST{
+		storage:   storage,
+		nodeStore: getter,
+	}, nil
+}
+
+// New implements rest.Storage
+func (r *REST) New() runtime.Object {
+	return &infra.Node{}
+}
+
+// Destroy implements rest.Storage
+func (r *REST) Destroy() {}
+
+// ConnectMethods implements rest.Connecter
+func (r *REST) ConnectMethods() []string {
+	return []string{http.MethodGet}
+}
+
+// NewConnectOptions implements rest.Connecter, the query parameters are parsed from the request
+func (r *REST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect implements rest.Connecter
+func (r *REST) Connect(ctx context.Context, name string, _ runtime.Object, responder rest.Responder) (http.Handler, error) {
+	if _, err := r.nodeStore.Get(ctx, name, &metav1.GetOptions{}); err != nil {
+		return nil, err
+	}
+	graph, err := r.storage.BuildGraph(ctx)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		params := req.URL.Query()
+		result, err := Run(graph, name, params)
+		if err != nil {
+			responder.Error(apierrors.NewBadRequest(err.Error()))
+			return
+		}
+		switch Format(params.Get("format")) {
+		case FormatDOT:
+			w.Header().Set("Content-Type", "text/vnd.graphviz")
+			w.WriteHeader(http.StatusOK)
+			_ = result.WriteDOT(w)
+		case FormatJSON, "":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(result)
+		default:
+			responder.Error(apierrors.NewBadRequest(fmt.Sprintf("unsupported format %q, supported formats: %s, %s", params.Get("format"), FormatJSON, FormatDOT)))
+		}
+	}), nil
+}
+
+// Run executes the topology query defined by the parameters for the node
+func Run(graph *Graph, node string, params url.Values) (*Result, error) {
+	query := Query(params.Get("query"))
+	if query == "" {
+		query = QueryNeighbors
+	}
+	to := params.Get("to")
+	result := &Result{
+		Query: query,
+		Node:  node,
+		To:    to,
+		Nodes: []string{},
+		Edges: []Edge{},
+	}
+
+	switch query {
+	case QueryNeighbors:
+		selector := PortSelector{}
+		var err error
+		if selector.ModuleBay, err = getUint32(params, "moduleBay"); err != nil {
+			return nil, err
+		}
+		if selector.Module, err = getUint32(params, "module"); err != nil {
+			return nil, err
+		}
+		if selector.Port, err = getUint32(params, "port"); err != nil {
+			return nil, err
+		}
+		edges, err := graph.Neighbors(node, selector)
+		if err != nil {
+			return nil, err
+		}
+		result.Nodes = append(result.Nodes, node)
+		for _, edge := range edges {
+			result.Nodes = appendUnique(result.Nodes, edge.Remote.Node)
+		}
+		result.Edges = edges
+	case QueryPath:
+		if to == "" {
+			return nil, fmt.Errorf("query %s requires a destination node (to)", query)
+		}
+		path, err := graph.ShortestPath(node, to)
+		if err != nil {
+			return nil, err
+		}
+		connected := path != nil
+		result.Connected = &connected
+		result.Nodes = append(result.Nodes, node)
+		for _, edge := range path {
+			result.Nodes = append(result.Nodes, edge.Remote.Node)
+		}
+		if path != nil {
+			result.Edges = path
+		}
+	case QueryComponent:
+		nodes, err := graph.Component(node)
+		if err != nil {
+			return nil, err
+		}
+		if to != "" {
+			if !graph.HasNode(to) {
+				return nil, fmt.Errorf("node %s not found", to)
+			}
+			connected := false
+			for _, n := range nodes {
+				if n == to {
+					connected = true
+				}
+			}
+			result.Connected = &connected
+		}
+		result.Nodes = nodes
+		result.Edges = graph.Edges(nodes)
+	case QueryComponents:
+		result.Components = graph.Components()
+		for _, component := range result.Components {
+			result.Nodes = append(result.Nodes, component...)
+		}
+		result.Edges = graph.Edges(result.Nodes)
+	default:
+		return nil, fmt.Errorf("unsupported query %q, supported queries: %s, %s, %s, %s", query, QueryNeighbors, QueryPath, QueryComponent, QueryComponents)
+	}
+	return result, nil
+}
+
+func getUint32(params url.Values, key string) (*uint32, error) {
+	s := params.Get(key)
+	if s == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q, err: %s", key, s, err.Error())
+	}
+	u := uint32(v)
+	return &u, nil
+}
+
+func appendUnique(s []string, v string) []string {
+	for _, e := range s {
+		if e == v {
+			return s
+		}
+	}
+	return append(s, v)
+}
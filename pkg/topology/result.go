@@ -0,0 +1,90 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+type Query string
+
+const (
+	QueryNeighbors  Query = "neighbors"
+	QueryPath       Query = "path"
+	QueryComponent  Query = "component"
+	QueryComponents Query = "components"
+)
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatDOT  Format = "dot"
+)
+
+// Result is the result of a topology query
+type Result struct {
+	// Query defines the query that was executed
+	Query Query `json:"query"`
+	// Node defines the node the query was executed for
+	Node string `json:"node"`
+	// To defines the destination node for path and component queries
+	To string `json:"to,omitempty"`
+	// Connected indicates if the node and the destination node are connected
+	Connected *bool `json:"connected,omitempty"`
+	// Nodes defines the nodes in the result
+	Nodes []string `json:"nodes"`
+	// Edges defines the edges in the result, for path queries the edges are ordered from node to destination
+	Edges []Edge `json:"edges"`
+	// Components defines the connected components of the graph
+	Components [][]string `json:"components,omitempty"`
+}
+
+// WriteDOT renders the result in Graphviz DOT format
+func (r *Result) WriteDOT(w io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString("graph topology {\n")
+	if len(r.Components) != 0 {
+		for i, component := range r.Components {
+			sb.WriteString(fmt.Sprintf("  subgraph cluster_%d {\n", i))
+			for _, node := range component {
+				sb.WriteString(fmt.Sprintf("    %q;\n", node))
+			}
+			sb.WriteString("  }\n")
+		}
+	} else {
+		for _, node := range r.Nodes {
+			sb.WriteString(fmt.Sprintf("  %q;\n", node))
+		}
+	}
+	for _, edge := range r.Edges {
+		attrs := []string{
+			fmt.Sprintf("label=%q", edge.Name),
+			fmt.Sprintf("taillabel=%q", edge.Local.Endpoint),
+			fmt.Sprintf("headlabel=%q", edge.Remote.Endpoint),
+		}
+		if edge.Kind == EdgeKindLinkSet {
+			attrs = append(attrs, "style=dashed")
+		}
+		sb.WriteString(fmt.Sprintf("  %q -- %q [%s];\n", edge.Local.Node, edge.Remote.Node, strings.Join(attrs, ", ")))
+	}
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
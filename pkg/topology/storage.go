@@ -0,0 +1,101 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/henderiw/apiserver-store/pkg/generic/registry"
+	"github.com/kuidio/kuid/apis/infra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Storage provides access to the infra stores the topology graph and the location
+// queries are built from. The stores are added once the apiserver storage is initialized.
+type Storage struct {
+	m      sync.RWMutex
+	stores map[string]*registry.Store
+}
+
+func NewStorage() *Storage {
+	return &Storage{
+		stores: map[string]*registry.Store{},
+	}
+}
+
+// AddStore adds the store of a resource, the resource is identified by its plural name
+func (r *Storage) AddStore(resource string, store *registry.Store) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.stores[resource] = store
+}
+
+// BuildGraph builds the topology graph of the namespace in the context
+func (r *Storage) BuildGraph(ctx context.Context) (*Graph, error) {
+	nodes, err := List[*infra.Node](ctx, r, infra.NodePlural)
+	if err != nil {
+		return nil, err
+	}
+	endpoints, err := List[*infra.Endpoint](ctx, r, infra.EndpointPlural)
+	if err != nil {
+		return nil, err
+	}
+	links, err := List[*infra.Link](ctx, r, infra.LinkPlural)
+	if err != nil {
+		return nil, err
+	}
+	linkSets, err := List[*infra.LinkSet](ctx, r, infra.LinkSetPlural)
+	if err != nil {
+		return nil, err
+	}
+	endpointSets, err := List[*infra.EndpointSet](ctx, r, infra.EndpointSetPlural)
+	if err != nil {
+		return nil, err
+	}
+	return NewGraph(nodes, endpoints, links, linkSets, endpointSets), nil
+}
+
+// List returns the objects of the resource in the namespace of the context
+func List[T runtime.Object](ctx context.Context, r *Storage, resource string) ([]T, error) {
+	r.m.RLock()
+	store, ok := r.stores[resource]
+	r.m.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("topology storage for %s not initialized", resource)
+	}
+	list, err := store.List(ctx, &internalversion.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]T, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(T)
+		if !ok {
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}